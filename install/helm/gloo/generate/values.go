@@ -234,9 +234,15 @@ type Settings struct {
 	// NOTE: DevMode is deprecated. See https://docs.solo.io/gloo-edge/latest/operations/debugging_gloo/#debugging-the-control-plane for more details.
 	DevMode       *bool         `json:"devMode,omitempty" desc:"Whether or not to enable dev mode. Defaults to false. Setting to true at install time will expose the gloo dev admin endpoint on port 10010. Not recommended for production. Warning: this value is deprecated as of 1.17 and will be removed in a future release."`
 	SecretOptions SecretOptions `json:"secretOptions,omitempty" desc:"Options for how Gloo Edge should handle secrets."`
+	XdsTls        *XdsTls       `json:"xdsTls,omitempty" desc:"Require the gateway proxies to connect to the Gloo Edge xDS server with mTLS. Each gateway proxy must then be given a client certificate with gatewayProxies.NAME.xdsTls.secretName. Not supported with global.glooMtls, kubeGateway, ingress or knative proxies."`
 	*KubeResourceOverride
 }
 
+type XdsTls struct {
+	SecretName  *string `json:"secretName,omitempty" desc:"The name of the kubernetes.io/tls Secret, in the install namespace, mounted into the gloo pod, whose tls.crt and tls.key are served by the xDS server, and whose ca.crt is the CA bundle that the client certificates of the gateway proxies must be signed by. The xDS server requires mTLS when it is set."`
+	TrustDomain *string `json:"trustDomain,omitempty" desc:"Restricts the SPIFFE IDs of the client certificates of the gateway proxies to a trust domain, such as cluster.local."`
+}
+
 type AwsSettings struct {
 	EnableCredentialsDiscovery      *bool     `json:"enableCredentialsDiscovery,omitempty" desc:"Enable AWS credentials discovery in Envoy for lambda requests. If enableServiceAccountCredentials is also set, it will take precedence as only one may be enabled in Gloo Edge"`
 	EnableServiceAccountCredentials *bool     `json:"enableServiceAccountCredentials,omitempty" desc:"Use ServiceAccount credentials to authenticate lambda requests. If enableCredentialsDiscovery is also set, this will take precedence as only one may be enabled in Gloo Edge"`
@@ -612,6 +618,7 @@ type GatewayProxy struct {
 	EnvoyStatsConfig               map[string]interface{}           `json:"envoyStatsConfig,omitempty" desc:"Envoy statistics configuration, such as tagging. For more info, see https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/metrics/v3/stats.proto#config-metrics-v3-statsconfig"`
 	XdsServiceAddress              *string                          `json:"xdsServiceAddress,omitempty" desc:"The k8s service name for the xds server. Defaults to gloo."`
 	XdsServicePort                 *uint32                          `json:"xdsServicePort,omitempty" desc:"The k8s service port for the xds server. Defaults to the value from .Values.gloo.deployment.xdsPort, but can be overridden to use, for example, xds-relay."`
+	XdsTls                         *GatewayProxyXdsTls              `json:"xdsTls,omitempty" desc:"The client certificate that the gateway proxy presents to the xDS server when settings.xdsTls.secretName is set."`
 	TcpKeepaliveTimeSeconds        *uint32                          `json:"tcpKeepaliveTimeSeconds,omitempty" desc:"The amount of time in seconds for connections to be idle before sending keep-alive probes. Defaults to 60. See here: https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/core/v3/address.proto#envoy-v3-api-msg-config-core-v3-tcpkeepalive"`
 	DisableCoreDumps               *bool                            `json:"disableCoreDumps,omitempty" desc:"If set to true, Envoy will not generate core dumps in the event of a crash. Defaults to false"`
 	DisableExtauthSidecar          *bool                            `json:"disableExtauthSidecar,omitempty" desc:"If set to true, this gateway proxy will not come up with an extauth sidecar container when global.extAuth.envoySidecar is enabled. This setting has no effect otherwise. Defaults to false"`
@@ -709,6 +716,10 @@ type GracefulShutdownSpec struct {
 	SleepTimeSeconds *int  `json:"sleepTimeSeconds,omitempty" desc:"Time (in seconds) for the preStop hook to wait before allowing Envoy to terminate"`
 }
 
+type GatewayProxyXdsTls struct {
+	SecretName *string `json:"secretName,omitempty" desc:"The name of the kubernetes.io/tls Secret, in the namespace of the gateway proxy, mounted into its pods, whose tls.crt and tls.key are presented to the xDS server, and whose ca.crt is the CA bundle that the certificate of the xDS server must be signed by. The certificate must authorize the role of the proxy, such as with the SPIFFE ID of its namespace."`
+}
+
 type GatewayProxyService struct {
 	Type                     *string               "json:\"type,omitempty\" desc:\"gateway [service type](https://kubernetes.io/docs/concepts/services-networking/service/#publishing-services-service-types). default is `LoadBalancer`\""
 	HttpPort                 *int                  `json:"httpPort,omitempty" desc:"HTTP port for the gateway service"`
@@ -756,6 +756,104 @@ spec:
 				})
 			})
 
+			Context("xds tls settings", func() {
+				var (
+					xdsTlsSecretVolume = func(secretName string) corev1.Volume {
+						return corev1.Volume{
+							Name: "xds-tls",
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{
+									SecretName:  secretName,
+									DefaultMode: proto.Int32(420),
+								},
+							},
+						}
+					}
+
+					xdsTlsVolumeMount = func(mountPath string) corev1.VolumeMount {
+						return corev1.VolumeMount{
+							Name:      "xds-tls",
+							MountPath: mountPath,
+							ReadOnly:  true,
+						}
+					}
+				)
+
+				It("should mount the secrets in the Gloo and Gateway-Proxy Deployments and configure the xDS server and cluster with them", func() {
+					prepareMakefile(namespace, glootestutils.HelmValues{
+						ValuesArgs: []string{
+							"settings.xdsTls.secretName=gloo-xds-tls",
+							"settings.xdsTls.trustDomain=cluster.local",
+							"gatewayProxies.gatewayProxy.xdsTls.secretName=gateway-proxy-xds-tls",
+						},
+					})
+
+					testManifest.SelectResources(func(resource *unstructured.Unstructured) bool {
+						return resource.GetKind() == "Settings"
+					}).ExpectAll(func(settings *unstructured.Unstructured) {
+						Expect(getFieldFromUnstructured(settings, "spec", "gloo", "xdsTls")).To(Equal(map[string]interface{}{
+							"certFile":    "/etc/xds-tls/tls.crt",
+							"keyFile":     "/etc/xds-tls/tls.key",
+							"caFile":      "/etc/xds-tls/ca.crt",
+							"trustDomain": "cluster.local",
+						}))
+					})
+
+					testManifest.SelectResources(func(resource *unstructured.Unstructured) bool {
+						return resource.GetKind() == "Deployment"
+					}).ExpectAll(func(deployment *unstructured.Unstructured) {
+						deploymentObject, err := kuberesource.ConvertUnstructured(deployment)
+						Expect(err).NotTo(HaveOccurred(), fmt.Sprintf("Deployment %+v should be able to convert from unstructured", deployment))
+						structuredDeployment, ok := deploymentObject.(*appsv1.Deployment)
+						Expect(ok).To(BeTrue(), fmt.Sprintf("Deployment %+v should be able to cast to a structured deployment", deployment))
+
+						if structuredDeployment.GetName() == "gloo" {
+							Expect(structuredDeployment.Spec.Template.Spec.Volumes).To(ContainElement(xdsTlsSecretVolume("gloo-xds-tls")))
+							Expect(structuredDeployment.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(xdsTlsVolumeMount("/etc/xds-tls")))
+						}
+
+						if structuredDeployment.GetName() == "gateway-proxy" {
+							Expect(structuredDeployment.Spec.Template.Spec.Volumes).To(ContainElement(xdsTlsSecretVolume("gateway-proxy-xds-tls")))
+							Expect(structuredDeployment.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(xdsTlsVolumeMount("/etc/envoy/xds-tls")))
+						}
+					})
+
+					testManifest.SelectResources(func(resource *unstructured.Unstructured) bool {
+						return resource.GetKind() == "ConfigMap" && resource.GetName() == "gateway-proxy-envoy-config"
+					}).ExpectAll(func(configMap *unstructured.Unstructured) {
+						configMapObject, err := kuberesource.ConvertUnstructured(configMap)
+						Expect(err).NotTo(HaveOccurred(), fmt.Sprintf("ConfigMap %+v should be able to convert from unstructured", configMap))
+						structuredConfigMap, ok := configMapObject.(*corev1.ConfigMap)
+						Expect(ok).To(BeTrue(), fmt.Sprintf("ConfigMap %+v should be able to cast to a structured config map", configMap))
+
+						Expect(structuredConfigMap.Data["envoy.yaml"]).To(ContainSubstring("filename: /etc/envoy/xds-tls/tls.crt"))
+						Expect(structuredConfigMap.Data["envoy.yaml"]).To(ContainSubstring("filename: /etc/envoy/xds-tls/ca.crt"))
+					})
+				})
+
+				It("errors rendering when a gateway proxy has no client certificate", func() {
+					expectRenderError(namespace, glootestutils.HelmValues{
+						ValuesArgs: []string{"settings.xdsTls.secretName=gloo-xds-tls"},
+					})
+
+					Expect(renderErr).To(HaveOccurred())
+					Expect(renderErr.Error()).To(ContainSubstring("gatewayProxies.gatewayProxy.xdsTls.secretName must be set if and only if settings.xdsTls.secretName is"))
+				})
+
+				It("errors rendering with gloo mtls", func() {
+					expectRenderError(namespace, glootestutils.HelmValues{
+						ValuesArgs: []string{
+							"global.glooMtls.enabled=true",
+							"settings.xdsTls.secretName=gloo-xds-tls",
+							"gatewayProxies.gatewayProxy.xdsTls.secretName=gateway-proxy-xds-tls",
+						},
+					})
+
+					Expect(renderErr).To(HaveOccurred())
+					Expect(renderErr.Error()).To(ContainSubstring("settings.xdsTls is not supported with global.glooMtls, kubeGateway, ingress or knative proxies"))
+				})
+			})
+
 			Context("gloo mtls settings", func() {
 				var (
 					glooMtlsSecretVolume = corev1.Volume{
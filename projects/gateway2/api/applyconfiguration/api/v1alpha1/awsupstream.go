@@ -9,8 +9,11 @@ import (
 // AwsUpstreamApplyConfiguration represents a declarative configuration of the AwsUpstream type for use
 // with apply.
 type AwsUpstreamApplyConfiguration struct {
-	Region    *string                  `json:"region,omitempty"`
-	SecretRef *v1.LocalObjectReference `json:"secretRef,omitempty"`
+	Region              *string                      `json:"region,omitempty"`
+	SecretRef           *v1.LocalObjectReference     `json:"secretRef,omitempty"`
+	RoleArn             *string                      `json:"roleArn,omitempty"`
+	DisableRoleChaining *bool                        `json:"disableRoleChaining,omitempty"`
+	Lambda              *AwsLambdaApplyConfiguration `json:"lambda,omitempty"`
 }
 
 // AwsUpstreamApplyConfiguration constructs a declarative configuration of the AwsUpstream type for use with
@@ -34,3 +37,27 @@ func (b *AwsUpstreamApplyConfiguration) WithSecretRef(value v1.LocalObjectRefere
 	b.SecretRef = &value
 	return b
 }
+
+// WithRoleArn sets the RoleArn field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RoleArn field is set to the value of the last call.
+func (b *AwsUpstreamApplyConfiguration) WithRoleArn(value string) *AwsUpstreamApplyConfiguration {
+	b.RoleArn = &value
+	return b
+}
+
+// WithDisableRoleChaining sets the DisableRoleChaining field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisableRoleChaining field is set to the value of the last call.
+func (b *AwsUpstreamApplyConfiguration) WithDisableRoleChaining(value bool) *AwsUpstreamApplyConfiguration {
+	b.DisableRoleChaining = &value
+	return b
+}
+
+// WithLambda sets the Lambda field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Lambda field is set to the value of the last call.
+func (b *AwsUpstreamApplyConfiguration) WithLambda(value *AwsLambdaApplyConfiguration) *AwsUpstreamApplyConfiguration {
+	b.Lambda = value
+	return b
+}
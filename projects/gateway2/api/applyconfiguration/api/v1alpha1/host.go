@@ -9,8 +9,12 @@ import (
 // HostApplyConfiguration represents a declarative configuration of the Host type for use
 // with apply.
 type HostApplyConfiguration struct {
-	Host *string        `json:"host,omitempty"`
-	Port *v1.PortNumber `json:"port,omitempty"`
+	Host            *string                     `json:"host,omitempty"`
+	Port            *v1.PortNumber              `json:"port,omitempty"`
+	SniAddr         *string                     `json:"sniAddr,omitempty"`
+	Weight          *uint32                     `json:"weight,omitempty"`
+	Locality        *LocalityApplyConfiguration `json:"locality,omitempty"`
+	HealthCheckPath *string                     `json:"healthCheckPath,omitempty"`
 }
 
 // HostApplyConfiguration constructs a declarative configuration of the Host type for use with
@@ -34,3 +38,35 @@ func (b *HostApplyConfiguration) WithPort(value v1.PortNumber) *HostApplyConfigu
 	b.Port = &value
 	return b
 }
+
+// WithSniAddr sets the SniAddr field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SniAddr field is set to the value of the last call.
+func (b *HostApplyConfiguration) WithSniAddr(value string) *HostApplyConfiguration {
+	b.SniAddr = &value
+	return b
+}
+
+// WithWeight sets the Weight field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Weight field is set to the value of the last call.
+func (b *HostApplyConfiguration) WithWeight(value uint32) *HostApplyConfiguration {
+	b.Weight = &value
+	return b
+}
+
+// WithLocality sets the Locality field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Locality field is set to the value of the last call.
+func (b *HostApplyConfiguration) WithLocality(value *LocalityApplyConfiguration) *HostApplyConfiguration {
+	b.Locality = value
+	return b
+}
+
+// WithHealthCheckPath sets the HealthCheckPath field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HealthCheckPath field is set to the value of the last call.
+func (b *HostApplyConfiguration) WithHealthCheckPath(value string) *HostApplyConfiguration {
+	b.HealthCheckPath = &value
+	return b
+}
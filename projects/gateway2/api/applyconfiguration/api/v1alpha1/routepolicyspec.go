@@ -7,6 +7,7 @@ package v1alpha1
 type RoutePolicySpecApplyConfiguration struct {
 	TargetRef *LocalPolicyTargetReferenceApplyConfiguration `json:"targetRef,omitempty"`
 	Timeout   *int                                          `json:"timeout,omitempty"`
+	ExtProc   *ExtProcPolicyApplyConfiguration              `json:"extProc,omitempty"`
 }
 
 // RoutePolicySpecApplyConfiguration constructs a declarative configuration of the RoutePolicySpec type for use with
@@ -30,3 +31,11 @@ func (b *RoutePolicySpecApplyConfiguration) WithTimeout(value int) *RoutePolicyS
 	b.Timeout = &value
 	return b
 }
+
+// WithExtProc sets the ExtProc field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExtProc field is set to the value of the last call.
+func (b *RoutePolicySpecApplyConfiguration) WithExtProc(value *ExtProcPolicyApplyConfiguration) *RoutePolicySpecApplyConfiguration {
+	b.ExtProc = value
+	return b
+}
@@ -2,10 +2,17 @@
 
 package v1alpha1
 
+import (
+	apiv1alpha1 "github.com/solo-io/gloo/projects/gateway2/api/v1alpha1"
+)
+
 // StaticUpstreamApplyConfiguration represents a declarative configuration of the StaticUpstream type for use
 // with apply.
 type StaticUpstreamApplyConfiguration struct {
-	Hosts []HostApplyConfiguration `json:"hosts,omitempty"`
+	Hosts          []HostApplyConfiguration `json:"hosts,omitempty"`
+	UseTls         *bool                    `json:"useTls,omitempty"`
+	AutoSniRewrite *bool                    `json:"autoSniRewrite,omitempty"`
+	DnsMode        *apiv1alpha1.DnsMode     `json:"dnsMode,omitempty"`
 }
 
 // StaticUpstreamApplyConfiguration constructs a declarative configuration of the StaticUpstream type for use with
@@ -26,3 +33,27 @@ func (b *StaticUpstreamApplyConfiguration) WithHosts(values ...*HostApplyConfigu
 	}
 	return b
 }
+
+// WithUseTls sets the UseTls field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UseTls field is set to the value of the last call.
+func (b *StaticUpstreamApplyConfiguration) WithUseTls(value bool) *StaticUpstreamApplyConfiguration {
+	b.UseTls = &value
+	return b
+}
+
+// WithAutoSniRewrite sets the AutoSniRewrite field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AutoSniRewrite field is set to the value of the last call.
+func (b *StaticUpstreamApplyConfiguration) WithAutoSniRewrite(value bool) *StaticUpstreamApplyConfiguration {
+	b.AutoSniRewrite = &value
+	return b
+}
+
+// WithDnsMode sets the DnsMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DnsMode field is set to the value of the last call.
+func (b *StaticUpstreamApplyConfiguration) WithDnsMode(value apiv1alpha1.DnsMode) *StaticUpstreamApplyConfiguration {
+	b.DnsMode = &value
+	return b
+}
@@ -5,8 +5,9 @@ package v1alpha1
 // UpstreamSpecApplyConfiguration represents a declarative configuration of the UpstreamSpec type for use
 // with apply.
 type UpstreamSpecApplyConfiguration struct {
-	Aws    *AwsUpstreamApplyConfiguration    `json:"aws,omitempty"`
-	Static *StaticUpstreamApplyConfiguration `json:"static,omitempty"`
+	Aws                 *AwsUpstreamApplyConfiguration                 `json:"aws,omitempty"`
+	Static              *StaticUpstreamApplyConfiguration              `json:"static,omitempty"`
+	DynamicForwardProxy *DynamicForwardProxyUpstreamApplyConfiguration `json:"dynamicForwardProxy,omitempty"`
 }
 
 // UpstreamSpecApplyConfiguration constructs a declarative configuration of the UpstreamSpec type for use with
@@ -30,3 +31,11 @@ func (b *UpstreamSpecApplyConfiguration) WithStatic(value *StaticUpstreamApplyCo
 	b.Static = value
 	return b
 }
+
+// WithDynamicForwardProxy sets the DynamicForwardProxy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DynamicForwardProxy field is set to the value of the last call.
+func (b *UpstreamSpecApplyConfiguration) WithDynamicForwardProxy(value *DynamicForwardProxyUpstreamApplyConfiguration) *UpstreamSpecApplyConfiguration {
+	b.DynamicForwardProxy = value
+	return b
+}
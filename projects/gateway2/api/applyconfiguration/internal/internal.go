@@ -62,16 +62,39 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: com.github.solo-io.gloo.projects.gateway2.api.v1alpha1.CustomLabel
           elementRelationship: atomic
+- name: com.github.solo-io.gloo.projects.gateway2.api.v1alpha1.AwsLambda
+  map:
+    fields:
+    - name: functionName
+      type:
+        scalar: string
+    - name: invocationMode
+      type:
+        scalar: string
+    - name: qualifier
+      type:
+        scalar: string
+    - name: responseMode
+      type:
+        scalar: string
 - name: com.github.solo-io.gloo.projects.gateway2.api.v1alpha1.AwsUpstream
   map:
     fields:
+    - name: disableRoleChaining
+      type:
+        scalar: boolean
+    - name: lambda
+      type:
+        namedType: com.github.solo-io.gloo.projects.gateway2.api.v1alpha1.AwsLambda
     - name: region
       type:
         scalar: string
+    - name: roleArn
+      type:
+        scalar: string
     - name: secretRef
       type:
         namedType: io.k8s.api.core.v1.LocalObjectReference
-      default: {}
 - name: com.github.solo-io.gloo.projects.gateway2.api.v1alpha1.CustomLabel
   map:
     fields:
@@ -132,6 +155,21 @@ var schemaYAML = typed.YAMLObject(`types:
         elementType:
           namedType: __untyped_deduced_
         elementRelationship: separable
+- name: com.github.solo-io.gloo.projects.gateway2.api.v1alpha1.DynamicForwardProxyUpstream
+  map:
+    fields:
+    - name: dnsRefreshRate
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: hostTtl
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: maxHosts
+      type:
+        scalar: numeric
+    - name: useTls
+      type:
+        scalar: boolean
 - name: com.github.solo-io.gloo.projects.gateway2.api.v1alpha1.EnvoyBootstrap
   map:
     fields:
@@ -158,6 +196,36 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: securityContext
       type:
         namedType: io.k8s.api.core.v1.SecurityContext
+- name: com.github.solo-io.gloo.projects.gateway2.api.v1alpha1.ExtProcPolicy
+  map:
+    fields:
+    - name: disable
+      type:
+        scalar: boolean
+    - name: processingMode
+      type:
+        namedType: com.github.solo-io.gloo.projects.gateway2.api.v1alpha1.ExtProcProcessingMode
+- name: com.github.solo-io.gloo.projects.gateway2.api.v1alpha1.ExtProcProcessingMode
+  map:
+    fields:
+    - name: requestBodyMode
+      type:
+        scalar: string
+    - name: requestHeaderMode
+      type:
+        scalar: string
+    - name: requestTrailerMode
+      type:
+        scalar: string
+    - name: responseBodyMode
+      type:
+        scalar: string
+    - name: responseHeaderMode
+      type:
+        scalar: string
+    - name: responseTrailerMode
+      type:
+        scalar: string
 - name: com.github.solo-io.gloo.projects.gateway2.api.v1alpha1.GatewayParameters
   map:
     fields:
@@ -212,14 +280,26 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.solo-io.gloo.projects.gateway2.api.v1alpha1.Host
   map:
     fields:
+    - name: healthCheckPath
+      type:
+        scalar: string
     - name: host
       type:
         scalar: string
       default: ""
+    - name: locality
+      type:
+        namedType: com.github.solo-io.gloo.projects.gateway2.api.v1alpha1.Locality
     - name: port
       type:
         scalar: numeric
       default: 0
+    - name: sniAddr
+      type:
+        scalar: string
+    - name: weight
+      type:
+        scalar: numeric
 - name: com.github.solo-io.gloo.projects.gateway2.api.v1alpha1.HttpListenerPolicy
   map:
     fields:
@@ -384,6 +464,18 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: com.github.solo-io.gloo.projects.gateway2.api.v1alpha1.Locality
+  map:
+    fields:
+    - name: region
+      type:
+        scalar: string
+    - name: subZone
+      type:
+        scalar: string
+    - name: zone
+      type:
+        scalar: string
 - name: com.github.solo-io.gloo.projects.gateway2.api.v1alpha1.Pod
   map:
     fields:
@@ -498,6 +590,9 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.solo-io.gloo.projects.gateway2.api.v1alpha1.RoutePolicySpec
   map:
     fields:
+    - name: extProc
+      type:
+        namedType: com.github.solo-io.gloo.projects.gateway2.api.v1alpha1.ExtProcPolicy
     - name: targetRef
       type:
         namedType: com.github.solo-io.gloo.projects.gateway2.api.v1alpha1.LocalPolicyTargetReference
@@ -573,12 +668,21 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.solo-io.gloo.projects.gateway2.api.v1alpha1.StaticUpstream
   map:
     fields:
+    - name: autoSniRewrite
+      type:
+        scalar: boolean
+    - name: dnsMode
+      type:
+        scalar: string
     - name: hosts
       type:
         list:
           elementType:
             namedType: com.github.solo-io.gloo.projects.gateway2.api.v1alpha1.Host
           elementRelationship: atomic
+    - name: useTls
+      type:
+        scalar: boolean
 - name: com.github.solo-io.gloo.projects.gateway2.api.v1alpha1.StatsConfig
   map:
     fields:
@@ -621,6 +725,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: aws
       type:
         namedType: com.github.solo-io.gloo.projects.gateway2.api.v1alpha1.AwsUpstream
+    - name: dynamicForwardProxy
+      type:
+        namedType: com.github.solo-io.gloo.projects.gateway2.api.v1alpha1.DynamicForwardProxyUpstream
     - name: static
       type:
         namedType: com.github.solo-io.gloo.projects.gateway2.api.v1alpha1.StaticUpstream
@@ -1457,6 +1564,8 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+  scalar: string
 - name: io.k8s.apimachinery.pkg.apis.meta.v1.FieldsV1
   map:
     elementType:
@@ -20,6 +20,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.AiExtensionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AiExtensionStats"):
 		return &apiv1alpha1.AiExtensionStatsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AwsLambda"):
+		return &apiv1alpha1.AwsLambdaApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AwsUpstream"):
 		return &apiv1alpha1.AwsUpstreamApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CustomLabel"):
@@ -28,10 +30,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.DirectResponseApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DirectResponseSpec"):
 		return &apiv1alpha1.DirectResponseSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DynamicForwardProxyUpstream"):
+		return &apiv1alpha1.DynamicForwardProxyUpstreamApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("EnvoyBootstrap"):
 		return &apiv1alpha1.EnvoyBootstrapApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("EnvoyContainer"):
 		return &apiv1alpha1.EnvoyContainerApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ExtProcPolicy"):
+		return &apiv1alpha1.ExtProcPolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ExtProcProcessingMode"):
+		return &apiv1alpha1.ExtProcProcessingModeApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("GatewayParameters"):
 		return &apiv1alpha1.GatewayParametersApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("GatewayParametersSpec"):
@@ -56,6 +64,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.ListenerPolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ListenerPolicySpec"):
 		return &apiv1alpha1.ListenerPolicySpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Locality"):
+		return &apiv1alpha1.LocalityApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("LocalPolicyTargetReference"):
 		return &apiv1alpha1.LocalPolicyTargetReferenceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Pod"):
@@ -32,4 +32,64 @@ type RoutePolicySpec struct {
 	TargetRef LocalPolicyTargetReference `json:"targetRef,omitempty"`
 	// +kubebuilder:validation:Minimum=1
 	Timeout int `json:"timeout,omitempty"`
+
+	// Configures the external processing (ext_proc) filter on the targeted
+	// routes. The filter itself, and the gRPC service it calls, are configured
+	// by the extProc field of the Settings.
+	//
+	// +optional
+	ExtProc *ExtProcPolicy `json:"extProc,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:message="processingMode cannot be set when disable is true",rule="!has(self.disable) || !self.disable || !has(self.processingMode)"
+type ExtProcPolicy struct {
+	// Do not send the requests of the targeted routes to the external processor.
+	//
+	// +optional
+	Disable bool `json:"disable,omitempty"`
+
+	// Overrides which parts of the requests and responses of the targeted
+	// routes are sent to the external processor.
+	//
+	// +optional
+	ProcessingMode *ExtProcProcessingMode `json:"processingMode,omitempty"`
+}
+
+type ExtProcProcessingMode struct {
+	// +optional
+	RequestHeaderMode ExtProcHeaderSendMode `json:"requestHeaderMode,omitempty"`
+	// +optional
+	ResponseHeaderMode ExtProcHeaderSendMode `json:"responseHeaderMode,omitempty"`
+	// +optional
+	RequestBodyMode ExtProcBodySendMode `json:"requestBodyMode,omitempty"`
+	// +optional
+	ResponseBodyMode ExtProcBodySendMode `json:"responseBodyMode,omitempty"`
+	// +optional
+	RequestTrailerMode ExtProcHeaderSendMode `json:"requestTrailerMode,omitempty"`
+	// +optional
+	ResponseTrailerMode ExtProcHeaderSendMode `json:"responseTrailerMode,omitempty"`
 }
+
+// +kubebuilder:validation:Enum=Default;Send;Skip
+type ExtProcHeaderSendMode string
+
+const (
+	// Use the mode of the filter.
+	ExtProcHeaderSendModeDefault ExtProcHeaderSendMode = "Default"
+	ExtProcHeaderSendModeSend    ExtProcHeaderSendMode = "Send"
+	ExtProcHeaderSendModeSkip    ExtProcHeaderSendMode = "Skip"
+)
+
+// +kubebuilder:validation:Enum=None;Streamed;Buffered;BufferedPartial
+type ExtProcBodySendMode string
+
+const (
+	// Do not send the body.
+	ExtProcBodySendModeNone ExtProcBodySendMode = "None"
+	// Stream the body to the processor as it arrives.
+	ExtProcBodySendModeStreamed ExtProcBodySendMode = "Streamed"
+	// Buffer the whole body, up to the buffer limit, and send it at once.
+	ExtProcBodySendModeBuffered ExtProcBodySendMode = "Buffered"
+	// Buffer the body up to the buffer limit, and send what was buffered.
+	ExtProcBodySendModeBufferedPartial ExtProcBodySendMode = "BufferedPartial"
+)
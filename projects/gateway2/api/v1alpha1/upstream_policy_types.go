@@ -29,26 +29,200 @@ type UpstreamList struct {
 	Items           []Upstream `json:"items"`
 }
 
-// +kubebuilder:validation:XValidation:message="There must one and only one upstream type set",rule="1 == (self.aws != null?1:0) + (self.static != null?1:0)"
+// +kubebuilder:validation:XValidation:message="There must one and only one upstream type set",rule="1 == (self.aws != null?1:0) + (self.static != null?1:0) + (self.dynamicForwardProxy != null?1:0)"
 type UpstreamSpec struct {
-	Aws    *AwsUpstream    `json:"aws,omitempty"`
-	Static *StaticUpstream `json:"static,omitempty"`
+	Aws                 *AwsUpstream                 `json:"aws,omitempty"`
+	Static              *StaticUpstream              `json:"static,omitempty"`
+	DynamicForwardProxy *DynamicForwardProxyUpstream `json:"dynamicForwardProxy,omitempty"`
 }
 type AwsUpstream struct {
-	Region    string                      `json:"region,omitempty"`
-	SecretRef corev1.LocalObjectReference `json:"secretRef,omitempty"`
+	Region string `json:"region,omitempty"`
+
+	// A secret with static credentials, in the accessKey, secretKey and
+	// (optionally) sessionToken keys. When unset, the credentials are taken
+	// from the AWS options in the Settings: either credentials discovery or
+	// service account credentials (IRSA).
+	//
+	// +optional
+	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
+
+	// The ARN of an IAM role to assume when invoking functions. With service
+	// account credentials, the role is assumed using the role of the service
+	// account, unless disableRoleChaining is set.
+	//
+	// +optional
+	RoleArn *string `json:"roleArn,omitempty"`
+
+	// Assume roleArn directly with the web identity token of the service
+	// account, instead of chaining it through the role of the service account.
+	//
+	// +optional
+	DisableRoleChaining *bool `json:"disableRoleChaining,omitempty"`
+
+	// How routes to this upstream invoke Lambda functions. A route can select
+	// another function with a gloo.solo.io/Parameter extensionRef filter on
+	// its backendRef, whose name is the function name, optionally followed by
+	// ":" and a qualifier.
+	//
+	// +optional
+	Lambda *AwsLambda `json:"lambda,omitempty"`
 }
+
+type AwsLambda struct {
+	// The function invoked by routes that don't select one.
+	//
+	// +optional
+	FunctionName *string `json:"functionName,omitempty"`
+
+	// The version or alias of the function to invoke. Defaults to $LATEST.
+	//
+	// +optional
+	Qualifier *string `json:"qualifier,omitempty"`
+
+	// Defaults to Sync.
+	//
+	// +optional
+	InvocationMode *AwsLambdaInvocationMode `json:"invocationMode,omitempty"`
+
+	// How the response of the function is turned into the HTTP response.
+	// Defaults to Passthrough.
+	//
+	// +optional
+	ResponseMode *AwsLambdaResponseMode `json:"responseMode,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Sync;Async
+type AwsLambdaInvocationMode string
+
+const (
+	AwsLambdaInvocationModeSync  AwsLambdaInvocationMode = "Sync"
+	AwsLambdaInvocationModeAsync AwsLambdaInvocationMode = "Async"
+)
+
+// +kubebuilder:validation:Enum=Passthrough;UnwrapAsAlb;UnwrapAsApiGateway
+type AwsLambdaResponseMode string
+
+const (
+	// The function's response is the response body.
+	AwsLambdaResponseModePassthrough AwsLambdaResponseMode = "Passthrough"
+	// The function's response is a JSON object with a status code, headers
+	// and a body, as returned to an AWS Application Load Balancer.
+	AwsLambdaResponseModeUnwrapAsAlb AwsLambdaResponseMode = "UnwrapAsAlb"
+	// The function's response is a JSON object with a status code, headers
+	// and a body, as returned to an AWS API Gateway.
+	AwsLambdaResponseModeUnwrapAsApiGateway AwsLambdaResponseMode = "UnwrapAsApiGateway"
+)
+
+// +kubebuilder:validation:XValidation:message="LogicalDns requires exactly one host",rule="!has(self.dnsMode) || self.dnsMode != 'LogicalDns' || (has(self.hosts) && size(self.hosts) == 1)"
 type StaticUpstream struct {
 	Hosts []Host `json:"hosts,omitempty"`
+
+	// Use TLS when connecting to the hosts. When unset, TLS is used if any of
+	// the hosts uses port 443.
+	//
+	// +optional
+	UseTls *bool `json:"useTls,omitempty"`
+
+	// When TLS is used, set the SNI of each host to its address (or to its
+	// sniAddr, if set). Defaults to true.
+	//
+	// +optional
+	AutoSniRewrite *bool `json:"autoSniRewrite,omitempty"`
+
+	// How Envoy resolves hosts that are DNS names. Defaults to StrictDns.
+	// Ignored if all hosts are IP addresses.
+	//
+	// +optional
+	DnsMode *DnsMode `json:"dnsMode,omitempty"`
 }
 
+// +kubebuilder:validation:Enum=StrictDns;LogicalDns
+type DnsMode string
+
+const (
+	// Envoy continuously resolves the hosts, and load balances across all
+	// the returned addresses.
+	DnsModeStrict DnsMode = "StrictDns"
+	// Envoy only uses the first address returned by DNS. Suitable for large
+	// web services that return many addresses with a short TTL.
+	DnsModeLogical DnsMode = "LogicalDns"
+)
+
 type Host struct {
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=253
 	Host string          `json:"host"`
 	Port gwv1.PortNumber `json:"port"`
+
+	// SNI to use for this host when TLS is used. Overrides autoSniRewrite.
+	//
+	// +optional
+	SniAddr *string `json:"sniAddr,omitempty"`
+
+	// The load balancing weight of this host, relative to the other hosts.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Weight *uint32 `json:"weight,omitempty"`
+
+	// The locality of this host. Hosts in the same locality are grouped
+	// together for locality aware load balancing.
+	//
+	// +optional
+	Locality *Locality `json:"locality,omitempty"`
+
+	// Path used by active HTTP health checks for this host.
+	//
+	// +optional
+	HealthCheckPath *string `json:"healthCheckPath,omitempty"`
 }
 
+type Locality struct {
+	// +optional
+	Region string `json:"region,omitempty"`
+	// +optional
+	Zone string `json:"zone,omitempty"`
+	// +optional
+	SubZone string `json:"subZone,omitempty"`
+}
+
+// A DynamicForwardProxyUpstream routes to the host in the request's
+// authority, resolving it with DNS at request time. This allows HTTPRoutes to
+// reach arbitrary external hosts.
+type DynamicForwardProxyUpstream struct {
+	// Use TLS when connecting to the resolved hosts. The SNI and the validated
+	// SAN are taken from the request's host.
+	//
+	// +optional
+	UseTls *bool `json:"useTls,omitempty"`
+
+	// How often resolved hosts are refreshed. Defaults to 60s.
+	//
+	// +optional
+	DnsRefreshRate *metav1.Duration `json:"dnsRefreshRate,omitempty"`
+
+	// How long a resolved host may stay unused before it is removed from the
+	// DNS cache. Defaults to 5m.
+	//
+	// +optional
+	HostTtl *metav1.Duration `json:"hostTtl,omitempty"`
+
+	// The maximum number of hosts in the DNS cache. Defaults to 1024.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxHosts *uint32 `json:"maxHosts,omitempty"`
+}
+
+const (
+	// UpstreamConditionAccepted is true when the upstream is valid, and false
+	// with a message describing the problems otherwise.
+	UpstreamConditionAccepted = "Accepted"
+
+	UpstreamReasonAccepted = "Accepted"
+	UpstreamReasonInvalid  = "Invalid"
+)
+
 type UpstreamStatus struct {
 	// +optional
 	// +listType=map
@@ -88,10 +88,64 @@ func (in *AiExtensionStats) DeepCopy() *AiExtensionStats {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AwsLambda) DeepCopyInto(out *AwsLambda) {
+	*out = *in
+	if in.FunctionName != nil {
+		in, out := &in.FunctionName, &out.FunctionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Qualifier != nil {
+		in, out := &in.Qualifier, &out.Qualifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.InvocationMode != nil {
+		in, out := &in.InvocationMode, &out.InvocationMode
+		*out = new(AwsLambdaInvocationMode)
+		**out = **in
+	}
+	if in.ResponseMode != nil {
+		in, out := &in.ResponseMode, &out.ResponseMode
+		*out = new(AwsLambdaResponseMode)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsLambda.
+func (in *AwsLambda) DeepCopy() *AwsLambda {
+	if in == nil {
+		return nil
+	}
+	out := new(AwsLambda)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AwsUpstream) DeepCopyInto(out *AwsUpstream) {
 	*out = *in
-	out.SecretRef = in.SecretRef
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.RoleArn != nil {
+		in, out := &in.RoleArn, &out.RoleArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.DisableRoleChaining != nil {
+		in, out := &in.DisableRoleChaining, &out.DisableRoleChaining
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Lambda != nil {
+		in, out := &in.Lambda, &out.Lambda
+		*out = new(AwsLambda)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsUpstream.
@@ -218,6 +272,41 @@ func (in *DirectResponseStatus) DeepCopy() *DirectResponseStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamicForwardProxyUpstream) DeepCopyInto(out *DynamicForwardProxyUpstream) {
+	*out = *in
+	if in.UseTls != nil {
+		in, out := &in.UseTls, &out.UseTls
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DnsRefreshRate != nil {
+		in, out := &in.DnsRefreshRate, &out.DnsRefreshRate
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.HostTtl != nil {
+		in, out := &in.HostTtl, &out.HostTtl
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxHosts != nil {
+		in, out := &in.MaxHosts, &out.MaxHosts
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicForwardProxyUpstream.
+func (in *DynamicForwardProxyUpstream) DeepCopy() *DynamicForwardProxyUpstream {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamicForwardProxyUpstream)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnvoyBootstrap) DeepCopyInto(out *EnvoyBootstrap) {
 	*out = *in
@@ -280,6 +369,41 @@ func (in *EnvoyContainer) DeepCopy() *EnvoyContainer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtProcPolicy) DeepCopyInto(out *ExtProcPolicy) {
+	*out = *in
+	if in.ProcessingMode != nil {
+		in, out := &in.ProcessingMode, &out.ProcessingMode
+		*out = new(ExtProcProcessingMode)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtProcPolicy.
+func (in *ExtProcPolicy) DeepCopy() *ExtProcPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtProcPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtProcProcessingMode) DeepCopyInto(out *ExtProcProcessingMode) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtProcProcessingMode.
+func (in *ExtProcProcessingMode) DeepCopy() *ExtProcProcessingMode {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtProcProcessingMode)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayParameters) DeepCopyInto(out *GatewayParameters) {
 	*out = *in
@@ -407,6 +531,26 @@ func (in *GracefulShutdownSpec) DeepCopy() *GracefulShutdownSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Host) DeepCopyInto(out *Host) {
 	*out = *in
+	if in.SniAddr != nil {
+		in, out := &in.SniAddr, &out.SniAddr
+		*out = new(string)
+		**out = **in
+	}
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Locality != nil {
+		in, out := &in.Locality, &out.Locality
+		*out = new(Locality)
+		**out = **in
+	}
+	if in.HealthCheckPath != nil {
+		in, out := &in.HealthCheckPath, &out.HealthCheckPath
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Host.
@@ -766,6 +910,21 @@ func (in *LocalPolicyTargetReference) DeepCopy() *LocalPolicyTargetReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Locality) DeepCopyInto(out *Locality) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Locality.
+func (in *Locality) DeepCopy() *Locality {
+	if in == nil {
+		return nil
+	}
+	out := new(Locality)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Pod) DeepCopyInto(out *Pod) {
 	*out = *in
@@ -921,7 +1080,7 @@ func (in *RoutePolicy) DeepCopyInto(out *RoutePolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -979,6 +1138,11 @@ func (in *RoutePolicyList) DeepCopyObject() runtime.Object {
 func (in *RoutePolicySpec) DeepCopyInto(out *RoutePolicySpec) {
 	*out = *in
 	out.TargetRef = in.TargetRef
+	if in.ExtProc != nil {
+		in, out := &in.ExtProc, &out.ExtProc
+		*out = new(ExtProcPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutePolicySpec.
@@ -1135,7 +1299,24 @@ func (in *StaticUpstream) DeepCopyInto(out *StaticUpstream) {
 	if in.Hosts != nil {
 		in, out := &in.Hosts, &out.Hosts
 		*out = make([]Host, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UseTls != nil {
+		in, out := &in.UseTls, &out.UseTls
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutoSniRewrite != nil {
+		in, out := &in.AutoSniRewrite, &out.AutoSniRewrite
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DnsMode != nil {
+		in, out := &in.DnsMode, &out.DnsMode
+		*out = new(DnsMode)
+		**out = **in
 	}
 }
 
@@ -1249,13 +1430,18 @@ func (in *UpstreamSpec) DeepCopyInto(out *UpstreamSpec) {
 	if in.Aws != nil {
 		in, out := &in.Aws, &out.Aws
 		*out = new(AwsUpstream)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Static != nil {
 		in, out := &in.Static, &out.Static
 		*out = new(StaticUpstream)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DynamicForwardProxy != nil {
+		in, out := &in.DynamicForwardProxy, &out.DynamicForwardProxy
+		*out = new(DynamicForwardProxyUpstream)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamSpec.
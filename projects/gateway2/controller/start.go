@@ -30,6 +30,7 @@ import (
 	"github.com/solo-io/gloo/projects/gateway2/utils/krtutil"
 	"github.com/solo-io/gloo/projects/gateway2/wellknown"
 	glookubev1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/kube/apis/gloo.solo.io/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	uzap "go.uber.org/zap"
 	istiokube "istio.io/istio/pkg/kube"
 	"istio.io/istio/pkg/kube/krt"
@@ -51,6 +52,9 @@ type SetupOpts struct {
 
 	XdsHost string
 	XdsPort int32
+
+	// XdsStatuses records the ACKs and NACKs of the proxies, to report the Gateways whose config envoy rejected
+	XdsStatuses *xds.AckTracker
 }
 
 var setupLog = ctrl.Log.WithName("setup")
@@ -158,6 +162,7 @@ func NewControllerBuilder(ctx context.Context, cfg StartConfig) (*ControllerBuil
 		pluginFactoryWithBuiltin(cfg.ExtraPlugins),
 		commoncol,
 		cfg.SetupOpts.Cache,
+		cfg.SetupOpts.XdsStatuses,
 	)
 	proxySyncer.Init(ctx, isOurGw, cfg.KrtOptions)
 	if err := mgr.Add(proxySyncer); err != nil {
@@ -1,6 +1,7 @@
 package endpoints
 
 import (
+	"slices"
 	"sort"
 	"strings"
 
@@ -45,6 +46,10 @@ type LoadBalancingInfo struct {
 type PriorityInfo struct {
 	FailoverPriority *Prioritizer
 	Failover         []*v1alpha3.LocalityLoadBalancerSetting_Failover
+	// prefer the endpoints whose EndpointSlice topology hints include the zone of the proxy.
+	// hints are only used when all the endpoints have them, like kube-proxy does; otherwise
+	// the locality failover is used.
+	ZoneHints bool
 }
 
 type Prioritizer struct {
@@ -101,6 +106,11 @@ func prioritizeWithLbInfo(logger *zap.Logger, ep ir.EndpointsForUpstream, lbInfo
 	cla := &envoy_config_endpoint_v3.ClusterLoadAssignment{
 		ClusterName: ep.ClusterName,
 	}
+	if lbInfo.PriorityInfo != nil && lbInfo.PriorityInfo.ZoneHints && !allHaveZoneHints(ep) {
+		priorityInfo := *lbInfo.PriorityInfo
+		priorityInfo.ZoneHints = false
+		lbInfo.PriorityInfo = &priorityInfo
+	}
 	totalEndpoints := 0
 	for loc, eps := range ep.LbEps {
 		var l *envoy_config_core_v3.Locality
@@ -121,7 +131,9 @@ func prioritizeWithLbInfo(logger *zap.Logger, ep ir.EndpointsForUpstream, lbInfo
 		cla.Endpoints = append(cla.GetEndpoints(), endpoints...)
 	}
 
-	if lbInfo.PriorityInfo != nil && lbInfo.PriorityInfo.FailoverPriority == nil {
+	if lbInfo.PriorityInfo != nil && lbInfo.PriorityInfo.ZoneHints {
+		normalizePriorities(cla)
+	} else if lbInfo.PriorityInfo != nil && lbInfo.PriorityInfo.FailoverPriority == nil {
 		// if no priorities, fallback to failover
 		proxyLocality := envoy_config_core_v3.Locality{
 			Region:  lbInfo.PodLocality.Region,
@@ -141,6 +153,9 @@ func prioritizeWithLbInfo(logger *zap.Logger, ep ir.EndpointsForUpstream, lbInfo
 }
 
 func getEndpoints(eps []ir.EndpointWithMd, lbinfo LoadBalancingInfo) []*envoy_config_endpoint_v3.LocalityLbEndpoints {
+	if lbinfo.PriorityInfo != nil && lbinfo.PriorityInfo.ZoneHints {
+		return applyZoneHintsPerLocality(eps, lbinfo)
+	}
 	if lbinfo.PriorityInfo != nil && lbinfo.PriorityInfo.FailoverPriority != nil {
 		return applyFailoverPriorityPerLocality(eps, lbinfo)
 	}
@@ -165,11 +180,44 @@ func getEndpoints(eps []ir.EndpointWithMd, lbinfo LoadBalancingInfo) []*envoy_co
 
 func applyFailoverPriorityPerLocality(
 	eps []ir.EndpointWithMd, lbinfo LoadBalancingInfo,
+) []*envoy_config_endpoint_v3.LocalityLbEndpoints {
+	return splitByPriority(eps, func(ep ir.EndpointWithMd) int {
+		return lbinfo.PriorityInfo.FailoverPriority.GetPriority(lbinfo.PodLabels, ep.EndpointMd.Labels)
+	})
+}
+
+// the endpoints with hints for the zone of the proxy get priority 0, and the rest get priority 1.
+// the priorities are normalized across localities later, so that they start at 0 even if no
+// endpoint has hints for the zone of the proxy.
+func applyZoneHintsPerLocality(
+	eps []ir.EndpointWithMd, lbinfo LoadBalancingInfo,
+) []*envoy_config_endpoint_v3.LocalityLbEndpoints {
+	return splitByPriority(eps, func(ep ir.EndpointWithMd) int {
+		if lbinfo.PodLocality.Zone != "" && slices.Contains(ep.EndpointMd.ZoneHints, lbinfo.PodLocality.Zone) {
+			return 0
+		}
+		return 1
+	})
+}
+
+func allHaveZoneHints(ep ir.EndpointsForUpstream) bool {
+	for _, eps := range ep.LbEps {
+		for _, ep := range eps {
+			if len(ep.EndpointMd.ZoneHints) == 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func splitByPriority(
+	eps []ir.EndpointWithMd, getPriority func(ep ir.EndpointWithMd) int,
 ) []*envoy_config_endpoint_v3.LocalityLbEndpoints {
 	// key is priority, value is the index of LocalityLbEndpoints.LbEndpoints
 	priorityMap := map[int][]int{}
 	for i, ep := range eps {
-		priority := lbinfo.PriorityInfo.FailoverPriority.GetPriority(lbinfo.PodLabels, ep.EndpointMd.Labels)
+		priority := getPriority(ep)
 		priorityMap[priority] = append(priorityMap[priority], i)
 	}
 
@@ -208,9 +256,6 @@ func applyLocalityFailover(
 	loadAssignment *envoy_config_endpoint_v3.ClusterLoadAssignment,
 	failover []*v1alpha3.LocalityLoadBalancerSetting_Failover,
 ) {
-	// key is priority, value is the index of the LocalityLbEndpoints in ClusterLoadAssignment
-	priorityMap := map[int][]int{}
-
 	// 1. calculate the LocalityLbEndpoints.Priority compared with proxy locality
 	for i, localityEndpoint := range loadAssignment.GetEndpoints() {
 		// if region/zone/subZone all match, the priority is 0.
@@ -236,18 +281,30 @@ func applyLocalityFailover(
 		// Afterwards the final priorities can be calculted from 0 (highest) to N (lowest) without skipping.
 		priorityInt := int(loadAssignment.GetEndpoints()[i].GetPriority()*5) + priority
 		loadAssignment.GetEndpoints()[i].Priority = uint32(priorityInt)
-		priorityMap[priorityInt] = append(priorityMap[priorityInt], i)
 	}
 
 	// since Priorities should range from 0 (highest) to N (lowest) without skipping.
 	// 2. adjust the priorities in order
-	// 2.1 sort all priorities in increasing order.
+	normalizePriorities(loadAssignment)
+}
+
+// normalizePriorities renumbers the priorities of the LocalityLbEndpoints so that they range from
+// 0 (highest) to N (lowest) without skipping, keeping their order.
+func normalizePriorities(loadAssignment *envoy_config_endpoint_v3.ClusterLoadAssignment) {
+	// key is priority, value is the index of the LocalityLbEndpoints in ClusterLoadAssignment
+	priorityMap := map[int][]int{}
+	for i, localityEndpoint := range loadAssignment.GetEndpoints() {
+		priority := int(localityEndpoint.GetPriority())
+		priorityMap[priority] = append(priorityMap[priority], i)
+	}
+
+	// sort all priorities in increasing order.
 	priorities := []int{}
 	for priority := range priorityMap {
 		priorities = append(priorities, priority)
 	}
 	sort.Ints(priorities)
-	// 2.2 adjust LocalityLbEndpoints priority
+	// adjust LocalityLbEndpoints priority
 	// if the index and value of priorities array is not equal.
 	for i, priority := range priorities {
 		if i != priority {
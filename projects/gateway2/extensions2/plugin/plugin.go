@@ -70,6 +70,9 @@ type Plugin struct {
 	ContributesGwTranslator GwTranslatorFactory
 	// extra has sync beyong primary resources in the collections above
 	ExtraHasSynced func() bool
+	// writes the statuses of the plugin's resources until ctx is done. it is started by the proxy syncer,
+	// once it is elected leader, so that a single replica writes statuses, like those of Gateways and routes.
+	StatusSyncer func(ctx context.Context)
 }
 
 func (p PolicyPlugin) AttachmentPoints() AttachmentPoints {
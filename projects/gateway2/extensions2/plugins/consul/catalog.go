@@ -0,0 +1,276 @@
+package consul
+
+import (
+	"cmp"
+	"context"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	consulapi "github.com/hashicorp/consul/api"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	consulplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/consul"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/zap"
+	"istio.io/istio/pkg/kube/krt"
+)
+
+// how long to wait before querying consul again after a failed query.
+const retryInterval = time.Second
+
+// consulService is a consul service with all its instances, across data centers.
+type consulService struct {
+	Name        string
+	DataCenters []string
+	Tags        []string
+	Instances   []consulInstance
+}
+
+type consulInstance struct {
+	DataCenter string
+	// set when the instance registered a hostname and Address is what it resolved to.
+	Hostname string
+	Address  string
+	Port     uint32
+	Tags     []string
+}
+
+func (s consulService) ResourceName() string {
+	return s.Name
+}
+
+func (s consulService) Equals(in consulService) bool {
+	return s.Name == in.Name &&
+		slices.Equal(s.DataCenters, in.DataCenters) &&
+		slices.Equal(s.Tags, in.Tags) &&
+		slices.EqualFunc(s.Instances, in.Instances, func(a, b consulInstance) bool {
+			return a.DataCenter == b.DataCenter && a.Hostname == b.Hostname && a.Address == b.Address &&
+				a.Port == b.Port && slices.Equal(a.Tags, b.Tags)
+		})
+}
+
+var _ krt.ResourceNamer = consulService{}
+var _ krt.Equaler[consulService] = consulService{}
+
+// catalog mirrors the consul catalog into a krt collection.
+type catalog struct {
+	client       consul.ClientWrapper
+	resolver     consulplugin.DnsResolver
+	discoveryCfg *v1.Settings_ConsulUpstreamDiscoveryConfiguration
+
+	services krt.StaticCollection[consulService]
+	// number of data centers that haven't answered their first query yet.
+	pending atomic.Int32
+	synced  atomic.Bool
+
+	mu sync.Mutex
+	// the services and their tags, by data center.
+	servicesByDc map[string]map[string][]string
+}
+
+func newCatalog(client consul.ClientWrapper, resolver consulplugin.DnsResolver, discoveryCfg *v1.Settings_ConsulUpstreamDiscoveryConfiguration) *catalog {
+	return &catalog{
+		client:       client,
+		resolver:     resolver,
+		discoveryCfg: discoveryCfg,
+		services:     krt.NewStaticCollection[consulService](nil),
+		servicesByDc: map[string]map[string][]string{},
+	}
+}
+
+func (c *catalog) hasSynced() bool {
+	return c.synced.Load()
+}
+
+// firstQueryDone is called once per data center, after its first query, successful or not. we don't
+// hold up translation of everything else while consul is unavailable.
+func (c *catalog) firstQueryDone() {
+	if c.pending.Add(-1) <= 0 {
+		c.synced.Store(true)
+	}
+}
+
+func (c *catalog) run(ctx context.Context) {
+	logger := contextutils.LoggerFrom(ctx).Desugar()
+
+	var dataCenters []string
+	for {
+		var err error
+		dataCenters, err = c.client.DataCenters()
+		if err == nil {
+			break
+		}
+		logger.Error("failed to list consul data centers", zap.Error(err))
+		c.synced.Store(true)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+
+	c.pending.Store(int32(len(dataCenters)))
+	if len(dataCenters) == 0 {
+		c.synced.Store(true)
+	}
+	for _, dc := range dataCenters {
+		go c.watchDataCenter(ctx, dc)
+	}
+}
+
+// watchDataCenter watches the services of a data center with blocking queries. the index of the
+// services query changes on any change to the data center's catalog, so this also picks up changes
+// to the instances of the services.
+func (c *catalog) watchDataCenter(ctx context.Context, dc string) {
+	logger := contextutils.LoggerFrom(ctx).Desugar().With(zap.String("datacenter", dc))
+
+	first := true
+	lastIndex := uint64(0)
+	for ctx.Err() == nil {
+		queryOpts := consul.NewConsulServicesQueryOptions(dc, c.discoveryCfg.GetConsistencyMode(), c.discoveryCfg.GetQueryOptions())
+		queryOpts.WaitIndex = lastIndex
+		services, queryMeta, err := c.client.Services(queryOpts.WithContext(ctx))
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			logger.Error("failed to list consul services", zap.Error(err))
+			if first {
+				first = false
+				c.firstQueryDone()
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(retryInterval):
+			}
+			continue
+		}
+
+		// if the index went backwards, start over, as the consul blocking query docs recommend
+		if queryMeta.LastIndex < lastIndex {
+			lastIndex = 0
+		} else if queryMeta.LastIndex == lastIndex {
+			continue
+		} else {
+			lastIndex = queryMeta.LastIndex
+		}
+
+		c.update(ctx, dc, services)
+		if first {
+			first = false
+			c.firstQueryDone()
+		}
+	}
+}
+
+// update refreshes the instances of all services.
+func (c *catalog) update(ctx context.Context, dc string, services map[string][]string) {
+	logger := contextutils.LoggerFrom(ctx).Desugar()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.servicesByDc[dc] = services
+
+	metas := map[string]*consul.ServiceMeta{}
+	for dc, services := range c.servicesByDc {
+		for name, tags := range services {
+			meta := metas[name]
+			if meta == nil {
+				meta = &consul.ServiceMeta{Name: name}
+				metas[name] = meta
+			}
+			meta.DataCenters = append(meta.DataCenters, dc)
+			for _, tag := range tags {
+				if !slices.Contains(meta.Tags, tag) {
+					meta.Tags = append(meta.Tags, tag)
+				}
+			}
+		}
+	}
+
+	for _, meta := range metas {
+		svc, err := c.fetchService(ctx, meta)
+		if err != nil {
+			// keep the instances we last saw
+			logger.Error("failed to fetch consul service instances", zap.String("service", meta.Name), zap.Error(err))
+			continue
+		}
+		if old := c.services.GetKey(krt.Key[consulService](svc.Name)); old != nil && old.Equals(svc) {
+			continue
+		}
+		c.services.UpdateObject(svc)
+	}
+	c.services.DeleteObjects(func(svc consulService) bool {
+		_, ok := metas[svc.Name]
+		return !ok
+	})
+}
+
+func (c *catalog) fetchService(ctx context.Context, meta *consul.ServiceMeta) (consulService, error) {
+	svc := consulService{
+		Name:        meta.Name,
+		DataCenters: slices.Sorted(slices.Values(meta.DataCenters)),
+		Tags:        slices.Sorted(slices.Values(meta.Tags)),
+	}
+	for _, dc := range svc.DataCenters {
+		queryOpts := consul.NewConsulCatalogServiceQueryOptions(dc, c.discoveryCfg.GetConsistencyMode(), c.discoveryCfg.GetQueryOptions())
+		catalogServices, _, err := c.client.Service(meta.Name, "", queryOpts.WithContext(ctx))
+		if err != nil {
+			return consulService{}, err
+		}
+		for _, cs := range catalogServices {
+			instances, err := c.toInstances(ctx, cs)
+			if err != nil {
+				contextutils.LoggerFrom(ctx).Desugar().Warn("failed to resolve consul service instance address",
+					zap.String("service", meta.Name), zap.String("id", cs.ServiceID), zap.Error(err))
+				continue
+			}
+			svc.Instances = append(svc.Instances, instances...)
+		}
+	}
+	slices.SortFunc(svc.Instances, func(a, b consulInstance) int {
+		return cmp.Or(
+			strings.Compare(a.DataCenter, b.DataCenter),
+			strings.Compare(a.Address, b.Address),
+			cmp.Compare(a.Port, b.Port),
+		)
+	})
+	return svc, nil
+}
+
+// toInstances returns an instance per ip address of the catalog service. envoy can't resolve
+// hostnames of EDS endpoints, so we do it here.
+func (c *catalog) toInstances(ctx context.Context, cs *consulapi.CatalogService) ([]consulInstance, error) {
+	// ServiceAddress is the address of the service host; if empty, the node address should be used
+	address := cs.ServiceAddress
+	if address == "" {
+		address = cs.Address
+	}
+	instance := consulInstance{
+		DataCenter: cs.Datacenter,
+		Address:    address,
+		Port:       uint32(cs.ServicePort),
+		Tags:       slices.Sorted(slices.Values(cs.ServiceTags)),
+	}
+	if net.ParseIP(address) != nil {
+		return []consulInstance{instance}, nil
+	}
+
+	ipAddrs, err := c.resolver.Resolve(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	instances := make([]consulInstance, 0, len(ipAddrs))
+	for _, ipAddr := range ipAddrs {
+		resolved := instance
+		resolved.Hostname = address
+		resolved.Address = ipAddr.String()
+		instances = append(instances, resolved)
+	}
+	return instances, nil
+}
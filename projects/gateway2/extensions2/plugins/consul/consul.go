@@ -0,0 +1,313 @@
+package consul
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	"github.com/solo-io/gloo/projects/gateway2/extensions2/common"
+	extensionsplug "github.com/solo-io/gloo/projects/gateway2/extensions2/plugin"
+	"github.com/solo-io/gloo/projects/gateway2/ir"
+	"github.com/solo-io/gloo/projects/gateway2/utils/krtutil"
+	"github.com/solo-io/gloo/projects/gloo/constants"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap/clients"
+	consulplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/consul"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/structpb"
+	"istio.io/istio/pkg/kube/krt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ExtensionName = "Consul"
+	Group         = "consul.solo.io"
+)
+
+var (
+	// ServiceGK is the kind a route backendRef uses to reference a consul service by name.
+	ServiceGK = schema.GroupKind{
+		Group: Group,
+		Kind:  "Service",
+	}
+	// TagGK and DataCenterGK are virtual extensionRef filters for consul backendRefs. They select the
+	// subset of service instances that have the tag / run in the data center given as the filter's name.
+	TagGK = schema.GroupKind{
+		Group: Group,
+		Kind:  "Tag",
+	}
+	DataCenterGK = schema.GroupKind{
+		Group: Group,
+		Kind:  "DataCenter",
+	}
+)
+
+func NewPlugin(ctx context.Context, commoncol *common.CommonCollections) extensionsplug.Plugin {
+	settings := &commoncol.InitialSettings.Spec
+	serviceDiscovery := settings.GetConsul().GetServiceDiscovery()
+	if serviceDiscovery == nil {
+		// don't discover consul services if consul service discovery is not configured
+		return extensionsplug.Plugin{}
+	}
+
+	logger := contextutils.LoggerFrom(ctx).Desugar()
+	client, err := clients.ConsulClientForSettings(ctx, settings)
+	if err != nil {
+		logger.Error("failed to create consul client", zap.Error(err))
+		return extensionsplug.Plugin{}
+	}
+	filteredClient, err := consul.NewFilteredConsulClient(consul.NewConsulClientWrapper(client),
+		serviceDiscovery.GetDataCenters(), settings.GetConsulDiscovery().GetServiceTagsAllowlist())
+	if err != nil {
+		logger.Error("failed to create consul client", zap.Error(err))
+		return extensionsplug.Plugin{}
+	}
+
+	dnsAddress := settings.GetConsul().GetDnsAddress()
+	if dnsAddress == "" {
+		dnsAddress = consulplugin.DefaultDnsAddress
+	}
+	return NewPluginFromClient(ctx, commoncol.KrtOpts, filteredClient, consulplugin.NewConsulDnsResolver(dnsAddress), settings.GetConsulDiscovery())
+}
+
+func NewPluginFromClient(
+	ctx context.Context,
+	krtOpts krtutil.KrtOptions,
+	client consul.ClientWrapper,
+	resolver consulplugin.DnsResolver,
+	discoveryCfg *v1.Settings_ConsulUpstreamDiscoveryConfiguration,
+) extensionsplug.Plugin {
+	c := newCatalog(client, resolver, discoveryCfg)
+	go c.run(ctx)
+
+	upstreams := krt.NewCollection(c.services, func(kctx krt.HandlerContext, svc consulService) *ir.Upstream {
+		us := toUpstream(svc)
+		return &us
+	}, krtOpts.ToOptions("ConsulUpstreams")...)
+	endpoints := krt.NewCollection(c.services, func(kctx krt.HandlerContext, svc consulService) *ir.EndpointsForUpstream {
+		return toEndpoints(svc)
+	}, krtOpts.ToOptions("ConsulEndpoints")...)
+
+	subsetPolicy := extensionsplug.PolicyPlugin{
+		Name:                      "consul",
+		NewGatewayTranslationPass: newPlug,
+	}
+	tagPolicy := subsetPolicy
+	tagPolicy.PoliciesFetch = func(n, ns string) ir.PolicyIR {
+		// virtual policy - we don't have a real policy object
+		return &subsetFilter{Prefix: constants.ConsulTagKeyPrefix, Value: n}
+	}
+	dcPolicy := subsetPolicy
+	dcPolicy.PoliciesFetch = func(n, ns string) ir.PolicyIR {
+		return &subsetFilter{Prefix: constants.ConsulDataCenterKeyPrefix, Value: n}
+	}
+
+	return extensionsplug.Plugin{
+		ContributesUpstreams: map[schema.GroupKind]extensionsplug.UpstreamPlugin{
+			ServiceGK: {
+				UpstreamInit: ir.UpstreamInit{
+					InitUpstream: processUpstream,
+				},
+				Upstreams: upstreams,
+				Endpoints: endpoints,
+			},
+		},
+		ContributesPolicies: map[schema.GroupKind]extensionsplug.PolicyPlugin{
+			ServiceGK: {
+				Name: "consul",
+				// consul services are not namespaced, so they can be referenced from any namespace.
+				GetBackendForRef: func(kctx krt.HandlerContext, key ir.ObjectSource, port int32) *ir.Upstream {
+					if key.GetGroupKind() != ServiceGK {
+						return nil
+					}
+					key.Namespace = ""
+					return krt.FetchOne(kctx, upstreams, krt.FilterKey(ir.UpstreamResourceName(key, 0)))
+				},
+			},
+			TagGK:        tagPolicy,
+			DataCenterGK: dcPolicy,
+		},
+		ExtraHasSynced: c.hasSynced,
+	}
+}
+
+// serviceIr holds the tags and data centers of all the instances of a consul service.
+// They are the keys of the cluster's load balancer subsets.
+type serviceIr struct {
+	DataCenters []string
+	Tags        []string
+}
+
+func (s *serviceIr) Equals(other any) bool {
+	o, ok := other.(*serviceIr)
+	if !ok {
+		return false
+	}
+	return slices.Equal(s.DataCenters, o.DataCenters) && slices.Equal(s.Tags, o.Tags)
+}
+
+func toUpstream(svc consulService) ir.Upstream {
+	sir := &serviceIr{
+		DataCenters: svc.DataCenters,
+		Tags:        svc.Tags,
+	}
+	return ir.Upstream{
+		ObjectSource: ir.ObjectSource{
+			Group: ServiceGK.Group,
+			Kind:  ServiceGK.Kind,
+			Name:  svc.Name,
+		},
+		GvPrefix:          "consul",
+		CanonicalHostname: fmt.Sprintf("%s.service.consul", svc.Name),
+		// there is no kubernetes object behind a consul service; the resource version changes with the
+		// subset keys, so that the cluster is updated when they do.
+		Obj:   &metav1.ObjectMeta{Name: svc.Name, ResourceVersion: sir.version()},
+		ObjIr: sir,
+	}
+}
+
+func (s *serviceIr) version() string {
+	h := fnv.New64a()
+	for _, dc := range s.DataCenters {
+		h.Write([]byte(dc))
+		h.Write([]byte{0})
+	}
+	h.Write([]byte{0})
+	for _, tag := range s.Tags {
+		h.Write([]byte(tag))
+		h.Write([]byte{0})
+	}
+	return strconv.FormatUint(h.Sum64(), 10)
+}
+
+func processUpstream(ctx context.Context, in ir.Upstream, out *envoy_config_cluster_v3.Cluster) {
+	out.ClusterDiscoveryType = &envoy_config_cluster_v3.Cluster_Type{
+		Type: envoy_config_cluster_v3.Cluster_EDS,
+	}
+	out.EdsClusterConfig = &envoy_config_cluster_v3.Cluster_EdsClusterConfig{
+		EdsConfig: &envoy_config_core_v3.ConfigSource{
+			ResourceApiVersion: envoy_config_core_v3.ApiVersion_V3,
+			ConfigSourceSpecifier: &envoy_config_core_v3.ConfigSource_Ads{
+				Ads: &envoy_config_core_v3.AggregatedConfigSource{},
+			},
+		},
+	}
+
+	sir, ok := in.ObjIr.(*serviceIr)
+	if !ok {
+		// log - should never happen
+		return
+	}
+	out.LbSubsetConfig = subsetConfig(sir)
+}
+
+// subsetConfig partitions the endpoints by data center, by tags, and by both; the same way
+// edge does for consul upstreams.
+func subsetConfig(sir *serviceIr) *envoy_config_cluster_v3.Cluster_LbSubsetConfig {
+	dcKeys := subsetKeys(constants.ConsulDataCenterKeyPrefix, sir.DataCenters)
+	cfg := &envoy_config_cluster_v3.Cluster_LbSubsetConfig{
+		FallbackPolicy: envoy_config_cluster_v3.Cluster_LbSubsetConfig_ANY_ENDPOINT,
+		SubsetSelectors: []*envoy_config_cluster_v3.Cluster_LbSubsetConfig_LbSubsetSelector{
+			{Keys: dcKeys},
+		},
+	}
+	if len(sir.Tags) == 0 {
+		return cfg
+	}
+	tagKeys := subsetKeys(constants.ConsulTagKeyPrefix, sir.Tags)
+	cfg.SubsetSelectors = append(cfg.GetSubsetSelectors(),
+		&envoy_config_cluster_v3.Cluster_LbSubsetConfig_LbSubsetSelector{Keys: tagKeys},
+		&envoy_config_cluster_v3.Cluster_LbSubsetConfig_LbSubsetSelector{Keys: append(slices.Clone(dcKeys), tagKeys...)},
+	)
+	return cfg
+}
+
+func subsetKeys(prefix string, values []string) []string {
+	keys := make([]string, 0, len(values))
+	for _, v := range values {
+		keys = append(keys, prefix+v)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// subsetLabels has a label for each of the service's data centers and tags, set to "1" if the
+// instance runs in the data center / has the tag, and "0" otherwise.
+func subsetLabels(svc consulService, inst consulInstance) map[string]string {
+	labels := make(map[string]string, len(svc.DataCenters)+len(svc.Tags))
+	for _, dc := range svc.DataCenters {
+		labels[constants.ConsulDataCenterKeyPrefix+dc] = matchValue(dc == inst.DataCenter)
+	}
+	for _, tag := range svc.Tags {
+		labels[constants.ConsulTagKeyPrefix+tag] = matchValue(slices.Contains(inst.Tags, tag))
+	}
+	return labels
+}
+
+func matchValue(match bool) string {
+	if match {
+		return constants.ConsulEndpointMetadataMatchTrue
+	}
+	return constants.ConsulEndpointMetadataMatchFalse
+}
+
+func toEndpoints(svc consulService) *ir.EndpointsForUpstream {
+	us := toUpstream(svc)
+	eps := ir.NewEndpointsForUpstream(us)
+	for _, inst := range svc.Instances {
+		labels := subsetLabels(svc, inst)
+		eps.Add(ir.PodLocality{}, ir.EndpointWithMd{
+			LbEndpoint: lbEndpoint(inst, labels),
+			EndpointMd: ir.EndpointMetadata{
+				Labels: labels,
+			},
+		})
+	}
+	return eps
+}
+
+func lbEndpoint(inst consulInstance, labels map[string]string) *envoy_config_endpoint_v3.LbEndpoint {
+	lbStruct := &structpb.Struct{Fields: make(map[string]*structpb.Value, len(labels))}
+	for k, v := range labels {
+		lbStruct.GetFields()[k] = structpb.NewStringValue(v)
+	}
+	ep := &envoy_config_endpoint_v3.Endpoint{
+		Hostname: inst.Hostname,
+		Address: &envoy_config_core_v3.Address{
+			Address: &envoy_config_core_v3.Address_SocketAddress{
+				SocketAddress: &envoy_config_core_v3.SocketAddress{
+					Protocol: envoy_config_core_v3.SocketAddress_TCP,
+					Address:  inst.Address,
+					PortSpecifier: &envoy_config_core_v3.SocketAddress_PortValue{
+						PortValue: inst.Port,
+					},
+				},
+			},
+		},
+	}
+	if inst.Hostname != "" {
+		ep.HealthCheckConfig = &envoy_config_endpoint_v3.Endpoint_HealthCheckConfig{
+			Hostname: inst.Hostname,
+		}
+	}
+	return &envoy_config_endpoint_v3.LbEndpoint{
+		HostIdentifier: &envoy_config_endpoint_v3.LbEndpoint_Endpoint{
+			Endpoint: ep,
+		},
+		Metadata: &envoy_config_core_v3.Metadata{
+			FilterMetadata: map[string]*structpb.Struct{
+				translator.EnvoyLb: lbStruct,
+			},
+		},
+	}
+}
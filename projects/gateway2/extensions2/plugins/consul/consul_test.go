@@ -0,0 +1,215 @@
+package consul
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	consulapi "github.com/hashicorp/consul/api"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gateway2/ir"
+	"github.com/solo-io/gloo/projects/gateway2/utils/krtutil"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
+)
+
+// fakeConsul serves the parts of the consul catalog http api that we use, including blocking queries.
+type fakeConsul struct {
+	mu        sync.Mutex
+	index     uint64
+	changed   chan struct{}
+	instances []*consulapi.CatalogService
+}
+
+func newFakeConsul(instances ...*consulapi.CatalogService) *fakeConsul {
+	return &fakeConsul{index: 1, changed: make(chan struct{}), instances: instances}
+}
+
+func (f *fakeConsul) setInstances(instances ...*consulapi.CatalogService) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.instances = instances
+	f.index++
+	close(f.changed)
+	f.changed = make(chan struct{})
+}
+
+func (f *fakeConsul) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	index, changed := f.index, f.changed
+	f.mu.Unlock()
+	if waitIndex, _ := strconv.ParseUint(r.URL.Query().Get("index"), 10, 64); waitIndex >= index {
+		select {
+		case <-changed:
+		case <-r.Context().Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	dc := r.URL.Query().Get("dc")
+	var resp any
+	switch {
+	case r.URL.Path == "/v1/catalog/datacenters":
+		resp = []string{"dc1", "dc2"}
+	case r.URL.Path == "/v1/catalog/services":
+		services := map[string][]string{}
+		for _, inst := range f.instances {
+			if inst.Datacenter == dc {
+				services[inst.ServiceName] = append(services[inst.ServiceName], inst.ServiceTags...)
+			}
+		}
+		resp = services
+	case strings.HasPrefix(r.URL.Path, "/v1/catalog/service/"):
+		name := strings.TrimPrefix(r.URL.Path, "/v1/catalog/service/")
+		services := []*consulapi.CatalogService{}
+		for _, inst := range f.instances {
+			if inst.Datacenter == dc && inst.ServiceName == name {
+				services = append(services, inst)
+			}
+		}
+		resp = services
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("X-Consul-Index", strconv.FormatUint(f.index, 10))
+	w.Header().Set("X-Consul-LastContact", "0")
+	w.Header().Set("X-Consul-KnownLeader", "true")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func instance(dc, name, address string, port int, tags ...string) *consulapi.CatalogService {
+	return &consulapi.CatalogService{
+		Datacenter:  dc,
+		ServiceName: name,
+		ServiceID:   name + "-" + address,
+		Address:     address,
+		ServicePort: port,
+		ServiceTags: tags,
+	}
+}
+
+func newTestPlugin(t *testing.T, fake *fakeConsul, dataCenters []string) func() []ir.EndpointsForUpstream {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+
+	client, err := consulapi.NewClient(&consulapi.Config{Address: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	filteredClient, err := consul.NewFilteredConsulClient(consul.NewConsulClientWrapper(client), dataCenters, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plugin := NewPluginFromClient(ctx, krtutil.KrtOptions{}, filteredClient, nil, nil)
+	endpoints := plugin.ContributesUpstreams[ServiceGK].Endpoints
+	g := NewWithT(t)
+	g.Eventually(plugin.ExtraHasSynced, time.Second*5).Should(BeTrue())
+	g.Eventually(endpoints.Synced().HasSynced, time.Second*5).Should(BeTrue())
+	return endpoints.List
+}
+
+func TestDiscoversServicesAcrossDataCenters(t *testing.T) {
+	g := NewWithT(t)
+	fake := newFakeConsul(
+		instance("dc1", "web", "10.0.0.1", 8080, "v1"),
+		instance("dc2", "web", "10.0.0.2", 8080, "v2"),
+		instance("dc1", "db", "10.0.0.3", 5432),
+	)
+	endpoints := newTestPlugin(t, fake, nil)
+
+	g.Eventually(endpoints, time.Second*5).Should(HaveLen(2))
+	var web ir.EndpointsForUpstream
+	for _, eps := range endpoints() {
+		if eps.Hostname == "web.service.consul" {
+			web = eps
+		}
+	}
+	g.Expect(web.ClusterName).To(Equal("consul__web_0"))
+	lbEps := web.LbEps[ir.PodLocality{}]
+	g.Expect(lbEps).To(HaveLen(2))
+	g.Expect(lbEps[0].EndpointMd.Labels).To(Equal(map[string]string{
+		"dc_dc1": "1", "dc_dc2": "0", "tag_v1": "1", "tag_v2": "0",
+	}))
+	g.Expect(lbEps[1].EndpointMd.Labels).To(Equal(map[string]string{
+		"dc_dc1": "0", "dc_dc2": "1", "tag_v1": "0", "tag_v2": "1",
+	}))
+	lbMeta := lbEps[0].GetMetadata().GetFilterMetadata()[translator.EnvoyLb]
+	g.Expect(lbMeta.GetFields()["tag_v1"].GetStringValue()).To(Equal("1"))
+
+	// instance changes are picked up by the blocking watch
+	fake.setInstances(
+		instance("dc1", "web", "10.0.0.1", 8080, "v1"),
+		instance("dc1", "web", "10.0.0.4", 8080, "v1"),
+	)
+	g.Eventually(func() int {
+		eps := endpoints()
+		if len(eps) != 1 {
+			return 0
+		}
+		return len(eps[0].LbEps[ir.PodLocality{}])
+	}, time.Second*5).Should(Equal(2))
+}
+
+func TestDataCenterAllowlist(t *testing.T) {
+	g := NewWithT(t)
+	fake := newFakeConsul(
+		instance("dc1", "web", "10.0.0.1", 8080),
+		instance("dc2", "web", "10.0.0.2", 8080),
+	)
+	endpoints := newTestPlugin(t, fake, []string{"dc2"})
+
+	g.Eventually(endpoints, time.Second*5).Should(HaveLen(1))
+	lbEps := endpoints()[0].LbEps[ir.PodLocality{}]
+	g.Expect(lbEps).To(HaveLen(1))
+	g.Expect(lbEps[0].GetEndpoint().GetAddress().GetSocketAddress().GetAddress()).To(Equal("10.0.0.2"))
+}
+
+func TestSubsetConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	us := toUpstream(consulService{Name: "web", DataCenters: []string{"dc1"}, Tags: []string{"v1", "v2"}})
+	out := &envoy_config_cluster_v3.Cluster{}
+	processUpstream(context.Background(), us, out)
+	g.Expect(out.GetType()).To(Equal(envoy_config_cluster_v3.Cluster_EDS))
+	selectors := out.GetLbSubsetConfig().GetSubsetSelectors()
+	g.Expect(selectors).To(HaveLen(3))
+	g.Expect(selectors[0].GetKeys()).To(Equal([]string{"dc_dc1"}))
+	g.Expect(selectors[1].GetKeys()).To(Equal([]string{"tag_v1", "tag_v2"}))
+	g.Expect(selectors[2].GetKeys()).To(Equal([]string{"dc_dc1", "tag_v1", "tag_v2"}))
+}
+
+func TestSubsetFilters(t *testing.T) {
+	g := NewWithT(t)
+
+	us := toUpstream(consulService{Name: "web", DataCenters: []string{"dc1", "dc2"}, Tags: []string{"v1", "v2", "v3"}})
+	pCtx := &ir.RouteBackendContext{Upstream: &us}
+	pass := &consulPass{}
+	g.Expect(pass.ApplyForRouteBackend(context.Background(), &subsetFilter{Prefix: "tag_", Value: "v1"}, pCtx)).To(Succeed())
+	g.Expect(pass.ApplyForRouteBackend(context.Background(), &subsetFilter{Prefix: "tag_", Value: "v3"}, pCtx)).To(Succeed())
+	g.Expect(pass.ApplyForRouteBackend(context.Background(), &subsetFilter{Prefix: "dc_", Value: "dc2"}, pCtx)).To(Succeed())
+
+	fields := pCtx.MetadataMatch.GetFilterMetadata()[translator.EnvoyLb].GetFields()
+	g.Expect(fields).To(HaveLen(5))
+	g.Expect(fields["tag_v1"].GetStringValue()).To(Equal("1"))
+	g.Expect(fields["tag_v2"].GetStringValue()).To(Equal("0"))
+	g.Expect(fields["tag_v3"].GetStringValue()).To(Equal("1"))
+	g.Expect(fields["dc_dc1"].GetStringValue()).To(Equal("0"))
+	g.Expect(fields["dc_dc2"].GetStringValue()).To(Equal("1"))
+
+	other := ir.Upstream{ObjectSource: ir.ObjectSource{Kind: "Service", Name: "web"}}
+	err := pass.ApplyForRouteBackend(context.Background(), &subsetFilter{Prefix: "tag_", Value: "v1"}, &ir.RouteBackendContext{Upstream: &other})
+	g.Expect(err).To(HaveOccurred())
+}
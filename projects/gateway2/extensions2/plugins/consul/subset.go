@@ -0,0 +1,111 @@
+package consul
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/solo-io/gloo/projects/gateway2/ir"
+	"github.com/solo-io/gloo/projects/gloo/constants"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// subsetFilter selects the instances of a consul service that have a tag or run in a data center.
+type subsetFilter struct {
+	// metadata key prefix; one of constants.ConsulTagKeyPrefix or constants.ConsulDataCenterKeyPrefix
+	Prefix string
+	Value  string
+}
+
+func (f *subsetFilter) CreationTime() time.Time {
+	return time.Time{}
+}
+
+func (f *subsetFilter) Equals(in any) bool {
+	f2, ok := in.(*subsetFilter)
+	if !ok {
+		return false
+	}
+	return *f == *f2
+}
+
+type consulPass struct{}
+
+func newPlug(ctx context.Context, tctx ir.GwTranslationCtx) ir.ProxyTranslationPass {
+	return &consulPass{}
+}
+
+func (p *consulPass) Name() string {
+	return "consul"
+}
+
+func (p *consulPass) ApplyListenerPlugin(ctx context.Context, pCtx *ir.ListenerContext, out *envoy_config_listener_v3.Listener) {
+}
+
+func (p *consulPass) ApplyVhostPlugin(ctx context.Context, pCtx *ir.VirtualHostContext, out *envoy_config_route_v3.VirtualHost) {
+}
+
+func (p *consulPass) ApplyForRoute(ctx context.Context, pCtx *ir.RouteContext, outputRoute *envoy_config_route_v3.Route) error {
+	return nil
+}
+
+// ApplyForRouteBackend adds the subset of the filter to the backend's metadata match. Like in edge,
+// the match has a key for each of the service's tags (or data centers), so that only instances with
+// exactly the selected tags match. Filters may be repeated to select more than one tag.
+func (p *consulPass) ApplyForRouteBackend(ctx context.Context, policy ir.PolicyIR, pCtx *ir.RouteBackendContext) error {
+	filter, ok := policy.(*subsetFilter)
+	if !ok {
+		return nil
+	}
+	if pCtx.Upstream == nil || pCtx.Upstream.GetGroupKind() != ServiceGK {
+		return fmt.Errorf("consul subset filter %s%s can only be used with consul service backends", filter.Prefix, filter.Value)
+	}
+	sir, ok := pCtx.Upstream.ObjIr.(*serviceIr)
+	if !ok {
+		return nil
+	}
+	all := sir.Tags
+	if filter.Prefix == constants.ConsulDataCenterKeyPrefix {
+		all = sir.DataCenters
+	}
+
+	if pCtx.MetadataMatch == nil {
+		pCtx.MetadataMatch = &envoy_config_core_v3.Metadata{
+			FilterMetadata: map[string]*structpb.Struct{},
+		}
+	}
+	lbMatch := pCtx.MetadataMatch.GetFilterMetadata()[translator.EnvoyLb]
+	if lbMatch == nil {
+		lbMatch = &structpb.Struct{Fields: map[string]*structpb.Value{}}
+		pCtx.MetadataMatch.GetFilterMetadata()[translator.EnvoyLb] = lbMatch
+	}
+	lbMatch.GetFields()[filter.Prefix+filter.Value] = structpb.NewStringValue(constants.ConsulEndpointMetadataMatchTrue)
+	for _, v := range all {
+		// don't override values selected by other filters
+		if _, ok := lbMatch.GetFields()[filter.Prefix+v]; !ok {
+			lbMatch.GetFields()[filter.Prefix+v] = structpb.NewStringValue(constants.ConsulEndpointMetadataMatchFalse)
+		}
+	}
+	return nil
+}
+
+func (p *consulPass) HttpFilters(ctx context.Context, fc ir.FilterChainCommon) ([]plugins.StagedHttpFilter, error) {
+	return nil, nil
+}
+
+func (p *consulPass) UpstreamHttpFilters(ctx context.Context) ([]plugins.StagedUpstreamHttpFilter, error) {
+	return nil, nil
+}
+
+func (p *consulPass) NetworkFilters(ctx context.Context) ([]plugins.StagedNetworkFilter, error) {
+	return nil, nil
+}
+
+func (p *consulPass) ResourcesToAdd(ctx context.Context) ir.Resources {
+	return ir.Resources{}
+}
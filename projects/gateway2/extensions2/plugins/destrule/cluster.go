@@ -0,0 +1,375 @@
+package destrule
+
+import (
+	"fmt"
+	"strings"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	rawbufferv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/raw_buffer/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_upstreams_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/solo-io/gloo/projects/gateway2/ir"
+	"github.com/solo-io/gloo/projects/gateway2/translator/sslutils"
+	"github.com/solo-io/gloo/projects/gateway2/translator/utils"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"istio.io/api/networking/v1alpha3"
+)
+
+// keys of the secret referenced by the credentialName of the tls settings. like in istio, both
+// kubernetes.io/tls secrets and generic secrets are supported.
+var (
+	certKeys   = []string{"tls.crt", "cert"}
+	keyKeys    = []string{"tls.key", "key"}
+	caCertKeys = []string{"ca.crt", "cacert"}
+)
+
+func applyOutlierDetection(trafficPolicy *v1alpha3.TrafficPolicy, outCluster *envoy_config_cluster_v3.Cluster) {
+	outlier := trafficPolicy.GetOutlierDetection()
+	if outlier == nil {
+		return
+	}
+
+	if getLocalityLbSetting(trafficPolicy) != nil {
+		if outCluster.GetCommonLbConfig() == nil {
+			outCluster.CommonLbConfig = &envoy_config_cluster_v3.Cluster_CommonLbConfig{}
+		}
+		outCluster.GetCommonLbConfig().LocalityConfigSpecifier = &envoy_config_cluster_v3.Cluster_CommonLbConfig_LocalityWeightedLbConfig_{
+			LocalityWeightedLbConfig: &envoy_config_cluster_v3.Cluster_CommonLbConfig_LocalityWeightedLbConfig{},
+		}
+	}
+	out := &envoy_config_cluster_v3.OutlierDetection{
+		Consecutive_5Xx:  outlier.GetConsecutive_5XxErrors(),
+		Interval:         outlier.GetInterval(),
+		BaseEjectionTime: outlier.GetBaseEjectionTime(),
+	}
+	if e := outlier.GetConsecutiveGatewayErrors(); e != nil {
+		v := e.GetValue()
+		out.ConsecutiveGatewayFailure = &wrapperspb.UInt32Value{Value: v}
+		if v > 0 {
+			v = 100
+		}
+		out.EnforcingConsecutiveGatewayFailure = &wrapperspb.UInt32Value{Value: v}
+	}
+	if outlier.GetMaxEjectionPercent() > 0 {
+		out.MaxEjectionPercent = &wrapperspb.UInt32Value{Value: uint32(outlier.GetMaxEjectionPercent())}
+	}
+	if outlier.GetSplitExternalLocalOriginErrors() {
+		out.SplitExternalLocalOriginErrors = true
+		if outlier.GetConsecutiveLocalOriginFailures().GetValue() > 0 {
+			out.ConsecutiveLocalOriginFailure = &wrapperspb.UInt32Value{Value: outlier.GetConsecutiveLocalOriginFailures().Value}
+			out.EnforcingConsecutiveLocalOriginFailure = &wrapperspb.UInt32Value{Value: 100}
+		}
+		// SuccessRate based outlier detection should be disabled.
+		out.EnforcingLocalOriginSuccessRate = &wrapperspb.UInt32Value{Value: 0}
+	}
+	minHealthPercent := outlier.GetMinHealthPercent()
+	if minHealthPercent >= 0 {
+		if outCluster.GetCommonLbConfig() == nil {
+			outCluster.CommonLbConfig = &envoy_config_cluster_v3.Cluster_CommonLbConfig{}
+		}
+		outCluster.GetCommonLbConfig().HealthyPanicThreshold = &envoy_type_v3.Percent{Value: float64(minHealthPercent)}
+	}
+
+	outCluster.OutlierDetection = out
+}
+
+func applyConnectionPool(connectionPool *v1alpha3.ConnectionPoolSettings, out *envoy_config_cluster_v3.Cluster) error {
+	if connectionPool == nil {
+		return nil
+	}
+	tcp := connectionPool.GetTcp()
+	http := connectionPool.GetHttp()
+
+	thresholds := &envoy_config_cluster_v3.CircuitBreakers_Thresholds{}
+	setThresholds := false
+	if v := tcp.GetMaxConnections(); v > 0 {
+		thresholds.MaxConnections = wrapperspb.UInt32(uint32(v))
+		setThresholds = true
+	}
+	if v := http.GetHttp1MaxPendingRequests(); v > 0 {
+		thresholds.MaxPendingRequests = wrapperspb.UInt32(uint32(v))
+		setThresholds = true
+	}
+	if v := http.GetHttp2MaxRequests(); v > 0 {
+		thresholds.MaxRequests = wrapperspb.UInt32(uint32(v))
+		setThresholds = true
+	}
+	if v := http.GetMaxRetries(); v > 0 {
+		thresholds.MaxRetries = wrapperspb.UInt32(uint32(v))
+		setThresholds = true
+	}
+	if setThresholds {
+		out.CircuitBreakers = &envoy_config_cluster_v3.CircuitBreakers{
+			Thresholds: []*envoy_config_cluster_v3.CircuitBreakers_Thresholds{thresholds},
+		}
+	}
+
+	if tcp.GetConnectTimeout() != nil {
+		out.ConnectTimeout = tcp.GetConnectTimeout()
+	}
+	if keepalive := tcp.GetTcpKeepalive(); keepalive != nil {
+		tcpKeepalive := &envoy_config_core_v3.TcpKeepalive{}
+		if keepalive.GetProbes() > 0 {
+			tcpKeepalive.KeepaliveProbes = wrapperspb.UInt32(keepalive.GetProbes())
+		}
+		if keepalive.GetTime() != nil {
+			tcpKeepalive.KeepaliveTime = wrapperspb.UInt32(uint32(keepalive.GetTime().AsDuration().Seconds()))
+		}
+		if keepalive.GetInterval() != nil {
+			tcpKeepalive.KeepaliveInterval = wrapperspb.UInt32(uint32(keepalive.GetInterval().AsDuration().Seconds()))
+		}
+		out.UpstreamConnectionOptions = &envoy_config_cluster_v3.UpstreamConnectionOptions{
+			TcpKeepalive: tcpKeepalive,
+		}
+	}
+
+	if http.GetIdleTimeout() == nil && http.GetMaxRequestsPerConnection() <= 0 && tcp.GetMaxConnectionDuration() == nil &&
+		http.GetH2UpgradePolicy() != v1alpha3.ConnectionPoolSettings_HTTPSettings_UPGRADE &&
+		!http.GetUseClientProtocol() && http.GetMaxConcurrentStreams() <= 0 {
+		return nil
+	}
+	return utils.MutateHttpOptions(out, func(opts *envoy_upstreams_v3.HttpProtocolOptions) {
+		if opts.GetCommonHttpProtocolOptions() == nil {
+			opts.CommonHttpProtocolOptions = &envoy_config_core_v3.HttpProtocolOptions{}
+		}
+		if http.GetIdleTimeout() != nil {
+			opts.GetCommonHttpProtocolOptions().IdleTimeout = http.GetIdleTimeout()
+		}
+		if v := http.GetMaxRequestsPerConnection(); v > 0 {
+			opts.GetCommonHttpProtocolOptions().MaxRequestsPerConnection = wrapperspb.UInt32(uint32(v))
+		}
+		if tcp.GetMaxConnectionDuration() != nil {
+			opts.GetCommonHttpProtocolOptions().MaxConnectionDuration = tcp.GetMaxConnectionDuration()
+		}
+
+		http2Options := opts.GetExplicitHttpConfig().GetHttp2ProtocolOptions()
+		switch {
+		case http.GetUseClientProtocol():
+			if http2Options == nil {
+				http2Options = &envoy_config_core_v3.Http2ProtocolOptions{}
+			}
+			opts.UpstreamProtocolOptions = &envoy_upstreams_v3.HttpProtocolOptions_UseDownstreamProtocolConfig{
+				UseDownstreamProtocolConfig: &envoy_upstreams_v3.HttpProtocolOptions_UseDownstreamHttpConfig{
+					HttpProtocolOptions:  &envoy_config_core_v3.Http1ProtocolOptions{},
+					Http2ProtocolOptions: http2Options,
+				},
+			}
+		case http.GetH2UpgradePolicy() == v1alpha3.ConnectionPoolSettings_HTTPSettings_UPGRADE && http2Options == nil:
+			http2Options = &envoy_config_core_v3.Http2ProtocolOptions{}
+			opts.UpstreamProtocolOptions = &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+				ExplicitHttpConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig{
+					ProtocolConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_Http2ProtocolOptions{
+						Http2ProtocolOptions: http2Options,
+					},
+				},
+			}
+		}
+		if v := http.GetMaxConcurrentStreams(); v > 0 && http2Options != nil {
+			http2Options.MaxConcurrentStreams = wrapperspb.UInt32(uint32(v))
+		}
+	})
+}
+
+func applyLoadBalancer(lb *v1alpha3.LoadBalancerSettings, out *envoy_config_cluster_v3.Cluster) {
+	if lb == nil {
+		return
+	}
+	if consistentHash := lb.GetConsistentHash(); consistentHash != nil {
+		if maglev := consistentHash.GetMaglev(); maglev != nil {
+			out.LbPolicy = envoy_config_cluster_v3.Cluster_MAGLEV
+			if maglev.GetTableSize() > 0 {
+				out.LbConfig = &envoy_config_cluster_v3.Cluster_MaglevLbConfig_{
+					MaglevLbConfig: &envoy_config_cluster_v3.Cluster_MaglevLbConfig{
+						TableSize: wrapperspb.UInt64(maglev.GetTableSize()),
+					},
+				}
+			}
+			return
+		}
+		out.LbPolicy = envoy_config_cluster_v3.Cluster_RING_HASH
+		minimumRingSize := consistentHash.GetRingHash().GetMinimumRingSize()
+		if minimumRingSize == 0 {
+			minimumRingSize = consistentHash.GetMinimumRingSize()
+		}
+		if minimumRingSize > 0 {
+			out.LbConfig = &envoy_config_cluster_v3.Cluster_RingHashLbConfig_{
+				RingHashLbConfig: &envoy_config_cluster_v3.Cluster_RingHashLbConfig{
+					MinimumRingSize: wrapperspb.UInt64(minimumRingSize),
+				},
+			}
+		}
+		return
+	}
+
+	switch lb.GetSimple() {
+	case v1alpha3.LoadBalancerSettings_ROUND_ROBIN:
+		out.LbPolicy = envoy_config_cluster_v3.Cluster_ROUND_ROBIN
+		if slowStart := slowStartConfig(lb); slowStart != nil {
+			out.LbConfig = &envoy_config_cluster_v3.Cluster_RoundRobinLbConfig_{
+				RoundRobinLbConfig: &envoy_config_cluster_v3.Cluster_RoundRobinLbConfig{SlowStartConfig: slowStart},
+			}
+		}
+	case v1alpha3.LoadBalancerSettings_LEAST_CONN, v1alpha3.LoadBalancerSettings_LEAST_REQUEST:
+		out.LbPolicy = envoy_config_cluster_v3.Cluster_LEAST_REQUEST
+		if slowStart := slowStartConfig(lb); slowStart != nil {
+			out.LbConfig = &envoy_config_cluster_v3.Cluster_LeastRequestLbConfig_{
+				LeastRequestLbConfig: &envoy_config_cluster_v3.Cluster_LeastRequestLbConfig{SlowStartConfig: slowStart},
+			}
+		}
+	case v1alpha3.LoadBalancerSettings_RANDOM:
+		out.LbPolicy = envoy_config_cluster_v3.Cluster_RANDOM
+	}
+}
+
+func slowStartConfig(lb *v1alpha3.LoadBalancerSettings) *envoy_config_cluster_v3.Cluster_SlowStartConfig {
+	if warmup := lb.GetWarmup(); warmup.GetDuration() != nil {
+		slowStart := &envoy_config_cluster_v3.Cluster_SlowStartConfig{
+			SlowStartWindow: warmup.GetDuration(),
+		}
+		if warmup.GetMinimumPercent() != nil {
+			slowStart.MinWeightPercent = &envoy_type_v3.Percent{Value: warmup.GetMinimumPercent().GetValue()}
+		}
+		if warmup.GetAggression() != nil {
+			slowStart.Aggression = &envoy_config_core_v3.RuntimeDouble{
+				DefaultValue: warmup.GetAggression().GetValue(),
+				RuntimeKey:   "upstream.warmup.aggression",
+			}
+		}
+		return slowStart
+	}
+	if lb.GetWarmupDurationSecs() != nil {
+		return &envoy_config_cluster_v3.Cluster_SlowStartConfig{
+			SlowStartWindow: lb.GetWarmupDurationSecs(),
+		}
+	}
+	return nil
+}
+
+// applyTls configures the transport socket of the cluster from the tls settings. secret is the
+// secret referenced by the credentialName of the settings, if any.
+// ISTIO_MUTUAL is left to the auto mTLS of the istio plugin.
+func applyTls(tls *v1alpha3.ClientTLSSettings, secret *ir.Secret, out *envoy_config_cluster_v3.Cluster) error {
+	var transportSocket *envoy_config_core_v3.TransportSocket
+	switch tls.GetMode() {
+	case v1alpha3.ClientTLSSettings_ISTIO_MUTUAL:
+		return nil
+	case v1alpha3.ClientTLSSettings_DISABLE:
+		// an explicit plaintext transport socket, so that auto mTLS doesn't apply.
+		typedConfig, err := anypb.New(&rawbufferv3.RawBuffer{})
+		if err != nil {
+			return err
+		}
+		transportSocket = &envoy_config_core_v3.TransportSocket{
+			Name:       wellknown.TransportSocketRawBuffer,
+			ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
+		}
+	default:
+		tlsContext, err := upstreamTlsContext(tls, secret)
+		if err != nil {
+			return err
+		}
+		typedConfig, err := anypb.New(tlsContext)
+		if err != nil {
+			return err
+		}
+		transportSocket = &envoy_config_core_v3.TransportSocket{
+			Name:       wellknown.TransportSocketTls,
+			ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
+		}
+	}
+	out.TransportSocket = transportSocket
+	out.TransportSocketMatches = nil
+	return nil
+}
+
+func upstreamTlsContext(tls *v1alpha3.ClientTLSSettings, secret *ir.Secret) (*envoyauth.UpstreamTlsContext, error) {
+	commonTlsContext := &envoyauth.CommonTlsContext{}
+
+	if !tls.GetInsecureSkipVerify().GetValue() {
+		validationContext := &envoyauth.CertificateValidationContext{}
+		switch {
+		case secretValue(secret, caCertKeys) != nil:
+			validationContext.TrustedCa = inlineBytes(secretValue(secret, caCertKeys))
+		case tls.GetCaCertificates() != "":
+			validationContext.TrustedCa = filename(tls.GetCaCertificates())
+		default:
+			// like istio, verify the upstream with the CAs of the system by default.
+			validationContext.TrustedCa = filename(sslutils.SystemCaBundle)
+		}
+		if tls.GetCaCrl() != "" {
+			validationContext.Crl = filename(tls.GetCaCrl())
+		}
+		for _, san := range tls.GetSubjectAltNames() {
+			sanType := envoyauth.SubjectAltNameMatcher_DNS
+			if strings.Contains(san, "://") {
+				sanType = envoyauth.SubjectAltNameMatcher_URI
+			}
+			validationContext.MatchTypedSubjectAltNames = append(validationContext.GetMatchTypedSubjectAltNames(), &envoyauth.SubjectAltNameMatcher{
+				SanType: sanType,
+				Matcher: &envoy_type_matcher_v3.StringMatcher{
+					MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: san},
+				},
+			})
+		}
+		commonTlsContext.ValidationContextType = &envoyauth.CommonTlsContext_ValidationContext{
+			ValidationContext: validationContext,
+		}
+	}
+
+	if tls.GetMode() == v1alpha3.ClientTLSSettings_MUTUAL {
+		var certificate *envoyauth.TlsCertificate
+		switch {
+		case secret != nil:
+			cert, key := secretValue(secret, certKeys), secretValue(secret, keyKeys)
+			if cert == nil || key == nil {
+				return nil, fmt.Errorf("secret %s does not have a client certificate and private key", secret.Name)
+			}
+			certificate = &envoyauth.TlsCertificate{
+				CertificateChain: inlineBytes(cert),
+				PrivateKey:       inlineBytes(key),
+			}
+		case tls.GetClientCertificate() != "" && tls.GetPrivateKey() != "":
+			certificate = &envoyauth.TlsCertificate{
+				CertificateChain: filename(tls.GetClientCertificate()),
+				PrivateKey:       filename(tls.GetPrivateKey()),
+			}
+		default:
+			return nil, fmt.Errorf("MUTUAL tls mode requires a credentialName or a clientCertificate and privateKey")
+		}
+		commonTlsContext.TlsCertificates = []*envoyauth.TlsCertificate{certificate}
+	}
+
+	return &envoyauth.UpstreamTlsContext{
+		CommonTlsContext: commonTlsContext,
+		Sni:              tls.GetSni(),
+	}, nil
+}
+
+func secretValue(secret *ir.Secret, keys []string) []byte {
+	if secret == nil {
+		return nil
+	}
+	for _, k := range keys {
+		if v := secret.Data[k]; len(v) > 0 {
+			return v
+		}
+	}
+	return nil
+}
+
+func inlineBytes(b []byte) *envoy_config_core_v3.DataSource {
+	return &envoy_config_core_v3.DataSource{
+		Specifier: &envoy_config_core_v3.DataSource_InlineBytes{InlineBytes: b},
+	}
+}
+
+func filename(f string) *envoy_config_core_v3.DataSource {
+	return &envoy_config_core_v3.DataSource{
+		Specifier: &envoy_config_core_v3.DataSource_Filename{Filename: f},
+	}
+}
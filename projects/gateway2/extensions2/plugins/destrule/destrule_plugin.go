@@ -5,52 +5,75 @@ import (
 	"fmt"
 	"hash/fnv"
 
-	"google.golang.org/protobuf/types/known/wrapperspb"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
-	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/solo-io/gloo/projects/gateway2/endpoints"
 	"github.com/solo-io/gloo/projects/gateway2/extensions2/common"
 	extensionsplug "github.com/solo-io/gloo/projects/gateway2/extensions2/plugin"
 	"github.com/solo-io/gloo/projects/gateway2/ir"
+	"github.com/solo-io/gloo/projects/gateway2/krtcollections"
 	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/zap"
 	"istio.io/api/networking/v1alpha3"
 	"istio.io/istio/pkg/config/schema/gvr"
 	"istio.io/istio/pkg/kube/krt"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 const (
 	ExtensionName = "Destrule"
 )
 
+var (
+	DestinationRuleGK = schema.GroupKind{
+		Group: gvr.DestinationRule.Group,
+		Kind:  "DestinationRule",
+	}
+	// SubsetGK is a virtual extensionRef filter for Service backendRefs. It selects the endpoints of
+	// the DestinationRule subset given as the filter's name.
+	SubsetGK = schema.GroupKind{
+		Group: gvr.DestinationRule.Group,
+		Kind:  "Subset",
+	}
+)
+
 func NewPlugin(ctx context.Context, commoncol *common.CommonCollections) extensionsplug.Plugin {
 	if !commoncol.InitialSettings.Spec.GetGloo().GetIstioOptions().GetEnableIntegration().GetValue() {
 		// don't add support for destination rules if istio integration is not enabled
 		return extensionsplug.Plugin{}
 	}
 
-	gk := schema.GroupKind{
-		Group: gvr.DestinationRule.Group,
-		Kind:  "DestinationRule",
-	}
 	d := &destrulePlugin{
 		destinationRulesIndex: NewDestRuleIndex(commoncol.Client, &commoncol.KrtOpts),
+		secrets:               commoncol.Secrets,
 	}
 	return extensionsplug.Plugin{
 		ContributesPolicies: map[schema.GroupKind]extensionsplug.PolicyPlugin{
-			gk: {
+			DestinationRuleGK: {
 				Name:                      "destrule",
+				NewGatewayTranslationPass: newPass,
 				PerClientProcessUpstream:  d.processUpstream,
 				PerClientProcessEndpoints: d.processEnddpoints,
+				Policies:                  newDestrulePolicies(d.destinationRulesIndex.Destrules, &commoncol.KrtOpts),
+			},
+			SubsetGK: {
+				Name:                      "destrule-subset",
+				NewGatewayTranslationPass: newPass,
+				PoliciesFetch: func(n, ns string) ir.PolicyIR {
+					// virtual policy - we don't have a real policy object
+					return &subsetFilter{Name: n}
+				},
 			},
 		},
+		StatusSyncer: reportUnsupportedFields(commoncol.Client, d.destinationRulesIndex.Destrules),
 	}
 }
 
 type destrulePlugin struct {
 	destinationRulesIndex DestinationRuleIndex
+	secrets               *krtcollections.SecretIndex
 }
 
 func (d *destrulePlugin) processEnddpoints(kctx krt.HandlerContext, ctx context.Context, ucc ir.UniqlyConnectedClient, in ir.EndpointsForUpstream) (*envoy_config_endpoint_v3.ClusterLoadAssignment, uint64) {
@@ -59,74 +82,65 @@ func (d *destrulePlugin) processEnddpoints(kctx krt.HandlerContext, ctx context.
 		return nil, 0
 	}
 
-	logger := contextutils.LoggerFrom(ctx).Desugar()
 	trafficPolicy := getTrafficPolicy(destrule, in.Port)
 	localityLb := getLocalityLbSetting(trafficPolicy)
+	subsets := destrule.Spec.GetSubsets()
+	if localityLb == nil && len(subsets) == 0 {
+		// nothing to do for the endpoints; let other plugins prioritize them
+		return nil, 0
+	}
+
+	logger := contextutils.LoggerFrom(ctx).Desugar()
 	var priorityInfo *endpoints.PriorityInfo
-	var additionalHash uint64
 	if localityLb != nil {
 		priorityInfo = getPriorityInfoFromDestrule(localityLb)
-		hasher := fnv.New64()
-		hasher.Write([]byte(destrule.UID))
-		hasher.Write([]byte(fmt.Sprintf("%v", destrule.Generation)))
-		additionalHash = hasher.Sum64()
 	}
-	return endpoints.PrioritizeEndpoints(logger, priorityInfo, in, ucc), additionalHash
+	if len(subsets) > 0 {
+		in = withSubsetMetadata(subsets, in)
+	}
+	hasher := fnv.New64()
+	hasher.Write([]byte(destrule.UID))
+	hasher.Write([]byte(fmt.Sprintf("%v", destrule.Generation)))
+	return endpoints.PrioritizeEndpoints(logger, priorityInfo, in, ucc), hasher.Sum64()
 }
 
 func (d *destrulePlugin) processUpstream(kctx krt.HandlerContext, ctx context.Context, ucc ir.UniqlyConnectedClient, in ir.Upstream, outCluster *envoy_config_cluster_v3.Cluster) {
 	destrule := d.destinationRulesIndex.FetchDestRulesFor(kctx, ucc.Namespace, in.CanonicalHostname, ucc.Labels)
+	if destrule == nil {
+		return
+	}
+	logger := contextutils.LoggerFrom(ctx).Desugar().With(zap.String("destinationrule", destrule.ResourceName()))
 
-	if destrule != nil {
-		trafficPolicy := getTrafficPolicy(destrule, uint32(in.Port))
-		if outlier := trafficPolicy.GetOutlierDetection(); outlier != nil {
-
-			if getLocalityLbSetting(trafficPolicy) != nil {
-				if outCluster.GetCommonLbConfig() == nil {
-					outCluster.CommonLbConfig = &envoy_config_cluster_v3.Cluster_CommonLbConfig{}
-				}
-				outCluster.GetCommonLbConfig().LocalityConfigSpecifier = &envoy_config_cluster_v3.Cluster_CommonLbConfig_LocalityWeightedLbConfig_{
-					LocalityWeightedLbConfig: &envoy_config_cluster_v3.Cluster_CommonLbConfig_LocalityWeightedLbConfig{},
-				}
-			}
-			out := &envoy_config_cluster_v3.OutlierDetection{
-				Consecutive_5Xx:  outlier.GetConsecutive_5XxErrors(),
-				Interval:         outlier.GetInterval(),
-				BaseEjectionTime: outlier.GetBaseEjectionTime(),
-			}
-			if e := outlier.GetConsecutiveGatewayErrors(); e != nil {
-				v := e.GetValue()
-				out.ConsecutiveGatewayFailure = &wrapperspb.UInt32Value{Value: v}
-				if v > 0 {
-					v = 100
-				}
-				out.EnforcingConsecutiveGatewayFailure = &wrapperspb.UInt32Value{Value: v}
-			}
-			if outlier.GetMaxEjectionPercent() > 0 {
-				out.MaxEjectionPercent = &wrapperspb.UInt32Value{Value: uint32(outlier.GetMaxEjectionPercent())}
-			}
-			if outlier.GetSplitExternalLocalOriginErrors() {
-				out.SplitExternalLocalOriginErrors = true
-				if outlier.GetConsecutiveLocalOriginFailures().GetValue() > 0 {
-					out.ConsecutiveLocalOriginFailure = &wrapperspb.UInt32Value{Value: outlier.GetConsecutiveLocalOriginFailures().Value}
-					out.EnforcingConsecutiveLocalOriginFailure = &wrapperspb.UInt32Value{Value: 100}
-				}
-				// SuccessRate based outlier detection should be disabled.
-				out.EnforcingLocalOriginSuccessRate = &wrapperspb.UInt32Value{Value: 0}
-			}
-			minHealthPercent := outlier.GetMinHealthPercent()
-			if minHealthPercent >= 0 {
-				if outCluster.GetCommonLbConfig() == nil {
-					outCluster.CommonLbConfig = &envoy_config_cluster_v3.Cluster_CommonLbConfig{}
-				}
-				outCluster.GetCommonLbConfig().HealthyPanicThreshold = &envoy_type_v3.Percent{Value: float64(minHealthPercent)}
-			}
-
-			outCluster.OutlierDetection = out
-
+	trafficPolicy := getTrafficPolicy(destrule, uint32(in.Port))
+	applyOutlierDetection(trafficPolicy, outCluster)
+	applyLoadBalancer(trafficPolicy.GetLoadBalancer(), outCluster)
+	if err := applyConnectionPool(trafficPolicy.GetConnectionPool(), outCluster); err != nil {
+		logger.Error("failed to apply connection pool settings", zap.Error(err))
+	}
+	if tls := trafficPolicy.GetTls(); tls != nil {
+		if err := d.applyTls(kctx, ucc, tls, outCluster); err != nil {
+			logger.Error("failed to apply tls settings", zap.Error(err))
 		}
 	}
+	if len(destrule.Spec.GetSubsets()) > 0 {
+		outCluster.LbSubsetConfig = subsetLbConfig()
+	}
+}
 
+// applyTls applies the tls settings. like in istio, the secret of the credentialName is in the
+// namespace of the proxy.
+func (d *destrulePlugin) applyTls(kctx krt.HandlerContext, ucc ir.UniqlyConnectedClient, tls *v1alpha3.ClientTLSSettings, out *envoy_config_cluster_v3.Cluster) error {
+	var secret *ir.Secret
+	if name := tls.GetCredentialName(); name != "" && tls.GetMode() != v1alpha3.ClientTLSSettings_ISTIO_MUTUAL {
+		var err error
+		secret, err = d.secrets.GetSecret(kctx, krtcollections.From{GroupKind: DestinationRuleGK, Namespace: ucc.Namespace}, gwv1.SecretObjectReference{
+			Name: gwv1.ObjectName(name),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get tls secret %s: %w", name, err)
+		}
+	}
+	return applyTls(tls, secret, out)
 }
 
 func getPriorityInfoFromDestrule(localityLb *v1alpha3.LocalityLoadBalancerSetting) *endpoints.PriorityInfo {
@@ -0,0 +1,295 @@
+package destrule
+
+import (
+	"context"
+	"testing"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	endpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_upstreams_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gateway2/ir"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"google.golang.org/protobuf/types/known/anypb"
+	"istio.io/api/networking/v1alpha3"
+	networkingclient "istio.io/client-go/pkg/apis/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestOutlierDetection(t *testing.T) {
+	g := NewWithT(t)
+
+	destRule := &DestinationRuleWrapper{&networkingclient.DestinationRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "do-failover",
+		},
+		Spec: v1alpha3.DestinationRule{
+			Host: "reviews.gwtest.svc.cluster.local",
+			TrafficPolicy: &v1alpha3.TrafficPolicy{
+				OutlierDetection: &v1alpha3.OutlierDetection{
+					Consecutive_5XxErrors: &wrappers.UInt32Value{Value: 7},
+					Interval:              &duration.Duration{Seconds: 300}, // 5 minutes
+					BaseEjectionTime:      &duration.Duration{Seconds: 900}, // 15 minutes
+				},
+				LoadBalancer: &v1alpha3.LoadBalancerSettings{
+					LocalityLbSetting: &v1alpha3.LocalityLoadBalancerSetting{
+						FailoverPriority: []string{
+							"topology.kubernetes.io/region",
+						},
+					},
+				},
+			},
+		},
+	}}
+	out := &envoy_config_cluster_v3.Cluster{}
+	applyOutlierDetection(getTrafficPolicy(destRule, 8080), out)
+	g.Expect(out.GetOutlierDetection().GetConsecutive_5Xx().GetValue()).To(Equal(uint32(7)))
+	g.Expect(out.GetOutlierDetection().GetInterval().GetSeconds()).To(Equal(int64(300)))
+	g.Expect(out.GetOutlierDetection().GetBaseEjectionTime().GetSeconds()).To(Equal(int64(900)))
+	g.Expect(out.GetCommonLbConfig().GetLocalityWeightedLbConfig()).NotTo(BeNil())
+}
+
+func TestConnectionPool(t *testing.T) {
+	g := NewWithT(t)
+
+	out := &envoy_config_cluster_v3.Cluster{}
+	err := applyConnectionPool(&v1alpha3.ConnectionPoolSettings{
+		Tcp: &v1alpha3.ConnectionPoolSettings_TCPSettings{
+			MaxConnections: 10,
+			ConnectTimeout: &duration.Duration{Seconds: 3},
+			TcpKeepalive: &v1alpha3.ConnectionPoolSettings_TCPSettings_TcpKeepalive{
+				Probes: 5,
+				Time:   &duration.Duration{Seconds: 60},
+			},
+		},
+		Http: &v1alpha3.ConnectionPoolSettings_HTTPSettings{
+			Http1MaxPendingRequests:  20,
+			Http2MaxRequests:         30,
+			MaxRetries:               3,
+			MaxRequestsPerConnection: 100,
+			IdleTimeout:              &duration.Duration{Seconds: 30},
+			H2UpgradePolicy:          v1alpha3.ConnectionPoolSettings_HTTPSettings_UPGRADE,
+			MaxConcurrentStreams:     50,
+		},
+	}, out)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	thresholds := out.GetCircuitBreakers().GetThresholds()[0]
+	g.Expect(thresholds.GetMaxConnections().GetValue()).To(Equal(uint32(10)))
+	g.Expect(thresholds.GetMaxPendingRequests().GetValue()).To(Equal(uint32(20)))
+	g.Expect(thresholds.GetMaxRequests().GetValue()).To(Equal(uint32(30)))
+	g.Expect(thresholds.GetMaxRetries().GetValue()).To(Equal(uint32(3)))
+	g.Expect(out.GetConnectTimeout().GetSeconds()).To(Equal(int64(3)))
+	g.Expect(out.GetUpstreamConnectionOptions().GetTcpKeepalive().GetKeepaliveProbes().GetValue()).To(Equal(uint32(5)))
+	g.Expect(out.GetUpstreamConnectionOptions().GetTcpKeepalive().GetKeepaliveTime().GetValue()).To(Equal(uint32(60)))
+
+	var opts envoy_upstreams_v3.HttpProtocolOptions
+	g.Expect(out.GetTypedExtensionProtocolOptions()["envoy.extensions.upstreams.http.v3.HttpProtocolOptions"].UnmarshalTo(&opts)).To(Succeed())
+	g.Expect(opts.GetCommonHttpProtocolOptions().GetMaxRequestsPerConnection().GetValue()).To(Equal(uint32(100)))
+	g.Expect(opts.GetCommonHttpProtocolOptions().GetIdleTimeout().GetSeconds()).To(Equal(int64(30)))
+	g.Expect(opts.GetExplicitHttpConfig().GetHttp2ProtocolOptions().GetMaxConcurrentStreams().GetValue()).To(Equal(uint32(50)))
+}
+
+func TestLoadBalancer(t *testing.T) {
+	g := NewWithT(t)
+
+	out := &envoy_config_cluster_v3.Cluster{}
+	applyLoadBalancer(&v1alpha3.LoadBalancerSettings{
+		LbPolicy: &v1alpha3.LoadBalancerSettings_Simple{Simple: v1alpha3.LoadBalancerSettings_LEAST_REQUEST},
+		Warmup:   &v1alpha3.WarmupConfiguration{Duration: &duration.Duration{Seconds: 60}},
+	}, out)
+	g.Expect(out.GetLbPolicy()).To(Equal(envoy_config_cluster_v3.Cluster_LEAST_REQUEST))
+	g.Expect(out.GetLeastRequestLbConfig().GetSlowStartConfig().GetSlowStartWindow().GetSeconds()).To(Equal(int64(60)))
+
+	out = &envoy_config_cluster_v3.Cluster{}
+	applyLoadBalancer(&v1alpha3.LoadBalancerSettings{
+		LbPolicy: &v1alpha3.LoadBalancerSettings_ConsistentHash{ConsistentHash: &v1alpha3.LoadBalancerSettings_ConsistentHashLB{
+			HashKey:       &v1alpha3.LoadBalancerSettings_ConsistentHashLB_HttpHeaderName{HttpHeaderName: "x-user"},
+			HashAlgorithm: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_Maglev{Maglev: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_MagLev{TableSize: 65537}},
+		}},
+	}, out)
+	g.Expect(out.GetLbPolicy()).To(Equal(envoy_config_cluster_v3.Cluster_MAGLEV))
+	g.Expect(out.GetMaglevLbConfig().GetTableSize().GetValue()).To(Equal(uint64(65537)))
+}
+
+func TestHashPolicies(t *testing.T) {
+	g := NewWithT(t)
+
+	policies := hashPolicies(&v1alpha3.LoadBalancerSettings_ConsistentHashLB{
+		HashKey: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_HttpCookie{HttpCookie: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_HTTPCookie{
+			Name: "session",
+			Ttl:  &duration.Duration{Seconds: 10},
+		}},
+	})
+	g.Expect(policies).To(HaveLen(1))
+	g.Expect(policies[0].GetCookie().GetName()).To(Equal("session"))
+
+	policies = hashPolicies(&v1alpha3.LoadBalancerSettings_ConsistentHashLB{
+		HashKey: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_UseSourceIp{UseSourceIp: true},
+	})
+	g.Expect(policies[0].GetConnectionProperties().GetSourceIp()).To(BeTrue())
+
+	g.Expect(hashPolicies(nil)).To(BeEmpty())
+}
+
+func TestServiceForHost(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(serviceForHost("ns", "reviews")).To(Equal("reviews"))
+	g.Expect(serviceForHost("ns", "reviews.ns.svc.cluster.local")).To(Equal("reviews"))
+	g.Expect(serviceForHost("ns", "reviews.other.svc.cluster.local")).To(BeEmpty())
+	g.Expect(serviceForHost("ns", "*.ns.svc.cluster.local")).To(BeEmpty())
+	g.Expect(serviceForHost("ns", "example.com")).To(BeEmpty())
+}
+
+func TestTls(t *testing.T) {
+	g := NewWithT(t)
+
+	out := &envoy_config_cluster_v3.Cluster{
+		TransportSocketMatches: []*envoy_config_cluster_v3.Cluster_TransportSocketMatch{{Name: "istio"}},
+	}
+	secret := &ir.Secret{
+		ObjectSource: ir.ObjectSource{Name: "client-creds"},
+		Data: map[string][]byte{
+			"tls.crt": []byte("cert"),
+			"tls.key": []byte("key"),
+			"ca.crt":  []byte("ca"),
+		},
+	}
+	err := applyTls(&v1alpha3.ClientTLSSettings{
+		Mode:            v1alpha3.ClientTLSSettings_MUTUAL,
+		CredentialName:  "client-creds",
+		Sni:             "reviews.example.com",
+		SubjectAltNames: []string{"reviews.example.com", "spiffe://cluster.local/ns/default/sa/reviews"},
+	}, secret, out)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out.GetTransportSocketMatches()).To(BeEmpty())
+	g.Expect(out.GetTransportSocket().GetName()).To(Equal(wellknown.TransportSocketTls))
+
+	var tlsContext envoyauth.UpstreamTlsContext
+	g.Expect(out.GetTransportSocket().GetTypedConfig().UnmarshalTo(&tlsContext)).To(Succeed())
+	g.Expect(tlsContext.GetSni()).To(Equal("reviews.example.com"))
+	common := tlsContext.GetCommonTlsContext()
+	g.Expect(common.GetTlsCertificates()[0].GetCertificateChain().GetInlineBytes()).To(Equal([]byte("cert")))
+	g.Expect(common.GetValidationContext().GetTrustedCa().GetInlineBytes()).To(Equal([]byte("ca")))
+	sans := common.GetValidationContext().GetMatchTypedSubjectAltNames()
+	g.Expect(sans).To(HaveLen(2))
+	g.Expect(sans[0].GetSanType()).To(Equal(envoyauth.SubjectAltNameMatcher_DNS))
+	g.Expect(sans[1].GetSanType()).To(Equal(envoyauth.SubjectAltNameMatcher_URI))
+
+	err = applyTls(&v1alpha3.ClientTLSSettings{Mode: v1alpha3.ClientTLSSettings_MUTUAL}, nil, out)
+	g.Expect(err).To(MatchError(ContainSubstring("requires a credentialName")))
+
+	// plaintext, even with auto mtls
+	out = &envoy_config_cluster_v3.Cluster{}
+	g.Expect(applyTls(&v1alpha3.ClientTLSSettings{Mode: v1alpha3.ClientTLSSettings_DISABLE}, nil, out)).To(Succeed())
+	g.Expect(out.GetTransportSocket().GetName()).To(Equal(wellknown.TransportSocketRawBuffer))
+}
+
+func TestSubsets(t *testing.T) {
+	g := NewWithT(t)
+
+	us := ir.Upstream{
+		ObjectSource: ir.ObjectSource{
+			Namespace: "ns",
+			Name:      "reviews",
+			Kind:      "Service",
+		},
+	}
+	efu := ir.NewEndpointsForUpstream(us)
+	for path, version := range map[string]string{"a": "v1", "b": "v2"} {
+		efu.Add(ir.PodLocality{}, ir.EndpointWithMd{
+			LbEndpoint: &endpointv3.LbEndpoint{
+				HostIdentifier: &endpointv3.LbEndpoint_Endpoint{
+					Endpoint: &endpointv3.Endpoint{
+						Address: &corev3.Address{
+							Address: &corev3.Address_Pipe{Pipe: &corev3.Pipe{Path: path}},
+						},
+					},
+				},
+			},
+			EndpointMd: ir.EndpointMetadata{
+				Labels: map[string]string{"app": "reviews", "version": version},
+			},
+		})
+	}
+	subsets := []*v1alpha3.Subset{
+		{Name: "v1", Labels: map[string]string{"version": "v1"}},
+		{Name: "all", Labels: map[string]string{"app": "reviews"}},
+	}
+
+	out := withSubsetMetadata(subsets, *efu)
+	for _, ep := range out.LbEps[ir.PodLocality{}] {
+		names := ep.LbEndpoint.GetMetadata().GetFilterMetadata()[translator.EnvoyLb].GetFields()[SubsetMetadataKey].GetListValue().AsSlice()
+		if ep.EndpointMd.Labels["version"] == "v1" {
+			g.Expect(names).To(ConsistOf("v1", "all"))
+		} else {
+			g.Expect(names).To(ConsistOf("all"))
+		}
+	}
+	// the input is not modified
+	for _, ep := range efu.LbEps[ir.PodLocality{}] {
+		g.Expect(ep.LbEndpoint.GetMetadata()).To(BeNil())
+	}
+
+	typedConfig := map[string]*anypb.Any{}
+	pCtx := &ir.RouteBackendContext{Upstream: &us, TypedFiledConfig: &typedConfig}
+	g.Expect((&destrulePass{}).ApplyForRouteBackend(context.Background(), &subsetFilter{Name: "v1"}, pCtx)).To(Succeed())
+	g.Expect(pCtx.MetadataMatch.GetFilterMetadata()[translator.EnvoyLb].GetFields()[SubsetMetadataKey].GetStringValue()).To(Equal("v1"))
+
+	pCtx = &ir.RouteBackendContext{Upstream: &ir.Upstream{ObjectSource: ir.ObjectSource{Group: "gateway.gloo.solo.io", Kind: "Upstream"}}}
+	g.Expect((&destrulePass{}).ApplyForRouteBackend(context.Background(), &subsetFilter{Name: "v1"}, pCtx)).NotTo(Succeed())
+}
+
+func TestUnsupportedFields(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(unsupportedFields(&v1alpha3.DestinationRule{
+		TrafficPolicy: &v1alpha3.TrafficPolicy{
+			LoadBalancer: &v1alpha3.LoadBalancerSettings{
+				LbPolicy: &v1alpha3.LoadBalancerSettings_Simple{Simple: v1alpha3.LoadBalancerSettings_ROUND_ROBIN},
+			},
+			Tunnel: &v1alpha3.TrafficPolicy_TunnelSettings{TargetHost: "proxy"},
+			PortLevelSettings: []*v1alpha3.TrafficPolicy_PortTrafficPolicy{{
+				LoadBalancer: &v1alpha3.LoadBalancerSettings{
+					LbPolicy: &v1alpha3.LoadBalancerSettings_Simple{Simple: v1alpha3.LoadBalancerSettings_PASSTHROUGH},
+				},
+			}},
+		},
+		Subsets: []*v1alpha3.Subset{{Name: "v1", TrafficPolicy: &v1alpha3.TrafficPolicy{}}},
+	})).To(Equal([]string{
+		"trafficPolicy.tunnel",
+		"trafficPolicy.portLevelSettings[0].loadBalancer.simple",
+		"subsets[0].trafficPolicy",
+	}))
+}
+
+func TestWarnUnsupportedFields(t *testing.T) {
+	g := NewWithT(t)
+
+	recorder := record.NewFakeRecorder(10)
+	warnUnsupportedFields(recorder, DestinationRuleWrapper{&networkingclient.DestinationRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "supported"},
+		Spec:       v1alpha3.DestinationRule{Host: "reviews"},
+	}})
+	g.Expect(recorder.Events).To(BeEmpty())
+
+	warnUnsupportedFields(recorder, DestinationRuleWrapper{&networkingclient.DestinationRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "unsupported"},
+		Spec: v1alpha3.DestinationRule{
+			Host: "reviews",
+			TrafficPolicy: &v1alpha3.TrafficPolicy{
+				Tunnel:        &v1alpha3.TrafficPolicy_TunnelSettings{TargetHost: "proxy"},
+				ProxyProtocol: &v1alpha3.TrafficPolicy_ProxyProtocol{},
+			},
+		},
+	}})
+	g.Expect(recorder.Events).To(Receive(Equal(
+		"Warning UnsupportedFields the gateway ignores these fields: trafficPolicy.tunnel, trafficPolicy.proxyProtocol")))
+}
@@ -0,0 +1,195 @@
+package destrule
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/solo-io/gloo/projects/gateway2/ir"
+	"github.com/solo-io/gloo/projects/gateway2/utils/krtutil"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pkg/kube/krt"
+)
+
+// destrulePolicy is a DestinationRule attached to the Service of its host, so that routes to the
+// service can be configured for it (e.g. with the hash policy of consistent hash load balancing).
+type destrulePolicy struct {
+	DestinationRuleWrapper
+}
+
+func (p *destrulePolicy) CreationTime() time.Time {
+	return p.CreationTimestamp.Time
+}
+
+func (p *destrulePolicy) Equals(in any) bool {
+	p2, ok := in.(*destrulePolicy)
+	if !ok {
+		return false
+	}
+	return p.DestinationRuleWrapper.Equals(p2.DestinationRuleWrapper)
+}
+
+// newDestrulePolicies attaches the DestinationRules that need to configure routes to the Services of
+// their hosts. Routes are not per client, so DestinationRules with a workload selector are not
+// attached. exportTo is not considered either, but the hash policies have no effect on clusters
+// that don't use consistent hash load balancing.
+func newDestrulePolicies(destrules krt.Collection[DestinationRuleWrapper], krtopts *krtutil.KrtOptions) krt.Collection[ir.PolicyWrapper] {
+	return krt.NewCollection(destrules, func(kctx krt.HandlerContext, dr DestinationRuleWrapper) *ir.PolicyWrapper {
+		if len(dr.Spec.GetWorkloadSelector().GetMatchLabels()) > 0 || !hasConsistentHash(&dr.Spec) {
+			return nil
+		}
+		svc := serviceForHost(dr.Namespace, dr.Spec.GetHost())
+		if svc == "" {
+			return nil
+		}
+		return &ir.PolicyWrapper{
+			ObjectSource: ir.ObjectSource{
+				Group:     DestinationRuleGK.Group,
+				Kind:      DestinationRuleGK.Kind,
+				Namespace: dr.Namespace,
+				Name:      dr.Name,
+			},
+			Policy:   dr.DestinationRule,
+			PolicyIR: &destrulePolicy{dr},
+			TargetRefs: []ir.PolicyTargetRef{{
+				Kind: "Service",
+				Name: svc,
+			}},
+		}
+	}, krtopts.ToOptions("DestinationRulePolicies")...)
+}
+
+func hasConsistentHash(spec *v1alpha3.DestinationRule) bool {
+	if spec.GetTrafficPolicy().GetLoadBalancer().GetConsistentHash().GetHashKey() != nil {
+		return true
+	}
+	for _, portLevel := range spec.GetTrafficPolicy().GetPortLevelSettings() {
+		if portLevel.GetLoadBalancer().GetConsistentHash().GetHashKey() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// serviceForHost returns the name of the Service in namespace ns the host refers to, if any.
+// like in istio, short names are relative to the namespace of the DestinationRule.
+func serviceForHost(ns, host string) string {
+	if host == "" || strings.Contains(host, "*") {
+		return ""
+	}
+	parts := strings.Split(host, ".")
+	if len(parts) == 1 {
+		return host
+	}
+	if len(parts) >= 3 && parts[1] == ns && parts[2] == "svc" {
+		return parts[0]
+	}
+	return ""
+}
+
+func hashPolicies(consistentHash *v1alpha3.LoadBalancerSettings_ConsistentHashLB) []*envoy_config_route_v3.RouteAction_HashPolicy {
+	var policy *envoy_config_route_v3.RouteAction_HashPolicy
+	switch {
+	case consistentHash.GetHttpHeaderName() != "":
+		policy = &envoy_config_route_v3.RouteAction_HashPolicy{
+			PolicySpecifier: &envoy_config_route_v3.RouteAction_HashPolicy_Header_{
+				Header: &envoy_config_route_v3.RouteAction_HashPolicy_Header{
+					HeaderName: consistentHash.GetHttpHeaderName(),
+				},
+			},
+		}
+	case consistentHash.GetHttpCookie() != nil:
+		cookie := consistentHash.GetHttpCookie()
+		policy = &envoy_config_route_v3.RouteAction_HashPolicy{
+			PolicySpecifier: &envoy_config_route_v3.RouteAction_HashPolicy_Cookie_{
+				Cookie: &envoy_config_route_v3.RouteAction_HashPolicy_Cookie{
+					Name: cookie.GetName(),
+					Path: cookie.GetPath(),
+					Ttl:  cookie.GetTtl(),
+				},
+			},
+		}
+	case consistentHash.GetUseSourceIp():
+		policy = &envoy_config_route_v3.RouteAction_HashPolicy{
+			PolicySpecifier: &envoy_config_route_v3.RouteAction_HashPolicy_ConnectionProperties_{
+				ConnectionProperties: &envoy_config_route_v3.RouteAction_HashPolicy_ConnectionProperties{
+					SourceIp: true,
+				},
+			},
+		}
+	case consistentHash.GetHttpQueryParameterName() != "":
+		policy = &envoy_config_route_v3.RouteAction_HashPolicy{
+			PolicySpecifier: &envoy_config_route_v3.RouteAction_HashPolicy_QueryParameter_{
+				QueryParameter: &envoy_config_route_v3.RouteAction_HashPolicy_QueryParameter{
+					Name: consistentHash.GetHttpQueryParameterName(),
+				},
+			},
+		}
+	default:
+		return nil
+	}
+	return []*envoy_config_route_v3.RouteAction_HashPolicy{policy}
+}
+
+func isService(us *ir.Upstream) bool {
+	return us != nil && us.Group == "" && us.Kind == "Service"
+}
+
+type destrulePass struct{}
+
+func newPass(ctx context.Context, tctx ir.GwTranslationCtx) ir.ProxyTranslationPass {
+	return &destrulePass{}
+}
+
+func (p *destrulePass) Name() string {
+	return "destrule"
+}
+
+func (p *destrulePass) ApplyListenerPlugin(ctx context.Context, pCtx *ir.ListenerContext, out *envoy_config_listener_v3.Listener) {
+}
+
+func (p *destrulePass) ApplyVhostPlugin(ctx context.Context, pCtx *ir.VirtualHostContext, out *envoy_config_route_v3.VirtualHost) {
+}
+
+func (p *destrulePass) ApplyForRoute(ctx context.Context, pCtx *ir.RouteContext, outputRoute *envoy_config_route_v3.Route) error {
+	return nil
+}
+
+// ApplyForRouteBackend selects the subset of a Subset filter, and adds the hash policy of the
+// DestinationRule of the backend's service.
+func (p *destrulePass) ApplyForRouteBackend(ctx context.Context, policy ir.PolicyIR, pCtx *ir.RouteBackendContext) error {
+	switch policy := policy.(type) {
+	case *subsetFilter:
+		if !isService(pCtx.Upstream) {
+			return fmt.Errorf("subset filter %s can only be used with Service backends", policy.Name)
+		}
+		subsetMetadataMatch(pCtx, policy.Name)
+	case *destrulePolicy:
+		if pCtx.Upstream == nil {
+			return nil
+		}
+		trafficPolicy := getTrafficPolicy(&policy.DestinationRuleWrapper, uint32(pCtx.Upstream.Port))
+		pCtx.HashPolicies = append(pCtx.HashPolicies, hashPolicies(trafficPolicy.GetLoadBalancer().GetConsistentHash())...)
+	}
+	return nil
+}
+
+func (p *destrulePass) HttpFilters(ctx context.Context, fc ir.FilterChainCommon) ([]plugins.StagedHttpFilter, error) {
+	return nil, nil
+}
+
+func (p *destrulePass) UpstreamHttpFilters(ctx context.Context) ([]plugins.StagedUpstreamHttpFilter, error) {
+	return nil, nil
+}
+
+func (p *destrulePass) NetworkFilters(ctx context.Context) ([]plugins.StagedNetworkFilter, error) {
+	return nil, nil
+}
+
+func (p *destrulePass) ResourcesToAdd(ctx context.Context) ir.Resources {
+	return ir.Resources{}
+}
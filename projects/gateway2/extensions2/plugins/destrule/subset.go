@@ -0,0 +1,105 @@
+package destrule
+
+import (
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	"github.com/solo-io/gloo/projects/gateway2/ir"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"istio.io/api/networking/v1alpha3"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// SubsetMetadataKey is the endpoint metadata key that holds the names of the DestinationRule subsets
+// the endpoint belongs to. An endpoint can be in more than one subset, so the value is a list.
+const SubsetMetadataKey = "gloo.solo.io/subset"
+
+// subsetFilter selects a subset of a DestinationRule, by name, for a backendRef.
+type subsetFilter struct {
+	Name string
+}
+
+func (f *subsetFilter) CreationTime() time.Time {
+	return time.Time{}
+}
+
+func (f *subsetFilter) Equals(in any) bool {
+	f2, ok := in.(*subsetFilter)
+	if !ok {
+		return false
+	}
+	return *f == *f2
+}
+
+// subsetLbConfig sets up subset load balancing on the subset names of the endpoints. Routes that
+// don't select a subset use all the endpoints; routes that select a subset no endpoint is in, like
+// a subset that doesn't exist, get no healthy upstream.
+func subsetLbConfig() *envoy_config_cluster_v3.Cluster_LbSubsetConfig {
+	return &envoy_config_cluster_v3.Cluster_LbSubsetConfig{
+		FallbackPolicy: envoy_config_cluster_v3.Cluster_LbSubsetConfig_ANY_ENDPOINT,
+		ListAsAny:      true,
+		SubsetSelectors: []*envoy_config_cluster_v3.Cluster_LbSubsetConfig_LbSubsetSelector{{
+			Keys:           []string{SubsetMetadataKey},
+			FallbackPolicy: envoy_config_cluster_v3.Cluster_LbSubsetConfig_LbSubsetSelector_NO_FALLBACK,
+		}},
+	}
+}
+
+// withSubsetMetadata returns a copy of the endpoints, with the names of the subsets that match each
+// endpoint's labels in its load balancing metadata.
+func withSubsetMetadata(subsets []*v1alpha3.Subset, in ir.EndpointsForUpstream) ir.EndpointsForUpstream {
+	out := in
+	out.LbEps = make(ir.LocalityLbMap, len(in.LbEps))
+	for loc, eps := range in.LbEps {
+		outEps := make([]ir.EndpointWithMd, 0, len(eps))
+		for _, ep := range eps {
+			var names []*structpb.Value
+			for _, subset := range subsets {
+				if labels.SelectorFromSet(subset.GetLabels()).Matches(labels.Set(ep.EndpointMd.Labels)) {
+					names = append(names, structpb.NewStringValue(subset.GetName()))
+				}
+			}
+			if len(names) > 0 {
+				ep.LbEndpoint = withSubsetNames(ep.LbEndpoint, names)
+			}
+			outEps = append(outEps, ep)
+		}
+		out.LbEps[loc] = outEps
+	}
+	return out
+}
+
+func withSubsetNames(in *envoy_config_endpoint_v3.LbEndpoint, names []*structpb.Value) *envoy_config_endpoint_v3.LbEndpoint {
+	out := proto.Clone(in).(*envoy_config_endpoint_v3.LbEndpoint)
+	if out.GetMetadata() == nil {
+		out.Metadata = &envoy_config_core_v3.Metadata{}
+	}
+	if out.GetMetadata().GetFilterMetadata() == nil {
+		out.GetMetadata().FilterMetadata = map[string]*structpb.Struct{}
+	}
+	lbMetadata := out.GetMetadata().GetFilterMetadata()[translator.EnvoyLb]
+	if lbMetadata == nil {
+		lbMetadata = &structpb.Struct{Fields: map[string]*structpb.Value{}}
+		out.GetMetadata().GetFilterMetadata()[translator.EnvoyLb] = lbMetadata
+	}
+	lbMetadata.GetFields()[SubsetMetadataKey] = structpb.NewListValue(&structpb.ListValue{Values: names})
+	return out
+}
+
+func subsetMetadataMatch(pCtx *ir.RouteBackendContext, name string) {
+	if pCtx.MetadataMatch == nil {
+		pCtx.MetadataMatch = &envoy_config_core_v3.Metadata{
+			FilterMetadata: map[string]*structpb.Struct{},
+		}
+	}
+	lbMatch := pCtx.MetadataMatch.GetFilterMetadata()[translator.EnvoyLb]
+	if lbMatch == nil {
+		lbMatch = &structpb.Struct{Fields: map[string]*structpb.Value{}}
+		pCtx.MetadataMatch.GetFilterMetadata()[translator.EnvoyLb] = lbMatch
+	}
+	lbMatch.GetFields()[SubsetMetadataKey] = structpb.NewStringValue(name)
+}
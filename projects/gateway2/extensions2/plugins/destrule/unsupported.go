@@ -0,0 +1,86 @@
+package destrule
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/solo-io/gloo/projects/gateway2/wellknown"
+	"istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pkg/kube"
+	"istio.io/istio/pkg/kube/controllers"
+	"istio.io/istio/pkg/kube/krt"
+	corev1 "k8s.io/api/core/v1"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// UnsupportedFieldsReason is the reason of the Warning events recorded on the DestinationRules that set fields
+// the gateway ignores
+const UnsupportedFieldsReason = "UnsupportedFields"
+
+// reportUnsupportedFields records a Warning event on each DestinationRule that sets fields the gateway ignores,
+// until ctx is done. DestinationRules are not given a status, since theirs is written by istiod.
+func reportUnsupportedFields(client kube.Client, destrules krt.Collection[DestinationRuleWrapper]) func(ctx context.Context) {
+	return func(ctx context.Context) {
+		broadcaster := record.NewBroadcaster()
+		defer broadcaster.Shutdown()
+		broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: client.Kube().CoreV1().Events("")})
+		recorder := broadcaster.NewRecorder(kube.IstioScheme, corev1.EventSource{Component: wellknown.GatewayControllerName})
+
+		destrules.Register(func(o krt.Event[DestinationRuleWrapper]) {
+			if o.Event == controllers.EventDelete {
+				return
+			}
+			warnUnsupportedFields(recorder, o.Latest())
+		})
+		<-ctx.Done()
+	}
+}
+
+func warnUnsupportedFields(recorder record.EventRecorder, dr DestinationRuleWrapper) {
+	if fields := unsupportedFields(&dr.Spec); len(fields) > 0 {
+		recorder.Eventf(dr.DestinationRule, corev1.EventTypeWarning, UnsupportedFieldsReason,
+			"the gateway ignores these fields: %s", strings.Join(fields, ", "))
+	}
+}
+
+// unsupportedFields returns the paths of the fields of the DestinationRule that are set, but not
+// translated by the gateway.
+func unsupportedFields(spec *v1alpha3.DestinationRule) []string {
+	var fields []string
+	fields = append(fields, unsupportedTrafficPolicyFields("trafficPolicy", spec.GetTrafficPolicy())...)
+	for i, portLevel := range spec.GetTrafficPolicy().GetPortLevelSettings() {
+		fields = append(fields, unsupportedTrafficPolicyFields(fmt.Sprintf("trafficPolicy.portLevelSettings[%d]", i), convertPortLevel(portLevel))...)
+	}
+	for i, subset := range spec.GetSubsets() {
+		// subsets share the cluster of the service, so they can't have their own traffic policies.
+		if subset.GetTrafficPolicy() != nil {
+			fields = append(fields, fmt.Sprintf("subsets[%d].trafficPolicy", i))
+		}
+	}
+	return fields
+}
+
+func unsupportedTrafficPolicyFields(path string, trafficPolicy *v1alpha3.TrafficPolicy) []string {
+	if trafficPolicy == nil {
+		return nil
+	}
+	var fields []string
+	if trafficPolicy.GetTunnel() != nil {
+		fields = append(fields, path+".tunnel")
+	}
+	if trafficPolicy.GetProxyProtocol() != nil {
+		fields = append(fields, path+".proxyProtocol")
+	}
+	if trafficPolicy.GetLoadBalancer().GetSimple() == v1alpha3.LoadBalancerSettings_PASSTHROUGH {
+		fields = append(fields, path+".loadBalancer.simple")
+	}
+	if trafficPolicy.GetConnectionPool().GetTcp().GetIdleTimeout() != nil {
+		fields = append(fields, path+".connectionPool.tcp.idleTimeout")
+	}
+	if trafficPolicy.GetOutlierDetection().GetConsecutiveErrors() != 0 {
+		fields = append(fields, path+".outlierDetection.consecutiveErrors")
+	}
+	return fields
+}
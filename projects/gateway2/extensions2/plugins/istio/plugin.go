@@ -104,9 +104,10 @@ func isDisabledForUpstream(upstream ir.Upstream) bool {
 // we don't have a good way of know if we have ssl on the upstream, so check cluster instead
 // this could be a problem if the policy that adds ssl runs after this one.
 // so we need to think about how's best to handle this.
+// for now, policies that configure the transport socket (like DestinationRule tls settings) also
+// remove the transport socket matches, so it works in either order.
 func doesClusterHaveSslConfigPresent(out *envoy_config_cluster_v3.Cluster) bool {
-	// TODO: implement this
-	return false
+	return out.GetTransportSocket() != nil
 }
 
 func (p plugin) processUpstream(ctx context.Context, settings ir.PolicyIR, in ir.Upstream, out *envoy_config_cluster_v3.Cluster) {
@@ -71,6 +71,12 @@ func NewPluginFromCollections(ctx context.Context, krtOpts krtutil.KrtOptions,
 				Upstreams: k8sServiceUpstreams,
 			},
 		},
+		ContributesPolicies: map[schema.GroupKind]extensionsplug.PolicyPlugin{
+			gk: {
+				Name:                      "kubernetes",
+				PerClientProcessEndpoints: processEndpoints(k8sServiceUpstreams),
+			},
+		},
 	}
 }
 
@@ -0,0 +1,74 @@
+package kubernetes
+
+import (
+	"context"
+	"hash/fnv"
+	"strings"
+
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	"github.com/solo-io/gloo/projects/gateway2/endpoints"
+	extensionsplug "github.com/solo-io/gloo/projects/gateway2/extensions2/plugin"
+	"github.com/solo-io/gloo/projects/gateway2/ir"
+	"github.com/solo-io/go-utils/contextutils"
+	"istio.io/istio/pkg/kube/krt"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// FailoverPriorityAnnotation sets the order in which the endpoints of a Service fail over, as a comma
+// separated list of labels, like the failoverPriority of an Istio DestinationRule. The endpoints that
+// match the proxy on all the labels have the highest priority, then those that match on all but the
+// last label, and so on. The localities of pods and proxies are available as the well known
+// topology labels, so "topology.kubernetes.io/region,topology.kubernetes.io/zone" prefers the zone
+// of the proxy, then its region.
+const FailoverPriorityAnnotation = "gateway.gloo.solo.io/failover-priority"
+
+// processEndpoints prioritizes the endpoints of Services relative to the locality of each proxy, when
+// the Service asks for it. Endpoints of Services with a DestinationRule are prioritized by the
+// destrule plugin instead.
+func processEndpoints(services krt.Collection[ir.Upstream]) extensionsplug.EndpointPlugin {
+	return func(kctx krt.HandlerContext, ctx context.Context, ucc ir.UniqlyConnectedClient, in ir.EndpointsForUpstream) (*envoy_config_endpoint_v3.ClusterLoadAssignment, uint64) {
+		us := krt.FetchOne(kctx, services, krt.FilterKey(in.UpstreamResourceName))
+		if us == nil {
+			return nil, 0
+		}
+		svc, ok := us.Obj.(*corev1.Service)
+		if !ok {
+			return nil, 0
+		}
+		priorityInfo, additionalHash := priorityInfoForService(svc)
+		if priorityInfo == nil {
+			return nil, 0
+		}
+		logger := contextutils.LoggerFrom(ctx).Desugar()
+		return endpoints.PrioritizeEndpoints(logger, priorityInfo, in, ucc), additionalHash
+	}
+}
+
+// priorityInfoForService returns how to prioritize the endpoints of the service, and a hash of the
+// configuration it came from; or nil if the service doesn't prefer close endpoints.
+func priorityInfoForService(svc *corev1.Service) (*endpoints.PriorityInfo, uint64) {
+	hasher := fnv.New64a()
+	if failoverPriority := svc.Annotations[FailoverPriorityAnnotation]; failoverPriority != "" {
+		var labels []string
+		for _, label := range strings.Split(failoverPriority, ",") {
+			if label = strings.TrimSpace(label); label != "" {
+				labels = append(labels, label)
+			}
+		}
+		if prioritizer := endpoints.NewPriorities(labels); prioritizer != nil {
+			hasher.Write([]byte(FailoverPriorityAnnotation))
+			hasher.Write([]byte(strings.Join(labels, ",")))
+			return &endpoints.PriorityInfo{FailoverPriority: prioritizer}, hasher.Sum64()
+		}
+	}
+
+	// topology aware routing: the EndpointSlice controller adds hints to the endpoints. when they
+	// are missing, fall back to preferring the locality of the proxy.
+	topologyMode := strings.ToLower(svc.Annotations[corev1.AnnotationTopologyMode])
+	preferClose := svc.Spec.TrafficDistribution != nil && *svc.Spec.TrafficDistribution == corev1.ServiceTrafficDistributionPreferClose
+	if topologyMode == "auto" || preferClose {
+		hasher.Write([]byte(corev1.AnnotationTopologyMode))
+		return &endpoints.PriorityInfo{ZoneHints: true}, hasher.Sum64()
+	}
+	return nil, 0
+}
@@ -0,0 +1,46 @@
+package kubernetes
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestPriorityInfoForService(t *testing.T) {
+	g := NewWithT(t)
+
+	info, _ := priorityInfoForService(&corev1.Service{})
+	g.Expect(info).To(BeNil())
+
+	info, hash := priorityInfoForService(&corev1.Service{
+		Spec: corev1.ServiceSpec{
+			TrafficDistribution: ptr.To(corev1.ServiceTrafficDistributionPreferClose),
+		},
+	})
+	g.Expect(info.ZoneHints).To(BeTrue())
+	g.Expect(info.FailoverPriority).To(BeNil())
+
+	info, topologyModeHash := priorityInfoForService(&corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{corev1.AnnotationTopologyMode: "Auto"},
+		},
+	})
+	g.Expect(info.ZoneHints).To(BeTrue())
+	g.Expect(topologyModeHash).To(Equal(hash))
+
+	// the failover priority takes precedence over the topology hints
+	info, failoverHash := priorityInfoForService(&corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				FailoverPriorityAnnotation:    corev1.LabelTopologyRegion + ", " + corev1.LabelTopologyZone,
+				corev1.AnnotationTopologyMode: "Auto",
+			},
+		},
+	})
+	g.Expect(info.ZoneHints).To(BeFalse())
+	g.Expect(info.FailoverPriority).NotTo(BeNil())
+	g.Expect(failoverHash).NotTo(Equal(hash))
+}
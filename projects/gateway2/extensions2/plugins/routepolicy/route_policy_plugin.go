@@ -4,24 +4,39 @@ import (
 	"context"
 	"time"
 
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyextproc "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/projects/gateway2/api/v1alpha1"
 	"github.com/solo-io/gloo/projects/gateway2/extensions2/common"
 	extensionplug "github.com/solo-io/gloo/projects/gateway2/extensions2/plugin"
 	extensionsplug "github.com/solo-io/gloo/projects/gateway2/extensions2/plugin"
 	"github.com/solo-io/gloo/projects/gateway2/ir"
 	"github.com/solo-io/gloo/projects/gateway2/utils/krtutil"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extproc"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	extprocplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/extproc"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"istio.io/istio/pkg/kube/krt"
 )
 
+var (
+	UpstreamNotFoundError = func(nn types.NamespacedName) error {
+		return eris.Errorf("ext_proc server upstream %s not found", nn)
+	}
+)
+
 type routeOptsPlugin struct {
-	ct   time.Time
-	spec v1alpha1.RoutePolicySpec
+	ct      time.Time
+	spec    v1alpha1.RoutePolicySpec
+	extProc *envoyextproc.ExtProcPerRoute
 }
 
 func (d *routeOptsPlugin) CreationTime() time.Time {
@@ -33,10 +48,15 @@ func (d *routeOptsPlugin) Equals(in any) bool {
 	if !ok {
 		return false
 	}
-	return d.spec == d2.spec
+	return d.spec.TargetRef == d2.spec.TargetRef &&
+		d.spec.Timeout == d2.spec.Timeout &&
+		proto.Equal(d.extProc, d2.extProc)
 }
 
 type routeOptsPluginGwPass struct {
+	extProcSettings *extproc.Settings
+	krtCtx          krt.HandlerContext
+	upstreams       krt.Collection[ir.Upstream]
 }
 
 func NewPlugin(ctx context.Context, commoncol *common.CommonCollections) extensionplug.Plugin {
@@ -56,8 +76,12 @@ func NewPlugin(ctx context.Context, commoncol *common.CommonCollections) extensi
 				Namespace: i.Namespace,
 				Name:      i.Name,
 			},
-			Policy:     i,
-			PolicyIR:   &routeOptsPlugin{ct: i.CreationTimestamp.Time, spec: i.Spec},
+			Policy: i,
+			PolicyIR: &routeOptsPlugin{
+				ct:      i.CreationTimestamp.Time,
+				spec:    i.Spec,
+				extProc: translateExtProcPolicy(i.Spec.ExtProc),
+			},
 			TargetRefs: convert(i.Spec.TargetRef),
 		}
 		return pol
@@ -67,7 +91,7 @@ func NewPlugin(ctx context.Context, commoncol *common.CommonCollections) extensi
 		ContributesPolicies: map[schema.GroupKind]extensionsplug.PolicyPlugin{
 			v1alpha1.RoutePolicyGVK.GroupKind(): {
 				//AttachmentPoints: []ir.AttachmentPoints{ir.HttpAttachmentPoint},
-				NewGatewayTranslationPass: NewGatewayTranslationPass,
+				NewGatewayTranslationPass: newGatewayTranslationPass(commoncol.InitialSettings.Spec.GetExtProc()),
 				Policies:                  policyCol,
 			},
 		},
@@ -82,8 +106,11 @@ func convert(targetRef v1alpha1.LocalPolicyTargetReference) []ir.PolicyTargetRef
 	}}
 }
 
-func NewGatewayTranslationPass(ctx context.Context, tctx ir.GwTranslationCtx) ir.ProxyTranslationPass {
-	return &routeOptsPluginGwPass{}
+func newGatewayTranslationPass(extProcSettings *extproc.Settings) func(context.Context, ir.GwTranslationCtx) ir.ProxyTranslationPass {
+	return func(ctx context.Context, tctx ir.GwTranslationCtx) ir.ProxyTranslationPass {
+		// the ext_proc server of the settings runs behind an Upstream, which is fetched from the gateway's translation
+		return &routeOptsPluginGwPass{extProcSettings: extProcSettings, krtCtx: tctx.KrtCtx, upstreams: tctx.Upstreams}
+	}
 }
 func (p *routeOptsPlugin) Name() string {
 	return "routepolicies"
@@ -107,6 +134,10 @@ func (p *routeOptsPluginGwPass) ApplyForRoute(ctx context.Context, pCtx *ir.Rout
 		outputRoute.GetRoute().Timeout = durationpb.New(time.Second * time.Duration(policy.spec.Timeout))
 	}
 
+	if policy.extProc != nil {
+		return pluginutils.SetRoutePerFilterConfig(outputRoute, extprocplugin.FilterName, policy.extProc)
+	}
+
 	return nil
 }
 
@@ -122,7 +153,25 @@ func (p *routeOptsPluginGwPass) ApplyForRouteBackend(
 // if a plugin emits new filters, they must be with a plugin unique name.
 // any filter returned from route config must be disabled, so it doesnt impact other routes.
 func (p *routeOptsPluginGwPass) HttpFilters(ctx context.Context, fcc ir.FilterChainCommon) ([]plugins.StagedHttpFilter, error) {
-	return nil, nil
+	// unlike the filters of route policies, the ext_proc filter configured by the settings applies to
+	// every route; route policies can only disable it or override its processing mode.
+	if p.extProcSettings == nil {
+		return nil, nil
+	}
+	filterStage, err := extprocplugin.FilterStage(p.extProcSettings)
+	if err != nil {
+		return nil, err
+	}
+	// a missing upstream fails the filter, which the translator reports on the listener
+	externalProcessor, err := extprocplugin.NewExternalProcessor(ctx, p.extProcSettings, p.upstreamClusterName)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := plugins.NewStagedFilter(extprocplugin.FilterName, externalProcessor, filterStage)
+	if err != nil {
+		return nil, err
+	}
+	return []plugins.StagedHttpFilter{filter}, nil
 }
 
 func (p *routeOptsPluginGwPass) UpstreamHttpFilters(ctx context.Context) ([]plugins.StagedUpstreamHttpFilter, error) {
@@ -137,3 +186,72 @@ func (p *routeOptsPluginGwPass) NetworkFilters(ctx context.Context) ([]plugins.S
 func (p *routeOptsPluginGwPass) ResourcesToAdd(ctx context.Context) ir.Resources {
 	return ir.Resources{}
 }
+
+// upstreamClusterName returns the cluster of the Upstream that runs the ext_proc server
+func (p *routeOptsPluginGwPass) upstreamClusterName(ref *core.ResourceRef) (string, error) {
+	gk := v1alpha1.UpstreamGVK.GroupKind()
+	key := ir.UpstreamResourceName(ir.ObjectSource{
+		Group:     gk.Group,
+		Kind:      gk.Kind,
+		Namespace: ref.GetNamespace(),
+		Name:      ref.GetName(),
+	}, 0)
+	upstream := krt.FetchOne(p.krtCtx, p.upstreams, krt.FilterKey(key))
+	if upstream == nil {
+		return "", UpstreamNotFoundError(types.NamespacedName{Namespace: ref.GetNamespace(), Name: ref.GetName()})
+	}
+	return upstream.ClusterName(), nil
+}
+
+func translateExtProcPolicy(in *v1alpha1.ExtProcPolicy) *envoyextproc.ExtProcPerRoute {
+	if in == nil {
+		return nil
+	}
+	if in.Disable {
+		return &envoyextproc.ExtProcPerRoute{
+			Override: &envoyextproc.ExtProcPerRoute_Disabled{Disabled: true},
+		}
+	}
+	mode := in.ProcessingMode
+	if mode == nil {
+		return nil
+	}
+	return &envoyextproc.ExtProcPerRoute{
+		Override: &envoyextproc.ExtProcPerRoute_Overrides{
+			Overrides: &envoyextproc.ExtProcOverrides{
+				ProcessingMode: &envoyextproc.ProcessingMode{
+					RequestHeaderMode:   translateHeaderSendMode(mode.RequestHeaderMode),
+					ResponseHeaderMode:  translateHeaderSendMode(mode.ResponseHeaderMode),
+					RequestBodyMode:     translateBodySendMode(mode.RequestBodyMode),
+					ResponseBodyMode:    translateBodySendMode(mode.ResponseBodyMode),
+					RequestTrailerMode:  translateHeaderSendMode(mode.RequestTrailerMode),
+					ResponseTrailerMode: translateHeaderSendMode(mode.ResponseTrailerMode),
+				},
+			},
+		},
+	}
+}
+
+func translateHeaderSendMode(in v1alpha1.ExtProcHeaderSendMode) envoyextproc.ProcessingMode_HeaderSendMode {
+	switch in {
+	case v1alpha1.ExtProcHeaderSendModeSend:
+		return envoyextproc.ProcessingMode_SEND
+	case v1alpha1.ExtProcHeaderSendModeSkip:
+		return envoyextproc.ProcessingMode_SKIP
+	default:
+		return envoyextproc.ProcessingMode_DEFAULT
+	}
+}
+
+func translateBodySendMode(in v1alpha1.ExtProcBodySendMode) envoyextproc.ProcessingMode_BodySendMode {
+	switch in {
+	case v1alpha1.ExtProcBodySendModeStreamed:
+		return envoyextproc.ProcessingMode_STREAMED
+	case v1alpha1.ExtProcBodySendModeBuffered:
+		return envoyextproc.ProcessingMode_BUFFERED
+	case v1alpha1.ExtProcBodySendModeBufferedPartial:
+		return envoyextproc.ProcessingMode_BUFFERED_PARTIAL
+	default:
+		return envoyextproc.ProcessingMode_NONE
+	}
+}
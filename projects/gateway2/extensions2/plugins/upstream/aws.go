@@ -5,27 +5,36 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strings"
 	"unicode/utf8"
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
-	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"github.com/solo-io/gloo/projects/gateway2/api/v1alpha1"
 	"github.com/solo-io/gloo/projects/gateway2/ir"
+	"github.com/solo-io/gloo/projects/gateway2/translator/sslutils"
+	solo_core_v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
 	awspb "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/aws"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/ptr"
 )
 
 const (
 	AccessKey    = "accessKey"
 	SessionToken = "sessionToken"
 	SecretKey    = "secretKey"
+
+	ResponseTransformationName    = "io.solo.api_gateway.api_gateway_transformer"
+	ResponseTransformationTypeUrl = "type.googleapis.com/envoy.config.transformer.aws_lambda.v2.ApiGatewayTransformation"
 )
 
-func processAws(ctx context.Context, in *v1alpha1.AwsUpstream, ir *UpstreamIr, out *envoy_config_cluster_v3.Cluster) {
+func processAws(ctx context.Context, in *v1alpha1.AwsUpstream, ir *UpstreamIr, out *envoy_config_cluster_v3.Cluster) error {
 
 	lambdaHostname := getLambdaHostname(in)
 
@@ -40,57 +49,92 @@ func processAws(ctx context.Context, in *v1alpha1.AwsUpstream, ir *UpstreamIr, o
 
 	commonTlsContext, err := utils.GetCommonTlsContextFromUpstreamOptions(nil)
 	if err != nil {
-		// return err
-		return
+		return err
 	}
-	tlsContext := &envoyauth.UpstreamTlsContext{
+	// verify that we talk to aws, using the CAs of the envoy image.
+	commonTlsContext.ValidationContextType = &envoyauth.CommonTlsContext_ValidationContext{
+		ValidationContext: &envoyauth.CertificateValidationContext{
+			TrustedCa: &envoy_config_core_v3.DataSource{
+				Specifier: &envoy_config_core_v3.DataSource_Filename{Filename: sslutils.SystemCaBundle},
+			},
+			MatchTypedSubjectAltNames: []*envoyauth.SubjectAltNameMatcher{{
+				SanType: envoyauth.SubjectAltNameMatcher_DNS,
+				Matcher: &envoy_type_matcher_v3.StringMatcher{
+					MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: lambdaHostname},
+				},
+			}},
+		},
+	}
+	out.TransportSocket, err = tlsTransportSocket(&envoyauth.UpstreamTlsContext{
 		CommonTlsContext: commonTlsContext,
-		// TODO(yuval-k): Add verification context
-		Sni: lambdaHostname,
-	}
-	typedConfig, err := anypb.New(tlsContext)
+		Sni:              lambdaHostname,
+	})
 	if err != nil {
-		// return err
-		return
-	}
-	out.TransportSocket = &envoy_config_core_v3.TransportSocket{
-		Name:       wellknown.TransportSocketTls,
-		ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
-	}
-
-	// To utilize the aws lambda plugin much of the power comes via its secret management
-	// Check that one of the supported auth paradigms in enabled.
-	// Currently: static secret ref, credential discovery or ServiceAccountCreds such in eks
-
-	if ir.AwsSecret == nil {
-		// return errors.Errorf("no aws secret provided. consider setting enableCredentialsDiscovey to true or enabling service account credentials if running in EKS")
-		return
+		return err
 	}
 
-	// If static secret is set retrieve the information needed
+	// without a static secret, envoy uses the credentials fetcher of the filter, configured
+	// in the settings. validateAws reports upstreams that have neither.
 	var derivedSecret staticSecretDerivation
 	if ir.AwsSecret != nil {
 		derivedSecret, err = deriveStaticSecret(ir.AwsSecret)
 		if err != nil {
-			//			return err
-			return
+			return err
 		}
 	}
 
 	lpe := &awspb.AWSLambdaProtocolExtension{
-		Host:         lambdaHostname,
-		Region:       in.Region,
-		AccessKey:    derivedSecret.access,
-		SecretKey:    derivedSecret.secret,
-		SessionToken: derivedSecret.session,
-		//		RoleArn:             upstreamSpec.Aws.GetRoleArn(),
-		//		DisableRoleChaining: upstreamSpec.Aws.GetDisableRoleChaining(),
+		Host:                lambdaHostname,
+		Region:              in.Region,
+		AccessKey:           derivedSecret.access,
+		SecretKey:           derivedSecret.secret,
+		SessionToken:        derivedSecret.session,
+		RoleArn:             ptr.Deref(in.RoleArn, ""),
+		DisableRoleChaining: ptr.Deref(in.DisableRoleChaining, false),
 	}
 
 	if err := pluginutils.SetExtensionProtocolOptions(out, FilterName, lpe); err != nil {
-		//		return errors.Wrapf(err, "converting aws protocol options to struct")
+		return fmt.Errorf("converting aws protocol options to struct: %w", err)
+	}
+	return nil
+}
+
+// validateAws returns the problems with the upstream that we can find before translation, so that they
+// are reported in its status.
+func validateAws(in *v1alpha1.AwsUpstream, secret *ir.Secret, awsOptions *v1.GlooOptions_AWSOptions) []error {
+	var errs []error
+	if in.Region == "" {
+		errs = append(errs, errors.New("aws region is required"))
 	}
-	// return nil
+	if secret != nil {
+		if _, err := deriveStaticSecret(secret); err != nil {
+			errs = append(errs, fmt.Errorf("invalid aws secret %s: %w", secret.Name, err))
+		}
+	} else if in.SecretRef == nil &&
+		!awsOptions.GetEnableCredentialsDiscovey() &&
+		awsOptions.GetServiceAccountCredentials() == nil {
+		errs = append(errs, errors.New("no aws secret provided. consider setting enableCredentialsDiscovey to true or enabling service account credentials if running in EKS"))
+	}
+	return errs
+}
+
+// lambdaFilterConfig configures how the filter fetches credentials for upstreams without a static secret.
+func lambdaFilterConfig(awsOptions *v1.GlooOptions_AWSOptions) *awspb.AWSLambdaConfig {
+	filterConfig := &awspb.AWSLambdaConfig{}
+	switch typedFetcher := awsOptions.GetCredentialsFetcher().(type) {
+	case *v1.GlooOptions_AWSOptions_EnableCredentialsDiscovey:
+		filterConfig.CredentialsFetcher = &awspb.AWSLambdaConfig_UseDefaultCredentials{
+			UseDefaultCredentials: wrapperspb.Bool(typedFetcher.EnableCredentialsDiscovey),
+		}
+	case *v1.GlooOptions_AWSOptions_ServiceAccountCredentials:
+		// the deployer adds the sts cluster to the bootstrap of the proxies
+		filterConfig.CredentialsFetcher = &awspb.AWSLambdaConfig_ServiceAccountCredentials_{
+			ServiceAccountCredentials: typedFetcher.ServiceAccountCredentials,
+		}
+	}
+	filterConfig.CredentialRefreshDelay = awsOptions.GetCredentialRefreshDelay()
+	filterConfig.PropagateOriginalRouting = awsOptions.GetPropagateOriginalRouting().GetValue()
+	return filterConfig
 }
 
 func getLambdaHostname(in *v1alpha1.AwsUpstream) string {
@@ -101,26 +145,62 @@ func processEndpointsAws(in *v1alpha1.AwsUpstream) *ir.EndpointsForUpstream {
 	return nil
 }
 
+// parseUpstreamDestination parses the name of a gloo.solo.io/Parameter filter: a function name,
+// optionally followed by ":" and a qualifier. lambda function names can't contain ":", but ARNs can,
+// and may already include the qualifier; they are used as is.
+func parseUpstreamDestination(name string) *upstreamDestination {
+	if strings.HasPrefix(name, "arn:") {
+		return &upstreamDestination{FunctionName: name}
+	}
+	functionName, qualifier, _ := strings.Cut(name, ":")
+	return &upstreamDestination{FunctionName: functionName, Qualifier: qualifier}
+}
+
+// processBackendAws configures the lambda function invoked by a route. dest is the function selected
+// by the route, if any; otherwise the upstream's default function is invoked.
 func (p *plugin2) processBackendAws(
 	ctx context.Context,
 	pCtx *ir.RouteBackendContext,
+	in *v1alpha1.AwsUpstream,
 	dest *upstreamDestination,
 ) error {
+	lambda := in.Lambda
+	if lambda == nil {
+		lambda = &v1alpha1.AwsLambda{}
+	}
+	functionName := ptr.Deref(lambda.FunctionName, "")
+	qualifier := ptr.Deref(lambda.Qualifier, "")
+	if dest != nil {
+		functionName = dest.FunctionName
+		qualifier = dest.Qualifier
+	}
+	if functionName == "" {
+		if dest == nil {
+			// the route may still select a function with a filter
+			return nil
+		}
+		return errors.New("lambda function name is empty")
+	}
 
-	functionName := dest.FunctionName
-	if p.needFilter == nil {
-		p.needFilter = make(map[string]bool)
+	var transformerConfig *solo_core_v3.TypedExtensionConfig
+	responseMode := ptr.Deref(lambda.ResponseMode, v1alpha1.AwsLambdaResponseModePassthrough)
+	if responseMode == v1alpha1.AwsLambdaResponseModeUnwrapAsApiGateway {
+		transformerConfig = &solo_core_v3.TypedExtensionConfig{
+			Name: ResponseTransformationName,
+			TypedConfig: &anypb.Any{
+				TypeUrl: ResponseTransformationTypeUrl,
+			},
+		}
 	}
-	p.needFilter[pCtx.FilterChainName] = true
 
 	lambdaRouteFunc := &awspb.AWSLambdaPerRoute{
-		Async: false,
+		Async: ptr.Deref(lambda.InvocationMode, v1alpha1.AwsLambdaInvocationModeSync) == v1alpha1.AwsLambdaInvocationModeAsync,
 		// we need to query escape per AWS spec:
 		// see the CanonicalQueryString section in here: https://docs.aws.amazon.com/general/latest/gr/sigv4-create-canonical-request.html
-		// Qualifier:         url.QueryEscape(lambdaFunc.GetQualifier()),
-		Name: url.QueryEscape(functionName),
-		//UnwrapAsAlb:       destination.GetUnwrapAsAlb(),
-		//TransformerConfig: transformerConfig,
+		Qualifier:         url.QueryEscape(qualifier),
+		Name:              url.QueryEscape(functionName),
+		UnwrapAsAlb:       responseMode == v1alpha1.AwsLambdaResponseModeUnwrapAsAlb,
+		TransformerConfig: transformerConfig,
 	}
 	lambdaRouteFuncAny, err := anypb.New(lambdaRouteFunc)
 	if err != nil {
@@ -0,0 +1,182 @@
+package upstream
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gateway2/api/v1alpha1"
+	"github.com/solo-io/gloo/projects/gateway2/ir"
+	"github.com/solo-io/gloo/projects/gateway2/translator/sslutils"
+	awspb "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/aws"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"google.golang.org/protobuf/types/known/anypb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func awsSecret(data map[string]string) *ir.Secret {
+	secret := &ir.Secret{ObjectSource: ir.ObjectSource{Name: "aws-creds"}, Data: map[string][]byte{}}
+	for k, v := range data {
+		secret.Data[k] = []byte(v)
+	}
+	return secret
+}
+
+func TestAwsClusterVerifiesLambdaCertificate(t *testing.T) {
+	g := NewWithT(t)
+
+	out := &envoy_config_cluster_v3.Cluster{Name: "test"}
+	err := processAws(context.Background(), &v1alpha1.AwsUpstream{Region: "us-east-2"}, &UpstreamIr{}, out)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	var tlsContext envoyauth.UpstreamTlsContext
+	g.Expect(out.GetTransportSocket().GetTypedConfig().UnmarshalTo(&tlsContext)).To(Succeed())
+	g.Expect(tlsContext.GetSni()).To(Equal("lambda.us-east-2.amazonaws.com"))
+	validation := tlsContext.GetCommonTlsContext().GetValidationContext()
+	g.Expect(validation.GetTrustedCa().GetFilename()).To(Equal(sslutils.SystemCaBundle))
+	g.Expect(validation.GetMatchTypedSubjectAltNames()[0].GetMatcher().GetExact()).To(Equal("lambda.us-east-2.amazonaws.com"))
+}
+
+func TestAwsProtocolExtension(t *testing.T) {
+	g := NewWithT(t)
+
+	out := &envoy_config_cluster_v3.Cluster{Name: "test"}
+	err := processAws(context.Background(), &v1alpha1.AwsUpstream{
+		Region:              "us-east-1",
+		RoleArn:             ptr.To("arn:aws:iam::123456789012:role/lambda"),
+		DisableRoleChaining: ptr.To(true),
+	}, &UpstreamIr{
+		AwsSecret: awsSecret(map[string]string{AccessKey: "access", SecretKey: "secret"}),
+	}, out)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	var lpe awspb.AWSLambdaProtocolExtension
+	g.Expect(out.GetTypedExtensionProtocolOptions()).To(HaveKey(FilterName))
+	g.Expect(out.GetTypedExtensionProtocolOptions()[FilterName].UnmarshalTo(&lpe)).To(Succeed())
+	g.Expect(lpe.GetAccessKey()).To(Equal("access"))
+	g.Expect(lpe.GetRoleArn()).To(Equal("arn:aws:iam::123456789012:role/lambda"))
+	g.Expect(lpe.GetDisableRoleChaining()).To(BeTrue())
+}
+
+func TestAwsInvalidSecret(t *testing.T) {
+	g := NewWithT(t)
+
+	out := &envoy_config_cluster_v3.Cluster{Name: "test"}
+	err := processAws(context.Background(), &v1alpha1.AwsUpstream{Region: "us-east-1"}, &UpstreamIr{
+		AwsSecret: awsSecret(map[string]string{AccessKey: "access"}),
+	}, out)
+	g.Expect(err).To(MatchError(ContainSubstring("secret_key is not a valid string")))
+}
+
+func TestValidateAws(t *testing.T) {
+	g := NewWithT(t)
+
+	errs := validateAws(&v1alpha1.AwsUpstream{}, nil, nil)
+	g.Expect(errs).To(HaveLen(2))
+	g.Expect(errs[0]).To(MatchError("aws region is required"))
+	g.Expect(errs[1]).To(MatchError(ContainSubstring("no aws secret provided")))
+
+	// credentials from the settings
+	g.Expect(validateAws(&v1alpha1.AwsUpstream{Region: "us-east-1"}, nil, &v1.GlooOptions_AWSOptions{
+		CredentialsFetcher: &v1.GlooOptions_AWSOptions_ServiceAccountCredentials{
+			ServiceAccountCredentials: &awspb.AWSLambdaConfig_ServiceAccountCredentials{Cluster: "aws_sts_cluster"},
+		},
+	})).To(BeEmpty())
+
+	errs = validateAws(&v1alpha1.AwsUpstream{Region: "us-east-1"}, awsSecret(map[string]string{SecretKey: "secret"}), nil)
+	g.Expect(errs).To(HaveLen(1))
+	g.Expect(errs[0]).To(MatchError(ContainSubstring("invalid aws secret aws-creds")))
+}
+
+func TestLambdaFilterConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(lambdaFilterConfig(nil).GetCredentialsFetcher()).To(BeNil())
+
+	cfg := lambdaFilterConfig(&v1.GlooOptions_AWSOptions{
+		CredentialsFetcher: &v1.GlooOptions_AWSOptions_ServiceAccountCredentials{
+			ServiceAccountCredentials: &awspb.AWSLambdaConfig_ServiceAccountCredentials{
+				Cluster: "aws_sts_cluster",
+				Uri:     "sts.amazonaws.com",
+			},
+		},
+	})
+	g.Expect(cfg.GetServiceAccountCredentials().GetCluster()).To(Equal("aws_sts_cluster"))
+
+	cfg = lambdaFilterConfig(&v1.GlooOptions_AWSOptions{
+		CredentialsFetcher: &v1.GlooOptions_AWSOptions_EnableCredentialsDiscovey{EnableCredentialsDiscovey: true},
+	})
+	g.Expect(cfg.GetUseDefaultCredentials().GetValue()).To(BeTrue())
+}
+
+func TestParseUpstreamDestination(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(parseUpstreamDestination("fn")).To(Equal(&upstreamDestination{FunctionName: "fn"}))
+	g.Expect(parseUpstreamDestination("fn:prod")).To(Equal(&upstreamDestination{FunctionName: "fn", Qualifier: "prod"}))
+	arn := "arn:aws:lambda:us-east-1:123456789012:function:fn:prod"
+	g.Expect(parseUpstreamDestination(arn)).To(Equal(&upstreamDestination{FunctionName: arn}))
+}
+
+func lambdaRouteConfig(g *WithT, pCtx *ir.RouteBackendContext) *awspb.AWSLambdaPerRoute {
+	var perRoute awspb.AWSLambdaPerRoute
+	g.Expect((*pCtx.TypedFiledConfig)[FilterName].UnmarshalTo(&perRoute)).To(Succeed())
+	return &perRoute
+}
+
+func TestLambdaRouteConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	in := &v1alpha1.AwsUpstream{
+		Region: "us-east-1",
+		Lambda: &v1alpha1.AwsLambda{
+			FunctionName:   ptr.To("default-fn"),
+			Qualifier:      ptr.To("1"),
+			InvocationMode: ptr.To(v1alpha1.AwsLambdaInvocationModeAsync),
+			ResponseMode:   ptr.To(v1alpha1.AwsLambdaResponseModeUnwrapAsApiGateway),
+		},
+	}
+	p := &plugin2{}
+	typedConfig := map[string]*anypb.Any{}
+	pCtx := &ir.RouteBackendContext{TypedFiledConfig: &typedConfig}
+
+	g.Expect(p.processBackendAws(context.Background(), pCtx, in, nil)).To(Succeed())
+	perRoute := lambdaRouteConfig(g, pCtx)
+	g.Expect(perRoute.GetName()).To(Equal("default-fn"))
+	g.Expect(perRoute.GetQualifier()).To(Equal("1"))
+	g.Expect(perRoute.GetAsync()).To(BeTrue())
+	g.Expect(perRoute.GetUnwrapAsAlb()).To(BeFalse())
+	g.Expect(perRoute.GetTransformerConfig().GetTypedConfig().GetTypeUrl()).To(Equal(ResponseTransformationTypeUrl))
+
+	// the function selected by the route overrides the default
+	g.Expect(p.processBackendAws(context.Background(), pCtx, in, parseUpstreamDestination("other:$LATEST"))).To(Succeed())
+	perRoute = lambdaRouteConfig(g, pCtx)
+	g.Expect(perRoute.GetName()).To(Equal("other"))
+	g.Expect(perRoute.GetQualifier()).To(Equal("%24LATEST"))
+}
+
+func TestLambdaRouteWithoutFunction(t *testing.T) {
+	g := NewWithT(t)
+
+	typedConfig := map[string]*anypb.Any{}
+	pCtx := &ir.RouteBackendContext{TypedFiledConfig: &typedConfig}
+	g.Expect((&plugin2{}).processBackendAws(context.Background(), pCtx, &v1alpha1.AwsUpstream{Region: "us-east-1"}, nil)).To(Succeed())
+	g.Expect(typedConfig).To(BeEmpty())
+}
+
+func TestAcceptedCondition(t *testing.T) {
+	g := NewWithT(t)
+
+	cond := acceptedCondition(3, nil)
+	g.Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+	g.Expect(cond.ObservedGeneration).To(Equal(int64(3)))
+
+	cond = acceptedCondition(3, []error{errors.New("a"), errors.New("b")})
+	g.Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+	g.Expect(cond.Reason).To(Equal(v1alpha1.UpstreamReasonInvalid))
+	g.Expect(cond.Message).To(Equal("a\nb"))
+}
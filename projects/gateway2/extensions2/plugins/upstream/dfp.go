@@ -0,0 +1,144 @@
+package upstream
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_extensions_clusters_dynamic_forward_proxy_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/clusters/dynamic_forward_proxy/v3"
+	envoy_extensions_common_dynamic_forward_proxy_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/dynamic_forward_proxy/v3"
+	envoy_extensions_filters_http_dynamic_forward_proxy_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/dynamic_forward_proxy/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_upstreams_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	"github.com/solo-io/gloo/projects/gateway2/api/v1alpha1"
+	"github.com/solo-io/gloo/projects/gateway2/ir"
+	translatorutils "github.com/solo-io/gloo/projects/gateway2/translator/utils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	DfpFilterName  = "envoy.filters.http.dynamic_forward_proxy"
+	DfpClusterType = "envoy.clusters.dynamic_forward_proxy"
+)
+
+func processDynamicForwardProxy(ctx context.Context, in *v1alpha1.DynamicForwardProxyUpstream, out *envoy_config_cluster_v3.Cluster) error {
+	clusterConfig := &envoy_extensions_clusters_dynamic_forward_proxy_v3.ClusterConfig{
+		ClusterImplementationSpecifier: &envoy_extensions_clusters_dynamic_forward_proxy_v3.ClusterConfig_DnsCacheConfig{
+			DnsCacheConfig: dfpDnsCacheConfig(in),
+		},
+	}
+	typedConfig, err := anypb.New(clusterConfig)
+	if err != nil {
+		return err
+	}
+	out.LbPolicy = envoy_config_cluster_v3.Cluster_CLUSTER_PROVIDED
+	out.ClusterDiscoveryType = &envoy_config_cluster_v3.Cluster_ClusterType{
+		ClusterType: &envoy_config_cluster_v3.Cluster_CustomClusterType{
+			Name:        DfpClusterType,
+			TypedConfig: typedConfig,
+		},
+	}
+
+	if in.UseTls == nil || !*in.UseTls {
+		return nil
+	}
+
+	commonTlsContext, err := utils.GetCommonTlsContextFromUpstreamOptions(nil)
+	if err != nil {
+		return err
+	}
+	out.TransportSocket, err = tlsTransportSocket(&envoyauth.UpstreamTlsContext{
+		CommonTlsContext: commonTlsContext,
+	})
+	if err != nil {
+		return err
+	}
+	// the host is only known at request time, so envoy must derive the SNI and the SAN to
+	// validate from it. envoy rejects dynamic forward proxy clusters with TLS that don't do this.
+	return translatorutils.MutateHttpOptions(out, func(opts *envoy_upstreams_v3.HttpProtocolOptions) {
+		if opts.GetUpstreamHttpProtocolOptions() == nil {
+			opts.UpstreamHttpProtocolOptions = &envoy_config_core_v3.UpstreamHttpProtocolOptions{}
+		}
+		opts.GetUpstreamHttpProtocolOptions().AutoSni = true
+		opts.GetUpstreamHttpProtocolOptions().AutoSanValidation = true
+		if opts.GetUpstreamProtocolOptions() == nil {
+			opts.UpstreamProtocolOptions = &envoy_upstreams_v3.HttpProtocolOptions_UseDownstreamProtocolConfig{
+				UseDownstreamProtocolConfig: &envoy_upstreams_v3.HttpProtocolOptions_UseDownstreamHttpConfig{},
+			}
+		}
+	})
+}
+
+// dfpDnsCacheConfig must produce identical configs for the cluster and the http filter, as
+// envoy matches them by name and rejects different configs that share a name.
+func dfpDnsCacheConfig(in *v1alpha1.DynamicForwardProxyUpstream) *envoy_extensions_common_dynamic_forward_proxy_v3.DnsCacheConfig {
+	cfg := &envoy_extensions_common_dynamic_forward_proxy_v3.DnsCacheConfig{
+		DnsLookupFamily: envoy_config_cluster_v3.Cluster_V4_PREFERRED,
+	}
+	if in.DnsRefreshRate != nil {
+		cfg.DnsRefreshRate = durationpb.New(in.DnsRefreshRate.Duration)
+	}
+	if in.HostTtl != nil {
+		cfg.HostTtl = durationpb.New(in.HostTtl.Duration)
+	}
+	if in.MaxHosts != nil {
+		cfg.MaxHosts = wrapperspb.UInt32(*in.MaxHosts)
+	}
+	cfg.Name = dfpDnsCacheName(cfg)
+	return cfg
+}
+
+func dfpDnsCacheName(cfg *envoy_extensions_common_dynamic_forward_proxy_v3.DnsCacheConfig) string {
+	return fmt.Sprintf("dfp_%s_%s_%d",
+		cfg.GetDnsRefreshRate().AsDuration(), cfg.GetHostTtl().AsDuration(), cfg.GetMaxHosts().GetValue())
+}
+
+func (p *plugin2) processBackendDynamicForwardProxy(pCtx *ir.RouteBackendContext, in *v1alpha1.DynamicForwardProxyUpstream) {
+	if p.dfpCaches == nil {
+		p.dfpCaches = make(map[string]map[string]*envoy_extensions_common_dynamic_forward_proxy_v3.DnsCacheConfig)
+	}
+	caches := p.dfpCaches[pCtx.FilterChainName]
+	if caches == nil {
+		caches = make(map[string]*envoy_extensions_common_dynamic_forward_proxy_v3.DnsCacheConfig)
+		p.dfpCaches[pCtx.FilterChainName] = caches
+	}
+	cfg := dfpDnsCacheConfig(in)
+	caches[cfg.GetName()] = cfg
+}
+
+// dfpFilters returns one dynamic forward proxy filter per dns cache used by the filter chain, so
+// that the cache of whichever dynamic forward proxy cluster is selected gets populated.
+// The filter is a no-op for requests to clusters that are not dynamic forward proxy clusters.
+func (p *plugin2) dfpFilters(fc ir.FilterChainCommon) ([]plugins.StagedHttpFilter, error) {
+	caches := p.dfpCaches[fc.FilterChainName]
+	names := make([]string, 0, len(caches))
+	for name := range caches {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var filters []plugins.StagedHttpFilter
+	for i, name := range names {
+		filterConfig := &envoy_extensions_filters_http_dynamic_forward_proxy_v3.FilterConfig{
+			ImplementationSpecifier: &envoy_extensions_filters_http_dynamic_forward_proxy_v3.FilterConfig_DnsCacheConfig{
+				DnsCacheConfig: caches[name],
+			},
+		}
+		filterName := DfpFilterName
+		if i > 0 {
+			filterName = fmt.Sprintf("%s/%s", DfpFilterName, name)
+		}
+		f, err := plugins.NewStagedFilter(filterName, filterConfig, plugins.DuringStage(plugins.OutAuthStage))
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, f)
+	}
+	return filters, nil
+}
@@ -3,10 +3,11 @@ package upstream
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"maps"
+	"slices"
 	"time"
 
-	awspb "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/aws"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/watch"
@@ -14,13 +15,17 @@ import (
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_extensions_common_dynamic_forward_proxy_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/dynamic_forward_proxy/v3"
 	"github.com/solo-io/gloo/projects/gateway2/api/v1alpha1"
 	"github.com/solo-io/gloo/projects/gateway2/extensions2/common"
 	extensionsplug "github.com/solo-io/gloo/projects/gateway2/extensions2/plugin"
 	"github.com/solo-io/gloo/projects/gateway2/ir"
 	"github.com/solo-io/gloo/projects/gateway2/krtcollections"
 	"github.com/solo-io/gloo/projects/gateway2/pkg/client/clientset/versioned"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/zap"
 	skubeclient "istio.io/istio/pkg/config/schema/kubeclient"
 	"istio.io/istio/pkg/kube/kclient"
 	"istio.io/istio/pkg/kube/krt"
@@ -46,6 +51,7 @@ var (
 
 type upstreamDestination struct {
 	FunctionName string
+	Qualifier    string
 }
 
 func (d *upstreamDestination) CreationTime() time.Time {
@@ -57,11 +63,14 @@ func (d *upstreamDestination) Equals(in any) bool {
 	if !ok {
 		return false
 	}
-	return d.FunctionName == d2.FunctionName
+	return *d == *d2
 }
 
 type UpstreamIr struct {
 	AwsSecret *ir.Secret
+	// problems with the upstream found during translation. they are reported in the
+	// upstream's status.
+	Errors []error
 }
 
 func (u *UpstreamIr) data() map[string][]byte {
@@ -78,11 +87,17 @@ func (u *UpstreamIr) Equals(other any) bool {
 	}
 	return maps.EqualFunc(u.data(), otherUpstream.data(), func(a, b []byte) bool {
 		return bytes.Equal(a, b)
+	}) && slices.EqualFunc(u.Errors, otherUpstream.Errors, func(a, b error) bool {
+		return a.Error() == b.Error()
 	})
 }
 
 type plugin2 struct {
+	awsOptions *v1.GlooOptions_AWSOptions
+
 	needFilter map[string]bool
+	// dns caches of dynamic forward proxy upstreams, by filter chain and cache name.
+	dfpCaches map[string]map[string]*envoy_extensions_common_dynamic_forward_proxy_v3.DnsCacheConfig
 }
 
 func registerTypes(ourCli versioned.Interface) {
@@ -105,7 +120,8 @@ func NewPlugin(ctx context.Context, commoncol *common.CommonCollections) extensi
 	col := krt.WrapClient(kclient.New[*v1alpha1.Upstream](commoncol.Client), commoncol.KrtOpts.ToOptions("Upstreams")...)
 
 	gk := v1alpha1.UpstreamGVK.GroupKind()
-	translate := buildTranslateFunc(commoncol.Secrets)
+	awsOptions := commoncol.InitialSettings.Spec.GetGloo().GetAwsOptions()
+	translate := buildTranslateFunc(ctx, commoncol.Secrets, awsOptions)
 	ucol := krt.NewCollection(col, func(krtctx krt.HandlerContext, i *v1alpha1.Upstream) *ir.Upstream {
 
 		// resolve secrets
@@ -123,10 +139,14 @@ func NewPlugin(ctx context.Context, commoncol *common.CommonCollections) extensi
 			ObjIr:             translate(krtctx, i),
 		}
 	})
+	statusSyncer := newStatusSyncer(commoncol.OurClient, ucol)
 
 	epndpoints := krt.NewCollection(col, func(krtctx krt.HandlerContext, i *v1alpha1.Upstream) *ir.EndpointsForUpstream {
 		return processEndpoints(i)
 	})
+	newPlug := func(ctx context.Context, tctx ir.GwTranslationCtx) ir.ProxyTranslationPass {
+		return &plugin2{awsOptions: awsOptions}
+	}
 	return extensionsplug.Plugin{
 		ContributesUpstreams: map[schema.GroupKind]extensionsplug.UpstreamPlugin{
 			gk: {
@@ -137,37 +157,54 @@ func NewPlugin(ctx context.Context, commoncol *common.CommonCollections) extensi
 				Upstreams: ucol,
 			},
 		},
+		StatusSyncer: statusSyncer.run,
 		ContributesPolicies: map[schema.GroupKind]extensionsplug.PolicyPlugin{
+			// called for every route backend that is one of our upstreams
+			gk: {
+				Name:                      "upstream",
+				NewGatewayTranslationPass: newPlug,
+			},
 			ParameterGK: {
 				Name:                      "upstream",
 				NewGatewayTranslationPass: newPlug,
 				//			AttachmentPoints: []ir.AttachmentPoints{ir.HttpBackendRefAttachmentPoint},
 				PoliciesFetch: func(n, ns string) ir.PolicyIR {
 					// virtual policy - we don't have a real policy object
-					return &upstreamDestination{
-						FunctionName: n,
-					}
+					return parseUpstreamDestination(n)
 				},
 			},
 		},
 	}
 }
 
-func buildTranslateFunc(secrets *krtcollections.SecretIndex) func(krtctx krt.HandlerContext, i *v1alpha1.Upstream) *UpstreamIr {
+func buildTranslateFunc(
+	ctx context.Context,
+	secrets *krtcollections.SecretIndex,
+	awsOptions *v1.GlooOptions_AWSOptions,
+) func(krtctx krt.HandlerContext, i *v1alpha1.Upstream) *UpstreamIr {
 	return func(krtctx krt.HandlerContext, i *v1alpha1.Upstream) *UpstreamIr {
 		// resolve secrets
 		var ir UpstreamIr
 		if i.Spec.Aws != nil {
-			ns := i.GetNamespace()
-			secretRef := gwv1.SecretObjectReference{
-				Name: gwv1.ObjectName(i.Spec.Aws.SecretRef.Name),
+			if i.Spec.Aws.SecretRef != nil {
+				ns := i.GetNamespace()
+				secretRef := gwv1.SecretObjectReference{
+					Name: gwv1.ObjectName(i.Spec.Aws.SecretRef.Name),
+				}
+				secret, err := secrets.GetSecret(krtctx, krtcollections.From{GroupKind: v1alpha1.UpstreamGVK.GroupKind(), Namespace: ns}, secretRef)
+				if secret != nil {
+					ir.AwsSecret = secret
+				} else {
+					ir.Errors = append(ir.Errors, fmt.Errorf("failed to get aws secret: %w", err))
+				}
 			}
-			secret, _ := secrets.GetSecret(krtctx, krtcollections.From{GroupKind: v1alpha1.UpstreamGVK.GroupKind(), Namespace: ns}, secretRef)
-			if secret != nil {
-				ir.AwsSecret = secret
-			} else {
-				// TODO: handle error and write it to status
-				// return error
+			ir.Errors = append(ir.Errors, validateAws(i.Spec.Aws, ir.AwsSecret, awsOptions)...)
+		}
+		// the upstream is processed into a throwaway cluster, so that the errors that would leave its cluster
+		// incomplete are reported in its status. they are usually caused by the problems validated above.
+		if len(ir.Errors) == 0 {
+			if err := processSpec(ctx, i, &ir, &envoy_config_cluster_v3.Cluster{}); err != nil {
+				ir.Errors = append(ir.Errors, err)
 			}
 		}
 		return &ir
@@ -187,14 +224,23 @@ func processUpstream(ctx context.Context, in ir.Upstream, out *envoy_config_clus
 		return
 	}
 
-	spec := up.Spec
+	if err := processSpec(ctx, up, ir, out); err != nil {
+		contextutils.LoggerFrom(ctx).Desugar().Error("error processing upstream",
+			zap.String("namespace", up.GetNamespace()), zap.String("name", up.GetName()), zap.Error(err))
+	}
+}
 
+func processSpec(ctx context.Context, up *v1alpha1.Upstream, ir *UpstreamIr, out *envoy_config_cluster_v3.Cluster) error {
+	spec := up.Spec
 	switch {
 	case spec.Static != nil:
-		processStatic(ctx, spec.Static, out)
+		return processStatic(ctx, spec.Static, out)
 	case spec.Aws != nil:
-		processAws(ctx, spec.Aws, ir, out)
+		return processAws(ctx, spec.Aws, ir, out)
+	case spec.DynamicForwardProxy != nil:
+		return processDynamicForwardProxy(ctx, spec.DynamicForwardProxy, out)
 	}
+	return nil
 }
 
 func hostname(in *v1alpha1.Upstream) string {
@@ -219,10 +265,6 @@ func processEndpoints(up *v1alpha1.Upstream) *ir.EndpointsForUpstream {
 	return nil
 }
 
-func newPlug(ctx context.Context, tctx ir.GwTranslationCtx) ir.ProxyTranslationPass {
-	return &plugin2{}
-}
-
 func (p *plugin2) Name() string {
 	return "upstream"
 }
@@ -244,28 +286,53 @@ func (p *plugin2) ApplyForRouteBackend(
 	ctx context.Context, policy ir.PolicyIR,
 	pCtx *ir.RouteBackendContext,
 ) error {
+	up, _ := pCtx.Upstream.Obj.(*v1alpha1.Upstream)
+	if policy == nil {
+		// the backend is one of our upstreams
+		switch {
+		case up == nil:
+		case up.Spec.DynamicForwardProxy != nil:
+			p.processBackendDynamicForwardProxy(pCtx, up.Spec.DynamicForwardProxy)
+		case up.Spec.Aws != nil:
+			// this pass is the one that adds the filter; a function selected by the route is
+			// handled by the pass of the parameter policy.
+			if p.needFilter == nil {
+				p.needFilter = make(map[string]bool)
+			}
+			p.needFilter[pCtx.FilterChainName] = true
+			return p.processBackendAws(ctx, pCtx, up.Spec.Aws, nil)
+		}
+		return nil
+	}
 	pol, ok := policy.(*upstreamDestination)
 	if !ok {
 		return nil
 		// todo: should we return fmt.Errorf("internal error: policy is not a upstreamDestination")
 	}
-	return p.processBackendAws(ctx, pCtx, pol)
+	if up == nil || up.Spec.Aws == nil {
+		return fmt.Errorf("lambda function %s can only be used with aws upstreams", pol.FunctionName)
+	}
+	return p.processBackendAws(ctx, pCtx, up.Spec.Aws, pol)
 }
 
 // called 1 time per listener
 // if a plugin emits new filters, they must be with a plugin unique name.
 // any filter returned from route config must be disabled, so it doesnt impact other routes.
 func (p *plugin2) HttpFilters(ctx context.Context, fc ir.FilterChainCommon) ([]plugins.StagedHttpFilter, error) {
+	filters, err := p.dfpFilters(fc)
+	if err != nil {
+		return nil, err
+	}
 	if !p.needFilter[fc.FilterChainName] {
-		return nil, nil
+		return filters, nil
 	}
-	filterConfig := &awspb.AWSLambdaConfig{}
 	pluginStage := plugins.DuringStage(plugins.OutAuthStage)
-	f, _ := plugins.NewStagedFilter(FilterName, filterConfig, pluginStage)
+	f, err := plugins.NewStagedFilter(FilterName, lambdaFilterConfig(p.awsOptions), pluginStage)
+	if err != nil {
+		return nil, err
+	}
 
-	return []plugins.StagedHttpFilter{
-		f,
-	}, nil
+	return append(filters, f), nil
 }
 
 func (p *plugin2) UpstreamHttpFilters(ctx context.Context) ([]plugins.StagedUpstreamHttpFilter, error) {
@@ -2,29 +2,45 @@ package upstream
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/netip"
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/solo-io/gloo/projects/gateway2/api/v1alpha1"
 	"github.com/solo-io/gloo/projects/gateway2/ir"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-func processStatic(ctx context.Context, in *v1alpha1.StaticUpstream, out *envoy_config_cluster_v3.Cluster) {
+func processStatic(ctx context.Context, in *v1alpha1.StaticUpstream, out *envoy_config_cluster_v3.Cluster) error {
 
 	var hostname string
+	var foundSslPort bool
 	out.ClusterDiscoveryType = &envoy_config_cluster_v3.Cluster_Type{
 		Type: envoy_config_cluster_v3.Cluster_STATIC,
 	}
+	out.LoadAssignment = &envoy_config_endpoint_v3.ClusterLoadAssignment{
+		ClusterName: out.GetName(),
+	}
+	// hosts without a locality share the first (empty) locality
+	localities := map[v1alpha1.Locality]*envoy_config_endpoint_v3.LocalityLbEndpoints{}
 	for _, host := range in.Hosts {
 		if host.Host == "" {
-			//	return errors.Errorf("addr cannot be empty for host")
-			return
+			return errors.New("addr cannot be empty for host")
 		}
 		if host.Port == 0 {
-			//return errors.Errorf("port cannot be empty for host")
-			return
+			return errors.New("port cannot be empty for host")
+		}
+		if host.Port == 443 {
+			foundSslPort = true
 		}
 
 		_, err := netip.ParseAddr(host.Host)
@@ -36,52 +52,166 @@ func processStatic(ctx context.Context, in *v1alpha1.StaticUpstream, out *envoy_
 			}
 		}
 
-		if out.GetLoadAssignment() == nil {
-			out.LoadAssignment = &envoy_config_endpoint_v3.ClusterLoadAssignment{
-				ClusterName: out.GetName(),
-				Endpoints:   []*envoy_config_endpoint_v3.LocalityLbEndpoints{{}},
+		var locality v1alpha1.Locality
+		if host.Locality != nil {
+			locality = *host.Locality
+		}
+		localityEndpoints, ok := localities[locality]
+		if !ok {
+			localityEndpoints = &envoy_config_endpoint_v3.LocalityLbEndpoints{}
+			if locality != (v1alpha1.Locality{}) {
+				localityEndpoints.Locality = &envoy_config_core_v3.Locality{
+					Region:  locality.Region,
+					Zone:    locality.Zone,
+					SubZone: locality.SubZone,
+				}
 			}
+			localities[locality] = localityEndpoints
+			out.GetLoadAssignment().Endpoints = append(out.GetLoadAssignment().GetEndpoints(), localityEndpoints)
 		}
 
 		healthCheckConfig := &envoy_config_endpoint_v3.Endpoint_HealthCheckConfig{
 			Hostname: host.Host,
 		}
 
-		out.GetLoadAssignment().GetEndpoints()[0].LbEndpoints = append(out.GetLoadAssignment().GetEndpoints()[0].GetLbEndpoints(),
-			&envoy_config_endpoint_v3.LbEndpoint{
-				//	Metadata: getMetadata(params.Ctx, spec, host),
-				HostIdentifier: &envoy_config_endpoint_v3.LbEndpoint_Endpoint{
-					Endpoint: &envoy_config_endpoint_v3.Endpoint{
-						Hostname: host.Host,
-						Address: &envoy_config_core_v3.Address{
-							Address: &envoy_config_core_v3.Address_SocketAddress{
-								SocketAddress: &envoy_config_core_v3.SocketAddress{
-									Protocol: envoy_config_core_v3.SocketAddress_TCP,
-									Address:  host.Host,
-									PortSpecifier: &envoy_config_core_v3.SocketAddress_PortValue{
-										PortValue: uint32(host.Port),
-									},
+		lbEndpoint := &envoy_config_endpoint_v3.LbEndpoint{
+			Metadata: staticHostMetadata(in, host),
+			HostIdentifier: &envoy_config_endpoint_v3.LbEndpoint_Endpoint{
+				Endpoint: &envoy_config_endpoint_v3.Endpoint{
+					Hostname: host.Host,
+					Address: &envoy_config_core_v3.Address{
+						Address: &envoy_config_core_v3.Address_SocketAddress{
+							SocketAddress: &envoy_config_core_v3.SocketAddress{
+								Protocol: envoy_config_core_v3.SocketAddress_TCP,
+								Address:  host.Host,
+								PortSpecifier: &envoy_config_core_v3.SocketAddress_PortValue{
+									PortValue: uint32(host.Port),
 								},
 							},
 						},
-						HealthCheckConfig: healthCheckConfig,
 					},
+					HealthCheckConfig: healthCheckConfig,
 				},
-				//				LoadBalancingWeight: host.GetLoadBalancingWeight(),
-			})
+			},
+		}
+		if host.Weight != nil {
+			lbEndpoint.LoadBalancingWeight = wrapperspb.UInt32(*host.Weight)
+		}
+		localityEndpoints.LbEndpoints = append(localityEndpoints.GetLbEndpoints(), lbEndpoint)
 	}
+
+	// if host port is 443 or if the user wants it, we will use TLS
+	if (in.UseTls != nil && *in.UseTls) || (in.UseTls == nil && foundSslPort) {
+		if err := applyStaticTls(in, hostname, out); err != nil {
+			return err
+		}
+	}
+
 	// the upstream has a DNS name. We need Envoy to resolve the DNS name
 	if hostname != "" {
-		// set the type to strict dns
+		dnsType := envoy_config_cluster_v3.Cluster_STRICT_DNS
+		if in.DnsMode != nil && *in.DnsMode == v1alpha1.DnsModeLogical {
+			dnsType = envoy_config_cluster_v3.Cluster_LOGICAL_DNS
+		}
 		out.ClusterDiscoveryType = &envoy_config_cluster_v3.Cluster_Type{
-			Type: envoy_config_cluster_v3.Cluster_STRICT_DNS,
+			Type: dnsType,
 		}
 
 		//do we still need this?
 		//		// fix issue where ipv6 addr cannot bind
 		//		out.DnsLookupFamily = envoy_config_cluster_v3.Cluster_V4_ONLY
 	}
+	return nil
+}
+
+// applyStaticTls tells envoy to use TLS to connect to the upstream.
+// Each host gets its own transport socket match, so that it can have its own SNI.
+func applyStaticTls(in *v1alpha1.StaticUpstream, hostname string, out *envoy_config_cluster_v3.Cluster) error {
+	commonTlsContext, err := utils.GetCommonTlsContextFromUpstreamOptions(nil)
+	if err != nil {
+		return err
+	}
+	tlsContext := &envoyauth.UpstreamTlsContext{
+		CommonTlsContext: commonTlsContext,
+		// TODO(yuval-k): Add verification context
+		Sni: hostname,
+	}
+	out.TransportSocket, err = tlsTransportSocket(tlsContext)
+	if err != nil {
+		return err
+	}
 
+	for _, host := range in.Hosts {
+		sni := staticSniAddr(in, host)
+		if sni == "" {
+			continue
+		}
+		hostTlsContext := &envoyauth.UpstreamTlsContext{
+			CommonTlsContext: commonTlsContext,
+			Sni:              sni,
+		}
+		ts, err := tlsTransportSocket(hostTlsContext)
+		if err != nil {
+			return err
+		}
+		out.TransportSocketMatches = append(out.GetTransportSocketMatches(), &envoy_config_cluster_v3.Cluster_TransportSocketMatch{
+			Name:            staticHostName(in, host),
+			Match:           staticMetadataMatch(in, host),
+			TransportSocket: ts,
+		})
+	}
+	return nil
+}
+
+func tlsTransportSocket(tlsContext *envoyauth.UpstreamTlsContext) (*envoy_config_core_v3.TransportSocket, error) {
+	typedConfig, err := anypb.New(tlsContext)
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_config_core_v3.TransportSocket{
+		Name:       wellknown.TransportSocketTls,
+		ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
+	}, nil
+}
+
+func staticSniAddr(spec *v1alpha1.StaticUpstream, in v1alpha1.Host) string {
+	if in.SniAddr != nil && *in.SniAddr != "" {
+		return *in.SniAddr
+	}
+	if spec.AutoSniRewrite == nil || *spec.AutoSniRewrite {
+		return in.Host
+	}
+	return ""
+}
+
+func staticHostMetadata(spec *v1alpha1.StaticUpstream, in v1alpha1.Host) *envoy_config_core_v3.Metadata {
+	meta := &envoy_config_core_v3.Metadata{FilterMetadata: map[string]*structpb.Struct{}}
+	if staticSniAddr(spec, in) != "" {
+		meta.GetFilterMetadata()[static.TransportSocketMatchKey] = staticMetadataMatch(spec, in)
+	}
+	if in.HealthCheckPath != nil && *in.HealthCheckPath != "" {
+		meta.GetFilterMetadata()[static.AdvancedHttpCheckerName] = &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				static.PathFieldName: structpb.NewStringValue(*in.HealthCheckPath),
+			},
+		}
+	}
+	if len(meta.GetFilterMetadata()) == 0 {
+		return nil
+	}
+	return meta
+}
+
+func staticHostName(spec *v1alpha1.StaticUpstream, in v1alpha1.Host) string {
+	return fmt.Sprintf("%s;%s:%d", staticSniAddr(spec, in), in.Host, in.Port)
+}
+
+func staticMetadataMatch(spec *v1alpha1.StaticUpstream, in v1alpha1.Host) *structpb.Struct {
+	return &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			staticHostName(spec, in): structpb.NewBoolValue(true),
+		},
+	}
 }
 
 func processEndpointsStatic(in *v1alpha1.StaticUpstream) *ir.EndpointsForUpstream {
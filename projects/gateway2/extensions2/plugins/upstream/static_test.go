@@ -0,0 +1,147 @@
+package upstream
+
+import (
+	"context"
+	"testing"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gateway2/api/v1alpha1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/static"
+	"k8s.io/utils/ptr"
+)
+
+func TestStaticIpHostsAreStatic(t *testing.T) {
+	g := NewWithT(t)
+
+	out := &envoy_config_cluster_v3.Cluster{Name: "test"}
+	err := processStatic(context.Background(), &v1alpha1.StaticUpstream{
+		Hosts: []v1alpha1.Host{
+			{Host: "1.2.3.4", Port: 8080, Weight: ptr.To[uint32](3)},
+			{Host: "1.2.3.5", Port: 8080},
+		},
+	}, out)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(out.GetType()).To(Equal(envoy_config_cluster_v3.Cluster_STATIC))
+	g.Expect(out.GetTransportSocket()).To(BeNil())
+	g.Expect(out.GetLoadAssignment().GetEndpoints()).To(HaveLen(1))
+	lbEndpoints := out.GetLoadAssignment().GetEndpoints()[0].GetLbEndpoints()
+	g.Expect(lbEndpoints).To(HaveLen(2))
+	g.Expect(lbEndpoints[0].GetLoadBalancingWeight().GetValue()).To(Equal(uint32(3)))
+	g.Expect(lbEndpoints[1].GetLoadBalancingWeight()).To(BeNil())
+}
+
+func TestStaticDnsMode(t *testing.T) {
+	g := NewWithT(t)
+
+	out := &envoy_config_cluster_v3.Cluster{Name: "test"}
+	err := processStatic(context.Background(), &v1alpha1.StaticUpstream{
+		Hosts: []v1alpha1.Host{{Host: "example.com", Port: 80}},
+	}, out)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out.GetType()).To(Equal(envoy_config_cluster_v3.Cluster_STRICT_DNS))
+
+	out = &envoy_config_cluster_v3.Cluster{Name: "test"}
+	err = processStatic(context.Background(), &v1alpha1.StaticUpstream{
+		Hosts:   []v1alpha1.Host{{Host: "example.com", Port: 80}},
+		DnsMode: ptr.To(v1alpha1.DnsModeLogical),
+	}, out)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out.GetType()).To(Equal(envoy_config_cluster_v3.Cluster_LOGICAL_DNS))
+}
+
+func TestStaticTlsAndSni(t *testing.T) {
+	g := NewWithT(t)
+
+	out := &envoy_config_cluster_v3.Cluster{Name: "test"}
+	err := processStatic(context.Background(), &v1alpha1.StaticUpstream{
+		Hosts: []v1alpha1.Host{
+			{Host: "a.example.com", Port: 443},
+			{Host: "b.example.com", Port: 443, SniAddr: ptr.To("sni.example.com")},
+		},
+	}, out)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(out.GetTransportSocket()).NotTo(BeNil())
+	g.Expect(out.GetTransportSocketMatches()).To(HaveLen(2))
+
+	var tlsContext envoyauth.UpstreamTlsContext
+	g.Expect(out.GetTransportSocketMatches()[1].GetTransportSocket().GetTypedConfig().UnmarshalTo(&tlsContext)).To(Succeed())
+	g.Expect(tlsContext.GetSni()).To(Equal("sni.example.com"))
+
+	lbEndpoints := out.GetLoadAssignment().GetEndpoints()[0].GetLbEndpoints()
+	g.Expect(lbEndpoints[1].GetMetadata().GetFilterMetadata()).To(HaveKey(static.TransportSocketMatchKey))
+}
+
+func TestStaticTlsDisabled(t *testing.T) {
+	g := NewWithT(t)
+
+	out := &envoy_config_cluster_v3.Cluster{Name: "test"}
+	err := processStatic(context.Background(), &v1alpha1.StaticUpstream{
+		Hosts:  []v1alpha1.Host{{Host: "example.com", Port: 443}},
+		UseTls: ptr.To(false),
+	}, out)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out.GetTransportSocket()).To(BeNil())
+	g.Expect(out.GetTransportSocketMatches()).To(BeEmpty())
+}
+
+func TestStaticLocalities(t *testing.T) {
+	g := NewWithT(t)
+
+	zoneA := &v1alpha1.Locality{Region: "r", Zone: "a"}
+	out := &envoy_config_cluster_v3.Cluster{Name: "test"}
+	err := processStatic(context.Background(), &v1alpha1.StaticUpstream{
+		Hosts: []v1alpha1.Host{
+			{Host: "1.2.3.4", Port: 8080, Locality: zoneA},
+			{Host: "1.2.3.5", Port: 8080},
+			{Host: "1.2.3.6", Port: 8080, Locality: zoneA, HealthCheckPath: ptr.To("/healthz")},
+		},
+	}, out)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	endpoints := out.GetLoadAssignment().GetEndpoints()
+	g.Expect(endpoints).To(HaveLen(2))
+	g.Expect(endpoints[0].GetLocality().GetZone()).To(Equal("a"))
+	g.Expect(endpoints[0].GetLbEndpoints()).To(HaveLen(2))
+	g.Expect(endpoints[1].GetLocality()).To(BeNil())
+	g.Expect(endpoints[1].GetLbEndpoints()).To(HaveLen(1))
+
+	hcMeta := endpoints[0].GetLbEndpoints()[1].GetMetadata().GetFilterMetadata()[static.AdvancedHttpCheckerName]
+	g.Expect(hcMeta.GetFields()[static.PathFieldName].GetStringValue()).To(Equal("/healthz"))
+}
+
+func TestStaticInvalidHost(t *testing.T) {
+	g := NewWithT(t)
+
+	out := &envoy_config_cluster_v3.Cluster{Name: "test"}
+	err := processStatic(context.Background(), &v1alpha1.StaticUpstream{
+		Hosts: []v1alpha1.Host{{Host: "example.com"}},
+	}, out)
+	g.Expect(err).To(MatchError(ContainSubstring("port cannot be empty")))
+}
+
+func TestStaticProcessingErrorsAreReported(t *testing.T) {
+	g := NewWithT(t)
+
+	translate := buildTranslateFunc(context.Background(), nil, nil)
+	upstreamIr := translate(nil, &v1alpha1.Upstream{
+		Spec: v1alpha1.UpstreamSpec{
+			Static: &v1alpha1.StaticUpstream{
+				Hosts: []v1alpha1.Host{{Host: "1.2.3.4"}},
+			},
+		},
+	})
+	g.Expect(upstreamIr.Errors).To(ConsistOf(MatchError("port cannot be empty for host")))
+
+	upstreamIr = translate(nil, &v1alpha1.Upstream{
+		Spec: v1alpha1.UpstreamSpec{
+			Static: &v1alpha1.StaticUpstream{
+				Hosts: []v1alpha1.Host{{Host: "1.2.3.4", Port: 8080}},
+			},
+		},
+	})
+	g.Expect(upstreamIr.Errors).To(BeEmpty())
+}
@@ -0,0 +1,124 @@
+package upstream
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/solo-io/gloo/projects/gateway2/api/v1alpha1"
+	"github.com/solo-io/gloo/projects/gateway2/ir"
+	"github.com/solo-io/gloo/projects/gateway2/pkg/client/clientset/versioned"
+	ggv2utils "github.com/solo-io/gloo/projects/gateway2/utils"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/zap"
+	"istio.io/istio/pkg/kube/controllers"
+	"istio.io/istio/pkg/kube/krt"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+)
+
+// statusSyncer writes the Accepted condition of Upstreams, from the errors found while translating them.
+// It is run by the proxy syncer once it is elected leader, so that replicas don't race to write statuses.
+type statusSyncer struct {
+	client    versioned.Interface
+	upstreams krt.Collection[ir.Upstream]
+
+	mu sync.Mutex
+	// upstreams whose status needs to be synced
+	dirty map[types.NamespacedName]struct{}
+	// signals that dirty is not empty
+	queue ggv2utils.AsyncQueue[struct{}]
+}
+
+func newStatusSyncer(client versioned.Interface, upstreams krt.Collection[ir.Upstream]) *statusSyncer {
+	return &statusSyncer{
+		client:    client,
+		upstreams: upstreams,
+		dirty:     map[types.NamespacedName]struct{}{},
+		queue:     ggv2utils.NewAsyncQueue[struct{}](),
+	}
+}
+
+func (s *statusSyncer) run(ctx context.Context) {
+	s.upstreams.Register(func(o krt.Event[ir.Upstream]) {
+		if o.Event == controllers.EventDelete {
+			return
+		}
+		up := o.Latest()
+		s.mu.Lock()
+		s.dirty[types.NamespacedName{Namespace: up.Namespace, Name: up.Name}] = struct{}{}
+		s.mu.Unlock()
+		s.queue.Enqueue(struct{}{})
+	})
+
+	for {
+		if _, err := s.queue.Dequeue(ctx); err != nil {
+			return
+		}
+		s.mu.Lock()
+		dirty := s.dirty
+		s.dirty = map[types.NamespacedName]struct{}{}
+		s.mu.Unlock()
+
+		for nn := range dirty {
+			s.syncStatus(ctx, nn)
+		}
+	}
+}
+
+func (s *statusSyncer) syncStatus(ctx context.Context, nn types.NamespacedName) {
+	logger := contextutils.LoggerFrom(ctx).Desugar()
+
+	gk := v1alpha1.UpstreamGVK.GroupKind()
+	up := s.upstreams.GetKey(krt.Key[ir.Upstream](ir.UpstreamResourceName(ir.ObjectSource{
+		Group:     gk.Group,
+		Kind:      gk.Kind,
+		Namespace: nn.Namespace,
+		Name:      nn.Name,
+	}, 0)))
+	if up == nil {
+		return
+	}
+	var errs []error
+	if upIr, ok := up.ObjIr.(*UpstreamIr); ok {
+		errs = upIr.Errors
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		obj, err := s.client.GatewayV1alpha1().Upstreams(nn.Namespace).Get(ctx, nn.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if !meta.SetStatusCondition(&obj.Status.Conditions, acceptedCondition(obj.GetGeneration(), errs)) {
+			return nil
+		}
+		_, err = s.client.GatewayV1alpha1().Upstreams(nn.Namespace).UpdateStatus(ctx, obj, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		logger.Error("failed to update upstream status",
+			zap.String("namespace", nn.Namespace), zap.String("name", nn.Name), zap.Error(err))
+	}
+}
+
+func acceptedCondition(generation int64, errs []error) metav1.Condition {
+	if len(errs) > 0 {
+		return metav1.Condition{
+			Type:               v1alpha1.UpstreamConditionAccepted,
+			Status:             metav1.ConditionFalse,
+			Reason:             v1alpha1.UpstreamReasonInvalid,
+			Message:            errors.Join(errs...).Error(),
+			ObservedGeneration: generation,
+		}
+	}
+	return metav1.Condition{
+		Type:               v1alpha1.UpstreamConditionAccepted,
+		Status:             metav1.ConditionTrue,
+		Reason:             v1alpha1.UpstreamReasonAccepted,
+		Message:            "Upstream is valid",
+		ObservedGeneration: generation,
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/solo-io/gloo/projects/gateway2/extensions2/common"
 	extensionsplug "github.com/solo-io/gloo/projects/gateway2/extensions2/plugin"
+	"github.com/solo-io/gloo/projects/gateway2/extensions2/plugins/consul"
 	"github.com/solo-io/gloo/projects/gateway2/extensions2/plugins/destrule"
 	"github.com/solo-io/gloo/projects/gateway2/extensions2/plugins/directresponse"
 	"github.com/solo-io/gloo/projects/gateway2/extensions2/plugins/istio"
@@ -40,6 +41,14 @@ func mergeSynced(funcs []func() bool) func() bool {
 	}
 }
 
+func mergeStatusSyncers(funcs []func(ctx context.Context)) func(ctx context.Context) {
+	return func(ctx context.Context) {
+		for _, f := range funcs {
+			go f(ctx)
+		}
+	}
+}
+
 func MergePlugins(plug ...extensionsplug.Plugin) extensionsplug.Plugin {
 	ret := extensionsplug.Plugin{
 		ContributesPolicies:  make(map[schema.GroupKind]extensionsplug.PolicyPlugin),
@@ -47,6 +56,7 @@ func MergePlugins(plug ...extensionsplug.Plugin) extensionsplug.Plugin {
 	}
 	var funcs []extensionsplug.GwTranslatorFactory
 	var hasSynced []func() bool
+	var statusSyncers []func(ctx context.Context)
 	for _, p := range plug {
 		maps.Copy(ret.ContributesPolicies, p.ContributesPolicies)
 		maps.Copy(ret.ContributesUpstreams, p.ContributesUpstreams)
@@ -56,9 +66,13 @@ func MergePlugins(plug ...extensionsplug.Plugin) extensionsplug.Plugin {
 		if p.ExtraHasSynced != nil {
 			hasSynced = append(hasSynced, p.ExtraHasSynced)
 		}
+		if p.StatusSyncer != nil {
+			statusSyncers = append(statusSyncers, p.StatusSyncer)
+		}
 	}
 	ret.ContributesGwTranslator = mergedGw(funcs)
 	ret.ExtraHasSynced = mergeSynced(hasSynced)
+	ret.StatusSyncer = mergeStatusSyncers(statusSyncers)
 	return ret
 }
 
@@ -72,6 +86,7 @@ func Plugins(ctx context.Context, commoncol *common.CommonCollections) []extensi
 		istio.NewPlugin(ctx, commoncol),
 		destrule.NewPlugin(ctx, commoncol),
 		listenerpolicy.NewPlugin(ctx, commoncol),
+		consul.NewPlugin(ctx, commoncol),
 	}
 }
 
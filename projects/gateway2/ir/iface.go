@@ -6,10 +6,12 @@ import (
 	"time"
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	anypb "google.golang.org/protobuf/types/known/anypb"
+	"istio.io/istio/pkg/kube/krt"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -24,6 +26,11 @@ type RouteBackendContext struct {
 	Upstream        *Upstream
 	// todo: make this not public
 	TypedFiledConfig *map[string]*anypb.Any
+	// endpoint metadata to match when load balancing to the backend, for subset load balancing.
+	MetadataMatch *envoy_config_core_v3.Metadata
+	// hash policies for consistent hash load balancing to the backend. they are set on the route,
+	// so they apply to all its backends.
+	HashPolicies []*envoy_config_route_v3.RouteAction_HashPolicy
 }
 
 func (r *RouteBackendContext) AddTypedConfig(key string, v *anypb.Any) {
@@ -57,6 +64,9 @@ type ProxyTranslationPass interface {
 		ctx context.Context,
 		pCtx *RouteContext,
 		out *envoy_config_route_v3.Route) error
+	// called for each policy attached to a route backend, and for each policy attached to its
+	// upstream. also called with a nil policy on the pass registered for the backend upstream's
+	// group kind, if any.
 	ApplyForRouteBackend(
 		ctx context.Context,
 		policy PolicyIR,
@@ -78,6 +88,11 @@ type Resources struct {
 }
 
 type GwTranslationCtx struct {
+	// the context of the collection that translates the gateway; the passes fetch the objects they depend on with it,
+	// so that changing them re-translates the gateway.
+	KrtCtx krt.HandlerContext
+	// the upstreams that the gateway can reference.
+	Upstreams krt.Collection[Upstream]
 }
 
 type PolicyIR interface {
@@ -69,6 +69,8 @@ func labeledRole(role string, labels map[string]string) string {
 
 type EndpointMetadata struct {
 	Labels map[string]string
+	// the zones of the EndpointSlice topology hints of the endpoint, if any.
+	ZoneHints []string
 }
 type EndpointWithMd struct {
 	*envoy_config_endpoint_v3.LbEndpoint
@@ -136,6 +138,10 @@ func hashEndpoints(l PodLocality, emd EndpointWithMd) uint64 {
 	hasher.Write([]byte(l.Subzone))
 
 	utils.HashUint64(hasher, utils.HashLabels(emd.EndpointMd.Labels))
+	for _, zone := range emd.EndpointMd.ZoneHints {
+		hasher.Write([]byte{0})
+		hasher.Write([]byte(zone))
+	}
 	utils.HashProtoWithHasher(hasher, emd.LbEndpoint)
 	return hasher.Sum64()
 }
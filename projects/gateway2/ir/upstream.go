@@ -78,7 +78,15 @@ func UpstreamResourceName(objSource ObjectSource, port int32) string {
 }
 
 func (c Upstream) Equals(in Upstream) bool {
-	return c.ObjectSource.Equals(in.ObjectSource) && versionEquals(c.Obj, in.Obj) && c.AttachedPolicies.Equals(in.AttachedPolicies)
+	return c.ObjectSource.Equals(in.ObjectSource) && versionEquals(c.Obj, in.Obj) && objIrEquals(c.ObjIr, in.ObjIr) && c.AttachedPolicies.Equals(in.AttachedPolicies)
+}
+
+// the ir may depend on other objects (e.g. secrets), so it can change when the object doesn't.
+func objIrEquals(a, b interface{ Equals(any) bool }) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equals(b)
 }
 
 func (c Upstream) ClusterName() string {
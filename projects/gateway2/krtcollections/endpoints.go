@@ -197,12 +197,17 @@ func transformK8sEndpoints(ctx context.Context, inputs EndpointsInputs) func(kct
 							augmentedLabels = maybePod.AugmentedLabels
 						}
 					}
+					// the pod may not be in the collection yet; the EndpointSlice knows its zone
+					if l == (ir.PodLocality{}) && endpoint.Zone != nil {
+						l.Zone = *endpoint.Zone
+					}
 					ep := CreateLBEndpoint(addr, port, augmentedLabels, enableAutoMtls)
 
 					ret.Add(l, ir.EndpointWithMd{
 						LbEndpoint: ep,
 						EndpointMd: ir.EndpointMetadata{
-							Labels: augmentedLabels,
+							Labels:    augmentedLabels,
+							ZoneHints: zoneHints(endpoint),
 						},
 					})
 				}
@@ -213,6 +218,17 @@ func transformK8sEndpoints(ctx context.Context, inputs EndpointsInputs) func(kct
 	}
 }
 
+func zoneHints(endpoint discoveryv1.Endpoint) []string {
+	if endpoint.Hints == nil || len(endpoint.Hints.ForZones) == 0 {
+		return nil
+	}
+	zones := make([]string, 0, len(endpoint.Hints.ForZones))
+	for _, z := range endpoint.Hints.ForZones {
+		zones = append(zones, z.Name)
+	}
+	return zones
+}
+
 func CreateLBEndpoint(address string, port uint32, podLabels map[string]string, enableAutoMtls bool) *envoy_config_endpoint_v3.LbEndpoint {
 	// Don't get the metadata labels and filter metadata for the envoy load balancer based on the upstream, as this is not used
 	// metadata := getLbMetadata(upstream, labels, "")
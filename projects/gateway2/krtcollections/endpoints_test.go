@@ -1078,6 +1078,92 @@ func TestEndpoints(t *testing.T) {
 				return result
 			},
 		},
+		{
+			name: "topology hints, and zone of endpoints without pods",
+			inputs: []any{
+				&discoveryv1.EndpointSlice{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "svc-abcde",
+						Namespace: "ns",
+						Labels: map[string]string{
+							"kubernetes.io/service-name": "svc",
+						},
+					},
+					AddressType: discoveryv1.AddressTypeIPv4,
+					Endpoints: []discoveryv1.Endpoint{
+						{
+							Addresses: []string{"1.2.3.4"},
+							Conditions: discoveryv1.EndpointConditions{
+								Ready: ptr.To(true),
+							},
+							Zone: ptr.To("zone1"),
+							Hints: &discoveryv1.EndpointHints{
+								ForZones: []discoveryv1.ForZone{{Name: "zone1"}, {Name: "zone2"}},
+							},
+						},
+					},
+					Ports: []discoveryv1.EndpointPort{
+						{
+							Name:     ptr.To("http"),
+							Port:     ptr.To(int32(8080)),
+							Protocol: ptr.To(corev1.ProtocolTCP),
+						},
+					},
+				},
+			},
+			upstream: ir.Upstream{
+				ObjectSource: ir.ObjectSource{
+					Namespace: "ns",
+					Name:      "svc",
+					Group:     "",
+					Kind:      "Service",
+				},
+				Port: 8080,
+				Obj: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "svc",
+						Namespace: "ns",
+					},
+					Spec: corev1.ServiceSpec{
+						Ports: []corev1.ServicePort{
+							{
+								Name: "http",
+								Port: 8080,
+							},
+						},
+					},
+				},
+			},
+			result: func(us ir.Upstream) *ir.EndpointsForUpstream {
+				emd := ir.EndpointWithMd{
+					LbEndpoint: &endpointv3.LbEndpoint{
+						LoadBalancingWeight: wrapperspb.UInt32(1),
+						HostIdentifier: &endpointv3.LbEndpoint_Endpoint{
+							Endpoint: &endpointv3.Endpoint{
+								Address: &envoy_config_core_v3.Address{
+									Address: &envoy_config_core_v3.Address_SocketAddress{
+										SocketAddress: &envoy_config_core_v3.SocketAddress{
+											Address: "1.2.3.4",
+											PortSpecifier: &envoy_config_core_v3.SocketAddress_PortValue{
+												PortValue: 8080,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					EndpointMd: ir.EndpointMetadata{
+						ZoneHints: []string{"zone1", "zone2"},
+					},
+				}
+				result := ir.NewEndpointsForUpstream(us)
+				result.Add(ir.PodLocality{
+					Zone: "zone1",
+				}, emd)
+				return result
+			},
+		},
 	}
 
 	for _, tc := range testCases {
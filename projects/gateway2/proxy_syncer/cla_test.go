@@ -137,3 +137,84 @@ func TestTranslatesDestrulesFailover(t *testing.T) {
 	g.Expect(localLocality.Priority).To(gomega.Equal(uint32(0)))
 	g.Expect(remoteLocality.Priority).To(gomega.Equal(uint32(1)))
 }
+
+func zoneEndpoint(path string, hints ...string) ir.EndpointWithMd {
+	return ir.EndpointWithMd{
+		LbEndpoint: &endpointv3.LbEndpoint{
+			HostIdentifier: &endpointv3.LbEndpoint_Endpoint{
+				Endpoint: &endpointv3.Endpoint{
+					Address: &corev3.Address{
+						Address: &corev3.Address_Pipe{Pipe: &corev3.Pipe{Path: path}},
+					},
+				},
+			},
+		},
+		EndpointMd: ir.EndpointMetadata{
+			ZoneHints: hints,
+		},
+	}
+}
+
+func TestTranslatesZoneHints(t *testing.T) {
+	g := gomega.NewWithT(t)
+	us := ir.Upstream{
+		ObjectSource: ir.ObjectSource{
+			Namespace: "ns",
+			Name:      "name",
+		},
+	}
+	efu := ir.NewEndpointsForUpstream(us)
+	// the hints send traffic of zone Z1 to the endpoint in Z2
+	efu.Add(ir.PodLocality{Region: "R1", Zone: "Z1"}, zoneEndpoint("a", "Z3"))
+	efu.Add(ir.PodLocality{Region: "R1", Zone: "Z2"}, zoneEndpoint("b", "Z1", "Z2"))
+	ucc := ir.UniqlyConnectedClient{
+		Namespace: "ns",
+		Locality:  ir.PodLocality{Region: "R1", Zone: "Z1"},
+	}
+
+	cla := endpoints.PrioritizeEndpoints(nil, &endpoints.PriorityInfo{ZoneHints: true}, *efu, ucc)
+	g.Expect(cla.Endpoints).To(gomega.HaveLen(2))
+	for _, lbEps := range cla.Endpoints {
+		if lbEps.Locality.Zone == "Z2" {
+			g.Expect(lbEps.Priority).To(gomega.Equal(uint32(0)))
+		} else {
+			g.Expect(lbEps.Priority).To(gomega.Equal(uint32(1)))
+		}
+	}
+
+	// no endpoint has hints for the zone of the proxy
+	ucc.Locality.Zone = "Z4"
+	cla = endpoints.PrioritizeEndpoints(nil, &endpoints.PriorityInfo{ZoneHints: true}, *efu, ucc)
+	g.Expect(cla.Endpoints).To(gomega.HaveLen(2))
+	for _, lbEps := range cla.Endpoints {
+		g.Expect(lbEps.Priority).To(gomega.Equal(uint32(0)))
+	}
+}
+
+func TestZoneHintsFallbackToLocalityFailover(t *testing.T) {
+	g := gomega.NewWithT(t)
+	us := ir.Upstream{
+		ObjectSource: ir.ObjectSource{
+			Namespace: "ns",
+			Name:      "name",
+		},
+	}
+	efu := ir.NewEndpointsForUpstream(us)
+	efu.Add(ir.PodLocality{Region: "R1", Zone: "Z1"}, zoneEndpoint("a", "Z2"))
+	// an endpoint without hints disables them for the whole service
+	efu.Add(ir.PodLocality{Region: "R1", Zone: "Z2"}, zoneEndpoint("b"))
+	ucc := ir.UniqlyConnectedClient{
+		Namespace: "ns",
+		Locality:  ir.PodLocality{Region: "R1", Zone: "Z1"},
+	}
+
+	cla := endpoints.PrioritizeEndpoints(nil, &endpoints.PriorityInfo{ZoneHints: true}, *efu, ucc)
+	g.Expect(cla.Endpoints).To(gomega.HaveLen(2))
+	for _, lbEps := range cla.Endpoints {
+		if lbEps.Locality.Zone == "Z1" {
+			g.Expect(lbEps.Priority).To(gomega.Equal(uint32(0)))
+		} else {
+			g.Expect(lbEps.Priority).To(gomega.Equal(uint32(1)))
+		}
+	}
+}
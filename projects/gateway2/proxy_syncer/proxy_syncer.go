@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"slices"
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/types"
@@ -37,6 +39,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	"github.com/solo-io/go-utils/contextutils"
 	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -66,6 +69,9 @@ type ProxySyncer struct {
 	perclientSnapCollection krt.Collection[XdsSnapWrapper]
 
 	waitForSync []cache.InformerSynced
+
+	// xdsStatuses is used to report the Gateways whose xDS resources envoy rejected
+	xdsStatuses *xds.AckTracker
 }
 
 type GatewayXdsResources struct {
@@ -137,6 +143,7 @@ func NewProxySyncer(
 	extensionsFactory extensions.K8sGatewayExtensionsFactory,
 	commonCols *common.CommonCollections,
 	xdsCache envoycache.SnapshotCache,
+	xdsStatuses *xds.AckTracker,
 ) *ProxySyncer {
 	extensions := extensionsFactory(ctx, commonCols)
 
@@ -149,6 +156,7 @@ func NewProxySyncer(
 		uniqueClients:    uniqueClients,
 		translatorSyncer: translator.NewCombinedTranslator(ctx, extensions, commonCols),
 		extensions:       extensions,
+		xdsStatuses:      xdsStatuses,
 	}
 }
 
@@ -298,6 +306,16 @@ func (s *ProxySyncer) Start(ctx context.Context) error {
 		}
 		latestReportQueue.Enqueue(o.Latest().reportMap)
 	})
+	// the statuses of the plugins' resources, such as Upstreams, are written by the same replica as Gateway statuses
+	if s.extensions.StatusSyncer != nil {
+		go s.extensions.StatusSyncer(ctx)
+	}
+	// sync the Gateway statuses again when envoy rejects or accepts their resources
+	s.xdsStatuses.OnRejectionsChanged(func(string) {
+		if latest := s.statusReport.Get(); latest != nil {
+			latestReportQueue.Enqueue(latest.reportMap)
+		}
+	})
 
 	go func() {
 		timer := time.NewTicker(time.Second * 1)
@@ -454,6 +472,7 @@ func (s *ProxySyncer) syncGatewayStatus(ctx context.Context, rm reports.ReportMa
 			gwStatusWithoutAddress := gw.Status
 			gwStatusWithoutAddress.Addresses = nil
 			if status := rm.BuildGWStatus(ctx, gw); status != nil {
+				s.setXdsRejectedCondition(&gw, status)
 				if !isGatewayStatusEqual(&gwStatusWithoutAddress, status) {
 					gw.Status = *status
 					if err := s.mgr.GetClient().Status().Patch(ctx, &gw, client.Merge); err != nil {
@@ -479,6 +498,50 @@ func (s *ProxySyncer) syncGatewayStatus(ctx context.Context, rm reports.ReportMa
 	logger.Debugf("synced gw status for %d gateways in %s", len(rm.Gateways), duration.String())
 }
 
+// setXdsRejectedCondition sets the Programmed condition of the Gateway to False when its envoys rejected some of
+// its xDS resources. Envoy keeps serving the resources it accepted last.
+func (s *ProxySyncer) setXdsRejectedCondition(gw *gwv1.Gateway, status *gwv1.GatewayStatus) {
+	role := xds.OwnerNamespaceNameID(glooutils.GatewayApiProxyValue, gw.GetNamespace(), gw.GetName())
+	var messages []string
+	for _, nodeKey := range s.gatewayNodeKeys(role) {
+		for _, rejection := range s.xdsStatuses.Rejections(nodeKey) {
+			message := fmt.Sprintf("envoy rejected the %s resources (version %q): %s",
+				rejection.TypeUrl, rejection.RejectedVersion, rejection.Error)
+			// the envoys of different clients usually reject the same resources for the same reason
+			if !slices.Contains(messages, message) {
+				messages = append(messages, message)
+			}
+		}
+	}
+	if len(messages) == 0 {
+		return
+	}
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               string(gwv1.GatewayConditionProgrammed),
+		Status:             metav1.ConditionFalse,
+		Reason:             string(gwv1.GatewayReasonInvalid),
+		Message:            strings.Join(messages, "; "),
+		ObservedGeneration: gw.GetGeneration(),
+	})
+}
+
+// gatewayNodeKeys returns the keys the envoys of the Gateway with the given role are tracked under, sorted.
+// The uniquely connected clients callbacks augment the role of the envoys that use pod locality with their labels
+// and namespace before the AckTracker reads it, so each of these clients is tracked under its own key.
+func (s *ProxySyncer) gatewayNodeKeys(role string) []string {
+	nodeKeys := []string{role}
+	if s.uniqueClients == nil {
+		return nodeKeys
+	}
+	for _, ucc := range s.uniqueClients.List() {
+		if ucc.Role == role && ucc.ResourceName() != role {
+			nodeKeys = append(nodeKeys, ucc.ResourceName())
+		}
+	}
+	slices.Sort(nodeKeys[1:])
+	return nodeKeys
+}
+
 //func applyPostTranslationPlugins(ctx context.Context, pluginRegistry registry.PluginRegistry, translationContext *gwplugins.PostTranslationContext) {
 //	ctx = contextutils.WithLogger(ctx, "postTranslation")
 //	logger := contextutils.LoggerFrom(ctx)
@@ -1,12 +1,23 @@
 package proxy_syncer
 
 import (
+	"strings"
 	"testing"
 
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	"github.com/solo-io/gloo/projects/gateway2/ir"
 	"github.com/solo-io/gloo/projects/gateway2/wellknown"
+	glooutils "github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 )
 
 func TestIsGatewayStatusEqual(t *testing.T) {
@@ -140,3 +151,116 @@ func TestIsRouteStatusEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestSetXdsRejectedCondition(t *testing.T) {
+	gw := &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "default",
+			Name:       "gw",
+			Generation: 2,
+		},
+	}
+	programmed := metav1.Condition{
+		Type:   string(gwv1.GatewayConditionProgrammed),
+		Status: metav1.ConditionTrue,
+		Reason: string(gwv1.GatewayReasonProgrammed),
+	}
+
+	xdsStatuses := xds.NewAckTracker()
+	s := &ProxySyncer{xdsStatuses: xdsStatuses}
+
+	status := &gwv1.GatewayStatus{Conditions: []metav1.Condition{programmed}}
+	s.setXdsRejectedCondition(gw, status)
+	if cond := meta.FindStatusCondition(status.Conditions, programmed.Type); cond.Status != metav1.ConditionTrue {
+		t.Errorf("expected the gateway to be programmed, got %v", cond)
+	}
+
+	// envoy nacks the clusters of the gateway
+	err := xdsStatuses.OnStreamRequest(1, &envoy_service_discovery_v3.DiscoveryRequest{
+		Node: &envoy_config_core_v3.Node{
+			Metadata: &structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					xds.RoleKey: structpb.NewStringValue(xds.OwnerNamespaceNameID(glooutils.GatewayApiProxyValue, "default", "gw")),
+				},
+			},
+		},
+		TypeUrl:       "type.googleapis.com/envoy.config.cluster.v3.Cluster",
+		ResponseNonce: "1",
+		ErrorDetail:   &rpcstatus.Status{Message: "cluster foo: invalid"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	status = &gwv1.GatewayStatus{Conditions: []metav1.Condition{programmed}}
+	s.setXdsRejectedCondition(gw, status)
+	cond := meta.FindStatusCondition(status.Conditions, programmed.Type)
+	if cond.Status != metav1.ConditionFalse || cond.Reason != string(gwv1.GatewayReasonInvalid) {
+		t.Errorf("expected the gateway not to be programmed, got %v", cond)
+	}
+	if !strings.Contains(cond.Message, "cluster foo: invalid") {
+		t.Errorf("expected the condition message to contain the rejection, got %q", cond.Message)
+	}
+	if cond.ObservedGeneration != gw.Generation {
+		t.Errorf("expected observed generation %d, got %d", gw.Generation, cond.ObservedGeneration)
+	}
+}
+
+func TestSetXdsRejectedConditionWithPodAugmentedClients(t *testing.T) {
+	gw := &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "gw",
+		},
+	}
+	role := xds.OwnerNamespaceNameID(glooutils.GatewayApiProxyValue, "default", "gw")
+	// the envoys of the gateway use pod locality, so their role is augmented with their labels and namespace
+	zoneA := ir.NewUniqlyConnectedClient(role, "default", map[string]string{"zone": "a"}, ir.PodLocality{Zone: "a"})
+	zoneB := ir.NewUniqlyConnectedClient(role, "default", map[string]string{"zone": "b"}, ir.PodLocality{Zone: "b"})
+	other := ir.NewUniqlyConnectedClient(xds.OwnerNamespaceNameID(glooutils.GatewayApiProxyValue, "default", "other"), "default", nil, ir.PodLocality{})
+
+	xdsStatuses := xds.NewAckTracker()
+	s := &ProxySyncer{
+		xdsStatuses:   xdsStatuses,
+		uniqueClients: krt.NewStaticCollection([]ir.UniqlyConnectedClient{zoneA, zoneB, other}),
+	}
+
+	nack := func(sid int64, nodeKey, typeUrl, message string) {
+		t.Helper()
+		err := xdsStatuses.OnStreamRequest(sid, &envoy_service_discovery_v3.DiscoveryRequest{
+			Node: &envoy_config_core_v3.Node{
+				Metadata: &structpb.Struct{
+					Fields: map[string]*structpb.Value{
+						xds.RoleKey: structpb.NewStringValue(nodeKey),
+					},
+				},
+			},
+			TypeUrl:       typeUrl,
+			ResponseNonce: "1",
+			ErrorDetail:   &rpcstatus.Status{Message: message},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	nack(1, zoneA.ResourceName(), "type.googleapis.com/envoy.config.cluster.v3.Cluster", "cluster foo: invalid")
+	nack(2, zoneB.ResourceName(), "type.googleapis.com/envoy.config.cluster.v3.Cluster", "cluster foo: invalid")
+	nack(3, zoneB.ResourceName(), "type.googleapis.com/envoy.config.listener.v3.Listener", "listener bar: invalid")
+	nack(4, other.ResourceName(), "type.googleapis.com/envoy.config.route.v3.RouteConfiguration", "route baz: invalid")
+
+	status := &gwv1.GatewayStatus{}
+	s.setXdsRejectedCondition(gw, status)
+	cond := meta.FindStatusCondition(status.Conditions, string(gwv1.GatewayConditionProgrammed))
+	if cond == nil || cond.Status != metav1.ConditionFalse {
+		t.Fatalf("expected the gateway not to be programmed, got %v", cond)
+	}
+	if strings.Count(cond.Message, "cluster foo: invalid") != 1 {
+		t.Errorf("expected the rejection of both clients to be reported once, got %q", cond.Message)
+	}
+	if !strings.Contains(cond.Message, "listener bar: invalid") {
+		t.Errorf("expected the condition message to contain the rejection of the second client, got %q", cond.Message)
+	}
+	if strings.Contains(cond.Message, "route baz: invalid") {
+		t.Errorf("expected the condition message not to contain the rejection of another gateway, got %q", cond.Message)
+	}
+}
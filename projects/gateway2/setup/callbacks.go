@@ -0,0 +1,104 @@
+package setup
+
+import (
+	"context"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	xdsserver "github.com/envoyproxy/go-control-plane/pkg/server/v3"
+)
+
+// multiCallbacks invokes each of its callbacks in order.
+type multiCallbacks []xdsserver.Callbacks
+
+var _ xdsserver.Callbacks = multiCallbacks{}
+
+// OnStreamOpen is called once an xDS stream is open with a stream ID and the type URL (or "" for ADS).
+// Returning an error will end processing and close the stream. OnStreamClosed will still be called.
+func (mc multiCallbacks) OnStreamOpen(ctx context.Context, sid int64, url string) error {
+	for _, c := range mc {
+		if err := c.OnStreamOpen(ctx, sid, url); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnStreamClosed is called immediately prior to closing an xDS stream with a stream ID.
+func (mc multiCallbacks) OnStreamClosed(sid int64, node *envoy_config_core_v3.Node) {
+	for _, c := range mc {
+		c.OnStreamClosed(sid, node)
+	}
+}
+
+// OnDeltaStreamOpen is called once an incremental xDS stream is open with a stream ID and the type URL (or "" for ADS).
+// Returning an error will end processing and close the stream. OnDeltaStreamClosed will still be called.
+func (mc multiCallbacks) OnDeltaStreamOpen(ctx context.Context, sid int64, url string) error {
+	for _, c := range mc {
+		if err := c.OnDeltaStreamOpen(ctx, sid, url); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnDeltaStreamClosed is called immediately prior to closing an incremental xDS stream with a stream ID.
+func (mc multiCallbacks) OnDeltaStreamClosed(sid int64, node *envoy_config_core_v3.Node) {
+	for _, c := range mc {
+		c.OnDeltaStreamClosed(sid, node)
+	}
+}
+
+// OnStreamRequest is called once a request is received on a stream.
+// Returning an error will end processing and close the stream. OnStreamClosed will still be called.
+func (mc multiCallbacks) OnStreamRequest(sid int64, req *envoy_service_discovery_v3.DiscoveryRequest) error {
+	for _, c := range mc {
+		if err := c.OnStreamRequest(sid, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnStreamResponse is called immediately prior to sending a response on a stream.
+func (mc multiCallbacks) OnStreamResponse(ctx context.Context, sid int64, req *envoy_service_discovery_v3.DiscoveryRequest, resp *envoy_service_discovery_v3.DiscoveryResponse) {
+	for _, c := range mc {
+		c.OnStreamResponse(ctx, sid, req, resp)
+	}
+}
+
+// OnStreamDeltaRequest is called once a request is received on an incremental stream.
+// Returning an error will end processing and close the stream. OnDeltaStreamClosed will still be called.
+func (mc multiCallbacks) OnStreamDeltaRequest(sid int64, req *envoy_service_discovery_v3.DeltaDiscoveryRequest) error {
+	for _, c := range mc {
+		if err := c.OnStreamDeltaRequest(sid, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnStreamDeltaResponse is called immediately prior to sending a response on an incremental stream.
+func (mc multiCallbacks) OnStreamDeltaResponse(sid int64, req *envoy_service_discovery_v3.DeltaDiscoveryRequest, resp *envoy_service_discovery_v3.DeltaDiscoveryResponse) {
+	for _, c := range mc {
+		c.OnStreamDeltaResponse(sid, req, resp)
+	}
+}
+
+// OnFetchRequest is called for each Fetch request. Returning an error will end processing of the
+// request and respond with an error.
+func (mc multiCallbacks) OnFetchRequest(ctx context.Context, req *envoy_service_discovery_v3.DiscoveryRequest) error {
+	for _, c := range mc {
+		if err := c.OnFetchRequest(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnFetchResponse is called immediately prior to sending a response.
+func (mc multiCallbacks) OnFetchResponse(req *envoy_service_discovery_v3.DiscoveryRequest, resp *envoy_service_discovery_v3.DiscoveryResponse) {
+	for _, c := range mc {
+		c.OnFetchResponse(req, resp)
+	}
+}
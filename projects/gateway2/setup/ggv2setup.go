@@ -17,6 +17,7 @@ import (
 	"github.com/solo-io/gloo/projects/gateway2/krtcollections"
 	"github.com/solo-io/gloo/projects/gateway2/utils/krtutil"
 	glookubev1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/kube/apis/gloo.solo.io/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	"github.com/solo-io/go-utils/contextutils"
 	istiokube "istio.io/istio/pkg/kube"
 	"istio.io/istio/pkg/kube/krt"
@@ -68,8 +69,24 @@ func StartGGv2(ctx context.Context,
 ) error {
 	restConfig := ctrl.GetConfigOrDie()
 
+	// the proxies of Kubernetes Gateways are not given a client certificate,
+	// so they can't connect to an xDS server that requires mTLS
+	kubeClient, err := createKubeClient(restConfig)
+	if err != nil {
+		return err
+	}
+	initialSettings := getInitialSettings(ctx, kubeClient, setuputils.SetupNamespaceName())
+	if initialSettings == nil {
+		return fmt.Errorf("initial settings not found")
+	}
+	if initialSettings.Spec.GetGloo().GetXdsTls().GetCertFile() != "" {
+		return fmt.Errorf("gloo.xdsTls is not supported with Kubernetes Gateways, whose proxies have no client certificate")
+	}
+
 	uniqueClientCallbacks, uccBuilder := krtcollections.NewUniquelyConnectedClients()
-	cache, err := startControlPlane(ctx, uniqueClientCallbacks)
+	xdsStatuses := xds.NewAckTracker()
+	// the unique client callbacks augment the role of the envoys first, so the tracker keys them like their snapshots
+	cache, err := startControlPlane(ctx, multiCallbacks{uniqueClientCallbacks, xdsStatuses.EnvoyCallbacks()})
 	if err != nil {
 		return err
 	}
@@ -80,6 +97,7 @@ func StartGGv2(ctx context.Context,
 		ExtraGatewayClasses: extraGwClasses,
 		XdsHost:             GetControlPlaneXdsHost(),
 		XdsPort:             9977,
+		XdsStatuses:         xdsStatuses,
 	}
 
 	return StartGGv2WithConfig(ctx, setupOpts, restConfig, uccBuilder, extraPlugins, nil, setuputils.SetupNamespaceName())
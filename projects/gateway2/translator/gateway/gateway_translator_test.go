@@ -113,6 +113,16 @@ var _ = DescribeTable("Basic GatewayTranslator Tests",
 				Name:      "gw",
 			},
 		}),
+	Entry(
+		"http gateway with dynamic forward proxy upstream",
+		translatorTestCase{
+			inputFile:  "http-with-dfp-upstream",
+			outputFile: "http-with-dfp-upstream-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "gw",
+			},
+		}),
 	XEntry(
 		"http gateway with azure destination",
 		translatorTestCase{
@@ -8,11 +8,13 @@ import (
 	"github.com/solo-io/gloo/projects/gateway2/ir"
 	"github.com/solo-io/gloo/projects/gateway2/reports"
 	"golang.org/x/net/context"
+	"istio.io/istio/pkg/kube/krt"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 type Translator struct {
 	ContributedPolicies map[schema.GroupKind]extensionsplug.PolicyPlugin
+	Upstreams           krt.Collection[ir.Upstream]
 }
 
 type TranslationPassPlugins map[schema.GroupKind]*TranslationPass
@@ -23,9 +25,10 @@ type TranslationResult struct {
 	ExtraClusters []*envoy_config_cluster_v3.Cluster
 }
 
-// Translate IR to gateway. IR is self contained, so no need for krt context
-func (t *Translator) Translate(gw ir.GatewayIR, reporter reports.Reporter) TranslationResult {
-	pass := t.newPass()
+// Translate IR to gateway. IR is self contained; the krt context is only given to the translation passes of the
+// plugins, which fetch the objects they depend on that the IR doesn't reference, such as the upstreams of the settings.
+func (t *Translator) Translate(kctx krt.HandlerContext, gw ir.GatewayIR, reporter reports.Reporter) TranslationResult {
+	pass := t.newPass(kctx)
 	var res TranslationResult
 
 	for _, l := range gw.Listeners {
@@ -127,13 +130,16 @@ func (t *Translator) runListenerPlugins(ctx context.Context, pass TranslationPas
 	}
 }
 
-func (t *Translator) newPass() TranslationPassPlugins {
+func (t *Translator) newPass(kctx krt.HandlerContext) TranslationPassPlugins {
 	ret := TranslationPassPlugins{}
 	for k, v := range t.ContributedPolicies {
 		if v.NewGatewayTranslationPass == nil {
 			continue
 		}
-		tp := v.NewGatewayTranslationPass(context.TODO(), ir.GwTranslationCtx{})
+		tp := v.NewGatewayTranslationPass(context.TODO(), ir.GwTranslationCtx{
+			KrtCtx:    kctx,
+			Upstreams: t.Upstreams,
+		})
 		if tp != nil {
 			ret[k] = &TranslationPass{
 				ProxyTranslationPass: tp,
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"maps"
 	"regexp"
+	"slices"
 
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
@@ -16,6 +17,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/go-utils/contextutils"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -240,6 +242,30 @@ func (h *httpRouteConfigurationTranslator) runRoutePlugins(ctx context.Context,
 
 func (h *httpRouteConfigurationTranslator) runBackendPolicies(ctx context.Context, in ir.HttpBackend, pCtx *ir.RouteBackendContext) error {
 	var errs []error
+	// give the plugin that contributed the upstream a chance to configure the route for it,
+	// even if no policy is attached to the backend (e.g. to enable a filter on the chain).
+	if pCtx.Upstream != nil {
+		if pass := h.PluginPass[pCtx.Upstream.GetGroupKind()]; pass != nil {
+			if err := pass.ApplyForRouteBackend(ctx, nil, pCtx); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	// policies attached to the upstream (e.g. with target refs) may need to configure the routes to it.
+	// only the policies of plugins with a translation pass are relevant here.
+	if pCtx.Upstream != nil {
+		for gk, pols := range pCtx.Upstream.AttachedPolicies.Policies {
+			pass := h.PluginPass[gk]
+			if pass == nil {
+				continue
+			}
+			for _, pol := range pols {
+				if err := pass.ApplyForRouteBackend(ctx, pol.PolicyIr, pCtx); err != nil {
+					errs = append(errs, err)
+				}
+			}
+		}
+	}
 	for gk, pols := range in.AttachedPolicies.Policies {
 		pass := h.PluginPass[gk]
 		if pass == nil {
@@ -263,6 +289,7 @@ func (h *httpRouteConfigurationTranslator) translateRouteAction(
 	outRoute *envoy_config_route_v3.Route,
 ) *envoy_config_route_v3.Route_Route {
 	var clusters []*envoy_config_route_v3.WeightedCluster_ClusterWeight
+	var hashPolicies []*envoy_config_route_v3.RouteAction_HashPolicy
 
 	for _, backend := range in.Backends {
 		clusterName := backend.Backend.ClusterName
@@ -284,13 +311,22 @@ func (h *httpRouteConfigurationTranslator) translateRouteAction(
 			backend,
 			&pCtx,
 		)
+		cw.MetadataMatch = pCtx.MetadataMatch
 		clusters = append(clusters, cw)
+		for _, hp := range pCtx.HashPolicies {
+			if !slices.ContainsFunc(hashPolicies, func(existing *envoy_config_route_v3.RouteAction_HashPolicy) bool {
+				return proto.Equal(existing, hp)
+			}) {
+				hashPolicies = append(hashPolicies, hp)
+			}
+		}
 	}
 
 	// TODO: i think envoy nacks if all weights are 0, we should error on that.
 
 	action := &envoy_config_route_v3.RouteAction{
 		ClusterNotFoundResponseCode: envoy_config_route_v3.RouteAction_INTERNAL_SERVER_ERROR,
+		HashPolicy:                  hashPolicies,
 	}
 	routeAction := &envoy_config_route_v3.Route_Route{
 		Route: action,
@@ -302,6 +338,7 @@ func (h *httpRouteConfigurationTranslator) translateRouteAction(
 		action.ClusterSpecifier = &envoy_config_route_v3.RouteAction_Cluster{
 			Cluster: clusters[0].GetName(),
 		}
+		action.MetadataMatch = clusters[0].GetMetadataMatch()
 		if clusters[0].GetTypedPerFilterConfig() != nil {
 			if outRoute.GetTypedPerFilterConfig() == nil {
 				outRoute.TypedPerFilterConfig = clusters[0].GetTypedPerFilterConfig()
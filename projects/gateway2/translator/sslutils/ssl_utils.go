@@ -9,6 +9,9 @@ import (
 	"k8s.io/client-go/util/cert"
 )
 
+// SystemCaBundle is the CA bundle of the envoy image, used to verify upstreams when no CA is configured.
+const SystemCaBundle = "/etc/ssl/certs/ca-certificates.crt"
+
 var (
 	InvalidTlsSecretError = func(n, ns string, err error) error {
 		return fmt.Errorf("%v.%v is not a valid TLS secret: %w", ns, n, err)
@@ -45,12 +45,20 @@ func NewCombinedTranslator(
 	extensions extensionsplug.Plugin,
 	commonCols *common.CommonCollections,
 ) *CombinedTranslator {
-	var endpointPlugins []extensionsplug.EndpointPlugin
-	for _, ext := range extensions.ContributesPolicies {
-		if ext.PerClientProcessEndpoints != nil {
+	var endpointPlugins, upstreamEndpointPlugins []extensionsplug.EndpointPlugin
+	for gk, ext := range extensions.ContributesPolicies {
+		if ext.PerClientProcessEndpoints == nil {
+			continue
+		}
+		// plugins of upstream types only provide defaults for their own endpoints, so policies
+		// that attach to upstreams (like DestinationRules) take precedence over them.
+		if _, ok := extensions.ContributesUpstreams[gk]; ok {
+			upstreamEndpointPlugins = append(upstreamEndpointPlugins, ext.PerClientProcessEndpoints)
+		} else {
 			endpointPlugins = append(endpointPlugins, ext.PerClientProcessEndpoints)
 		}
 	}
+	endpointPlugins = append(endpointPlugins, upstreamEndpointPlugins...)
 	return &CombinedTranslator{
 		commonCols:      commonCols,
 		extensions:      extensions,
@@ -75,6 +83,7 @@ func (s *CombinedTranslator) Init(ctx context.Context, isOurGw func(gw *gwv1.Gat
 	s.gwtranslator = gwtranslator.NewTranslator(queries)
 	s.irtranslator = &irtranslator.Translator{
 		ContributedPolicies: s.extensions.ContributesPolicies,
+		Upstreams:           finalUpstreams,
 	}
 	s.upstreamTranslator = &irtranslator.UpstreamTranslator{
 		ContributedUpstreams: make(map[schema.GroupKind]ir.UpstreamInit),
@@ -154,7 +163,7 @@ func (s *CombinedTranslator) TranslateGateway(kctx krt.HandlerContext, ctx conte
 	}
 
 	// we are recomputing xds snapshots as proxies have changed, signal that we need to sync xds with these new snapshots
-	xdsSnap := s.irtranslator.Translate(*gwir, r)
+	xdsSnap := s.irtranslator.Translate(kctx, *gwir, r)
 
 	return &xdsSnap, rm
 
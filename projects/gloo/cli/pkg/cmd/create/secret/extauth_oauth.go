@@ -23,9 +23,10 @@ func ExtAuthOathCmd(opts *options.Options) *cobra.Command {
 	input := extauth.OauthSecret{}
 	cmd := &cobra.Command{
 		Use:   "oauth",
-		Short: `Create an OAuth secret with the given name (Enterprise)`,
+		Short: `Create an OAuth secret with the given name`,
 		Long: "Create an OAuth secret with the given name. The OAuth secret contains the client_secret as defined in [RFC 6749](https://tools.ietf.org/html/rfc6749). " +
-			"This is an enterprise-only feature. The format of the secret data is: `{\"oauth\" : [client-secret string]}`. ",
+			"It is the `clientSecretRef` of the `oauth2` options of virtual hosts and routes, and of Enterprise ext auth configs. " +
+			"The format of the secret data is: `{\"oauth\" : [client-secret string]}`. ",
 		RunE: func(c *cobra.Command, args []string) error {
 			err := argsutils.MetadataArgsParse(opts, args)
 			if err != nil {
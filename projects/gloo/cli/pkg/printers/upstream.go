@@ -97,6 +97,12 @@ func upstreamType(up *v1.Upstream) string {
 		return "Static"
 	case *v1.Upstream_Gcp:
 		return "GCP"
+	case *v1.Upstream_Eureka:
+		return "Eureka"
+	case *v1.Upstream_DnsSrv:
+		return "DNS SRV"
+	case *v1.Upstream_Nomad:
+		return "Nomad"
 	default:
 		return "Unknown"
 	}
@@ -190,6 +196,28 @@ func upstreamDetails(up *v1.Upstream, xdsDump *xdsinspection.XdsDump) []string {
 		if usType.Gcp.GetAudience() != "" {
 			add(fmt.Sprintf("host: %v", usType.Gcp.GetAudience()))
 		}
+	case *v1.Upstream_Eureka:
+		add(
+			fmt.Sprintf("app name:         %v", usType.Eureka.GetAppName()),
+			fmt.Sprintf("uses secure port: %v", usType.Eureka.GetUseSecurePort()),
+		)
+		for i := range usType.Eureka.GetServiceUrls() {
+			if i == 0 {
+				add("service urls:")
+			}
+			add(fmt.Sprintf("- %v", usType.Eureka.GetServiceUrls()[i]))
+		}
+	case *v1.Upstream_DnsSrv:
+		add(fmt.Sprintf("name: %v", usType.DnsSrv.GetName()))
+		if usType.DnsSrv.GetResolverAddress() != "" {
+			add(fmt.Sprintf("resolver: %v", usType.DnsSrv.GetResolverAddress()))
+		}
+	case *v1.Upstream_Nomad:
+		add(
+			fmt.Sprintf("svc name:      %v", usType.Nomad.GetServiceName()),
+			fmt.Sprintf("svc namespace: %v", usType.Nomad.GetNamespace()),
+			fmt.Sprintf("svc tags:      %v", usType.Nomad.GetServiceTags()),
+		)
 
 	}
 	add("")
@@ -107,11 +107,12 @@ func GetGlooXdsDump(ctx context.Context, proxyName, namespace string, verboseErr
 }
 
 type XdsDump struct {
-	Role      string
-	Endpoints []envoyendpoint.ClusterLoadAssignment
-	Clusters  []envoycluster.Cluster
-	Listeners []envoylistener.Listener
-	Routes    []envoy_config_route_v3.RouteConfiguration
+	Role         string
+	Endpoints    []envoyendpoint.ClusterLoadAssignment
+	Clusters     []envoycluster.Cluster
+	Listeners    []envoylistener.Listener
+	Routes       []envoy_config_route_v3.RouteConfiguration
+	ScopedRoutes []envoy_config_route_v3.ScopedRouteConfiguration
 }
 
 func getXdsDump(ctx context.Context, xdsPort, proxyName, proxyNamespace string) (*XdsDump, error) {
@@ -161,9 +162,27 @@ func getXdsDump(ctx context.Context, xdsPort, proxyName, proxyNamespace string)
 		}
 	}
 
-	var routes []string
+	var (
+		routes          []string
+		hasScopedRoutes bool
+	)
 	for _, hcm := range hcms {
-		routes = append(routes, hcm.GetRouteSpecifier().(*envoyhttp.HttpConnectionManager_Rds).Rds.GetRouteConfigName())
+		switch routeSpecifier := hcm.GetRouteSpecifier().(type) {
+		case *envoyhttp.HttpConnectionManager_Rds:
+			routes = append(routes, routeSpecifier.Rds.GetRouteConfigName())
+		case *envoyhttp.HttpConnectionManager_ScopedRoutes:
+			hasScopedRoutes = true
+		}
+	}
+
+	if hasScopedRoutes {
+		xdsDump.ScopedRoutes, err = listScopedRoutes(ctx, dr, conn)
+		if err != nil {
+			return nil, err
+		}
+		for _, scopedRoute := range xdsDump.ScopedRoutes { //nolint:all
+			routes = append(routes, scopedRoute.GetRouteConfigurationName())
+		}
 	}
 
 	xdsDump.Routes, err = listRoutes(ctx, conn, dr, routes)
@@ -256,6 +275,26 @@ func listRoutes(ctx context.Context, conn *grpc.ClientConn, dr *discovery_v3.Dis
 	return routes, nil
 }
 
+func listScopedRoutes(ctx context.Context, dr *discovery_v3.DiscoveryRequest, conn *grpc.ClientConn) ([]envoy_config_route_v3.ScopedRouteConfiguration, error) {
+
+	// scoped routes
+	srdsc := envoy_service_route_v3.NewScopedRoutesDiscoveryServiceClient(conn)
+	dresp, err := srdsc.FetchScopedRoutes(ctx, dr)
+	if err != nil {
+		return nil, eris.Errorf("scoped routes err: %v", err)
+	}
+	var scopedRoutes []envoy_config_route_v3.ScopedRouteConfiguration
+
+	for _, anyScopedRoute := range dresp.GetResources() {
+		var scopedRoute envoy_config_route_v3.ScopedRouteConfiguration
+		if err := ptypes.UnmarshalAny(anyScopedRoute, &scopedRoute); err != nil {
+			return nil, err
+		}
+		scopedRoutes = append(scopedRoutes, scopedRoute)
+	}
+	return scopedRoutes, nil
+}
+
 func (xd *XdsDump) String() string {
 	buf := &bytes.Buffer{}
 	errStrFmt := "unable to parse yaml: yaml format of %s failed to parse with: %s"
@@ -288,6 +327,15 @@ func (xd *XdsDump) String() string {
 		}
 		fmt.Fprintf(buf, "\n%s", yam)
 	}
+	fmt.Fprintf(buf, "\n\n#srds")
+
+	for _, c := range xd.ScopedRoutes { //nolint:all
+		yam, err := toYaml(&c)
+		if err != nil {
+			return fmt.Sprintf(errStrFmt, "srds", err.Error())
+		}
+		fmt.Fprintf(buf, "\n%s", yam)
+	}
 	fmt.Fprintf(buf, "\n\n#rds")
 
 	for _, c := range xd.Routes { //nolint:all
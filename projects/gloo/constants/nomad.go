@@ -0,0 +1,10 @@
+package constants
+
+// Nomad endpoints are labeled like Consul endpoints, so that routes select their subsets in the same way
+const (
+	NomadEndpointMetadataMatchTrue  = ConsulEndpointMetadataMatchTrue
+	NomadEndpointMetadataMatchFalse = ConsulEndpointMetadataMatchFalse
+
+	NomadTagKeyPrefix        = ConsulTagKeyPrefix
+	NomadDataCenterKeyPrefix = ConsulDataCenterKeyPrefix
+)
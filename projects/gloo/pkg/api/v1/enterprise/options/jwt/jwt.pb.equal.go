@@ -470,6 +470,20 @@ func (m *LocalJwks) Equal(that interface{}) bool {
 		return false
 	}
 
+	if h, ok := interface{}(m.GetSecretRef()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetSecretRef()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetSecretRef(), target.GetSecretRef()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetSecretKey(), target.GetSecretKey()) != 0 {
+		return false
+	}
+
 	return true
 }
 
@@ -12,6 +12,12 @@ import (
 
 	"github.com/solo-io/protoc-gen-ext/pkg/clone"
 	"google.golang.org/protobuf/proto"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_type_matcher_v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 )
 
 // ensure the imports are used
@@ -93,6 +99,8 @@ func (m *Policy) Clone() proto.Message {
 
 	target.NestedClaimDelimiter = m.GetNestedClaimDelimiter()
 
+	target.Shadow = m.GetShadow()
+
 	return target
 }
 
@@ -110,6 +118,24 @@ func (m *Principal) Clone() proto.Message {
 		target.JwtPrincipal = proto.Clone(m.GetJwtPrincipal()).(*JWTPrincipal)
 	}
 
+	if h, ok := interface{}(m.GetSourceIpPrincipal()).(clone.Cloner); ok {
+		target.SourceIpPrincipal = h.Clone().(*SourceIPPrincipal)
+	} else {
+		target.SourceIpPrincipal = proto.Clone(m.GetSourceIpPrincipal()).(*SourceIPPrincipal)
+	}
+
+	if h, ok := interface{}(m.GetHeaderPrincipal()).(clone.Cloner); ok {
+		target.HeaderPrincipal = h.Clone().(*HeaderPrincipal)
+	} else {
+		target.HeaderPrincipal = proto.Clone(m.GetHeaderPrincipal()).(*HeaderPrincipal)
+	}
+
+	if h, ok := interface{}(m.GetMtlsPrincipal()).(clone.Cloner); ok {
+		target.MtlsPrincipal = h.Clone().(*MTLSPrincipal)
+	} else {
+		target.MtlsPrincipal = proto.Clone(m.GetMtlsPrincipal()).(*MTLSPrincipal)
+	}
+
 	return target
 }
 
@@ -137,6 +163,80 @@ func (m *JWTPrincipal) Clone() proto.Message {
 	return target
 }
 
+// Clone function
+func (m *SourceIPPrincipal) Clone() proto.Message {
+	var target *SourceIPPrincipal
+	if m == nil {
+		return target
+	}
+	target = &SourceIPPrincipal{}
+
+	if m.GetCidrRanges() != nil {
+		target.CidrRanges = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3.CidrRange, len(m.GetCidrRanges()))
+		for idx, v := range m.GetCidrRanges() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.CidrRanges[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3.CidrRange)
+			} else {
+				target.CidrRanges[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3.CidrRange)
+			}
+
+		}
+	}
+
+	target.DirectRemoteAddress = m.GetDirectRemoteAddress()
+
+	return target
+}
+
+// Clone function
+func (m *HeaderPrincipal) Clone() proto.Message {
+	var target *HeaderPrincipal
+	if m == nil {
+		return target
+	}
+	target = &HeaderPrincipal{}
+
+	if m.GetHeaders() != nil {
+		target.Headers = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher, len(m.GetHeaders()))
+		for idx, v := range m.GetHeaders() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Headers[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			} else {
+				target.Headers[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			}
+
+		}
+	}
+
+	return target
+}
+
+// Clone function
+func (m *MTLSPrincipal) Clone() proto.Message {
+	var target *MTLSPrincipal
+	if m == nil {
+		return target
+	}
+	target = &MTLSPrincipal{}
+
+	if m.GetSans() != nil {
+		target.Sans = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_type_matcher_v3.StringMatcher, len(m.GetSans()))
+		for idx, v := range m.GetSans() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Sans[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_type_matcher_v3.StringMatcher)
+			} else {
+				target.Sans[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_type_matcher_v3.StringMatcher)
+			}
+
+		}
+	}
+
+	return target
+}
+
 // Clone function
 func (m *Permissions) Clone() proto.Message {
 	var target *Permissions
@@ -156,5 +256,18 @@ func (m *Permissions) Clone() proto.Message {
 		}
 	}
 
+	if m.GetHeaders() != nil {
+		target.Headers = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher, len(m.GetHeaders()))
+		for idx, v := range m.GetHeaders() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Headers[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			} else {
+				target.Headers[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			}
+
+		}
+	}
+
 	return target
 }
@@ -150,6 +150,10 @@ func (m *Policy) Equal(that interface{}) bool {
 		return false
 	}
 
+	if m.GetShadow() != target.GetShadow() {
+		return false
+	}
+
 	return true
 }
 
@@ -184,6 +188,36 @@ func (m *Principal) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetSourceIpPrincipal()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetSourceIpPrincipal()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetSourceIpPrincipal(), target.GetSourceIpPrincipal()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetHeaderPrincipal()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetHeaderPrincipal()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetHeaderPrincipal(), target.GetHeaderPrincipal()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetMtlsPrincipal()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMtlsPrincipal()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMtlsPrincipal(), target.GetMtlsPrincipal()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -230,6 +264,133 @@ func (m *JWTPrincipal) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *SourceIPPrincipal) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*SourceIPPrincipal)
+	if !ok {
+		that2, ok := that.(SourceIPPrincipal)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetCidrRanges()) != len(target.GetCidrRanges()) {
+		return false
+	}
+	for idx, v := range m.GetCidrRanges() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetCidrRanges()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetCidrRanges()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if m.GetDirectRemoteAddress() != target.GetDirectRemoteAddress() {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *HeaderPrincipal) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*HeaderPrincipal)
+	if !ok {
+		that2, ok := that.(HeaderPrincipal)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetHeaders()) != len(target.GetHeaders()) {
+		return false
+	}
+	for idx, v := range m.GetHeaders() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetHeaders()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetHeaders()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *MTLSPrincipal) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*MTLSPrincipal)
+	if !ok {
+		that2, ok := that.(MTLSPrincipal)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetSans()) != len(target.GetSans()) {
+		return false
+	}
+	for idx, v := range m.GetSans() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetSans()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetSans()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
+
 // Equal function
 func (m *Permissions) Equal(that interface{}) bool {
 	if that == nil {
@@ -266,5 +427,22 @@ func (m *Permissions) Equal(that interface{}) bool {
 
 	}
 
+	if len(m.GetHeaders()) != len(target.GetHeaders()) {
+		return false
+	}
+	for idx, v := range m.GetHeaders() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetHeaders()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetHeaders()[idx]) {
+				return false
+			}
+		}
+
+	}
+
 	return true
 }
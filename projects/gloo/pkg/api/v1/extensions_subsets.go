@@ -31,6 +31,14 @@ func (us *Upstream_Kube) SetSubsetSpec(spec *plugins.SubsetSpec) {
 	us.Kube.SubsetSpec = spec
 }
 
+func (us *Upstream_Eureka) GetSubsetSpec() *plugins.SubsetSpec {
+	return us.Eureka.GetSubsetSpec()
+}
+
+func (us *Upstream_Eureka) SetSubsetSpec(spec *plugins.SubsetSpec) {
+	us.Eureka.SubsetSpec = spec
+}
+
 func (us *Upstream_Consul) GetSubsetSpec() *plugins.SubsetSpec {
 	subsets := &plugins.SubsetSpec{}
 
@@ -77,3 +85,57 @@ func (us *Upstream_Consul) GetSubsetSpec() *plugins.SubsetSpec {
 
 	return subsets
 }
+
+func (us *Upstream_Nomad) GetSubsetSpec() *plugins.SubsetSpec {
+	subsets := &plugins.SubsetSpec{}
+
+	// Add a subset selector for data centers, if the upstream is restricted to some
+	// This will cause Envoy to partition the endpoints by their data center
+	var dataCenterMetadataKeys []string
+	for _, dc := range us.Nomad.GetDataCenters() {
+		dataCenterMetadataKeys = append(dataCenterMetadataKeys, constants.NomadDataCenterKeyPrefix+dc)
+	}
+	sort.Strings(dataCenterMetadataKeys)
+
+	if len(dataCenterMetadataKeys) > 0 {
+		subsets.Selectors = append(subsets.GetSelectors(), &plugins.Selector{
+			Keys: dataCenterMetadataKeys,
+		})
+	}
+
+	tags := us.Nomad.GetSubsetTags()
+	if len(tags) == 0 {
+		tags = us.Nomad.GetServiceTags()
+	}
+
+	if len(tags) > 0 {
+
+		// If any tags are present, create a subset selector with the tags as key set
+		// This will cause Envoy to partition the endpoints (registrations) by their tags
+		var tagMetadataKeys []string
+		for _, tag := range tags {
+			tagMetadataKeys = append(tagMetadataKeys, constants.NomadTagKeyPrefix+tag)
+		}
+		sort.Strings(tagMetadataKeys)
+
+		subsets.Selectors = append(subsets.GetSelectors(), &plugins.Selector{
+			Keys: tagMetadataKeys,
+		})
+
+		// Also create a subset selector with both the data center and the tag keys
+		// This will cause Envoy to partition the endpoints by data center and by their tags
+		if len(dataCenterMetadataKeys) > 0 {
+			var allKeys []string
+			allKeys = append(allKeys, dataCenterMetadataKeys...)
+			allKeys = append(allKeys, tagMetadataKeys...)
+			subsets.Selectors = append(subsets.GetSelectors(), &plugins.Selector{
+				Keys: allKeys,
+			})
+		}
+	}
+
+	if len(subsets.GetSelectors()) == 0 {
+		return nil
+	}
+	return subsets
+}
@@ -0,0 +1,89 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/eureka/eureka.proto
+
+package eureka
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *UpstreamSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*UpstreamSpec)
+	if !ok {
+		that2, ok := that.(UpstreamSpec)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetServiceUrls()) != len(target.GetServiceUrls()) {
+		return false
+	}
+	for idx, v := range m.GetServiceUrls() {
+
+		if strings.Compare(v, target.GetServiceUrls()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if strings.Compare(m.GetAppName(), target.GetAppName()) != 0 {
+		return false
+	}
+
+	if m.GetUseSecurePort() != target.GetUseSecurePort() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetSubsetSpec()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetSubsetSpec()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetSubsetSpec(), target.GetSubsetSpec()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetRefreshInterval()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRefreshInterval()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRefreshInterval(), target.GetRefreshInterval()) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,102 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/nomad/nomad.proto
+
+package nomad
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *UpstreamSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*UpstreamSpec)
+	if !ok {
+		that2, ok := that.(UpstreamSpec)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetServiceName(), target.GetServiceName()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetNamespace(), target.GetNamespace()) != 0 {
+		return false
+	}
+
+	if len(m.GetDataCenters()) != len(target.GetDataCenters()) {
+		return false
+	}
+	for idx, v := range m.GetDataCenters() {
+
+		if strings.Compare(v, target.GetDataCenters()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetServiceTags()) != len(target.GetServiceTags()) {
+		return false
+	}
+	for idx, v := range m.GetServiceTags() {
+
+		if strings.Compare(v, target.GetServiceTags()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetSubsetTags()) != len(target.GetSubsetTags()) {
+		return false
+	}
+	for idx, v := range m.GetSubsetTags() {
+
+		if strings.Compare(v, target.GetSubsetTags()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetInstanceTags()) != len(target.GetInstanceTags()) {
+		return false
+	}
+	for idx, v := range m.GetInstanceTags() {
+
+		if strings.Compare(v, target.GetInstanceTags()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	return true
+}
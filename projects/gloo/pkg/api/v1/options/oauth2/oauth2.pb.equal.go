@@ -0,0 +1,228 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/oauth2/oauth2.proto
+
+package oauth2
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *OAuth2) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*OAuth2)
+	if !ok {
+		that2, ok := that.(OAuth2)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetClientId(), target.GetClientId()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetClientSecretRef()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetClientSecretRef()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetClientSecretRef(), target.GetClientSecretRef()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetHmacSecretRef()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetHmacSecretRef()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetHmacSecretRef(), target.GetHmacSecretRef()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetTokenEndpoint()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTokenEndpoint()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTokenEndpoint(), target.GetTokenEndpoint()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetAuthorizationEndpoint()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetAuthorizationEndpoint()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetAuthorizationEndpoint(), target.GetAuthorizationEndpoint()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetRedirectPath(), target.GetRedirectPath()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetRedirectUri(), target.GetRedirectUri()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetSignoutPath(), target.GetSignoutPath()) != 0 {
+		return false
+	}
+
+	if len(m.GetAuthScopes()) != len(target.GetAuthScopes()) {
+		return false
+	}
+	for idx, v := range m.GetAuthScopes() {
+
+		if strings.Compare(v, target.GetAuthScopes()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetPassThroughMatcher()) != len(target.GetPassThroughMatcher()) {
+		return false
+	}
+	for idx, v := range m.GetPassThroughMatcher() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetPassThroughMatcher()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetPassThroughMatcher()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if m.GetForwardBearerToken() != target.GetForwardBearerToken() {
+		return false
+	}
+
+	if strings.Compare(m.GetCookieDomain(), target.GetCookieDomain()) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *TokenEndpoint) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TokenEndpoint)
+	if !ok {
+		that2, ok := that.(TokenEndpoint)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetUri(), target.GetUri()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetUpstream()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetUpstream()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetUpstream(), target.GetUpstream()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetTimeout()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTimeout()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTimeout(), target.GetTimeout()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *AuthorizationEndpoint) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*AuthorizationEndpoint)
+	if !ok {
+		that2, ok := that.(AuthorizationEndpoint)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetUri(), target.GetUri()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetUpstream()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetUpstream()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetUpstream(), target.GetUpstream()) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -14,6 +14,8 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	github_com_solo_io_solo_kit_pkg_api_v1_resources_core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+
+	google_golang_org_protobuf_types_known_wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // ensure the imports are used
@@ -43,5 +45,47 @@ func (m *RouteShadowing) Clone() proto.Message {
 
 	target.Percentage = m.GetPercentage()
 
+	if m.GetTargets() != nil {
+		target.Targets = make([]*RouteShadowing_Target, len(m.GetTargets()))
+		for idx, v := range m.GetTargets() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Targets[idx] = h.Clone().(*RouteShadowing_Target)
+			} else {
+				target.Targets[idx] = proto.Clone(v).(*RouteShadowing_Target)
+			}
+
+		}
+	}
+
+	return target
+}
+
+// Clone function
+func (m *RouteShadowing_Target) Clone() proto.Message {
+	var target *RouteShadowing_Target
+	if m == nil {
+		return target
+	}
+	target = &RouteShadowing_Target{}
+
+	if h, ok := interface{}(m.GetUpstream()).(clone.Cloner); ok {
+		target.Upstream = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	} else {
+		target.Upstream = proto.Clone(m.GetUpstream()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	}
+
+	target.Percentage = m.GetPercentage()
+
+	target.RuntimeKey = m.GetRuntimeKey()
+
+	if h, ok := interface{}(m.GetTraceSampled()).(clone.Cloner); ok {
+		target.TraceSampled = h.Clone().(*google_golang_org_protobuf_types_known_wrapperspb.BoolValue)
+	} else {
+		target.TraceSampled = proto.Clone(m.GetTraceSampled()).(*google_golang_org_protobuf_types_known_wrapperspb.BoolValue)
+	}
+
+	target.DisableHostSuffixAppend = m.GetDisableHostSuffixAppend()
+
 	return target
 }
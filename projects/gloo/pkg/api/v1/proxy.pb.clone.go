@@ -931,6 +931,12 @@ func (m *UpstreamGroup) Clone() proto.Message {
 		}
 	}
 
+	if h, ok := interface{}(m.GetFailover()).(clone.Cloner); ok {
+		target.Failover = h.Clone().(*UpstreamGroup_Failover)
+	} else {
+		target.Failover = proto.Clone(m.GetFailover()).(*UpstreamGroup_Failover)
+	}
+
 	if h, ok := interface{}(m.GetNamespacedStatuses()).(clone.Cloner); ok {
 		target.NamespacedStatuses = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.NamespacedStatuses)
 	} else {
@@ -1270,6 +1276,25 @@ func (m *AggregateListener_HttpFilterChain) Clone() proto.Message {
 	return target
 }
 
+// Clone function
+func (m *UpstreamGroup_Failover) Clone() proto.Message {
+	var target *UpstreamGroup_Failover
+	if m == nil {
+		return target
+	}
+	target = &UpstreamGroup_Failover{}
+
+	target.Mode = m.GetMode()
+
+	if h, ok := interface{}(m.GetOverprovisioningFactor()).(clone.Cloner); ok {
+		target.OverprovisioningFactor = h.Clone().(*google_golang_org_protobuf_types_known_wrapperspb.UInt32Value)
+	} else {
+		target.OverprovisioningFactor = proto.Clone(m.GetOverprovisioningFactor()).(*google_golang_org_protobuf_types_known_wrapperspb.UInt32Value)
+	}
+
+	return target
+}
+
 // Clone function
 func (m *SourceMetadata_SourceRef) Clone() proto.Message {
 	var target *SourceMetadata_SourceRef
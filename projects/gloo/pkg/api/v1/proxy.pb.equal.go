@@ -1416,6 +1416,16 @@ func (m *UpstreamGroup) Equal(that interface{}) bool {
 
 	}
 
+	if h, ok := interface{}(m.GetFailover()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetFailover()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetFailover(), target.GetFailover()) {
+			return false
+		}
+	}
+
 	if h, ok := interface{}(m.GetNamespacedStatuses()).(equality.Equalizer); ok {
 		if !h.Equal(target.GetNamespacedStatuses()) {
 			return false
@@ -1981,6 +1991,44 @@ func (m *AggregateListener_HttpFilterChain) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *UpstreamGroup_Failover) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*UpstreamGroup_Failover)
+	if !ok {
+		that2, ok := that.(UpstreamGroup_Failover)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetMode() != target.GetMode() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetOverprovisioningFactor()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetOverprovisioningFactor()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetOverprovisioningFactor(), target.GetOverprovisioningFactor()) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equal function
 func (m *SourceMetadata_SourceRef) Equal(that interface{}) bool {
 	if that == nil {
@@ -33,6 +33,56 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type UpstreamGroup_Failover_Mode int32
+
+const (
+	// Traffic is split between the destinations according to their weights. When the health of a destination
+	// drops, its share of the traffic fails over to the other destinations, in the order they are listed.
+	UpstreamGroup_Failover_WEIGHTED UpstreamGroup_Failover_Mode = 0
+	// All the traffic goes to the first destination. When its health drops, traffic fails over to the next
+	// destinations, in the order they are listed. The weights of the destinations are ignored.
+	UpstreamGroup_Failover_PRIORITY UpstreamGroup_Failover_Mode = 1
+)
+
+// Enum value maps for UpstreamGroup_Failover_Mode.
+var (
+	UpstreamGroup_Failover_Mode_name = map[int32]string{
+		0: "WEIGHTED",
+		1: "PRIORITY",
+	}
+	UpstreamGroup_Failover_Mode_value = map[string]int32{
+		"WEIGHTED": 0,
+		"PRIORITY": 1,
+	}
+)
+
+func (x UpstreamGroup_Failover_Mode) Enum() *UpstreamGroup_Failover_Mode {
+	p := new(UpstreamGroup_Failover_Mode)
+	*p = x
+	return p
+}
+
+func (x UpstreamGroup_Failover_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpstreamGroup_Failover_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_enumTypes[0].Descriptor()
+}
+
+func (UpstreamGroup_Failover_Mode) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_enumTypes[0]
+}
+
+func (x UpstreamGroup_Failover_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpstreamGroup_Failover_Mode.Descriptor instead.
+func (UpstreamGroup_Failover_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{16, 0, 0}
+}
+
 type RedirectAction_RedirectResponseCode int32
 
 const (
@@ -77,11 +127,11 @@ func (x RedirectAction_RedirectResponseCode) String() string {
 }
 
 func (RedirectAction_RedirectResponseCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_enumTypes[0].Descriptor()
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_enumTypes[1].Descriptor()
 }
 
 func (RedirectAction_RedirectResponseCode) Type() protoreflect.EnumType {
-	return &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_enumTypes[0]
+	return &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_enumTypes[1]
 }
 
 func (x RedirectAction_RedirectResponseCode) Number() protoreflect.EnumNumber {
@@ -1728,6 +1778,10 @@ type UpstreamGroup struct {
 
 	// The destinations that are part of this upstream group.
 	Destinations []*WeightedDestination `protobuf:"bytes,1,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	// When set, the upstream group is translated to Envoy aggregate clusters, with the destinations as ordered
+	// priorities, instead of weighted clusters. Envoy then fails over traffic between the destinations when the
+	// health of their hosts drops. The destinations must not select subsets of their upstreams.
+	Failover *UpstreamGroup_Failover `protobuf:"bytes,9,opt,name=failover,proto3" json:"failover,omitempty"`
 	// NamespacedStatuses indicates the validation status of this resource.
 	// NamespacedStatuses is read-only by clients, and set by gloo during validation
 	NamespacedStatuses *core.NamespacedStatuses `protobuf:"bytes,8,opt,name=namespaced_statuses,json=namespacedStatuses,proto3" json:"namespaced_statuses,omitempty"`
@@ -1772,6 +1826,13 @@ func (x *UpstreamGroup) GetDestinations() []*WeightedDestination {
 	return nil
 }
 
+func (x *UpstreamGroup) GetFailover() *UpstreamGroup_Failover {
+	if x != nil {
+		return x.Failover
+	}
+	return nil
+}
+
 func (x *UpstreamGroup) GetNamespacedStatuses() *core.NamespacedStatuses {
 	if x != nil {
 		return x.NamespacedStatuses
@@ -2509,6 +2570,65 @@ func (x *AggregateListener_HttpFilterChain) GetCustomNetworkFilters() []*CustomE
 	return nil
 }
 
+// Failover configures the automatic failover of traffic between the destinations of the upstream group.
+type UpstreamGroup_Failover struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// How traffic is distributed between the destinations. Defaults to `WEIGHTED`.
+	Mode UpstreamGroup_Failover_Mode `protobuf:"varint,1,opt,name=mode,proto3,enum=gloo.solo.io.UpstreamGroup_Failover_Mode" json:"mode,omitempty"`
+	// The overprovisioning factor of the upstreams of the destinations, in percent. Envoy starts failing over traffic
+	// from a destination when the ratio of its healthy hosts, multiplied by this factor, drops below 100%.
+	// Defaults to the Envoy default, 140, which fails over traffic once less than ~71% of the hosts are healthy.
+	// As the factor applies to the upstreams, the upstream groups that share an upstream must agree on it.
+	OverprovisioningFactor *wrapperspb.UInt32Value `protobuf:"bytes,2,opt,name=overprovisioning_factor,json=overprovisioningFactor,proto3" json:"overprovisioning_factor,omitempty"`
+}
+
+func (x *UpstreamGroup_Failover) Reset() {
+	*x = UpstreamGroup_Failover{}
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpstreamGroup_Failover) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpstreamGroup_Failover) ProtoMessage() {}
+
+func (x *UpstreamGroup_Failover) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpstreamGroup_Failover.ProtoReflect.Descriptor instead.
+func (*UpstreamGroup_Failover) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *UpstreamGroup_Failover) GetMode() UpstreamGroup_Failover_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return UpstreamGroup_Failover_WEIGHTED
+}
+
+func (x *UpstreamGroup_Failover) GetOverprovisioningFactor() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.OverprovisioningFactor
+	}
+	return nil
+}
+
 type SourceMetadata_SourceRef struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2524,7 +2644,7 @@ type SourceMetadata_SourceRef struct {
 
 func (x *SourceMetadata_SourceRef) Reset() {
 	*x = SourceMetadata_SourceRef{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[28]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2536,7 +2656,7 @@ func (x *SourceMetadata_SourceRef) String() string {
 func (*SourceMetadata_SourceRef) ProtoMessage() {}
 
 func (x *SourceMetadata_SourceRef) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[28]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3039,114 +3159,131 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDesc = []by
 	0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18,
 	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64,
 	0x61, 0x74, 0x61, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x22, 0x84,
-	0x02, 0x0a, 0x0d, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x09, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x22, 0x8d,
+	0x04, 0x0a, 0x0d, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70,
 	0x12, 0x45, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
 	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f,
 	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x44, 0x65,
 	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x57, 0x0a, 0x13, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
-	0x2e, 0x69, 0x6f, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x42, 0x04, 0xb8, 0xf5, 0x04, 0x01, 0x52, 0x12, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73,
-	0x12, 0x32, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
-	0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x3a, 0x19, 0x82, 0xf1, 0x04, 0x15, 0x0a, 0x02, 0x75, 0x67, 0x12, 0x0f,
-	0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x4a,
-	0x04, 0x08, 0x06, 0x10, 0x07, 0x22, 0x59, 0x0a, 0x10, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x44, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x0c, 0x64, 0x65, 0x73,
-	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x21, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x57,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0xcc, 0x01, 0x0a, 0x13, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x44, 0x65, 0x73,
-	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x65, 0x73,
-	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x42, 0x0a, 0x07, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67,
-	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x57, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x65, 0x64, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0xda, 0x04, 0x0a, 0x0e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x52,
-	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x0d, 0x70, 0x61, 0x74, 0x68, 0x5f,
-	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x0c, 0x70, 0x61, 0x74, 0x68, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x27,
-	0x0a, 0x0e, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
-	0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x5d, 0x0a, 0x0d, 0x72, 0x65, 0x67, 0x65, 0x78,
-	0x5f, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36,
-	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x33, 0x2e, 0x52,
-	0x65, 0x67, 0x65, 0x78, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x53, 0x75, 0x62, 0x73,
-	0x74, 0x69, 0x74, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x65, 0x78, 0x52,
-	0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e,
-	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x65, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65,
-	0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x25,
-	0x0a, 0x0e, 0x68, 0x74, 0x74, 0x70, 0x73, 0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x68, 0x74, 0x74, 0x70, 0x73, 0x52, 0x65, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x69,
-	0x70, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x41, 0x0a, 0x0d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72,
-	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x70, 0x6f, 0x72,
-	0x74, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x22, 0x77, 0x0a, 0x14, 0x52, 0x65, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x41,
-	0x4e, 0x45, 0x4e, 0x54, 0x4c, 0x59, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x4f, 0x55, 0x4e,
-	0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x45, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52,
-	0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x45, 0x4d, 0x50, 0x4f, 0x52, 0x41, 0x52, 0x59, 0x5f,
-	0x52, 0x45, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x45,
-	0x52, 0x4d, 0x41, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54,
-	0x10, 0x04, 0x42, 0x18, 0x0a, 0x16, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x77, 0x72, 0x69,
-	0x74, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x42, 0x0a, 0x14,
-	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79,
-	0x22, 0xf4, 0x01, 0x0a, 0x0e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
-	0x2e, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x52, 0x07, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x1a, 0x9f, 0x01, 0x0a, 0x09, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x52, 0x65, 0x66, 0x12, 0x3c, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x52, 0x65, 0x66, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
-	0x66, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6b, 0x69,
-	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9b, 0x01, 0x0a, 0x11, 0x43, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x45, 0x6e, 0x76, 0x6f, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x44, 0x0a,
-	0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x6c,
-	0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x06, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x3e, 0xb8, 0xf5, 0x04, 0x01, 0xc0, 0xf5, 0x04, 0x01, 0xd0,
-	0xf5, 0x04, 0x01, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x40, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x6f,
+	0x76, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
+	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x52,
+	0x08, 0x66, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x57, 0x0a, 0x13, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x42, 0x04, 0xb8, 0xf5, 0x04, 0x01, 0x52, 0x12,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x65, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x69, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0xc4, 0x01, 0x0a, 0x08, 0x46, 0x61, 0x69, 0x6c, 0x6f,
+	0x76, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x29, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
+	0x2e, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x46,
+	0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x12, 0x55, 0x0a, 0x17, 0x6f, 0x76, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x16, 0x6f, 0x76, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x69, 0x6e, 0x67, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x22, 0x0a, 0x04, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x0c, 0x0a, 0x08, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x10, 0x01, 0x3a, 0x19, 0x82,
+	0xf1, 0x04, 0x15, 0x0a, 0x02, 0x75, 0x67, 0x12, 0x0f, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x22, 0x59,
+	0x0a, 0x10, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64,
+	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xcc, 0x01, 0x0a, 0x13, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34,
+	0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x42, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x44, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xda, 0x04, 0x0a, 0x0e, 0x52, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x68,
+	0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x12, 0x25, 0x0a, 0x0d, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x70, 0x61, 0x74, 0x68, 0x52,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x27, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x5f, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x12, 0x5d, 0x0a, 0x0d, 0x72, 0x65, 0x67, 0x65, 0x78, 0x5f, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
+	0x6f, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x78, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x41, 0x6e, 0x64, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x65, 0x48,
+	0x00, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x65, 0x78, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12,
+	0x56, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x74, 0x74, 0x70, 0x73,
+	0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0d, 0x68, 0x74, 0x74, 0x70, 0x73, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x69, 0x70, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x41, 0x0a, 0x0d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x22, 0x77, 0x0a, 0x14, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x4f,
+	0x56, 0x45, 0x44, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x41, 0x4e, 0x45, 0x4e, 0x54, 0x4c, 0x59, 0x10,
+	0x00, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
+	0x53, 0x45, 0x45, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x54,
+	0x45, 0x4d, 0x50, 0x4f, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x44, 0x49, 0x52, 0x45, 0x43,
+	0x54, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x45, 0x52, 0x4d, 0x41, 0x4e, 0x45, 0x4e, 0x54,
+	0x5f, 0x52, 0x45, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x10, 0x04, 0x42, 0x18, 0x0a, 0x16, 0x70,
+	0x61, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x42, 0x0a, 0x14, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0xf4, 0x01, 0x0a, 0x0e, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x07,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x66, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x1a, 0x9f,
+	0x01, 0x0a, 0x09, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x12, 0x3c, 0x0a, 0x0c,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
+	0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x52, 0x0b, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12,
+	0x2f, 0x0a, 0x13, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x9b, 0x01, 0x0a, 0x11, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x45, 0x6e, 0x76, 0x6f, 0x79,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x69, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52,
+	0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x2c, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x3e,
+	0xb8, 0xf5, 0x04, 0x01, 0xc0, 0xf5, 0x04, 0x01, 0xd0, 0xf5, 0x04, 0x01, 0x5a, 0x30, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f,
+	0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67,
+	0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3161,152 +3298,157 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP()
 	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescData
 }
 
-var file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_goTypes = []any{
-	(RedirectAction_RedirectResponseCode)(0),  // 0: gloo.solo.io.RedirectAction.RedirectResponseCode
-	(*Proxy)(nil),                             // 1: gloo.solo.io.Proxy
-	(*Listener)(nil),                          // 2: gloo.solo.io.Listener
-	(*TcpListener)(nil),                       // 3: gloo.solo.io.TcpListener
-	(*TcpHost)(nil),                           // 4: gloo.solo.io.TcpHost
-	(*HttpListener)(nil),                      // 5: gloo.solo.io.HttpListener
-	(*HybridListener)(nil),                    // 6: gloo.solo.io.HybridListener
-	(*MatchedListener)(nil),                   // 7: gloo.solo.io.MatchedListener
-	(*MatchedTcpListener)(nil),                // 8: gloo.solo.io.MatchedTcpListener
-	(*Matcher)(nil),                           // 9: gloo.solo.io.Matcher
-	(*AggregateListener)(nil),                 // 10: gloo.solo.io.AggregateListener
-	(*VirtualHost)(nil),                       // 11: gloo.solo.io.VirtualHost
-	(*Route)(nil),                             // 12: gloo.solo.io.Route
-	(*RouteAction)(nil),                       // 13: gloo.solo.io.RouteAction
-	(*Destination)(nil),                       // 14: gloo.solo.io.Destination
-	(*KubernetesServiceDestination)(nil),      // 15: gloo.solo.io.KubernetesServiceDestination
-	(*ConsulServiceDestination)(nil),          // 16: gloo.solo.io.ConsulServiceDestination
-	(*UpstreamGroup)(nil),                     // 17: gloo.solo.io.UpstreamGroup
-	(*MultiDestination)(nil),                  // 18: gloo.solo.io.MultiDestination
-	(*WeightedDestination)(nil),               // 19: gloo.solo.io.WeightedDestination
-	(*RedirectAction)(nil),                    // 20: gloo.solo.io.RedirectAction
-	(*DirectResponseAction)(nil),              // 21: gloo.solo.io.DirectResponseAction
-	(*SourceMetadata)(nil),                    // 22: gloo.solo.io.SourceMetadata
-	(*CustomEnvoyFilter)(nil),                 // 23: gloo.solo.io.CustomEnvoyFilter
-	(*TcpHost_TcpAction)(nil),                 // 24: gloo.solo.io.TcpHost.TcpAction
-	(*AggregateListener_HttpResources)(nil),   // 25: gloo.solo.io.AggregateListener.HttpResources
-	(*AggregateListener_HttpFilterChain)(nil), // 26: gloo.solo.io.AggregateListener.HttpFilterChain
-	nil,                                          // 27: gloo.solo.io.AggregateListener.HttpResources.VirtualHostsEntry
-	nil,                                          // 28: gloo.solo.io.AggregateListener.HttpResources.HttpOptionsEntry
-	(*SourceMetadata_SourceRef)(nil),             // 29: gloo.solo.io.SourceMetadata.SourceRef
-	(*core.NamespacedStatuses)(nil),              // 30: core.solo.io.NamespacedStatuses
-	(*core.Metadata)(nil),                        // 31: core.solo.io.Metadata
-	(*ssl.SslConfig)(nil),                        // 32: gloo.solo.io.SslConfig
-	(*wrapperspb.BoolValue)(nil),                 // 33: google.protobuf.BoolValue
-	(*ListenerOptions)(nil),                      // 34: gloo.solo.io.ListenerOptions
-	(*structpb.Struct)(nil),                      // 35: google.protobuf.Struct
-	(*RouteConfigurationOptions)(nil),            // 36: gloo.solo.io.RouteConfigurationOptions
-	(*TcpListenerOptions)(nil),                   // 37: gloo.solo.io.TcpListenerOptions
-	(*HttpListenerOptions)(nil),                  // 38: gloo.solo.io.HttpListenerOptions
-	(*v3.CidrRange)(nil),                         // 39: solo.io.envoy.config.core.v3.CidrRange
-	(*wrapperspb.UInt32Value)(nil),               // 40: google.protobuf.UInt32Value
-	(*VirtualHostOptions)(nil),                   // 41: gloo.solo.io.VirtualHostOptions
-	(*matchers.Matcher)(nil),                     // 42: matchers.core.gloo.solo.io.Matcher
-	(*core.ResourceRef)(nil),                     // 43: core.solo.io.ResourceRef
-	(*RouteOptions)(nil),                         // 44: gloo.solo.io.RouteOptions
-	(*dynamic_forward_proxy.PerRouteConfig)(nil), // 45: dfp.options.gloo.solo.io.PerRouteConfig
-	(*DestinationSpec)(nil),                      // 46: gloo.solo.io.DestinationSpec
-	(*Subset)(nil),                               // 47: gloo.solo.io.Subset
-	(*WeightedDestinationOptions)(nil),           // 48: gloo.solo.io.WeightedDestinationOptions
-	(*v31.RegexMatchAndSubstitute)(nil),          // 49: solo.io.envoy.type.matcher.v3.RegexMatchAndSubstitute
-	(*filters.FilterStage)(nil),                  // 50: filters.gloo.solo.io.FilterStage
-	(*anypb.Any)(nil),                            // 51: google.protobuf.Any
-	(*emptypb.Empty)(nil),                        // 52: google.protobuf.Empty
+	(UpstreamGroup_Failover_Mode)(0),          // 0: gloo.solo.io.UpstreamGroup.Failover.Mode
+	(RedirectAction_RedirectResponseCode)(0),  // 1: gloo.solo.io.RedirectAction.RedirectResponseCode
+	(*Proxy)(nil),                             // 2: gloo.solo.io.Proxy
+	(*Listener)(nil),                          // 3: gloo.solo.io.Listener
+	(*TcpListener)(nil),                       // 4: gloo.solo.io.TcpListener
+	(*TcpHost)(nil),                           // 5: gloo.solo.io.TcpHost
+	(*HttpListener)(nil),                      // 6: gloo.solo.io.HttpListener
+	(*HybridListener)(nil),                    // 7: gloo.solo.io.HybridListener
+	(*MatchedListener)(nil),                   // 8: gloo.solo.io.MatchedListener
+	(*MatchedTcpListener)(nil),                // 9: gloo.solo.io.MatchedTcpListener
+	(*Matcher)(nil),                           // 10: gloo.solo.io.Matcher
+	(*AggregateListener)(nil),                 // 11: gloo.solo.io.AggregateListener
+	(*VirtualHost)(nil),                       // 12: gloo.solo.io.VirtualHost
+	(*Route)(nil),                             // 13: gloo.solo.io.Route
+	(*RouteAction)(nil),                       // 14: gloo.solo.io.RouteAction
+	(*Destination)(nil),                       // 15: gloo.solo.io.Destination
+	(*KubernetesServiceDestination)(nil),      // 16: gloo.solo.io.KubernetesServiceDestination
+	(*ConsulServiceDestination)(nil),          // 17: gloo.solo.io.ConsulServiceDestination
+	(*UpstreamGroup)(nil),                     // 18: gloo.solo.io.UpstreamGroup
+	(*MultiDestination)(nil),                  // 19: gloo.solo.io.MultiDestination
+	(*WeightedDestination)(nil),               // 20: gloo.solo.io.WeightedDestination
+	(*RedirectAction)(nil),                    // 21: gloo.solo.io.RedirectAction
+	(*DirectResponseAction)(nil),              // 22: gloo.solo.io.DirectResponseAction
+	(*SourceMetadata)(nil),                    // 23: gloo.solo.io.SourceMetadata
+	(*CustomEnvoyFilter)(nil),                 // 24: gloo.solo.io.CustomEnvoyFilter
+	(*TcpHost_TcpAction)(nil),                 // 25: gloo.solo.io.TcpHost.TcpAction
+	(*AggregateListener_HttpResources)(nil),   // 26: gloo.solo.io.AggregateListener.HttpResources
+	(*AggregateListener_HttpFilterChain)(nil), // 27: gloo.solo.io.AggregateListener.HttpFilterChain
+	nil,                                          // 28: gloo.solo.io.AggregateListener.HttpResources.VirtualHostsEntry
+	nil,                                          // 29: gloo.solo.io.AggregateListener.HttpResources.HttpOptionsEntry
+	(*UpstreamGroup_Failover)(nil),               // 30: gloo.solo.io.UpstreamGroup.Failover
+	(*SourceMetadata_SourceRef)(nil),             // 31: gloo.solo.io.SourceMetadata.SourceRef
+	(*core.NamespacedStatuses)(nil),              // 32: core.solo.io.NamespacedStatuses
+	(*core.Metadata)(nil),                        // 33: core.solo.io.Metadata
+	(*ssl.SslConfig)(nil),                        // 34: gloo.solo.io.SslConfig
+	(*wrapperspb.BoolValue)(nil),                 // 35: google.protobuf.BoolValue
+	(*ListenerOptions)(nil),                      // 36: gloo.solo.io.ListenerOptions
+	(*structpb.Struct)(nil),                      // 37: google.protobuf.Struct
+	(*RouteConfigurationOptions)(nil),            // 38: gloo.solo.io.RouteConfigurationOptions
+	(*TcpListenerOptions)(nil),                   // 39: gloo.solo.io.TcpListenerOptions
+	(*HttpListenerOptions)(nil),                  // 40: gloo.solo.io.HttpListenerOptions
+	(*v3.CidrRange)(nil),                         // 41: solo.io.envoy.config.core.v3.CidrRange
+	(*wrapperspb.UInt32Value)(nil),               // 42: google.protobuf.UInt32Value
+	(*VirtualHostOptions)(nil),                   // 43: gloo.solo.io.VirtualHostOptions
+	(*matchers.Matcher)(nil),                     // 44: matchers.core.gloo.solo.io.Matcher
+	(*core.ResourceRef)(nil),                     // 45: core.solo.io.ResourceRef
+	(*RouteOptions)(nil),                         // 46: gloo.solo.io.RouteOptions
+	(*dynamic_forward_proxy.PerRouteConfig)(nil), // 47: dfp.options.gloo.solo.io.PerRouteConfig
+	(*DestinationSpec)(nil),                      // 48: gloo.solo.io.DestinationSpec
+	(*Subset)(nil),                               // 49: gloo.solo.io.Subset
+	(*WeightedDestinationOptions)(nil),           // 50: gloo.solo.io.WeightedDestinationOptions
+	(*v31.RegexMatchAndSubstitute)(nil),          // 51: solo.io.envoy.type.matcher.v3.RegexMatchAndSubstitute
+	(*filters.FilterStage)(nil),                  // 52: filters.gloo.solo.io.FilterStage
+	(*anypb.Any)(nil),                            // 53: google.protobuf.Any
+	(*emptypb.Empty)(nil),                        // 54: google.protobuf.Empty
 }
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_depIdxs = []int32{
-	2,  // 0: gloo.solo.io.Proxy.listeners:type_name -> gloo.solo.io.Listener
-	30, // 1: gloo.solo.io.Proxy.namespaced_statuses:type_name -> core.solo.io.NamespacedStatuses
-	31, // 2: gloo.solo.io.Proxy.metadata:type_name -> core.solo.io.Metadata
-	5,  // 3: gloo.solo.io.Listener.http_listener:type_name -> gloo.solo.io.HttpListener
-	3,  // 4: gloo.solo.io.Listener.tcp_listener:type_name -> gloo.solo.io.TcpListener
-	6,  // 5: gloo.solo.io.Listener.hybrid_listener:type_name -> gloo.solo.io.HybridListener
-	10, // 6: gloo.solo.io.Listener.aggregate_listener:type_name -> gloo.solo.io.AggregateListener
-	32, // 7: gloo.solo.io.Listener.ssl_configurations:type_name -> gloo.solo.io.SslConfig
-	33, // 8: gloo.solo.io.Listener.use_proxy_proto:type_name -> google.protobuf.BoolValue
-	34, // 9: gloo.solo.io.Listener.options:type_name -> gloo.solo.io.ListenerOptions
-	35, // 10: gloo.solo.io.Listener.metadata:type_name -> google.protobuf.Struct
-	22, // 11: gloo.solo.io.Listener.metadata_static:type_name -> gloo.solo.io.SourceMetadata
-	36, // 12: gloo.solo.io.Listener.route_options:type_name -> gloo.solo.io.RouteConfigurationOptions
-	4,  // 13: gloo.solo.io.TcpListener.tcp_hosts:type_name -> gloo.solo.io.TcpHost
-	37, // 14: gloo.solo.io.TcpListener.options:type_name -> gloo.solo.io.TcpListenerOptions
-	23, // 15: gloo.solo.io.TcpListener.custom_network_filters:type_name -> gloo.solo.io.CustomEnvoyFilter
-	32, // 16: gloo.solo.io.TcpHost.ssl_config:type_name -> gloo.solo.io.SslConfig
-	24, // 17: gloo.solo.io.TcpHost.destination:type_name -> gloo.solo.io.TcpHost.TcpAction
-	11, // 18: gloo.solo.io.HttpListener.virtual_hosts:type_name -> gloo.solo.io.VirtualHost
-	38, // 19: gloo.solo.io.HttpListener.options:type_name -> gloo.solo.io.HttpListenerOptions
-	23, // 20: gloo.solo.io.HttpListener.custom_http_filters:type_name -> gloo.solo.io.CustomEnvoyFilter
-	23, // 21: gloo.solo.io.HttpListener.custom_network_filters:type_name -> gloo.solo.io.CustomEnvoyFilter
-	7,  // 22: gloo.solo.io.HybridListener.matched_listeners:type_name -> gloo.solo.io.MatchedListener
-	9,  // 23: gloo.solo.io.MatchedListener.matcher:type_name -> gloo.solo.io.Matcher
-	5,  // 24: gloo.solo.io.MatchedListener.http_listener:type_name -> gloo.solo.io.HttpListener
-	3,  // 25: gloo.solo.io.MatchedListener.tcp_listener:type_name -> gloo.solo.io.TcpListener
-	32, // 26: gloo.solo.io.MatchedListener.ssl_configurations:type_name -> gloo.solo.io.SslConfig
-	9,  // 27: gloo.solo.io.MatchedTcpListener.matcher:type_name -> gloo.solo.io.Matcher
-	3,  // 28: gloo.solo.io.MatchedTcpListener.tcp_listener:type_name -> gloo.solo.io.TcpListener
-	32, // 29: gloo.solo.io.Matcher.ssl_config:type_name -> gloo.solo.io.SslConfig
-	39, // 30: gloo.solo.io.Matcher.source_prefix_ranges:type_name -> solo.io.envoy.config.core.v3.CidrRange
-	39, // 31: gloo.solo.io.Matcher.prefix_ranges:type_name -> solo.io.envoy.config.core.v3.CidrRange
-	40, // 32: gloo.solo.io.Matcher.destination_port:type_name -> google.protobuf.UInt32Value
-	25, // 33: gloo.solo.io.AggregateListener.http_resources:type_name -> gloo.solo.io.AggregateListener.HttpResources
-	26, // 34: gloo.solo.io.AggregateListener.http_filter_chains:type_name -> gloo.solo.io.AggregateListener.HttpFilterChain
-	8,  // 35: gloo.solo.io.AggregateListener.tcp_listeners:type_name -> gloo.solo.io.MatchedTcpListener
-	12, // 36: gloo.solo.io.VirtualHost.routes:type_name -> gloo.solo.io.Route
-	41, // 37: gloo.solo.io.VirtualHost.options:type_name -> gloo.solo.io.VirtualHostOptions
-	35, // 38: gloo.solo.io.VirtualHost.metadata:type_name -> google.protobuf.Struct
-	22, // 39: gloo.solo.io.VirtualHost.metadata_static:type_name -> gloo.solo.io.SourceMetadata
-	42, // 40: gloo.solo.io.Route.matchers:type_name -> matchers.core.gloo.solo.io.Matcher
-	13, // 41: gloo.solo.io.Route.route_action:type_name -> gloo.solo.io.RouteAction
-	20, // 42: gloo.solo.io.Route.redirect_action:type_name -> gloo.solo.io.RedirectAction
-	21, // 43: gloo.solo.io.Route.direct_response_action:type_name -> gloo.solo.io.DirectResponseAction
-	43, // 44: gloo.solo.io.Route.graphql_api_ref:type_name -> core.solo.io.ResourceRef
-	44, // 45: gloo.solo.io.Route.options:type_name -> gloo.solo.io.RouteOptions
-	35, // 46: gloo.solo.io.Route.metadata:type_name -> google.protobuf.Struct
-	22, // 47: gloo.solo.io.Route.metadata_static:type_name -> gloo.solo.io.SourceMetadata
-	14, // 48: gloo.solo.io.RouteAction.single:type_name -> gloo.solo.io.Destination
-	18, // 49: gloo.solo.io.RouteAction.multi:type_name -> gloo.solo.io.MultiDestination
-	43, // 50: gloo.solo.io.RouteAction.upstream_group:type_name -> core.solo.io.ResourceRef
-	45, // 51: gloo.solo.io.RouteAction.dynamic_forward_proxy:type_name -> dfp.options.gloo.solo.io.PerRouteConfig
-	43, // 52: gloo.solo.io.Destination.upstream:type_name -> core.solo.io.ResourceRef
-	15, // 53: gloo.solo.io.Destination.kube:type_name -> gloo.solo.io.KubernetesServiceDestination
-	16, // 54: gloo.solo.io.Destination.consul:type_name -> gloo.solo.io.ConsulServiceDestination
-	46, // 55: gloo.solo.io.Destination.destination_spec:type_name -> gloo.solo.io.DestinationSpec
-	47, // 56: gloo.solo.io.Destination.subset:type_name -> gloo.solo.io.Subset
-	43, // 57: gloo.solo.io.KubernetesServiceDestination.ref:type_name -> core.solo.io.ResourceRef
-	19, // 58: gloo.solo.io.UpstreamGroup.destinations:type_name -> gloo.solo.io.WeightedDestination
-	30, // 59: gloo.solo.io.UpstreamGroup.namespaced_statuses:type_name -> core.solo.io.NamespacedStatuses
-	31, // 60: gloo.solo.io.UpstreamGroup.metadata:type_name -> core.solo.io.Metadata
-	19, // 61: gloo.solo.io.MultiDestination.destinations:type_name -> gloo.solo.io.WeightedDestination
-	14, // 62: gloo.solo.io.WeightedDestination.destination:type_name -> gloo.solo.io.Destination
-	40, // 63: gloo.solo.io.WeightedDestination.weight:type_name -> google.protobuf.UInt32Value
-	48, // 64: gloo.solo.io.WeightedDestination.options:type_name -> gloo.solo.io.WeightedDestinationOptions
-	49, // 65: gloo.solo.io.RedirectAction.regex_rewrite:type_name -> solo.io.envoy.type.matcher.v3.RegexMatchAndSubstitute
-	0,  // 66: gloo.solo.io.RedirectAction.response_code:type_name -> gloo.solo.io.RedirectAction.RedirectResponseCode
-	40, // 67: gloo.solo.io.RedirectAction.port_redirect:type_name -> google.protobuf.UInt32Value
-	29, // 68: gloo.solo.io.SourceMetadata.sources:type_name -> gloo.solo.io.SourceMetadata.SourceRef
-	50, // 69: gloo.solo.io.CustomEnvoyFilter.filter_stage:type_name -> filters.gloo.solo.io.FilterStage
-	51, // 70: gloo.solo.io.CustomEnvoyFilter.config:type_name -> google.protobuf.Any
-	14, // 71: gloo.solo.io.TcpHost.TcpAction.single:type_name -> gloo.solo.io.Destination
-	18, // 72: gloo.solo.io.TcpHost.TcpAction.multi:type_name -> gloo.solo.io.MultiDestination
-	43, // 73: gloo.solo.io.TcpHost.TcpAction.upstream_group:type_name -> core.solo.io.ResourceRef
-	52, // 74: gloo.solo.io.TcpHost.TcpAction.forward_sni_cluster_name:type_name -> google.protobuf.Empty
-	27, // 75: gloo.solo.io.AggregateListener.HttpResources.virtual_hosts:type_name -> gloo.solo.io.AggregateListener.HttpResources.VirtualHostsEntry
-	28, // 76: gloo.solo.io.AggregateListener.HttpResources.http_options:type_name -> gloo.solo.io.AggregateListener.HttpResources.HttpOptionsEntry
-	9,  // 77: gloo.solo.io.AggregateListener.HttpFilterChain.matcher:type_name -> gloo.solo.io.Matcher
-	23, // 78: gloo.solo.io.AggregateListener.HttpFilterChain.custom_http_filters:type_name -> gloo.solo.io.CustomEnvoyFilter
-	23, // 79: gloo.solo.io.AggregateListener.HttpFilterChain.custom_network_filters:type_name -> gloo.solo.io.CustomEnvoyFilter
-	11, // 80: gloo.solo.io.AggregateListener.HttpResources.VirtualHostsEntry.value:type_name -> gloo.solo.io.VirtualHost
-	38, // 81: gloo.solo.io.AggregateListener.HttpResources.HttpOptionsEntry.value:type_name -> gloo.solo.io.HttpListenerOptions
-	43, // 82: gloo.solo.io.SourceMetadata.SourceRef.resource_ref:type_name -> core.solo.io.ResourceRef
-	83, // [83:83] is the sub-list for method output_type
-	83, // [83:83] is the sub-list for method input_type
-	83, // [83:83] is the sub-list for extension type_name
-	83, // [83:83] is the sub-list for extension extendee
-	0,  // [0:83] is the sub-list for field type_name
+	3,  // 0: gloo.solo.io.Proxy.listeners:type_name -> gloo.solo.io.Listener
+	32, // 1: gloo.solo.io.Proxy.namespaced_statuses:type_name -> core.solo.io.NamespacedStatuses
+	33, // 2: gloo.solo.io.Proxy.metadata:type_name -> core.solo.io.Metadata
+	6,  // 3: gloo.solo.io.Listener.http_listener:type_name -> gloo.solo.io.HttpListener
+	4,  // 4: gloo.solo.io.Listener.tcp_listener:type_name -> gloo.solo.io.TcpListener
+	7,  // 5: gloo.solo.io.Listener.hybrid_listener:type_name -> gloo.solo.io.HybridListener
+	11, // 6: gloo.solo.io.Listener.aggregate_listener:type_name -> gloo.solo.io.AggregateListener
+	34, // 7: gloo.solo.io.Listener.ssl_configurations:type_name -> gloo.solo.io.SslConfig
+	35, // 8: gloo.solo.io.Listener.use_proxy_proto:type_name -> google.protobuf.BoolValue
+	36, // 9: gloo.solo.io.Listener.options:type_name -> gloo.solo.io.ListenerOptions
+	37, // 10: gloo.solo.io.Listener.metadata:type_name -> google.protobuf.Struct
+	23, // 11: gloo.solo.io.Listener.metadata_static:type_name -> gloo.solo.io.SourceMetadata
+	38, // 12: gloo.solo.io.Listener.route_options:type_name -> gloo.solo.io.RouteConfigurationOptions
+	5,  // 13: gloo.solo.io.TcpListener.tcp_hosts:type_name -> gloo.solo.io.TcpHost
+	39, // 14: gloo.solo.io.TcpListener.options:type_name -> gloo.solo.io.TcpListenerOptions
+	24, // 15: gloo.solo.io.TcpListener.custom_network_filters:type_name -> gloo.solo.io.CustomEnvoyFilter
+	34, // 16: gloo.solo.io.TcpHost.ssl_config:type_name -> gloo.solo.io.SslConfig
+	25, // 17: gloo.solo.io.TcpHost.destination:type_name -> gloo.solo.io.TcpHost.TcpAction
+	12, // 18: gloo.solo.io.HttpListener.virtual_hosts:type_name -> gloo.solo.io.VirtualHost
+	40, // 19: gloo.solo.io.HttpListener.options:type_name -> gloo.solo.io.HttpListenerOptions
+	24, // 20: gloo.solo.io.HttpListener.custom_http_filters:type_name -> gloo.solo.io.CustomEnvoyFilter
+	24, // 21: gloo.solo.io.HttpListener.custom_network_filters:type_name -> gloo.solo.io.CustomEnvoyFilter
+	8,  // 22: gloo.solo.io.HybridListener.matched_listeners:type_name -> gloo.solo.io.MatchedListener
+	10, // 23: gloo.solo.io.MatchedListener.matcher:type_name -> gloo.solo.io.Matcher
+	6,  // 24: gloo.solo.io.MatchedListener.http_listener:type_name -> gloo.solo.io.HttpListener
+	4,  // 25: gloo.solo.io.MatchedListener.tcp_listener:type_name -> gloo.solo.io.TcpListener
+	34, // 26: gloo.solo.io.MatchedListener.ssl_configurations:type_name -> gloo.solo.io.SslConfig
+	10, // 27: gloo.solo.io.MatchedTcpListener.matcher:type_name -> gloo.solo.io.Matcher
+	4,  // 28: gloo.solo.io.MatchedTcpListener.tcp_listener:type_name -> gloo.solo.io.TcpListener
+	34, // 29: gloo.solo.io.Matcher.ssl_config:type_name -> gloo.solo.io.SslConfig
+	41, // 30: gloo.solo.io.Matcher.source_prefix_ranges:type_name -> solo.io.envoy.config.core.v3.CidrRange
+	41, // 31: gloo.solo.io.Matcher.prefix_ranges:type_name -> solo.io.envoy.config.core.v3.CidrRange
+	42, // 32: gloo.solo.io.Matcher.destination_port:type_name -> google.protobuf.UInt32Value
+	26, // 33: gloo.solo.io.AggregateListener.http_resources:type_name -> gloo.solo.io.AggregateListener.HttpResources
+	27, // 34: gloo.solo.io.AggregateListener.http_filter_chains:type_name -> gloo.solo.io.AggregateListener.HttpFilterChain
+	9,  // 35: gloo.solo.io.AggregateListener.tcp_listeners:type_name -> gloo.solo.io.MatchedTcpListener
+	13, // 36: gloo.solo.io.VirtualHost.routes:type_name -> gloo.solo.io.Route
+	43, // 37: gloo.solo.io.VirtualHost.options:type_name -> gloo.solo.io.VirtualHostOptions
+	37, // 38: gloo.solo.io.VirtualHost.metadata:type_name -> google.protobuf.Struct
+	23, // 39: gloo.solo.io.VirtualHost.metadata_static:type_name -> gloo.solo.io.SourceMetadata
+	44, // 40: gloo.solo.io.Route.matchers:type_name -> matchers.core.gloo.solo.io.Matcher
+	14, // 41: gloo.solo.io.Route.route_action:type_name -> gloo.solo.io.RouteAction
+	21, // 42: gloo.solo.io.Route.redirect_action:type_name -> gloo.solo.io.RedirectAction
+	22, // 43: gloo.solo.io.Route.direct_response_action:type_name -> gloo.solo.io.DirectResponseAction
+	45, // 44: gloo.solo.io.Route.graphql_api_ref:type_name -> core.solo.io.ResourceRef
+	46, // 45: gloo.solo.io.Route.options:type_name -> gloo.solo.io.RouteOptions
+	37, // 46: gloo.solo.io.Route.metadata:type_name -> google.protobuf.Struct
+	23, // 47: gloo.solo.io.Route.metadata_static:type_name -> gloo.solo.io.SourceMetadata
+	15, // 48: gloo.solo.io.RouteAction.single:type_name -> gloo.solo.io.Destination
+	19, // 49: gloo.solo.io.RouteAction.multi:type_name -> gloo.solo.io.MultiDestination
+	45, // 50: gloo.solo.io.RouteAction.upstream_group:type_name -> core.solo.io.ResourceRef
+	47, // 51: gloo.solo.io.RouteAction.dynamic_forward_proxy:type_name -> dfp.options.gloo.solo.io.PerRouteConfig
+	45, // 52: gloo.solo.io.Destination.upstream:type_name -> core.solo.io.ResourceRef
+	16, // 53: gloo.solo.io.Destination.kube:type_name -> gloo.solo.io.KubernetesServiceDestination
+	17, // 54: gloo.solo.io.Destination.consul:type_name -> gloo.solo.io.ConsulServiceDestination
+	48, // 55: gloo.solo.io.Destination.destination_spec:type_name -> gloo.solo.io.DestinationSpec
+	49, // 56: gloo.solo.io.Destination.subset:type_name -> gloo.solo.io.Subset
+	45, // 57: gloo.solo.io.KubernetesServiceDestination.ref:type_name -> core.solo.io.ResourceRef
+	20, // 58: gloo.solo.io.UpstreamGroup.destinations:type_name -> gloo.solo.io.WeightedDestination
+	30, // 59: gloo.solo.io.UpstreamGroup.failover:type_name -> gloo.solo.io.UpstreamGroup.Failover
+	32, // 60: gloo.solo.io.UpstreamGroup.namespaced_statuses:type_name -> core.solo.io.NamespacedStatuses
+	33, // 61: gloo.solo.io.UpstreamGroup.metadata:type_name -> core.solo.io.Metadata
+	20, // 62: gloo.solo.io.MultiDestination.destinations:type_name -> gloo.solo.io.WeightedDestination
+	15, // 63: gloo.solo.io.WeightedDestination.destination:type_name -> gloo.solo.io.Destination
+	42, // 64: gloo.solo.io.WeightedDestination.weight:type_name -> google.protobuf.UInt32Value
+	50, // 65: gloo.solo.io.WeightedDestination.options:type_name -> gloo.solo.io.WeightedDestinationOptions
+	51, // 66: gloo.solo.io.RedirectAction.regex_rewrite:type_name -> solo.io.envoy.type.matcher.v3.RegexMatchAndSubstitute
+	1,  // 67: gloo.solo.io.RedirectAction.response_code:type_name -> gloo.solo.io.RedirectAction.RedirectResponseCode
+	42, // 68: gloo.solo.io.RedirectAction.port_redirect:type_name -> google.protobuf.UInt32Value
+	31, // 69: gloo.solo.io.SourceMetadata.sources:type_name -> gloo.solo.io.SourceMetadata.SourceRef
+	52, // 70: gloo.solo.io.CustomEnvoyFilter.filter_stage:type_name -> filters.gloo.solo.io.FilterStage
+	53, // 71: gloo.solo.io.CustomEnvoyFilter.config:type_name -> google.protobuf.Any
+	15, // 72: gloo.solo.io.TcpHost.TcpAction.single:type_name -> gloo.solo.io.Destination
+	19, // 73: gloo.solo.io.TcpHost.TcpAction.multi:type_name -> gloo.solo.io.MultiDestination
+	45, // 74: gloo.solo.io.TcpHost.TcpAction.upstream_group:type_name -> core.solo.io.ResourceRef
+	54, // 75: gloo.solo.io.TcpHost.TcpAction.forward_sni_cluster_name:type_name -> google.protobuf.Empty
+	28, // 76: gloo.solo.io.AggregateListener.HttpResources.virtual_hosts:type_name -> gloo.solo.io.AggregateListener.HttpResources.VirtualHostsEntry
+	29, // 77: gloo.solo.io.AggregateListener.HttpResources.http_options:type_name -> gloo.solo.io.AggregateListener.HttpResources.HttpOptionsEntry
+	10, // 78: gloo.solo.io.AggregateListener.HttpFilterChain.matcher:type_name -> gloo.solo.io.Matcher
+	24, // 79: gloo.solo.io.AggregateListener.HttpFilterChain.custom_http_filters:type_name -> gloo.solo.io.CustomEnvoyFilter
+	24, // 80: gloo.solo.io.AggregateListener.HttpFilterChain.custom_network_filters:type_name -> gloo.solo.io.CustomEnvoyFilter
+	12, // 81: gloo.solo.io.AggregateListener.HttpResources.VirtualHostsEntry.value:type_name -> gloo.solo.io.VirtualHost
+	40, // 82: gloo.solo.io.AggregateListener.HttpResources.HttpOptionsEntry.value:type_name -> gloo.solo.io.HttpListenerOptions
+	0,  // 83: gloo.solo.io.UpstreamGroup.Failover.mode:type_name -> gloo.solo.io.UpstreamGroup.Failover.Mode
+	42, // 84: gloo.solo.io.UpstreamGroup.Failover.overprovisioning_factor:type_name -> google.protobuf.UInt32Value
+	45, // 85: gloo.solo.io.SourceMetadata.SourceRef.resource_ref:type_name -> core.solo.io.ResourceRef
+	86, // [86:86] is the sub-list for method output_type
+	86, // [86:86] is the sub-list for method input_type
+	86, // [86:86] is the sub-list for extension type_name
+	86, // [86:86] is the sub-list for extension extendee
+	0,  // [0:86] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_init() }
@@ -3375,8 +3517,8 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   29,
+			NumEnums:      2,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1628,6 +1628,26 @@ func (m *UpstreamGroup) Hash(hasher hash.Hash64) (uint64, error) {
 
 	}
 
+	if h, ok := interface{}(m.GetFailover()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Failover")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetFailover(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Failover")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	if h, ok := interface{}(m.GetMetadata()).(safe_hasher.SafeHasher); ok {
 		if _, err = hasher.Write([]byte("Metadata")); err != nil {
 			return 0, err
@@ -2319,6 +2339,51 @@ func (m *AggregateListener_HttpFilterChain) Hash(hasher hash.Hash64) (uint64, er
 	return hasher.Sum64(), nil
 }
 
+// Hash function
+//
+// Deprecated: due to hashing implemention only using field values. The omission
+// of the field name in the hash calculation can lead to hash collisions.
+// Prefer the HashUnique function instead.
+func (m *UpstreamGroup_Failover) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1.UpstreamGroup_Failover")); err != nil {
+		return 0, err
+	}
+
+	err = binary.Write(hasher, binary.LittleEndian, m.GetMode())
+	if err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetOverprovisioningFactor()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("OverprovisioningFactor")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetOverprovisioningFactor(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("OverprovisioningFactor")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return hasher.Sum64(), nil
+}
+
 // Hash function
 //
 // Deprecated: due to hashing implemention only using field values. The omission
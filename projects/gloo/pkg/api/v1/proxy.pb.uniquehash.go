@@ -1781,6 +1781,26 @@ func (m *UpstreamGroup) HashUnique(hasher hash.Hash64) (uint64, error) {
 
 	}
 
+	if h, ok := interface{}(m.GetFailover()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Failover")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetFailover(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Failover")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	if h, ok := interface{}(m.GetMetadata()).(safe_hasher.SafeHasher); ok {
 		if _, err = hasher.Write([]byte("Metadata")); err != nil {
 			return 0, err
@@ -2532,6 +2552,53 @@ func (m *AggregateListener_HttpFilterChain) HashUnique(hasher hash.Hash64) (uint
 	return hasher.Sum64(), nil
 }
 
+// HashUnique function generates a hash of the object that is unique to the object by
+// hashing field name and value pairs.
+// Replaces Hash due to original hashing implemention only using field values. The omission
+// of the field name in the hash calculation can lead to hash collisions.
+func (m *UpstreamGroup_Failover) HashUnique(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1.UpstreamGroup_Failover")); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte("Mode")); err != nil {
+		return 0, err
+	}
+	err = binary.Write(hasher, binary.LittleEndian, m.GetMode())
+	if err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetOverprovisioningFactor()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("OverprovisioningFactor")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetOverprovisioningFactor(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("OverprovisioningFactor")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return hasher.Sum64(), nil
+}
+
 // HashUnique function generates a hash of the object that is unique to the object by
 // hashing field name and value pairs.
 // Replaces Hash due to original hashing implemention only using field values. The omission
@@ -43,6 +43,8 @@ import (
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_lbhash "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/lbhash"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_oauth2 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/oauth2"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_protocol_upgrade "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol_upgrade"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_retries "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
@@ -255,6 +257,12 @@ func (m *RouteOptions) Clone() proto.Message {
 		target.Ai = proto.Clone(m.GetAi()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_enterprise_options_ai.RouteSettings)
 	}
 
+	if h, ok := interface{}(m.GetOauth2()).(clone.Cloner); ok {
+		target.Oauth2 = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_oauth2.OAuth2)
+	} else {
+		target.Oauth2 = proto.Clone(m.GetOauth2()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_oauth2.OAuth2)
+	}
+
 	switch m.HostRewriteType.(type) {
 
 	case *RouteOptions_HostRewrite:
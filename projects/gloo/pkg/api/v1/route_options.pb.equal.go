@@ -330,6 +330,16 @@ func (m *RouteOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetOauth2()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetOauth2()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetOauth2(), target.GetOauth2()) {
+			return false
+		}
+	}
+
 	switch m.HostRewriteType.(type) {
 
 	case *RouteOptions_HostRewrite:
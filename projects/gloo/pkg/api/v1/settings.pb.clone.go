@@ -118,6 +118,12 @@ func (m *Settings) Clone() proto.Message {
 		target.ConsulDiscovery = proto.Clone(m.GetConsulDiscovery()).(*Settings_ConsulUpstreamDiscoveryConfiguration)
 	}
 
+	if h, ok := interface{}(m.GetNomad()).(clone.Cloner); ok {
+		target.Nomad = h.Clone().(*Settings_NomadConfiguration)
+	} else {
+		target.Nomad = proto.Clone(m.GetNomad()).(*Settings_NomadConfiguration)
+	}
+
 	if h, ok := interface{}(m.GetKubernetes()).(clone.Cloner); ok {
 		target.Kubernetes = h.Clone().(*Settings_KubernetesConfiguration)
 	} else {
@@ -530,6 +536,43 @@ func (m *GlooOptions) Clone() proto.Message {
 		target.IstioOptions = proto.Clone(m.GetIstioOptions()).(*GlooOptions_IstioOptions)
 	}
 
+	target.ScopedRoutesHeader = m.GetScopedRoutesHeader()
+
+	target.VirtualHostDiscoveryCluster = m.GetVirtualHostDiscoveryCluster()
+
+	if m.GetExtensionConfigDiscoveryHttpFilters() != nil {
+		target.ExtensionConfigDiscoveryHttpFilters = make([]string, len(m.GetExtensionConfigDiscoveryHttpFilters()))
+		for idx, v := range m.GetExtensionConfigDiscoveryHttpFilters() {
+
+			target.ExtensionConfigDiscoveryHttpFilters[idx] = v
+
+		}
+	}
+
+	if h, ok := interface{}(m.GetXdsSnapshotPersistence()).(clone.Cloner); ok {
+		target.XdsSnapshotPersistence = h.Clone().(*GlooOptions_XdsSnapshotPersistence)
+	} else {
+		target.XdsSnapshotPersistence = proto.Clone(m.GetXdsSnapshotPersistence()).(*GlooOptions_XdsSnapshotPersistence)
+	}
+
+	if h, ok := interface{}(m.GetXdsTls()).(clone.Cloner); ok {
+		target.XdsTls = h.Clone().(*GlooOptions_XdsTlsOptions)
+	} else {
+		target.XdsTls = proto.Clone(m.GetXdsTls()).(*GlooOptions_XdsTlsOptions)
+	}
+
+	target.CanarySettingsFile = m.GetCanarySettingsFile()
+
+	target.SnapshotHistorySize = m.GetSnapshotHistorySize()
+
+	if h, ok := interface{}(m.GetWasmImages()).(clone.Cloner); ok {
+		target.WasmImages = h.Clone().(*GlooOptions_WasmImageOptions)
+	} else {
+		target.WasmImages = proto.Clone(m.GetWasmImages()).(*GlooOptions_WasmImageOptions)
+	}
+
+	target.TranslationConcurrency = m.GetTranslationConcurrency()
+
 	return target
 }
 
@@ -990,6 +1033,68 @@ func (m *Settings_ConsulUpstreamDiscoveryConfiguration) Clone() proto.Message {
 	return target
 }
 
+// Clone function
+func (m *Settings_NomadConfiguration) Clone() proto.Message {
+	var target *Settings_NomadConfiguration
+	if m == nil {
+		return target
+	}
+	target = &Settings_NomadConfiguration{}
+
+	target.Address = m.GetAddress()
+
+	target.Token = m.GetToken()
+
+	target.Region = m.GetRegion()
+
+	if m.GetNamespaces() != nil {
+		target.Namespaces = make([]string, len(m.GetNamespaces()))
+		for idx, v := range m.GetNamespaces() {
+
+			target.Namespaces[idx] = v
+
+		}
+	}
+
+	if m.GetDataCenters() != nil {
+		target.DataCenters = make([]string, len(m.GetDataCenters()))
+		for idx, v := range m.GetDataCenters() {
+
+			target.DataCenters[idx] = v
+
+		}
+	}
+
+	if m.GetServiceTagsAllowlist() != nil {
+		target.ServiceTagsAllowlist = make([]string, len(m.GetServiceTagsAllowlist()))
+		for idx, v := range m.GetServiceTagsAllowlist() {
+
+			target.ServiceTagsAllowlist[idx] = v
+
+		}
+	}
+
+	if h, ok := interface{}(m.GetWaitTime()).(clone.Cloner); ok {
+		target.WaitTime = h.Clone().(*google_golang_org_protobuf_types_known_durationpb.Duration)
+	} else {
+		target.WaitTime = proto.Clone(m.GetWaitTime()).(*google_golang_org_protobuf_types_known_durationpb.Duration)
+	}
+
+	target.CaFile = m.GetCaFile()
+
+	target.CertFile = m.GetCertFile()
+
+	target.KeyFile = m.GetKeyFile()
+
+	if h, ok := interface{}(m.GetInsecureSkipVerify()).(clone.Cloner); ok {
+		target.InsecureSkipVerify = h.Clone().(*google_golang_org_protobuf_types_known_wrapperspb.BoolValue)
+	} else {
+		target.InsecureSkipVerify = proto.Clone(m.GetInsecureSkipVerify()).(*google_golang_org_protobuf_types_known_wrapperspb.BoolValue)
+	}
+
+	return target
+}
+
 // Clone function
 func (m *Settings_KubernetesConfiguration) Clone() proto.Message {
 	var target *Settings_KubernetesConfiguration
@@ -1304,6 +1409,79 @@ func (m *GlooOptions_IstioOptions) Clone() proto.Message {
 	return target
 }
 
+// Clone function
+func (m *GlooOptions_XdsSnapshotPersistence) Clone() proto.Message {
+	var target *GlooOptions_XdsSnapshotPersistence
+	if m == nil {
+		return target
+	}
+	target = &GlooOptions_XdsSnapshotPersistence{}
+
+	if h, ok := interface{}(m.GetMaxAge()).(clone.Cloner); ok {
+		target.MaxAge = h.Clone().(*google_golang_org_protobuf_types_known_durationpb.Duration)
+	} else {
+		target.MaxAge = proto.Clone(m.GetMaxAge()).(*google_golang_org_protobuf_types_known_durationpb.Duration)
+	}
+
+	switch m.Store.(type) {
+
+	case *GlooOptions_XdsSnapshotPersistence_Directory:
+
+		target.Store = &GlooOptions_XdsSnapshotPersistence_Directory{
+			Directory: m.GetDirectory(),
+		}
+
+	case *GlooOptions_XdsSnapshotPersistence_ConfigMap:
+
+		if h, ok := interface{}(m.GetConfigMap()).(clone.Cloner); ok {
+			target.Store = &GlooOptions_XdsSnapshotPersistence_ConfigMap{
+				ConfigMap: h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef),
+			}
+		} else {
+			target.Store = &GlooOptions_XdsSnapshotPersistence_ConfigMap{
+				ConfigMap: proto.Clone(m.GetConfigMap()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef),
+			}
+		}
+
+	}
+
+	return target
+}
+
+// Clone function
+func (m *GlooOptions_XdsTlsOptions) Clone() proto.Message {
+	var target *GlooOptions_XdsTlsOptions
+	if m == nil {
+		return target
+	}
+	target = &GlooOptions_XdsTlsOptions{}
+
+	target.CertFile = m.GetCertFile()
+
+	target.KeyFile = m.GetKeyFile()
+
+	target.CaFile = m.GetCaFile()
+
+	target.TrustDomain = m.GetTrustDomain()
+
+	return target
+}
+
+// Clone function
+func (m *GlooOptions_WasmImageOptions) Clone() proto.Message {
+	var target *GlooOptions_WasmImageOptions
+	if m == nil {
+		return target
+	}
+	target = &GlooOptions_WasmImageOptions{}
+
+	target.ServerAddr = m.GetServerAddr()
+
+	target.CacheDir = m.GetCacheDir()
+
+	return target
+}
+
 // Clone function
 func (m *GatewayOptions_ValidationOptions) Clone() proto.Message {
 	var target *GatewayOptions_ValidationOptions
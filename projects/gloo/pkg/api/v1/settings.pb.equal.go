@@ -153,6 +153,16 @@ func (m *Settings) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetNomad()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetNomad()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetNomad(), target.GetNomad()) {
+			return false
+		}
+	}
+
 	if h, ok := interface{}(m.GetKubernetes()).(equality.Equalizer); ok {
 		if !h.Equal(target.GetKubernetes()) {
 			return false
@@ -803,6 +813,67 @@ func (m *GlooOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if strings.Compare(m.GetScopedRoutesHeader(), target.GetScopedRoutesHeader()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetVirtualHostDiscoveryCluster(), target.GetVirtualHostDiscoveryCluster()) != 0 {
+		return false
+	}
+
+	if len(m.GetExtensionConfigDiscoveryHttpFilters()) != len(target.GetExtensionConfigDiscoveryHttpFilters()) {
+		return false
+	}
+	for idx, v := range m.GetExtensionConfigDiscoveryHttpFilters() {
+
+		if strings.Compare(v, target.GetExtensionConfigDiscoveryHttpFilters()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetXdsSnapshotPersistence()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetXdsSnapshotPersistence()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetXdsSnapshotPersistence(), target.GetXdsSnapshotPersistence()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetXdsTls()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetXdsTls()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetXdsTls(), target.GetXdsTls()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetCanarySettingsFile(), target.GetCanarySettingsFile()) != 0 {
+		return false
+	}
+
+	if m.GetSnapshotHistorySize() != target.GetSnapshotHistorySize() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetWasmImages()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetWasmImages()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetWasmImages(), target.GetWasmImages()) {
+			return false
+		}
+	}
+
+	if m.GetTranslationConcurrency() != target.GetTranslationConcurrency() {
+		return false
+	}
+
 	return true
 }
 
@@ -1683,6 +1754,107 @@ func (m *Settings_ConsulUpstreamDiscoveryConfiguration) Equal(that interface{})
 	return true
 }
 
+// Equal function
+func (m *Settings_NomadConfiguration) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*Settings_NomadConfiguration)
+	if !ok {
+		that2, ok := that.(Settings_NomadConfiguration)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetAddress(), target.GetAddress()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetToken(), target.GetToken()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetRegion(), target.GetRegion()) != 0 {
+		return false
+	}
+
+	if len(m.GetNamespaces()) != len(target.GetNamespaces()) {
+		return false
+	}
+	for idx, v := range m.GetNamespaces() {
+
+		if strings.Compare(v, target.GetNamespaces()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetDataCenters()) != len(target.GetDataCenters()) {
+		return false
+	}
+	for idx, v := range m.GetDataCenters() {
+
+		if strings.Compare(v, target.GetDataCenters()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetServiceTagsAllowlist()) != len(target.GetServiceTagsAllowlist()) {
+		return false
+	}
+	for idx, v := range m.GetServiceTagsAllowlist() {
+
+		if strings.Compare(v, target.GetServiceTagsAllowlist()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetWaitTime()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetWaitTime()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetWaitTime(), target.GetWaitTime()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetCaFile(), target.GetCaFile()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetCertFile(), target.GetCertFile()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetKeyFile(), target.GetKeyFile()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetInsecureSkipVerify()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetInsecureSkipVerify()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetInsecureSkipVerify(), target.GetInsecureSkipVerify()) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equal function
 func (m *Settings_KubernetesConfiguration) Equal(that interface{}) bool {
 	if that == nil {
@@ -2246,6 +2418,145 @@ func (m *GlooOptions_IstioOptions) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *GlooOptions_XdsSnapshotPersistence) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*GlooOptions_XdsSnapshotPersistence)
+	if !ok {
+		that2, ok := that.(GlooOptions_XdsSnapshotPersistence)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetMaxAge()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMaxAge()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMaxAge(), target.GetMaxAge()) {
+			return false
+		}
+	}
+
+	switch m.Store.(type) {
+
+	case *GlooOptions_XdsSnapshotPersistence_Directory:
+		if _, ok := target.Store.(*GlooOptions_XdsSnapshotPersistence_Directory); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetDirectory(), target.GetDirectory()) != 0 {
+			return false
+		}
+
+	case *GlooOptions_XdsSnapshotPersistence_ConfigMap:
+		if _, ok := target.Store.(*GlooOptions_XdsSnapshotPersistence_ConfigMap); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetConfigMap()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetConfigMap()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetConfigMap(), target.GetConfigMap()) {
+				return false
+			}
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.Store != target.Store {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *GlooOptions_XdsTlsOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*GlooOptions_XdsTlsOptions)
+	if !ok {
+		that2, ok := that.(GlooOptions_XdsTlsOptions)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetCertFile(), target.GetCertFile()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetKeyFile(), target.GetKeyFile()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetCaFile(), target.GetCaFile()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetTrustDomain(), target.GetTrustDomain()) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *GlooOptions_WasmImageOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*GlooOptions_WasmImageOptions)
+	if !ok {
+		that2, ok := that.(GlooOptions_WasmImageOptions)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetServerAddr(), target.GetServerAddr()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetCacheDir(), target.GetCacheDir()) != 0 {
+		return false
+	}
+
+	return true
+}
+
 // Equal function
 func (m *GatewayOptions_ValidationOptions) Equal(that interface{}) bool {
 	if that == nil {
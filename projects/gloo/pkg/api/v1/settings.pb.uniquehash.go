@@ -247,6 +247,26 @@ func (m *Settings) HashUnique(hasher hash.Hash64) (uint64, error) {
 		}
 	}
 
+	if h, ok := interface{}(m.GetNomad()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Nomad")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetNomad(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Nomad")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	if h, ok := interface{}(m.GetKubernetes()).(safe_hasher.SafeHasher); ok {
 		if _, err = hasher.Write([]byte("Kubernetes")); err != nil {
 			return 0, err
@@ -1300,6 +1320,120 @@ func (m *GlooOptions) HashUnique(hasher hash.Hash64) (uint64, error) {
 		}
 	}
 
+	if _, err = hasher.Write([]byte("ScopedRoutesHeader")); err != nil {
+		return 0, err
+	}
+	if _, err = hasher.Write([]byte(m.GetScopedRoutesHeader())); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte("VirtualHostDiscoveryCluster")); err != nil {
+		return 0, err
+	}
+	if _, err = hasher.Write([]byte(m.GetVirtualHostDiscoveryCluster())); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte("ExtensionConfigDiscoveryHttpFilters")); err != nil {
+		return 0, err
+	}
+	for i, v := range m.GetExtensionConfigDiscoveryHttpFilters() {
+		if _, err = hasher.Write([]byte(strconv.Itoa(i))); err != nil {
+			return 0, err
+		}
+
+		if _, err = hasher.Write([]byte("v")); err != nil {
+			return 0, err
+		}
+		if _, err = hasher.Write([]byte(v)); err != nil {
+			return 0, err
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetXdsSnapshotPersistence()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("XdsSnapshotPersistence")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetXdsSnapshotPersistence(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("XdsSnapshotPersistence")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetXdsTls()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("XdsTls")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetXdsTls(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("XdsTls")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if _, err = hasher.Write([]byte("CanarySettingsFile")); err != nil {
+		return 0, err
+	}
+	if _, err = hasher.Write([]byte(m.GetCanarySettingsFile())); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte("SnapshotHistorySize")); err != nil {
+		return 0, err
+	}
+	err = binary.Write(hasher, binary.LittleEndian, m.GetSnapshotHistorySize())
+	if err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetWasmImages()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("WasmImages")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetWasmImages(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("WasmImages")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if _, err = hasher.Write([]byte("TranslationConcurrency")); err != nil {
+		return 0, err
+	}
+	err = binary.Write(hasher, binary.LittleEndian, m.GetTranslationConcurrency())
+	if err != nil {
+		return 0, err
+	}
+
 	return hasher.Sum64(), nil
 }
 
@@ -2485,6 +2619,158 @@ func (m *Settings_ConsulUpstreamDiscoveryConfiguration) HashUnique(hasher hash.H
 	return hasher.Sum64(), nil
 }
 
+// HashUnique function generates a hash of the object that is unique to the object by
+// hashing field name and value pairs.
+// Replaces Hash due to original hashing implemention only using field values. The omission
+// of the field name in the hash calculation can lead to hash collisions.
+func (m *Settings_NomadConfiguration) HashUnique(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1.Settings_NomadConfiguration")); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte("Address")); err != nil {
+		return 0, err
+	}
+	if _, err = hasher.Write([]byte(m.GetAddress())); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte("Token")); err != nil {
+		return 0, err
+	}
+	if _, err = hasher.Write([]byte(m.GetToken())); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte("Region")); err != nil {
+		return 0, err
+	}
+	if _, err = hasher.Write([]byte(m.GetRegion())); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte("Namespaces")); err != nil {
+		return 0, err
+	}
+	for i, v := range m.GetNamespaces() {
+		if _, err = hasher.Write([]byte(strconv.Itoa(i))); err != nil {
+			return 0, err
+		}
+
+		if _, err = hasher.Write([]byte("v")); err != nil {
+			return 0, err
+		}
+		if _, err = hasher.Write([]byte(v)); err != nil {
+			return 0, err
+		}
+
+	}
+
+	if _, err = hasher.Write([]byte("DataCenters")); err != nil {
+		return 0, err
+	}
+	for i, v := range m.GetDataCenters() {
+		if _, err = hasher.Write([]byte(strconv.Itoa(i))); err != nil {
+			return 0, err
+		}
+
+		if _, err = hasher.Write([]byte("v")); err != nil {
+			return 0, err
+		}
+		if _, err = hasher.Write([]byte(v)); err != nil {
+			return 0, err
+		}
+
+	}
+
+	if _, err = hasher.Write([]byte("ServiceTagsAllowlist")); err != nil {
+		return 0, err
+	}
+	for i, v := range m.GetServiceTagsAllowlist() {
+		if _, err = hasher.Write([]byte(strconv.Itoa(i))); err != nil {
+			return 0, err
+		}
+
+		if _, err = hasher.Write([]byte("v")); err != nil {
+			return 0, err
+		}
+		if _, err = hasher.Write([]byte(v)); err != nil {
+			return 0, err
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetWaitTime()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("WaitTime")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetWaitTime(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("WaitTime")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if _, err = hasher.Write([]byte("CaFile")); err != nil {
+		return 0, err
+	}
+	if _, err = hasher.Write([]byte(m.GetCaFile())); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte("CertFile")); err != nil {
+		return 0, err
+	}
+	if _, err = hasher.Write([]byte(m.GetCertFile())); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte("KeyFile")); err != nil {
+		return 0, err
+	}
+	if _, err = hasher.Write([]byte(m.GetKeyFile())); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetInsecureSkipVerify()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("InsecureSkipVerify")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetInsecureSkipVerify(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("InsecureSkipVerify")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return hasher.Sum64(), nil
+}
+
 // HashUnique function generates a hash of the object that is unique to the object by
 // hashing field name and value pairs.
 // Replaces Hash due to original hashing implemention only using field values. The omission
@@ -3209,6 +3495,160 @@ func (m *GlooOptions_IstioOptions) HashUnique(hasher hash.Hash64) (uint64, error
 	return hasher.Sum64(), nil
 }
 
+// HashUnique function generates a hash of the object that is unique to the object by
+// hashing field name and value pairs.
+// Replaces Hash due to original hashing implemention only using field values. The omission
+// of the field name in the hash calculation can lead to hash collisions.
+func (m *GlooOptions_XdsSnapshotPersistence) HashUnique(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1.GlooOptions_XdsSnapshotPersistence")); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetMaxAge()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("MaxAge")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetMaxAge(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("MaxAge")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	switch m.Store.(type) {
+
+	case *GlooOptions_XdsSnapshotPersistence_Directory:
+
+		if _, err = hasher.Write([]byte("Directory")); err != nil {
+			return 0, err
+		}
+		if _, err = hasher.Write([]byte(m.GetDirectory())); err != nil {
+			return 0, err
+		}
+
+	case *GlooOptions_XdsSnapshotPersistence_ConfigMap:
+
+		if h, ok := interface{}(m.GetConfigMap()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("ConfigMap")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetConfigMap(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("ConfigMap")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// HashUnique function generates a hash of the object that is unique to the object by
+// hashing field name and value pairs.
+// Replaces Hash due to original hashing implemention only using field values. The omission
+// of the field name in the hash calculation can lead to hash collisions.
+func (m *GlooOptions_XdsTlsOptions) HashUnique(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1.GlooOptions_XdsTlsOptions")); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte("CertFile")); err != nil {
+		return 0, err
+	}
+	if _, err = hasher.Write([]byte(m.GetCertFile())); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte("KeyFile")); err != nil {
+		return 0, err
+	}
+	if _, err = hasher.Write([]byte(m.GetKeyFile())); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte("CaFile")); err != nil {
+		return 0, err
+	}
+	if _, err = hasher.Write([]byte(m.GetCaFile())); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte("TrustDomain")); err != nil {
+		return 0, err
+	}
+	if _, err = hasher.Write([]byte(m.GetTrustDomain())); err != nil {
+		return 0, err
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// HashUnique function generates a hash of the object that is unique to the object by
+// hashing field name and value pairs.
+// Replaces Hash due to original hashing implemention only using field values. The omission
+// of the field name in the hash calculation can lead to hash collisions.
+func (m *GlooOptions_WasmImageOptions) HashUnique(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1.GlooOptions_WasmImageOptions")); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte("ServerAddr")); err != nil {
+		return 0, err
+	}
+	if _, err = hasher.Write([]byte(m.GetServerAddr())); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte("CacheDir")); err != nil {
+		return 0, err
+	}
+	if _, err = hasher.Write([]byte(m.GetCacheDir())); err != nil {
+		return 0, err
+	}
+
+	return hasher.Sum64(), nil
+}
+
 // HashUnique function generates a hash of the object that is unique to the object by
 // hashing field name and value pairs.
 // Replaces Hash due to original hashing implemention only using field values. The omission
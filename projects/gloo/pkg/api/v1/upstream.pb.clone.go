@@ -29,8 +29,14 @@ import (
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_consul "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/consul"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_dns_srv "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dns_srv"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_eureka "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/eureka"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_kubernetes "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_nomad "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/nomad"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_pipe "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/pipe"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
@@ -333,6 +339,42 @@ func (m *Upstream) Clone() proto.Message {
 			}
 		}
 
+	case *Upstream_Eureka:
+
+		if h, ok := interface{}(m.GetEureka()).(clone.Cloner); ok {
+			target.UpstreamType = &Upstream_Eureka{
+				Eureka: h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_eureka.UpstreamSpec),
+			}
+		} else {
+			target.UpstreamType = &Upstream_Eureka{
+				Eureka: proto.Clone(m.GetEureka()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_eureka.UpstreamSpec),
+			}
+		}
+
+	case *Upstream_DnsSrv:
+
+		if h, ok := interface{}(m.GetDnsSrv()).(clone.Cloner); ok {
+			target.UpstreamType = &Upstream_DnsSrv{
+				DnsSrv: h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_dns_srv.UpstreamSpec),
+			}
+		} else {
+			target.UpstreamType = &Upstream_DnsSrv{
+				DnsSrv: proto.Clone(m.GetDnsSrv()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_dns_srv.UpstreamSpec),
+			}
+		}
+
+	case *Upstream_Nomad:
+
+		if h, ok := interface{}(m.GetNomad()).(clone.Cloner); ok {
+			target.UpstreamType = &Upstream_Nomad{
+				Nomad: h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_nomad.UpstreamSpec),
+			}
+		} else {
+			target.UpstreamType = &Upstream_Nomad{
+				Nomad: proto.Clone(m.GetNomad()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_nomad.UpstreamSpec),
+			}
+		}
+
 	}
 
 	return target
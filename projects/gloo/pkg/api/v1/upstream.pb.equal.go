@@ -441,6 +441,51 @@ func (m *Upstream) Equal(that interface{}) bool {
 			}
 		}
 
+	case *Upstream_Eureka:
+		if _, ok := target.UpstreamType.(*Upstream_Eureka); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetEureka()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetEureka()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetEureka(), target.GetEureka()) {
+				return false
+			}
+		}
+
+	case *Upstream_DnsSrv:
+		if _, ok := target.UpstreamType.(*Upstream_DnsSrv); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetDnsSrv()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetDnsSrv()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetDnsSrv(), target.GetDnsSrv()) {
+				return false
+			}
+		}
+
+	case *Upstream_Nomad:
+		if _, ok := target.UpstreamType.(*Upstream_Nomad); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetNomad()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetNomad()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetNomad(), target.GetNomad()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.UpstreamType != target.UpstreamType {
@@ -715,6 +715,72 @@ func (m *Upstream) Hash(hasher hash.Hash64) (uint64, error) {
 			}
 		}
 
+	case *Upstream_Eureka:
+
+		if h, ok := interface{}(m.GetEureka()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("Eureka")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetEureka(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("Eureka")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	case *Upstream_DnsSrv:
+
+		if h, ok := interface{}(m.GetDnsSrv()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("DnsSrv")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetDnsSrv(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("DnsSrv")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	case *Upstream_Nomad:
+
+		if h, ok := interface{}(m.GetNomad()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("Nomad")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetNomad(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("Nomad")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
 	}
 
 	return hasher.Sum64(), nil
@@ -35,6 +35,8 @@ import (
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_headers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_oauth2 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/oauth2"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_retries "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_stats "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/stats"
@@ -171,6 +173,12 @@ func (m *VirtualHostOptions) Clone() proto.Message {
 		target.CorsPolicyMergeSettings = proto.Clone(m.GetCorsPolicyMergeSettings()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_cors.CorsPolicyMergeSettings)
 	}
 
+	if h, ok := interface{}(m.GetOauth2()).(clone.Cloner); ok {
+		target.Oauth2 = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_oauth2.OAuth2)
+	} else {
+		target.Oauth2 = proto.Clone(m.GetOauth2()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_oauth2.OAuth2)
+	}
+
 	switch m.RateLimitEarlyConfigType.(type) {
 
 	case *VirtualHostOptions_RatelimitEarly:
@@ -226,6 +226,16 @@ func (m *VirtualHostOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetOauth2()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetOauth2()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetOauth2(), target.GetOauth2()) {
+			return false
+		}
+	}
+
 	switch m.RateLimitEarlyConfigType.(type) {
 
 	case *VirtualHostOptions_RatelimitEarly:
@@ -0,0 +1,62 @@
+package clients
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"os"
+
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/nomad"
+)
+
+const (
+	// the standard environment variables of the Nomad CLI
+	nomadAddrEnv   = "NOMAD_ADDR"
+	nomadTokenEnv  = "NOMAD_TOKEN"
+	nomadRegionEnv = "NOMAD_REGION"
+)
+
+// NomadClientForSettings constructs a Nomad API client for the configuration
+// provided in the settings parameter.
+func NomadClientForSettings(nomadSettings *v1.Settings_NomadConfiguration) (nomad.Client, error) {
+	address := nomadSettings.GetAddress()
+	if address == "" {
+		address = os.Getenv(nomadAddrEnv)
+	}
+	token := nomadSettings.GetToken()
+	if token == "" {
+		token = os.Getenv(nomadTokenEnv)
+	}
+	region := nomadSettings.GetRegion()
+	if region == "" {
+		region = os.Getenv(nomadRegionEnv)
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: nomadSettings.GetInsecureSkipVerify().GetValue(),
+	}
+	if caFile := nomadSettings.GetCaFile(); caFile != "" {
+		caPem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, eris.Wrapf(err, "reading the Nomad CA file")
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caPem) {
+			return nil, eris.Errorf("no certificate in the Nomad CA file %s", caFile)
+		}
+	}
+	if certFile, keyFile := nomadSettings.GetCertFile(), nomadSettings.GetKeyFile(); certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, eris.Wrapf(err, "loading the Nomad client certificate")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	// no timeout, the blocking queries of the watches are canceled with their context
+	return nomad.NewClient(address, token, region, &http.Client{Transport: transport})
+}
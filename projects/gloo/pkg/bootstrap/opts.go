@@ -24,7 +24,9 @@ import (
 	ggv2utils "github.com/solo-io/gloo/projects/gateway2/utils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/nomad"
 	"github.com/solo-io/gloo/projects/gloo/pkg/validation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	xdsserver "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/server"
 )
 
@@ -91,6 +93,7 @@ type Opts struct {
 	RouteOptions                 factory.ResourceClientFactory
 	KubeClient                   kubernetes.Interface
 	Consul                       Consul
+	Nomad                        Nomad
 	WatchOpts                    clients.WatchOpts
 	DevMode                      bool
 	ControlPlane                 ControlPlane
@@ -128,10 +131,18 @@ type Consul struct {
 	DnsPollingInterval *durationpb.Duration
 }
 
+type Nomad struct {
+	NomadWatcher nomad.NomadWatcher
+}
+
 type ControlPlane struct {
 	*GrpcService
 	SnapshotCache cache.SnapshotCache
 	XDSServer     server.Server
+	// DeltaXDSServer serves incremental xDS streams from the same SnapshotCache as the XDSServer
+	DeltaXDSServer xds.DeltaServer
+	// XdsStatuses records the ACKs and NACKs of the nodes connected to the XDSServer and the DeltaXDSServer
+	XdsStatuses *xds.AckTracker
 
 	Kube KubernetesControlPlaneConfig
 }
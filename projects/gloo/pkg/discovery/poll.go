@@ -0,0 +1,126 @@
+package discovery
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+// listEndpointsTimeout bounds each listing of the endpoints of an upstream, so that an unresponsive registry
+// doesn't stop its upstream from being polled again
+const listEndpointsTimeout = 30 * time.Second
+
+// ListEndpointsFunc lists the endpoints of an upstream, and returns how long to wait before listing them again.
+// The wait is also used after an error.
+type ListEndpointsFunc func(ctx context.Context, upstream *v1.Upstream) (v1.EndpointList, time.Duration, error)
+
+// polledEndpoints is the result of listing the endpoints of the upstream at index upstream
+type polledEndpoints struct {
+	upstream  int
+	endpoints v1.EndpointList
+	err       error
+}
+
+// PollEndpoints is the EDS watch of plugins that poll an external registry for the endpoints of the upstreams users
+// create, such as the EC2 API or a Eureka server.
+// Each upstream is polled concurrently whenever its endpoints are due, so that a slow registry doesn't delay the
+// others, and each listing is canceled after listEndpointsTimeout. The endpoints of all the upstreams are sent once
+// each upstream was listed, then whenever they change. When listing the endpoints of an upstream fails, it sends
+// the error and keeps the endpoints the upstream had.
+func PollEndpoints(ctx context.Context, upstreams v1.UpstreamList, listEndpoints ListEndpointsFunc) (<-chan v1.EndpointList, <-chan error) {
+	endpointsChan := make(chan v1.EndpointList)
+	errs := make(chan error)
+	results := make(chan polledEndpoints)
+	for i, upstream := range upstreams {
+		go pollUpstream(ctx, i, upstream, listEndpoints, results)
+	}
+
+	go func() {
+		defer close(endpointsChan)
+		defer close(errs)
+
+		endpointsByUpstream := make([]v1.EndpointList, len(upstreams))
+		listed := make([]bool, len(upstreams))
+		remaining := len(upstreams)
+		var previous v1.EndpointList
+		sent := false
+
+		for {
+			if remaining == 0 {
+				var endpoints v1.EndpointList
+				for _, upstreamEndpoints := range endpointsByUpstream {
+					endpoints = append(endpoints, upstreamEndpoints...)
+				}
+				sort.SliceStable(endpoints, func(i, j int) bool {
+					return endpoints[i].GetMetadata().Less(endpoints[j].GetMetadata())
+				})
+				if !sent || !endpointListsEqual(previous, endpoints) {
+					select {
+					case <-ctx.Done():
+						return
+					case endpointsChan <- endpoints:
+					}
+					previous, sent = endpoints, true
+				}
+			}
+
+			var result polledEndpoints
+			select {
+			case <-ctx.Done():
+				return
+			case result = <-results:
+			}
+			if !listed[result.upstream] {
+				listed[result.upstream] = true
+				remaining--
+			}
+			if result.err != nil {
+				select {
+				case <-ctx.Done():
+					return
+				case errs <- result.err:
+				}
+			} else {
+				endpointsByUpstream[result.upstream] = result.endpoints
+			}
+		}
+	}()
+	return endpointsChan, errs
+}
+
+// pollUpstream lists the endpoints of an upstream whenever they are due, until ctx is done
+func pollUpstream(ctx context.Context, index int, upstream *v1.Upstream, listEndpoints ListEndpointsFunc, results chan<- polledEndpoints) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		listCtx, cancel := context.WithTimeout(ctx, listEndpointsTimeout)
+		endpoints, wait, err := listEndpoints(listCtx, upstream)
+		cancel()
+		select {
+		case <-ctx.Done():
+			return
+		case results <- polledEndpoints{upstream: index, endpoints: endpoints, err: err}:
+		}
+		timer.Reset(wait)
+	}
+}
+
+func endpointListsEqual(a, b v1.EndpointList) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
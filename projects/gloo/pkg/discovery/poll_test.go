@@ -0,0 +1,109 @@
+package discovery_test
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+
+	. "github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+)
+
+var _ = Describe("PollEndpoints", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+
+		upstreams v1.UpstreamList
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		upstreams = v1.UpstreamList{
+			{Metadata: &core.Metadata{Name: "a", Namespace: "default"}},
+			{Metadata: &core.Metadata{Name: "b", Namespace: "default"}},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	endpoint := func(name string) *v1.Endpoint {
+		return &v1.Endpoint{Metadata: &core.Metadata{Name: name, Namespace: "default"}}
+	}
+
+	It("sends the sorted endpoints of all the upstreams", func() {
+		endpoints, _ := PollEndpoints(ctx, upstreams, func(ctx context.Context, upstream *v1.Upstream) (v1.EndpointList, time.Duration, error) {
+			return v1.EndpointList{endpoint(upstream.GetMetadata().GetName() + "-2"), endpoint(upstream.GetMetadata().GetName() + "-1")}, time.Hour, nil
+		})
+		var list v1.EndpointList
+		Eventually(endpoints).Should(Receive(&list))
+		Expect(list.Names()).To(Equal([]string{"a-1", "a-2", "b-1", "b-2"}))
+	})
+
+	It("sends the endpoints once without upstreams", func() {
+		endpoints, _ := PollEndpoints(ctx, nil, func(ctx context.Context, upstream *v1.Upstream) (v1.EndpointList, time.Duration, error) {
+			Fail("no upstream to list the endpoints of")
+			return nil, 0, nil
+		})
+		Eventually(endpoints).Should(Receive(BeEmpty()))
+		Consistently(endpoints).ShouldNot(Receive())
+	})
+
+	It("only sends the endpoints again when they change", func() {
+		var calls atomic.Int32
+		endpoints, _ := PollEndpoints(ctx, upstreams[:1], func(ctx context.Context, upstream *v1.Upstream) (v1.EndpointList, time.Duration, error) {
+			if calls.Add(1) < 5 {
+				return v1.EndpointList{endpoint("a-1")}, 10 * time.Millisecond, nil
+			}
+			return v1.EndpointList{endpoint("a-1"), endpoint("a-2")}, time.Hour, nil
+		})
+		Eventually(endpoints).Should(Receive(HaveLen(1)))
+		Eventually(endpoints).Should(Receive(HaveLen(2)))
+		Expect(calls.Load()).To(BeEquivalentTo(5))
+	})
+
+	It("polls the upstreams concurrently, so that a slow registry doesn't delay the others", func() {
+		var calls atomic.Int32
+		endpoints, _ := PollEndpoints(ctx, upstreams, func(ctx context.Context, upstream *v1.Upstream) (v1.EndpointList, time.Duration, error) {
+			if upstream.GetMetadata().GetName() == "a" {
+				if calls.Add(1) == 1 {
+					return v1.EndpointList{endpoint("a-1")}, 10 * time.Millisecond, nil
+				}
+				// the registry of a no longer responds
+				<-ctx.Done()
+				return nil, time.Hour, ctx.Err()
+			}
+			select {
+			case <-time.After(50 * time.Millisecond):
+				return v1.EndpointList{endpoint("b-1"), endpoint("b-2")}, time.Hour, nil
+			case <-ctx.Done():
+				return nil, time.Hour, ctx.Err()
+			}
+		})
+		var list v1.EndpointList
+		Eventually(endpoints).Should(Receive(&list))
+		Expect(list.Names()).To(Equal([]string{"a-1", "b-1", "b-2"}))
+		Expect(calls.Load()).To(BeEquivalentTo(2), "a is polled again while b is listed")
+	})
+
+	It("sends errors, and keeps the endpoints of the upstreams that fail", func() {
+		var calls atomic.Int32
+		endpoints, errs := PollEndpoints(ctx, upstreams[:1], func(ctx context.Context, upstream *v1.Upstream) (v1.EndpointList, time.Duration, error) {
+			if calls.Add(1) == 1 {
+				return v1.EndpointList{endpoint("a-1")}, 10 * time.Millisecond, nil
+			}
+			return nil, time.Hour, eris.New("registry unavailable")
+		})
+		Eventually(endpoints).Should(Receive(HaveLen(1)))
+		Eventually(errs).Should(Receive(MatchError("registry unavailable")))
+		Consistently(endpoints).ShouldNot(Receive())
+	})
+})
@@ -101,6 +101,11 @@ func setWeightedClusters(params plugins.Params, multiDest *v1.MultiDestination,
 
 		clusterName := translator.UpstreamToClusterName(usRef)
 		correspondentCluster := clusterMap[clusterName]
+		if correspondentCluster == nil {
+			// the route does not go to the cluster of the upstream, e.g. it goes to the aggregate cluster of an
+			// upstream group with failover
+			continue
+		}
 
 		correspondentCluster.MetadataMatch = metadataMatch
 	}
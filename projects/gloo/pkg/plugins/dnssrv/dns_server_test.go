@@ -0,0 +1,128 @@
+package dnssrv
+
+import (
+	"net"
+	"sync"
+
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// dnsServer is a DNS server with the SRV records of _ldap._tcp.example.com, whose response includes the address
+// of one of their targets, and the addresses of their targets
+type dnsServer struct {
+	conn net.PacketConn
+
+	lock    sync.Mutex
+	ttl     uint32
+	records []dnsmessage.SRVResource
+}
+
+func newDnsServer() *dnsServer {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	Expect(err).NotTo(HaveOccurred())
+	s := &dnsServer{
+		conn: conn,
+		ttl:  300,
+		records: []dnsmessage.SRVResource{
+			{Priority: 10, Weight: 60, Port: 389, Target: dnsmessage.MustNewName("ldap1.example.com.")},
+			{Priority: 20, Weight: 5, Port: 1389, Target: dnsmessage.MustNewName("ldap2.example.com.")},
+		},
+	}
+	go s.serve()
+	return s
+}
+
+func (s *dnsServer) address() string {
+	return s.conn.LocalAddr().String()
+}
+
+func (s *dnsServer) close() {
+	_ = s.conn.Close()
+}
+
+func (s *dnsServer) setTtl(ttl uint32) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.ttl = ttl
+}
+
+func (s *dnsServer) removeTarget(target string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var records []dnsmessage.SRVResource
+	for _, record := range s.records {
+		if record.Target.String() != target {
+			records = append(records, record)
+		}
+	}
+	s.records = records
+}
+
+func (s *dnsServer) serve() {
+	buf := make([]byte, 512)
+	for {
+		n, addr, err := s.conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		var query dnsmessage.Message
+		if err := query.Unpack(buf[:n]); err != nil || len(query.Questions) != 1 {
+			continue
+		}
+		response, err := s.respond(query).Pack()
+		if err != nil {
+			continue
+		}
+		_, _ = s.conn.WriteTo(response, addr)
+	}
+}
+
+func (s *dnsServer) respond(query dnsmessage.Message) *dnsmessage.Message {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	question := query.Questions[0]
+	response := &dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: query.Header.ID, Response: true, Authoritative: true},
+		Questions: query.Questions,
+	}
+	header := func(name string, qtype dnsmessage.Type) dnsmessage.ResourceHeader {
+		return dnsmessage.ResourceHeader{Name: dnsmessage.MustNewName(name), Type: qtype, Class: dnsmessage.ClassINET, TTL: s.ttl}
+	}
+
+	switch {
+	case question.Name.String() == "_ldap._tcp.example.com." && question.Type == dnsmessage.TypeSRV:
+		for i := range s.records {
+			response.Answers = append(response.Answers, dnsmessage.Resource{
+				Header: header(question.Name.String(), dnsmessage.TypeSRV),
+				Body:   &s.records[i],
+			})
+		}
+		response.Additionals = append(response.Additionals, dnsmessage.Resource{
+			Header: header("ldap1.example.com.", dnsmessage.TypeA),
+			Body:   &dnsmessage.AResource{A: [4]byte{10, 0, 0, 1}},
+		})
+	case question.Name.String() == "ldap2.example.com." && question.Type == dnsmessage.TypeA:
+		response.Answers = append(response.Answers, dnsmessage.Resource{
+			Header: header("ldap2.example.com.", dnsmessage.TypeA),
+			Body:   &dnsmessage.AResource{A: [4]byte{10, 0, 0, 2}},
+		})
+	case question.Name.String() == "ldap2.example.com." && question.Type == dnsmessage.TypeAAAA:
+		response.Answers = append(response.Answers, dnsmessage.Resource{
+			Header: header("ldap2.example.com.", dnsmessage.TypeAAAA),
+			Body:   &dnsmessage.AAAAResource{AAAA: [16]byte{0xfd, 15: 2}},
+		})
+	default:
+		response.Header.RCode = dnsmessage.RCodeNameError
+		response.Authorities = append(response.Authorities, dnsmessage.Resource{
+			Header: header("example.com.", dnsmessage.TypeSOA),
+			Body: &dnsmessage.SOAResource{
+				NS:     dnsmessage.MustNewName("ns.example.com."),
+				MBox:   dnsmessage.MustNewName("hostmaster.example.com."),
+				MinTTL: 60,
+			},
+		})
+	}
+	return response
+}
@@ -0,0 +1,13 @@
+package dnssrv_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestDnsSrv(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "DNS SRV Suite")
+}
@@ -0,0 +1,104 @@
+package dnssrv
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/k8s-utils/kubeutils"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const (
+	DefaultMinRefreshInterval = 5 * time.Second
+	DefaultMaxRefreshInterval = 5 * time.Minute
+)
+
+// TODO[eds enhancement] - update the EDS interface to include a registration function which would ensure uniqueness among prefixes
+const dnsSrvEndpointNamePrefix = "dns-srv"
+
+// EDS API
+// start the EDS watch which sends a new list of endpoints on any change
+func (p *plugin) WatchEndpoints(writeNamespace string, unfilteredUpstreams v1.UpstreamList, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
+	contextutils.LoggerFrom(opts.Ctx).Debugw("calling WatchEndpoints on DNS SRV")
+	var dnsSrvUpstreams v1.UpstreamList
+	for _, upstream := range unfilteredUpstreams {
+		if _, ok := upstream.GetUpstreamType().(*v1.Upstream_DnsSrv); ok {
+			dnsSrvUpstreams = append(dnsSrvUpstreams, upstream)
+		}
+	}
+	endpoints, errs := discovery.PollEndpoints(opts.Ctx, dnsSrvUpstreams, func(ctx context.Context, upstream *v1.Upstream) (v1.EndpointList, time.Duration, error) {
+		spec := upstream.GetDnsSrv()
+		minRefreshInterval, maxRefreshInterval := refreshIntervals(upstream)
+		if spec.GetName() == "" {
+			return nil, maxRefreshInterval, MissingNameError
+		}
+		r, err := newResolver(spec.GetResolverAddress())
+		if err != nil {
+			return nil, minRefreshInterval, err
+		}
+		res, err := r.resolveSrv(ctx, spec.GetName())
+		if err != nil {
+			return nil, minRefreshInterval, err
+		}
+		return buildEndpoints(writeNamespace, upstream, res.Targets), min(max(res.TTL, minRefreshInterval), maxRefreshInterval), nil
+	})
+	return endpoints, errs, nil
+}
+
+func refreshIntervals(upstream *v1.Upstream) (time.Duration, time.Duration) {
+	minRefreshInterval, maxRefreshInterval := DefaultMinRefreshInterval, DefaultMaxRefreshInterval
+	if interval := upstream.GetDnsSrv().GetMinRefreshInterval(); interval != nil && interval.AsDuration() > 0 {
+		minRefreshInterval = interval.AsDuration()
+	}
+	if interval := upstream.GetDnsSrv().GetMaxRefreshInterval(); interval != nil && interval.AsDuration() > 0 {
+		maxRefreshInterval = interval.AsDuration()
+	}
+	return minRefreshInterval, max(minRefreshInterval, maxRefreshInterval)
+}
+
+func buildEndpoints(writeNamespace string, upstream *v1.Upstream, targets []*srvTarget) v1.EndpointList {
+	upstreamRef := upstream.GetMetadata().Ref()
+
+	var endpoints v1.EndpointList
+	for _, target := range targets {
+		for _, address := range target.Addresses {
+			endpoints = append(endpoints, &v1.Endpoint{
+				Metadata: &core.Metadata{
+					// the priority and weight are part of the name, as endpoints are only updated when their address changes
+					Name:      generateName(upstreamRef, address, target),
+					Namespace: writeNamespace,
+					Annotations: map[string]string{
+						PriorityAnnotation: strconv.Itoa(int(target.Priority)),
+						WeightAnnotation:   strconv.Itoa(int(target.Weight)),
+					},
+				},
+				Upstreams: []*core.ResourceRef{upstreamRef},
+				Address:   address,
+				Port:      uint32(target.Port),
+				Hostname:  strings.TrimSuffix(target.Target, "."),
+			})
+		}
+	}
+	return endpoints
+}
+
+func generateName(upstreamRef *core.ResourceRef, address string, target *srvTarget) string {
+	return kubeutils.SanitizeNameV2(fmt.Sprintf(
+		"%v-name-%s-namespace-%s-%v-%v-%v-%v",
+		dnsSrvEndpointNamePrefix,
+		upstreamRef.GetName(),
+		upstreamRef.GetNamespace(),
+		address,
+		target.Port,
+		target.Priority,
+		target.Weight,
+	))
+}
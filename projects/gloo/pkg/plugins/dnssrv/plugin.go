@@ -0,0 +1,145 @@
+package dnssrv
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	_ plugins.Plugin            = new(plugin)
+	_ plugins.UpstreamPlugin    = new(plugin)
+	_ plugins.EndpointPlugin    = new(plugin)
+	_ discovery.DiscoveryPlugin = new(plugin)
+)
+
+const (
+	ExtensionName = "dns_srv"
+
+	// PriorityAnnotation and WeightAnnotation are the annotations of the endpoints with the priority and the weight
+	// of the SRV record of their target
+	PriorityAnnotation = "dns-srv.gloo.solo.io/priority"
+	WeightAnnotation   = "dns-srv.gloo.solo.io/weight"
+)
+
+/*
+Steps:
+- User creates a DNS SRV upstream
+  - names the SRV records of the service
+- Discovery resolves the records, and the addresses of their targets, whenever the shortest of their TTLs expires
+- Gloo plugin creates an endpoint for each address of each target, annotated with the priority and weight of its record
+- the endpoint plugin groups the endpoints of the load assignment by priority, and weights them
+*/
+
+type plugin struct {
+	settings *v1.Settings
+}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(params plugins.InitParams) {
+	p.settings = params.Settings
+}
+
+// DNS SRV upstreams are created by the user, not discovered
+func (p *plugin) DiscoverUpstreams(watchNamespaces []string, writeNamespace string, opts clients.WatchOpts, discOpts discovery.Opts) (chan v1.UpstreamList, chan error, error) {
+	return nil, nil, nil
+}
+
+// we do not need to update any fields, just check that the input is valid
+func (p *plugin) UpdateUpstream(original, desired *v1.Upstream) (bool, error) {
+	originalSpec, ok := original.GetUpstreamType().(*v1.Upstream_DnsSrv)
+	if !ok {
+		return false, WrongUpstreamTypeError(original)
+	}
+	desiredSpec, ok := desired.GetUpstreamType().(*v1.Upstream_DnsSrv)
+	if !ok {
+		return false, WrongUpstreamTypeError(desired)
+	}
+	if !originalSpec.DnsSrv.Equal(desiredSpec.DnsSrv) {
+		return false, UpstreamDeltaError()
+	}
+	return false, nil
+}
+
+func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
+	spec := in.GetDnsSrv()
+	if spec == nil {
+		return nil
+	}
+	if spec.GetName() == "" {
+		return MissingNameError
+	}
+
+	// configure the cluster to use EDS:ADS and call it a day
+	xds.SetEdsOnCluster(out, p.settings)
+	return nil
+}
+
+// ProcessEndpoints gives the endpoints of the targets of the records with the lowest priority the highest Envoy priority,
+// and so on, and weights them with the weight of their record.
+func (p *plugin) ProcessEndpoints(params plugins.Params, in *v1.Upstream, out *envoy_config_endpoint_v3.ClusterLoadAssignment) error {
+	if in.GetDnsSrv() == nil {
+		return nil
+	}
+
+	endpointsByPriority := map[uint64][]*envoy_config_endpoint_v3.LbEndpoint{}
+	for _, localityEndpoints := range out.GetEndpoints() {
+		for _, endpoint := range localityEndpoints.GetLbEndpoints() {
+			annotations := endpoint.GetMetadata().GetFilterMetadata()[translator.SoloAnnotations].GetFields()
+			priority, _ := strconv.ParseUint(annotations[PriorityAnnotation].GetStringValue(), 10, 16)
+			weight, _ := strconv.ParseUint(annotations[WeightAnnotation].GetStringValue(), 10, 16)
+			// a weight of 0 means that the target has a very small chance of being selected, Envoy requires at least 1
+			endpoint.LoadBalancingWeight = wrapperspb.UInt32(uint32(max(weight, 1)))
+			endpointsByPriority[priority] = append(endpointsByPriority[priority], endpoint)
+		}
+	}
+	if len(endpointsByPriority) == 0 {
+		return nil
+	}
+
+	priorities := make([]uint64, 0, len(endpointsByPriority))
+	for priority := range endpointsByPriority {
+		priorities = append(priorities, priority)
+	}
+	sort.Slice(priorities, func(i, j int) bool { return priorities[i] < priorities[j] })
+
+	// Envoy priorities must be consecutive, starting at 0
+	out.Endpoints = nil
+	for envoyPriority, priority := range priorities {
+		out.Endpoints = append(out.GetEndpoints(), &envoy_config_endpoint_v3.LocalityLbEndpoints{
+			LbEndpoints: endpointsByPriority[priority],
+			Priority:    uint32(envoyPriority),
+		})
+	}
+	return nil
+}
+
+var (
+	WrongUpstreamTypeError = func(upstream *v1.Upstream) error {
+		return eris.Errorf("internal error: expected *v1.Upstream_DnsSrv, got %v", reflect.TypeOf(upstream.GetUpstreamType()).Name())
+	}
+
+	UpstreamDeltaError = func() error {
+		return eris.New("expected no difference between *v1.Upstream_DnsSrv upstreams")
+	}
+
+	MissingNameError = eris.New("a DNS SRV upstream must have the name of its SRV records")
+)
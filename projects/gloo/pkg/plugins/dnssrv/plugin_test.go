@@ -0,0 +1,215 @@
+package dnssrv
+
+import (
+	"context"
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dns_srv"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		p        *plugin
+		upstream *v1.Upstream
+	)
+
+	BeforeEach(func() {
+		p = NewPlugin()
+		p.Init(plugins.InitParams{Settings: &v1.Settings{}})
+		upstream = &v1.Upstream{
+			Metadata: &core.Metadata{
+				Name:      "ldap",
+				Namespace: "gloo-system",
+			},
+			UpstreamType: &v1.Upstream_DnsSrv{
+				DnsSrv: &dns_srv.UpstreamSpec{
+					Name: "_ldap._tcp.example.com",
+				},
+			},
+		}
+	})
+
+	Context("ProcessUpstream", func() {
+
+		It("configures the cluster to use EDS", func() {
+			out := &envoy_config_cluster_v3.Cluster{}
+			err := p.ProcessUpstream(plugins.Params{}, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetType()).To(Equal(envoy_config_cluster_v3.Cluster_EDS))
+			Expect(out.GetEdsClusterConfig()).NotTo(BeNil())
+		})
+
+		It("errors without a name", func() {
+			upstream.GetDnsSrv().Name = ""
+			err := p.ProcessUpstream(plugins.Params{}, upstream, &envoy_config_cluster_v3.Cluster{})
+			Expect(err).To(MatchError(MissingNameError))
+		})
+	})
+
+	Context("ProcessEndpoints", func() {
+
+		lbEndpoint := func(address string, priority, weight string) *envoy_config_endpoint_v3.LbEndpoint {
+			annotations, err := structpb.NewStruct(map[string]interface{}{
+				PriorityAnnotation: priority,
+				WeightAnnotation:   weight,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			return &envoy_config_endpoint_v3.LbEndpoint{
+				HostIdentifier: &envoy_config_endpoint_v3.LbEndpoint_Endpoint{
+					Endpoint: &envoy_config_endpoint_v3.Endpoint{Hostname: address},
+				},
+				Metadata: &envoy_config_core_v3.Metadata{FilterMetadata: map[string]*structpb.Struct{translator.SoloAnnotations: annotations}},
+			}
+		}
+
+		It("groups the endpoints by priority, and weights them", func() {
+			primary1 := lbEndpoint("10.0.0.1", "10", "60")
+			primary2 := lbEndpoint("10.0.0.2", "10", "0")
+			backup := lbEndpoint("10.0.0.3", "20", "5")
+			out := &envoy_config_endpoint_v3.ClusterLoadAssignment{
+				Endpoints: []*envoy_config_endpoint_v3.LocalityLbEndpoints{{
+					LbEndpoints: []*envoy_config_endpoint_v3.LbEndpoint{backup, primary1, primary2},
+				}},
+			}
+
+			err := p.ProcessEndpoints(plugins.Params{}, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(out.GetEndpoints()).To(HaveLen(2))
+			Expect(out.GetEndpoints()[0].GetPriority()).To(BeEquivalentTo(0))
+			Expect(out.GetEndpoints()[0].GetLbEndpoints()).To(Equal([]*envoy_config_endpoint_v3.LbEndpoint{primary1, primary2}))
+			Expect(out.GetEndpoints()[1].GetPriority()).To(BeEquivalentTo(1))
+			Expect(out.GetEndpoints()[1].GetLbEndpoints()).To(Equal([]*envoy_config_endpoint_v3.LbEndpoint{backup}))
+
+			Expect(primary1.GetLoadBalancingWeight().GetValue()).To(BeEquivalentTo(60))
+			Expect(primary2.GetLoadBalancingWeight().GetValue()).To(BeEquivalentTo(1))
+			Expect(backup.GetLoadBalancingWeight().GetValue()).To(BeEquivalentTo(5))
+		})
+
+		It("ignores other upstreams", func() {
+			upstream.UpstreamType = &v1.Upstream_Static{}
+			out := &envoy_config_endpoint_v3.ClusterLoadAssignment{
+				Endpoints: []*envoy_config_endpoint_v3.LocalityLbEndpoints{{
+					LbEndpoints: []*envoy_config_endpoint_v3.LbEndpoint{lbEndpoint("10.0.0.1", "20", "5")},
+				}},
+			}
+
+			err := p.ProcessEndpoints(plugins.Params{}, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetEndpoints()[0].GetLbEndpoints()[0].GetLoadBalancingWeight()).To(BeNil())
+		})
+	})
+
+	Context("WatchEndpoints", func() {
+
+		var (
+			ctx    context.Context
+			cancel context.CancelFunc
+
+			server *dnsServer
+		)
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithCancel(context.Background())
+			server = newDnsServer()
+			upstream.GetDnsSrv().ResolverAddress = server.address()
+		})
+
+		AfterEach(func() {
+			cancel()
+			server.close()
+		})
+
+		watchEndpoints := func() <-chan v1.EndpointList {
+			endpoints, errs, err := p.WatchEndpoints("gloo-system", v1.UpstreamList{upstream}, clients.WatchOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			go func() {
+				defer GinkgoRecover()
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case err := <-errs:
+						Fail(err.Error())
+					}
+				}
+			}()
+			return endpoints
+		}
+
+		It("creates an endpoint for each address of each target", func() {
+			var endpoints v1.EndpointList
+			Eventually(watchEndpoints()).Should(Receive(&endpoints))
+			Expect(endpoints).To(HaveLen(3))
+
+			byAddress := map[string]*v1.Endpoint{}
+			for _, endpoint := range endpoints {
+				byAddress[endpoint.GetAddress()] = endpoint
+			}
+			Expect(byAddress).To(HaveKey("10.0.0.1"))
+			Expect(byAddress["10.0.0.1"].GetPort()).To(BeEquivalentTo(389))
+			Expect(byAddress["10.0.0.1"].GetHostname()).To(Equal("ldap1.example.com"))
+			Expect(byAddress["10.0.0.1"].GetUpstreams()).To(ConsistOf(upstream.GetMetadata().Ref()))
+			Expect(byAddress["10.0.0.1"].GetMetadata().GetAnnotations()).To(Equal(map[string]string{
+				PriorityAnnotation: "10",
+				WeightAnnotation:   "60",
+			}))
+
+			// the address of the second target is not in the response, and is resolved separately
+			Expect(byAddress).To(HaveKey("10.0.0.2"))
+			Expect(byAddress).To(HaveKey("fd00::2"))
+			Expect(byAddress["10.0.0.2"].GetPort()).To(BeEquivalentTo(1389))
+			Expect(byAddress["10.0.0.2"].GetHostname()).To(Equal("ldap2.example.com"))
+			Expect(byAddress["10.0.0.2"].GetMetadata().GetAnnotations()).To(Equal(map[string]string{
+				PriorityAnnotation: "20",
+				WeightAnnotation:   "5",
+			}))
+		})
+
+		It("has no endpoints for a name without SRV records", func() {
+			upstream.GetDnsSrv().Name = "_http._tcp.example.com"
+			var endpoints v1.EndpointList
+			Eventually(watchEndpoints()).Should(Receive(&endpoints))
+			Expect(endpoints).To(BeEmpty())
+		})
+
+		It("resolves the records again when their TTL expires", func() {
+			server.setTtl(1)
+			upstream.GetDnsSrv().MinRefreshInterval = durationpb.New(time.Second)
+			endpoints := watchEndpoints()
+			Eventually(endpoints).Should(Receive(HaveLen(3)))
+
+			server.removeTarget("ldap2.example.com.")
+			Eventually(endpoints, 5*time.Second).Should(Receive(HaveLen(1)))
+		})
+	})
+
+	Context("refreshIntervals", func() {
+
+		It("defaults the intervals", func() {
+			minRefreshInterval, maxRefreshInterval := refreshIntervals(upstream)
+			Expect(minRefreshInterval).To(Equal(DefaultMinRefreshInterval))
+			Expect(maxRefreshInterval).To(Equal(DefaultMaxRefreshInterval))
+		})
+
+		It("keeps the maximum interval at least the minimum", func() {
+			upstream.GetDnsSrv().MinRefreshInterval = durationpb.New(10 * time.Minute)
+			minRefreshInterval, maxRefreshInterval := refreshIntervals(upstream)
+			Expect(minRefreshInterval).To(Equal(10 * time.Minute))
+			Expect(maxRefreshInterval).To(Equal(10 * time.Minute))
+		})
+	})
+})
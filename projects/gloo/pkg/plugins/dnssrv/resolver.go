@@ -0,0 +1,256 @@
+package dnssrv
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"math/rand"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rotisserie/eris"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	resolvConfPath = "/etc/resolv.conf"
+	dnsPort        = "53"
+
+	queryTimeout = 5 * time.Second
+	// the largest UDP response we accept, responses that don't fit are truncated and queried again over TCP
+	maxUdpResponseSize = 4096
+)
+
+var (
+	NoNameserverError = func(path string) error {
+		return eris.Errorf("no nameserver found in %s", path)
+	}
+
+	ResponseCodeError = func(name string, rcode dnsmessage.RCode) error {
+		return eris.Errorf("resolving %s: %v", name, rcode)
+	}
+)
+
+// srvTarget is a target of an SRV record, with the addresses it resolves to
+type srvTarget struct {
+	Target    string
+	Port      uint16
+	Priority  uint16
+	Weight    uint16
+	Addresses []string
+}
+
+// resolution is the result of resolving the SRV records of a name
+type resolution struct {
+	Targets []*srvTarget
+	// TTL is the shortest TTL of the records, and of the addresses of their targets
+	TTL time.Duration
+}
+
+// resolver resolves SRV records, and the addresses of their targets, with the TTL of the records.
+// The resolver of the standard library doesn't return TTLs.
+type resolver struct {
+	address string
+}
+
+func newResolver(address string) (*resolver, error) {
+	if address == "" {
+		var err error
+		if address, err = nameserverFromResolvConf(resolvConfPath); err != nil {
+			return nil, err
+		}
+	}
+	return &resolver{address: address}, nil
+}
+
+func nameserverFromResolvConf(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "nameserver" {
+			return net.JoinHostPort(fields[1], dnsPort), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", NoNameserverError(path)
+}
+
+// resolveSrv resolves the SRV records of a name, and the A and AAAA records of their targets that the response
+// doesn't include. A name without SRV records has no targets, and the TTL of its negative answer.
+func (r *resolver) resolveSrv(ctx context.Context, name string) (*resolution, error) {
+	response, err := r.query(ctx, name, dnsmessage.TypeSRV)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &resolution{}
+	addresses := map[string][]string{}
+	for _, answer := range response.Answers {
+		srv, ok := answer.Body.(*dnsmessage.SRVResource)
+		if !ok {
+			continue
+		}
+		res.observeTTL(answer.Header.TTL)
+		res.Targets = append(res.Targets, &srvTarget{
+			Target:   srv.Target.String(),
+			Port:     srv.Port,
+			Priority: srv.Priority,
+			Weight:   srv.Weight,
+		})
+	}
+	if len(res.Targets) == 0 {
+		for _, authority := range response.Authorities {
+			if soa, ok := authority.Body.(*dnsmessage.SOAResource); ok {
+				// the TTL of a negative answer is the shortest of the TTL and the minimum of the SOA record
+				res.observeTTL(min(authority.Header.TTL, soa.MinTTL))
+			}
+		}
+		return res, nil
+	}
+	for _, additional := range response.Additionals {
+		if address, ok := addressOf(additional); ok {
+			res.observeTTL(additional.Header.TTL)
+			addresses[additional.Header.Name.String()] = append(addresses[additional.Header.Name.String()], address)
+		}
+	}
+
+	for _, target := range res.Targets {
+		if _, ok := addresses[target.Target]; !ok {
+			var targetAddresses []string
+			for _, qtype := range []dnsmessage.Type{dnsmessage.TypeA, dnsmessage.TypeAAAA} {
+				response, err := r.query(ctx, target.Target, qtype)
+				if err != nil {
+					return nil, err
+				}
+				for _, answer := range response.Answers {
+					if address, ok := addressOf(answer); ok {
+						res.observeTTL(answer.Header.TTL)
+						targetAddresses = append(targetAddresses, address)
+					}
+				}
+			}
+			addresses[target.Target] = targetAddresses
+		}
+		target.Addresses = addresses[target.Target]
+	}
+	return res, nil
+}
+
+func (r *resolution) observeTTL(ttl uint32) {
+	duration := time.Duration(ttl) * time.Second
+	if r.TTL == 0 || duration < r.TTL {
+		r.TTL = duration
+	}
+}
+
+func addressOf(resource dnsmessage.Resource) (string, bool) {
+	switch body := resource.Body.(type) {
+	case *dnsmessage.AResource:
+		return net.IP(body.A[:]).String(), true
+	case *dnsmessage.AAAAResource:
+		return net.IP(body.AAAA[:]).String(), true
+	}
+	return "", false
+}
+
+// query sends a query over UDP, and again over TCP when the response is truncated
+func (r *resolver) query(ctx context.Context, name string, qtype dnsmessage.Type) (*dnsmessage.Message, error) {
+	qname, err := dnsmessage.NewName(fqdn(name))
+	if err != nil {
+		return nil, err
+	}
+	id := uint16(rand.Uint32())
+	query, err := (&dnsmessage.Message{
+		Header: dnsmessage.Header{ID: id, RecursionDesired: true},
+		Questions: []dnsmessage.Question{{
+			Name:  qname,
+			Type:  qtype,
+			Class: dnsmessage.ClassINET,
+		}},
+	}).Pack()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := r.exchange(ctx, "udp", query)
+	if err != nil {
+		return nil, err
+	}
+	if response.Header.Truncated {
+		if response, err = r.exchange(ctx, "tcp", query); err != nil {
+			return nil, err
+		}
+	}
+	if response.Header.ID != id {
+		return nil, eris.Errorf("resolving %s: the response doesn't match the query", name)
+	}
+	if response.Header.RCode != dnsmessage.RCodeSuccess && response.Header.RCode != dnsmessage.RCodeNameError {
+		return nil, ResponseCodeError(name, response.Header.RCode)
+	}
+	return response, nil
+}
+
+func (r *resolver) exchange(ctx context.Context, network string, query []byte) (*dnsmessage.Message, error) {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, network, r.address)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	var buf []byte
+	if network == "tcp" {
+		// over TCP, messages are prefixed with their length
+		if _, err := conn.Write(append(binary.BigEndian.AppendUint16(nil, uint16(len(query))), query...)); err != nil {
+			return nil, err
+		}
+		var length [2]byte
+		if _, err := io.ReadFull(conn, length[:]); err != nil {
+			return nil, err
+		}
+		buf = make([]byte, binary.BigEndian.Uint16(length[:]))
+		if _, err := io.ReadFull(conn, buf); err != nil {
+			return nil, err
+		}
+	} else {
+		if _, err := conn.Write(query); err != nil {
+			return nil, err
+		}
+		buf = make([]byte, maxUdpResponseSize)
+		n, err := conn.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+		buf = buf[:n]
+	}
+
+	var response dnsmessage.Message
+	if err := response.Unpack(buf); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func fqdn(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
@@ -35,16 +35,11 @@ const (
 	DlpExtensionName                   = "dlp"
 	FailoverExtensionName              = "failover"
 	GcpExtensionName                   = "failover"
-	JwtExtensionName                   = "jwt"
 	LeftmostXffAddressExtensionName    = "leftmost_xff_address"
 	ProxyLatencyExtensionName          = "proxy_latency"
-	RbacExtensionName                  = "rbac"
 	SanitizeClusterHeaderExtensionName = "sanitize_cluster_header"
 	WafExtensionName                   = "waf"
-	WasmExtensionName                  = "wasm"
 	Aws                                = "aws"
-	ExtProcExtensionName               = "extproc"
-	TapFilterExtensionName             = "tap"
 	StatefulSessionName                = "stateful_session"
 )
 
@@ -75,36 +70,16 @@ func (p *plugin) ProcessVirtualHost(
 ) error {
 	var enterpriseExtensions []string
 
-	if isJwtConfiguredOnVirtualHost(in) {
-		enterpriseExtensions = append(enterpriseExtensions, JwtExtensionName)
-	}
-
-	if isRbacConfiguredOnVirtualHost(in) {
-		enterpriseExtensions = append(enterpriseExtensions, RbacExtensionName)
-	}
-
 	if isWafConfiguredOnVirtualHost(in) {
 		enterpriseExtensions = append(enterpriseExtensions, WafExtensionName)
 	}
 
-	if isExtProcConfiguredOnVirtualHost(in) {
-		enterpriseExtensions = append(enterpriseExtensions, ExtProcExtensionName)
-	}
-
 	return GetErrorForEnterpriseOnlyExtensions(enterpriseExtensions)
 }
 
 func (p *plugin) ProcessRoute(_ plugins.RouteParams, in *v1.Route, _ *envoy_config_route_v3.Route) error {
 	var enterpriseExtensions []string
 
-	if isJwtConfiguredOnRoute(in) {
-		enterpriseExtensions = append(enterpriseExtensions, JwtExtensionName)
-	}
-
-	if isRbacConfiguredOnRoute(in) {
-		enterpriseExtensions = append(enterpriseExtensions, RbacExtensionName)
-	}
-
 	if isWafConfiguredOnRoute(in) {
 		enterpriseExtensions = append(enterpriseExtensions, WafExtensionName)
 	}
@@ -113,10 +88,6 @@ func (p *plugin) ProcessRoute(_ plugins.RouteParams, in *v1.Route, _ *envoy_conf
 		enterpriseExtensions = append(enterpriseExtensions, Aws)
 	}
 
-	if isExtProcConfiguredOnRoute(in) {
-		enterpriseExtensions = append(enterpriseExtensions, ExtProcExtensionName)
-	}
-
 	return GetErrorForEnterpriseOnlyExtensions(enterpriseExtensions)
 }
 
@@ -165,18 +136,6 @@ func (p *plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plu
 		enterpriseExtensions = append(enterpriseExtensions, WafExtensionName)
 	}
 
-	if isWasmConfiguredOnListener(listener) {
-		enterpriseExtensions = append(enterpriseExtensions, WasmExtensionName)
-	}
-
-	if isExtProcConfiguredOnListener(listener) {
-		enterpriseExtensions = append(enterpriseExtensions, ExtProcExtensionName)
-	}
-
-	if isTapConfiguredOnListener(listener) {
-		enterpriseExtensions = append(enterpriseExtensions, TapFilterExtensionName)
-	}
-
 	if isStatefulSessionConfiguredOnListener(listener) {
 		enterpriseExtensions = append(enterpriseExtensions, StatefulSessionName)
 	}
@@ -224,15 +183,6 @@ func isGcpConfiguredOnUpstream(in *v1.Upstream) bool {
 	return in.GetGcp() != nil
 }
 
-// jwt
-func isJwtConfiguredOnVirtualHost(in *v1.VirtualHost) bool {
-	return in.GetOptions().GetJwtConfig() != nil
-}
-
-func isJwtConfiguredOnRoute(in *v1.Route) bool {
-	return in.GetOptions().GetJwtConfig() != nil || in.GetOptions().GetJwtStaged() != nil || in.GetOptions().GetJwtProvidersStaged() != nil
-}
-
 // leftmost_xff_address
 func isLeftmostXffAddressConfiguredOnListener(in *v1.HttpListener) bool {
 	return in.GetOptions().GetLeftmostXffAddress() != nil
@@ -243,25 +193,11 @@ func isProxyLatencyConfiguredOnListener(in *v1.HttpListener) bool {
 	return in.GetOptions().GetProxyLatency() != nil
 }
 
-// rbac
-func isRbacConfiguredOnVirtualHost(in *v1.VirtualHost) bool {
-	return in.GetOptions().GetRbac() != nil
-}
-
-func isRbacConfiguredOnRoute(in *v1.Route) bool {
-	return in.GetOptions().GetRbac() != nil
-}
-
 // sanitize_cluster_header
 func isSanitizeClusterHeaderConfiguredOnListener(in *v1.HttpListener) bool {
 	return in.GetOptions().GetSanitizeClusterHeader() != nil
 }
 
-// tap
-func isTapConfiguredOnListener(in *v1.HttpListener) bool {
-	return in.GetOptions().GetTap() != nil
-}
-
 // stateful session
 func isStatefulSessionConfiguredOnListener(in *v1.HttpListener) bool {
 	return in.GetOptions().GetStatefulSession() != nil
@@ -280,11 +216,6 @@ func isWafConfiguredOnListener(in *v1.HttpListener) bool {
 	return in.GetOptions().GetWaf() != nil
 }
 
-// wasm
-func isWasmConfiguredOnListener(in *v1.HttpListener) bool {
-	return in.GetOptions().GetWasm() != nil
-}
-
 // aws
 func isEnterpriseAWSConfiguredOnRoute(in *v1.Route) bool {
 	var awsDestinationSpecs []*aws.DestinationSpec
@@ -308,16 +239,3 @@ func isEnterpriseAWSConfiguredOnRoute(in *v1.Route) bool {
 
 	return false
 }
-
-// extproc
-func isExtProcConfiguredOnVirtualHost(in *v1.VirtualHost) bool {
-	return in.GetOptions().GetExtProc() != nil
-}
-
-func isExtProcConfiguredOnRoute(in *v1.Route) bool {
-	return in.GetOptions().GetExtProc() != nil
-}
-
-func isExtProcConfiguredOnListener(in *v1.HttpListener) bool {
-	return in.GetOptions().GetExtProc() != nil || in.GetOptions().GetDisableExtProc() != nil
-}
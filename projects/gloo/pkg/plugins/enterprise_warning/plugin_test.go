@@ -9,16 +9,11 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/proxylatency"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/dlp"
-	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extproc"
-	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
-	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/rbac"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/stateful_session"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/waf"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/advanced_http"
 	awsapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws"
 	v1static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
-	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tap"
-	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/enterprise_warning"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
@@ -132,77 +127,6 @@ var _ = Describe("enterprise_warning plugin", func() {
 
 	})
 
-	Context("jwt", func() {
-
-		It("should not add filter if jwt config is nil", func() {
-			p := NewPlugin()
-			err := p.ProcessVirtualHost(plugins.VirtualHostParams{}, &v1.VirtualHost{}, &envoy_config_route.VirtualHost{})
-			Expect(err).NotTo(HaveOccurred())
-		})
-
-		It("will err if jwt is configured", func() {
-			p := NewPlugin()
-			virtualHost := &v1.VirtualHost{
-				Name:    "virt1",
-				Domains: []string{"*"},
-				Options: &v1.VirtualHostOptions{
-					JwtConfig: &v1.VirtualHostOptions_JwtStaged{
-						JwtStaged: &jwt.JwtStagedVhostExtension{},
-					},
-				},
-			}
-
-			err := p.ProcessVirtualHost(plugins.VirtualHostParams{}, virtualHost, &envoy_config_route.VirtualHost{})
-			ExpectEnterpriseOnlyErr(err)
-		})
-
-		It("will err if jwt is configured on route", func() {
-			p := NewPlugin()
-			route := &v1.Route{
-				Name: "route1",
-				Options: &v1.RouteOptions{
-					JwtConfig: &v1.RouteOptions_Jwt{
-						Jwt: &jwt.RouteExtension{},
-					},
-				},
-			}
-
-			err := p.ProcessRoute(plugins.RouteParams{}, route, &envoy_config_route.Route{})
-			ExpectEnterpriseOnlyErr(err)
-		})
-
-		It("will err if staged jwt is configured on route", func() {
-			p := NewPlugin()
-			route := &v1.Route{
-				Name: "route1",
-				Options: &v1.RouteOptions{
-					JwtConfig: &v1.RouteOptions_JwtStaged{
-						JwtStaged: &jwt.JwtStagedRouteExtension{},
-					},
-				},
-			}
-
-			err := p.ProcessRoute(plugins.RouteParams{}, route, &envoy_config_route.Route{})
-			ExpectEnterpriseOnlyErr(err)
-		})
-
-		It("will err if staged jwt provider is configured on route", func() {
-			p := NewPlugin()
-			route := &v1.Route{
-				Name: "route1",
-				Options: &v1.RouteOptions{
-					JwtConfig: &v1.RouteOptions_JwtProvidersStaged{
-						JwtProvidersStaged: &jwt.JwtStagedRouteProvidersExtension{},
-					},
-				},
-			}
-
-			err := p.ProcessRoute(plugins.RouteParams{}, route, &envoy_config_route.Route{})
-			ExpectEnterpriseOnlyErr(err)
-		})
-
-	})
-
 	Context("leftmost_xff_address", func() {
 
 		It("should not add filter if leftmost xff header config is nil", func() {
@@ -251,43 +175,6 @@ var _ = Describe("enterprise_warning plugin", func() {
 
 	})
 
-	Context("rbac", func() {
-
-		It("should not add filter if rbac config is nil", func() {
-			p := NewPlugin()
-			err := p.ProcessVirtualHost(plugins.VirtualHostParams{}, &v1.VirtualHost{}, &envoy_config_route.VirtualHost{})
-			Expect(err).NotTo(HaveOccurred())
-		})
-
-		It("will err if rbac is configured on vhost", func() {
-			p := NewPlugin()
-			virtualHost := &v1.VirtualHost{
-				Name:    "virt1",
-				Domains: []string{"*"},
-				Options: &v1.VirtualHostOptions{
-					Rbac: &rbac.ExtensionSettings{},
-				},
-			}
-
-			err := p.ProcessVirtualHost(plugins.VirtualHostParams{}, virtualHost, &envoy_config_route.VirtualHost{})
-			ExpectEnterpriseOnlyErr(err)
-		})
-
-		It("will err if rbac is configured on route", func() {
-			p := NewPlugin()
-			virtualHost := &v1.Route{
-				Name: "route1",
-				Options: &v1.RouteOptions{
-					Rbac: &rbac.ExtensionSettings{},
-				},
-			}
-
-			err := p.ProcessRoute(plugins.RouteParams{}, virtualHost, &envoy_config_route.Route{})
-			ExpectEnterpriseOnlyErr(err)
-		})
-
-	})
-
 	Context("sanitize_cluster_header", func() {
 
 		It("should not add filter if sanitize cluster header config is nil", func() {
@@ -311,28 +198,6 @@ var _ = Describe("enterprise_warning plugin", func() {
 		})
 	})
 
-	Context("tap", func() {
-		It("should not add filter if tap config is nil", func() {
-			p := NewPlugin()
-			f, err := p.HttpFilters(plugins.Params{}, nil)
-			Expect(err).NotTo(HaveOccurred())
-			Expect(f).To(BeNil())
-		})
-
-		It("will error if tap is configured", func() {
-			p := NewPlugin()
-			hl := &v1.HttpListener{
-				Options: &v1.HttpListenerOptions{
-					Tap: &tap.Tap{},
-				},
-			}
-
-			f, err := p.HttpFilters(plugins.Params{}, hl)
-			ExpectEnterpriseOnlyErr(err)
-			Expect(f).To(BeNil())
-		})
-	})
-
 	Context("waf", func() {
 
 		It("should not add filter if waf config is nil", func() {
@@ -384,108 +249,6 @@ var _ = Describe("enterprise_warning plugin", func() {
 
 	})
 
-	Context("extproc", func() {
-
-		It("should not add filter if extproc config is nil", func() {
-			p := NewPlugin()
-			f, err := p.HttpFilters(plugins.Params{}, nil)
-			Expect(err).NotTo(HaveOccurred())
-			Expect(f).To(BeNil())
-		})
-
-		It("should error if disable extproc is configured on listener", func() {
-			p := NewPlugin()
-			hl := &v1.HttpListener{
-				Options: &v1.HttpListenerOptions{
-					ExtProcConfig: &v1.HttpListenerOptions_DisableExtProc{DisableExtProc: &wrappers.BoolValue{Value: false}},
-				},
-			}
-
-			f, err := p.HttpFilters(plugins.Params{}, hl)
-			ExpectEnterpriseOnlyErr(err)
-			Expect(f).To(BeNil())
-		})
-
-		It("should error if extproc is configured on listener", func() {
-			p := NewPlugin()
-			hl := &v1.HttpListener{
-				Options: &v1.HttpListenerOptions{
-					ExtProcConfig: &v1.HttpListenerOptions_ExtProc{ExtProc: &extproc.Settings{}},
-				},
-			}
-
-			f, err := p.HttpFilters(plugins.Params{}, hl)
-			ExpectEnterpriseOnlyErr(err)
-			Expect(f).To(BeNil())
-		})
-
-		It("should error if extproc is configured on vhost", func() {
-			p := NewPlugin()
-			virtualHost := &v1.VirtualHost{
-				Name:    "virt1",
-				Domains: []string{"*"},
-				Options: &v1.VirtualHostOptions{
-					ExtProc: &extproc.RouteSettings{},
-				},
-			}
-
-			err := p.ProcessVirtualHost(plugins.VirtualHostParams{}, virtualHost, &envoy_config_route.VirtualHost{})
-			ExpectEnterpriseOnlyErr(err)
-		})
-
-		It("should error if extproc is configured on route", func() {
-			p := NewPlugin()
-			virtualHost := &v1.Route{
-				Name: "route1",
-				Options: &v1.RouteOptions{
-					ExtProc: &extproc.RouteSettings{},
-				},
-			}
-
-			err := p.ProcessRoute(plugins.RouteParams{}, virtualHost, &envoy_config_route.Route{})
-			ExpectEnterpriseOnlyErr(err)
-		})
-
-	})
-
-	Context("wasm", func() {
-
-		var (
-			p plugins.HttpFilterPlugin
-		)
-
-		BeforeEach(func() {
-			p = NewPlugin()
-		})
-
-		It("should not add filter if wasm config is nil", func() {
-			f, err := p.HttpFilters(plugins.Params{}, nil)
-			Expect(err).NotTo(HaveOccurred())
-			Expect(f).To(BeNil())
-		})
-
-		It("will err if wasm is configured", func() {
-			image := "hello"
-			hl := &v1.HttpListener{
-				Options: &v1.HttpListenerOptions{
-					Wasm: &wasm.PluginSource{
-						Filters: []*wasm.WasmFilter{
-							{
-								Src: &wasm.WasmFilter_Image{
-									Image: image,
-								},
-							},
-						},
-					},
-				},
-			}
-
-			f, err := p.HttpFilters(plugins.Params{}, hl)
-			ExpectEnterpriseOnlyErr(err)
-			Expect(f).To(BeNil())
-		})
-	})
-
 	Context("aws", func() {
 
 		It("will not err if aws.WrapAsApiGateway is not configured on single destination route", func() {
@@ -0,0 +1,150 @@
+package eureka
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+)
+
+const requestTimeout = 10 * time.Second
+
+var (
+	UnexpectedStatusError = func(serviceUrl string, status int) error {
+		return eris.Errorf("Eureka server %s responded with status %d", serviceUrl, status)
+	}
+)
+
+// client queries the REST API of Eureka servers
+// https://github.com/Netflix/eureka/wiki/Eureka-REST-operations
+type client struct {
+	httpClient *http.Client
+}
+
+func newClient() *client {
+	return &client{
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// instances returns the instances of an application, as registered in the first of the Eureka servers that responds.
+// An application that isn't registered has no instances.
+func (c *client) instances(ctx context.Context, serviceUrls []string, appName string) ([]*instance, error) {
+	var errs *multierror.Error
+	for _, serviceUrl := range serviceUrls {
+		instances, err := c.appInstances(ctx, serviceUrl, appName)
+		if err == nil {
+			return instances, nil
+		}
+		errs = multierror.Append(errs, err)
+	}
+	return nil, errs.ErrorOrNil()
+}
+
+func (c *client) appInstances(ctx context.Context, serviceUrl, appName string) ([]*instance, error) {
+	appUrl := strings.TrimSuffix(serviceUrl, "/") + "/apps/" + url.PathEscape(strings.ToUpper(appName))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, appUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, UnexpectedStatusError(serviceUrl, resp.StatusCode)
+	}
+
+	var body struct {
+		Application struct {
+			Instance instances `json:"instance"`
+		} `json:"application"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, eris.Wrapf(err, "decoding the instances of %s from Eureka server %s", appName, serviceUrl)
+	}
+	return body.Application.Instance, nil
+}
+
+// instance is an instance of an application, as serialized by Eureka
+type instance struct {
+	InstanceId     string            `json:"instanceId"`
+	HostName       string            `json:"hostName"`
+	IpAddr         string            `json:"ipAddr"`
+	Status         string            `json:"status"`
+	Port           port              `json:"port"`
+	SecurePort     port              `json:"securePort"`
+	Metadata       map[string]string `json:"metadata"`
+	DataCenterInfo struct {
+		Metadata map[string]string `json:"metadata"`
+	} `json:"dataCenterInfo"`
+}
+
+// id returns the id of an instance, which older Eureka servers don't report
+func (i *instance) id() string {
+	if i.InstanceId != "" {
+		return i.InstanceId
+	}
+	return i.HostName + ":" + strconv.Itoa(int(i.Port.Number))
+}
+
+// instances is the list of instances of an application.
+// Eureka serializes a list of a single instance as that instance.
+type instances []*instance
+
+func (i *instances) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		var single instance
+		if err := json.Unmarshal(data, &single); err != nil {
+			return err
+		}
+		*i = instances{&single}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]*instance)(i))
+}
+
+// port is a port of an instance, which Eureka serializes as {"$": 8080, "@enabled": "true"}.
+// Depending on the version of Eureka, both values may be strings or not.
+type port struct {
+	Number  uint32
+	Enabled bool
+}
+
+func (p *port) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Number  json.RawMessage `json:"$"`
+		Enabled json.RawMessage `json:"@enabled"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw.Number) == 0 {
+		return nil
+	}
+	number, err := strconv.ParseUint(unquote(raw.Number), 10, 32)
+	if err != nil {
+		return eris.Wrapf(err, "parsing port %s", data)
+	}
+	p.Number = uint32(number)
+	p.Enabled = unquote(raw.Enabled) == "true"
+	return nil
+}
+
+func unquote(raw json.RawMessage) string {
+	return strings.Trim(string(raw), `"`)
+}
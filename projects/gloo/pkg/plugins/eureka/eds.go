@@ -0,0 +1,139 @@
+package eureka
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/k8s-utils/kubeutils"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/eureka"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const (
+	// DefaultRefreshInterval is the registry fetch interval of Eureka clients
+	DefaultRefreshInterval = 30 * time.Second
+
+	// ZoneLabel is the label of the endpoints whose value is the zone of their instance
+	ZoneLabel = "zone"
+
+	// the metadata key of the zone of an instance, set by Spring Cloud Netflix
+	zoneMetadataKey = "zone"
+	// the metadata key of the zone of the Amazon data center of an instance
+	availabilityZoneMetadataKey = "availability-zone"
+
+	statusUp = "UP"
+)
+
+// TODO[eds enhancement] - update the EDS interface to include a registration function which would ensure uniqueness among prefixes
+const eurekaEndpointNamePrefix = "eureka"
+
+// EDS API
+// start the EDS watch which sends a new list of endpoints on any change
+func (p *plugin) WatchEndpoints(writeNamespace string, unfilteredUpstreams v1.UpstreamList, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
+	contextutils.LoggerFrom(opts.Ctx).Debugw("calling WatchEndpoints on Eureka")
+	var eurekaUpstreams v1.UpstreamList
+	for _, upstream := range unfilteredUpstreams {
+		if _, ok := upstream.GetUpstreamType().(*v1.Upstream_Eureka); ok {
+			eurekaUpstreams = append(eurekaUpstreams, upstream)
+		}
+	}
+	client := newClient()
+	endpoints, errs := discovery.PollEndpoints(opts.Ctx, eurekaUpstreams, func(ctx context.Context, upstream *v1.Upstream) (v1.EndpointList, time.Duration, error) {
+		spec := upstream.GetEureka()
+		refreshInterval := DefaultRefreshInterval
+		if interval := spec.GetRefreshInterval(); interval != nil && interval.AsDuration() > 0 {
+			refreshInterval = interval.AsDuration()
+		}
+		if err := validateSpec(spec); err != nil {
+			return nil, refreshInterval, err
+		}
+		instances, err := client.instances(ctx, spec.GetServiceUrls(), spec.GetAppName())
+		if err != nil {
+			return nil, refreshInterval, err
+		}
+		return buildEndpoints(writeNamespace, upstream, instances), refreshInterval, nil
+	})
+	return endpoints, errs, nil
+}
+
+func validateSpec(spec *eureka.UpstreamSpec) error {
+	if spec.GetAppName() == "" {
+		return MissingAppNameError
+	}
+	if len(spec.GetServiceUrls()) == 0 {
+		return MissingServiceUrlsError
+	}
+	return nil
+}
+
+func buildEndpoints(writeNamespace string, upstream *v1.Upstream, instances []*instance) v1.EndpointList {
+	upstreamRef := upstream.GetMetadata().Ref()
+	useSecurePort := upstream.GetEureka().GetUseSecurePort()
+
+	var endpoints v1.EndpointList
+	for _, inst := range instances {
+		if !strings.EqualFold(inst.Status, statusUp) {
+			continue
+		}
+		port := inst.Port
+		if useSecurePort {
+			port = inst.SecurePort
+		}
+		if !port.Enabled || port.Number == 0 {
+			continue
+		}
+		address := inst.IpAddr
+		if address == "" {
+			address = inst.HostName
+		}
+		endpoints = append(endpoints, &v1.Endpoint{
+			Metadata: &core.Metadata{
+				Name:      generateName(upstreamRef, inst.id()),
+				Namespace: writeNamespace,
+				Labels:    instanceLabels(inst),
+			},
+			Upstreams: []*core.ResourceRef{upstreamRef},
+			Address:   address,
+			Port:      port.Number,
+			Hostname:  inst.HostName,
+		})
+	}
+	return endpoints
+}
+
+// instanceLabels returns the metadata of an instance, and its zone
+func instanceLabels(inst *instance) map[string]string {
+	labels := map[string]string{}
+	for key, value := range inst.Metadata {
+		// Eureka serializes the class of empty metadata maps as "@class"
+		if strings.HasPrefix(key, "@") {
+			continue
+		}
+		labels[key] = value
+	}
+	zone := inst.Metadata[zoneMetadataKey]
+	if zone == "" {
+		zone = inst.DataCenterInfo.Metadata[availabilityZoneMetadataKey]
+	}
+	if zone != "" {
+		labels[ZoneLabel] = zone
+	}
+	return labels
+}
+
+func generateName(upstreamRef *core.ResourceRef, instanceId string) string {
+	return kubeutils.SanitizeNameV2(fmt.Sprintf(
+		"%v-name-%s-namespace-%s-%v",
+		eurekaEndpointNamePrefix,
+		upstreamRef.GetName(),
+		upstreamRef.GetNamespace(),
+		instanceId,
+	))
+}
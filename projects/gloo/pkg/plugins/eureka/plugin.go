@@ -0,0 +1,96 @@
+package eureka
+
+import (
+	"reflect"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+)
+
+var (
+	_ plugins.Plugin            = new(plugin)
+	_ plugins.UpstreamPlugin    = new(plugin)
+	_ discovery.DiscoveryPlugin = new(plugin)
+)
+
+const (
+	ExtensionName = "eureka"
+)
+
+/*
+Steps:
+- User creates a Eureka upstream
+  - names the application, and the Eureka servers it is registered in
+- Discovery polls the Eureka servers for the instances of the application
+- Gloo plugin creates an endpoint for each instance that is UP
+*/
+
+type plugin struct {
+	settings *v1.Settings
+}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(params plugins.InitParams) {
+	p.settings = params.Settings
+}
+
+// Eureka upstreams are created by the user, not discovered
+func (p *plugin) DiscoverUpstreams(watchNamespaces []string, writeNamespace string, opts clients.WatchOpts, discOpts discovery.Opts) (chan v1.UpstreamList, chan error, error) {
+	return nil, nil, nil
+}
+
+// we do not need to update any fields, just check that the input is valid
+func (p *plugin) UpdateUpstream(original, desired *v1.Upstream) (bool, error) {
+	originalSpec, ok := original.GetUpstreamType().(*v1.Upstream_Eureka)
+	if !ok {
+		return false, WrongUpstreamTypeError(original)
+	}
+	desiredSpec, ok := desired.GetUpstreamType().(*v1.Upstream_Eureka)
+	if !ok {
+		return false, WrongUpstreamTypeError(desired)
+	}
+	if !originalSpec.Eureka.Equal(desiredSpec.Eureka) {
+		return false, UpstreamDeltaError()
+	}
+	return false, nil
+}
+
+func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
+	spec := in.GetEureka()
+	if spec == nil {
+		return nil
+	}
+	if err := validateSpec(spec); err != nil {
+		return err
+	}
+
+	// configure the cluster to use EDS:ADS and call it a day
+	xds.SetEdsOnCluster(out, p.settings)
+	return nil
+}
+
+var (
+	WrongUpstreamTypeError = func(upstream *v1.Upstream) error {
+		return eris.Errorf("internal error: expected *v1.Upstream_Eureka, got %v", reflect.TypeOf(upstream.GetUpstreamType()).Name())
+	}
+
+	UpstreamDeltaError = func() error {
+		return eris.New("expected no difference between *v1.Upstream_Eureka upstreams")
+	}
+
+	MissingAppNameError = eris.New("a Eureka upstream must have an appName")
+
+	MissingServiceUrlsError = eris.New("a Eureka upstream must have at least one serviceUrl")
+)
@@ -0,0 +1,206 @@
+package eureka
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/eureka"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		p        *plugin
+		upstream *v1.Upstream
+	)
+
+	BeforeEach(func() {
+		p = NewPlugin()
+		p.Init(plugins.InitParams{Settings: &v1.Settings{}})
+		upstream = &v1.Upstream{
+			Metadata: &core.Metadata{
+				Name:      "payments",
+				Namespace: "gloo-system",
+			},
+			UpstreamType: &v1.Upstream_Eureka{
+				Eureka: &eureka.UpstreamSpec{
+					ServiceUrls: []string{"http://eureka:8761/eureka"},
+					AppName:     "payments",
+				},
+			},
+		}
+	})
+
+	Context("ProcessUpstream", func() {
+
+		It("configures the cluster to use EDS", func() {
+			out := &envoy_config_cluster_v3.Cluster{}
+			err := p.ProcessUpstream(plugins.Params{}, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetType()).To(Equal(envoy_config_cluster_v3.Cluster_EDS))
+			Expect(out.GetEdsClusterConfig()).NotTo(BeNil())
+		})
+
+		It("errors without an app name", func() {
+			upstream.GetEureka().AppName = ""
+			err := p.ProcessUpstream(plugins.Params{}, upstream, &envoy_config_cluster_v3.Cluster{})
+			Expect(err).To(MatchError(MissingAppNameError))
+		})
+
+		It("errors without service urls", func() {
+			upstream.GetEureka().ServiceUrls = nil
+			err := p.ProcessUpstream(plugins.Params{}, upstream, &envoy_config_cluster_v3.Cluster{})
+			Expect(err).To(MatchError(MissingServiceUrlsError))
+		})
+
+		It("ignores other upstreams", func() {
+			upstream.UpstreamType = &v1.Upstream_Static{}
+			out := &envoy_config_cluster_v3.Cluster{}
+			err := p.ProcessUpstream(plugins.Params{}, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetEdsClusterConfig()).To(BeNil())
+		})
+	})
+
+	Context("WatchEndpoints", func() {
+
+		var (
+			ctx    context.Context
+			cancel context.CancelFunc
+
+			response atomic.Value
+			server   *httptest.Server
+		)
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithCancel(context.Background())
+			response.Store(`{"application": {"name": "PAYMENTS", "instance": [
+				{
+					"instanceId": "payments-1",
+					"hostName": "payments-1.internal",
+					"ipAddr": "10.0.0.1",
+					"status": "UP",
+					"port": {"$": 8080, "@enabled": "true"},
+					"securePort": {"$": 8443, "@enabled": "true"},
+					"metadata": {"version": "v1", "zone": "us-east-1a"}
+				},
+				{
+					"instanceId": "payments-2",
+					"hostName": "payments-2.internal",
+					"ipAddr": "10.0.0.2",
+					"status": "DOWN",
+					"port": {"$": 8080, "@enabled": "true"},
+					"securePort": {"$": 8443, "@enabled": "false"}
+				},
+				{
+					"instanceId": "payments-3",
+					"hostName": "payments-3.internal",
+					"ipAddr": "10.0.0.3",
+					"status": "UP",
+					"port": {"$": "8081", "@enabled": "true"},
+					"securePort": {"$": "8443", "@enabled": "false"},
+					"metadata": {"@class": "java.util.Collections$EmptyMap"},
+					"dataCenterInfo": {"name": "Amazon", "metadata": {"availability-zone": "us-east-1b"}}
+				}
+			]}}`)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/eureka/apps/PAYMENTS" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				Expect(r.Header.Get("Accept")).To(Equal("application/json"))
+				_, _ = w.Write([]byte(response.Load().(string)))
+			}))
+			upstream.GetEureka().ServiceUrls = []string{server.URL + "/eureka"}
+		})
+
+		AfterEach(func() {
+			cancel()
+			server.Close()
+		})
+
+		watchEndpoints := func() <-chan v1.EndpointList {
+			endpoints, errs, err := p.WatchEndpoints("gloo-system", v1.UpstreamList{upstream}, clients.WatchOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			go func() {
+				defer GinkgoRecover()
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case err := <-errs:
+						Fail(err.Error())
+					}
+				}
+			}()
+			return endpoints
+		}
+
+		It("creates an endpoint for each instance that is up", func() {
+			var endpoints v1.EndpointList
+			Eventually(watchEndpoints()).Should(Receive(&endpoints))
+			Expect(endpoints).To(HaveLen(2))
+
+			Expect(endpoints[0].GetAddress()).To(Equal("10.0.0.1"))
+			Expect(endpoints[0].GetPort()).To(BeEquivalentTo(8080))
+			Expect(endpoints[0].GetHostname()).To(Equal("payments-1.internal"))
+			Expect(endpoints[0].GetUpstreams()).To(ConsistOf(upstream.GetMetadata().Ref()))
+			Expect(endpoints[0].GetMetadata().GetNamespace()).To(Equal("gloo-system"))
+			Expect(endpoints[0].GetMetadata().GetLabels()).To(Equal(map[string]string{"version": "v1", ZoneLabel: "us-east-1a"}))
+
+			Expect(endpoints[1].GetAddress()).To(Equal("10.0.0.3"))
+			Expect(endpoints[1].GetPort()).To(BeEquivalentTo(8081))
+			Expect(endpoints[1].GetMetadata().GetLabels()).To(Equal(map[string]string{ZoneLabel: "us-east-1b"}))
+		})
+
+		It("uses the secure port of the instances that enable it", func() {
+			upstream.GetEureka().UseSecurePort = true
+			var endpoints v1.EndpointList
+			Eventually(watchEndpoints()).Should(Receive(&endpoints))
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].GetAddress()).To(Equal("10.0.0.1"))
+			Expect(endpoints[0].GetPort()).To(BeEquivalentTo(8443))
+		})
+
+		It("reads an application with a single instance", func() {
+			response.Store(`{"application": {"name": "PAYMENTS", "instance": {
+				"hostName": "payments-1.internal",
+				"ipAddr": "10.0.0.1",
+				"status": "UP",
+				"port": {"$": 8080, "@enabled": "true"}
+			}}}`)
+			var endpoints v1.EndpointList
+			Eventually(watchEndpoints()).Should(Receive(&endpoints))
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].GetMetadata().GetName()).To(Equal(generateName(upstream.GetMetadata().Ref(), "payments-1.internal:8080")))
+		})
+
+		It("has no endpoints for an application that isn't registered", func() {
+			upstream.GetEureka().AppName = "orders"
+			var endpoints v1.EndpointList
+			Eventually(watchEndpoints()).Should(Receive(&endpoints))
+			Expect(endpoints).To(BeEmpty())
+		})
+
+		It("fails over to the next Eureka server", func() {
+			unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			defer unavailable.Close()
+			upstream.GetEureka().ServiceUrls = []string{unavailable.URL + "/eureka", server.URL + "/eureka"}
+
+			var endpoints v1.EndpointList
+			Eventually(watchEndpoints()).Should(Receive(&endpoints))
+			Expect(endpoints).To(HaveLen(2))
+		})
+	})
+})
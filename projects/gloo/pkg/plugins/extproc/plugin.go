@@ -0,0 +1,104 @@
+package extproc
+
+import (
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extproc"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var (
+	_ plugins.Plugin            = new(plugin)
+	_ plugins.HttpFilterPlugin  = new(plugin)
+	_ plugins.VirtualHostPlugin = new(plugin)
+	_ plugins.RoutePlugin       = new(plugin)
+)
+
+const (
+	ExtensionName = "extproc"
+	FilterName    = "envoy.filters.http.ext_proc"
+)
+
+var (
+	UpstreamNotFoundError = func(err error) error {
+		return eris.Wrapf(err, "finding the ext_proc server upstream")
+	}
+)
+
+type plugin struct {
+	settings *extproc.Settings
+}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(params plugins.InitParams) {
+	p.settings = params.Settings.GetExtProc()
+}
+
+// HttpFilters adds the ext_proc filter configured by the listener, or else by the Settings
+func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	if listener.GetOptions().GetDisableExtProc().GetValue() {
+		return []plugins.StagedHttpFilter{}, nil
+	}
+	settings := listener.GetOptions().GetExtProc()
+	if settings == nil {
+		settings = p.settings
+	}
+	if settings == nil {
+		return []plugins.StagedHttpFilter{}, nil
+	}
+
+	filterStage, err := FilterStage(settings)
+	if err != nil {
+		return nil, err
+	}
+	externalProcessor, err := NewExternalProcessor(params.Ctx, settings, upstreamClusterName(params))
+	if err != nil {
+		return nil, err
+	}
+	extProcFilter, err := plugins.NewStagedFilter(FilterName, externalProcessor, filterStage)
+	if err != nil {
+		return nil, eris.Wrap(err, "generating filter config")
+	}
+	return []plugins.StagedHttpFilter{extProcFilter}, nil
+}
+
+func (p *plugin) ProcessVirtualHost(
+	params plugins.VirtualHostParams,
+	in *v1.VirtualHost,
+	out *envoy_config_route_v3.VirtualHost,
+) error {
+	perRoute, err := NewExtProcPerRoute(in.GetOptions().GetExtProc(), upstreamClusterName(params.Params))
+	if err != nil || perRoute == nil {
+		return err
+	}
+	return pluginutils.SetVhostPerFilterConfig(out, FilterName, perRoute)
+}
+
+func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	perRoute, err := NewExtProcPerRoute(in.GetOptions().GetExtProc(), upstreamClusterName(params.Params))
+	if err != nil || perRoute == nil {
+		return err
+	}
+	return pluginutils.SetRoutePerFilterConfig(out, FilterName, perRoute)
+}
+
+// upstreamClusterName resolves the cluster of an Upstream of the snapshot
+func upstreamClusterName(params plugins.Params) ClusterNameFunc {
+	return func(ref *core.ResourceRef) (string, error) {
+		if _, err := params.Snapshot.Upstreams.Find(ref.GetNamespace(), ref.GetName()); err != nil {
+			return "", UpstreamNotFoundError(err)
+		}
+		return translator.UpstreamToClusterName(ref), nil
+	}
+}
@@ -0,0 +1,167 @@
+package extproc_test
+
+import (
+	"context"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyextproc "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	solo_ext_proc "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/ext_proc/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extproc"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/filters"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/extproc"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/test/matchers"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		p        plugins.Plugin
+		upstream *v1.Upstream
+		params   plugins.Params
+		settings *extproc.Settings
+	)
+
+	BeforeEach(func() {
+		p = NewPlugin()
+		upstream = &v1.Upstream{
+			Metadata: &core.Metadata{Name: "ext-proc", Namespace: "gloo-system"},
+		}
+		params = plugins.Params{
+			Ctx:      context.TODO(),
+			Snapshot: &v1snap.ApiSnapshot{Upstreams: v1.UpstreamList{upstream}},
+		}
+		settings = &extproc.Settings{
+			GrpcService: &extproc.GrpcService{
+				ExtProcServerRef: upstream.GetMetadata().Ref(),
+				Timeout:          durationpb.New(time.Second),
+			},
+			FilterStage: &filters.FilterStage{
+				Stage:     filters.FilterStage_AuthZStage,
+				Predicate: filters.FilterStage_After,
+			},
+			FailureModeAllow: &wrapperspb.BoolValue{Value: true},
+			ProcessingMode: &solo_ext_proc.ProcessingMode{
+				RequestHeaderMode: solo_ext_proc.ProcessingMode_SEND,
+				RequestBodyMode:   solo_ext_proc.ProcessingMode_BUFFERED,
+			},
+			MessageTimeout: durationpb.New(500 * time.Millisecond),
+		}
+	})
+
+	httpFilters := func(listener *v1.HttpListener) []plugins.StagedHttpFilter {
+		filters, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, listener)
+		Expect(err).NotTo(HaveOccurred())
+		return filters
+	}
+
+	It("adds the filter configured by the settings", func() {
+		p.Init(plugins.InitParams{Ctx: context.TODO(), Settings: &v1.Settings{ExtProc: settings}})
+		filters := httpFilters(&v1.HttpListener{})
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].Stage).To(Equal(plugins.AfterStage(plugins.AuthZStage)))
+
+		var externalProcessor envoyextproc.ExternalProcessor
+		Expect(filters[0].Filter.GetTypedConfig().UnmarshalTo(&externalProcessor)).To(Succeed())
+		Expect(&externalProcessor).To(matchers.MatchProto(&envoyextproc.ExternalProcessor{
+			GrpcService: &envoy_config_core_v3.GrpcService{
+				TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &envoy_config_core_v3.GrpcService_EnvoyGrpc{
+						ClusterName: translator.UpstreamToClusterName(upstream.GetMetadata().Ref()),
+					},
+				},
+				Timeout: durationpb.New(time.Second),
+			},
+			FailureModeAllow: true,
+			ProcessingMode: &envoyextproc.ProcessingMode{
+				RequestHeaderMode: envoyextproc.ProcessingMode_SEND,
+				RequestBodyMode:   envoyextproc.ProcessingMode_BUFFERED,
+			},
+			MessageTimeout: durationpb.New(500 * time.Millisecond),
+		}))
+	})
+
+	It("uses the settings of the listener over the ones of the settings", func() {
+		p.Init(plugins.InitParams{Ctx: context.TODO(), Settings: &v1.Settings{}})
+		Expect(httpFilters(&v1.HttpListener{})).To(BeEmpty())
+
+		filters := httpFilters(&v1.HttpListener{
+			Options: &v1.HttpListenerOptions{
+				ExtProcConfig: &v1.HttpListenerOptions_ExtProc{ExtProc: settings},
+			},
+		})
+		Expect(filters).To(HaveLen(1))
+	})
+
+	It("does not add the filter to listeners that disable it", func() {
+		p.Init(plugins.InitParams{Ctx: context.TODO(), Settings: &v1.Settings{ExtProc: settings}})
+		filters := httpFilters(&v1.HttpListener{
+			Options: &v1.HttpListenerOptions{
+				ExtProcConfig: &v1.HttpListenerOptions_DisableExtProc{DisableExtProc: &wrapperspb.BoolValue{Value: true}},
+			},
+		})
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("errors when the upstream of the gRPC service does not exist", func() {
+		p.Init(plugins.InitParams{Ctx: context.TODO(), Settings: &v1.Settings{ExtProc: settings}})
+		params.Snapshot = &v1snap.ApiSnapshot{}
+		_, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, &v1.HttpListener{})
+		Expect(err).To(MatchError(ContainSubstring("finding the ext_proc server upstream")))
+	})
+
+	It("disables the filter on a virtual host", func() {
+		p.Init(plugins.InitParams{Ctx: context.TODO(), Settings: &v1.Settings{ExtProc: settings}})
+		out := &envoy_config_route_v3.VirtualHost{}
+		err := p.(plugins.VirtualHostPlugin).ProcessVirtualHost(plugins.VirtualHostParams{Params: params}, &v1.VirtualHost{
+			Options: &v1.VirtualHostOptions{
+				ExtProc: &extproc.RouteSettings{
+					Override: &extproc.RouteSettings_Disabled{Disabled: &wrapperspb.BoolValue{Value: true}},
+				},
+			},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+
+		var perRoute envoyextproc.ExtProcPerRoute
+		Expect(out.GetTypedPerFilterConfig()[FilterName].UnmarshalTo(&perRoute)).To(Succeed())
+		Expect(perRoute.GetDisabled()).To(BeTrue())
+	})
+
+	It("overrides the processing mode and gRPC service on a route", func() {
+		p.Init(plugins.InitParams{Ctx: context.TODO(), Settings: &v1.Settings{ExtProc: settings}})
+		out := &envoy_config_route_v3.Route{}
+		err := p.(plugins.RoutePlugin).ProcessRoute(plugins.RouteParams{VirtualHostParams: plugins.VirtualHostParams{Params: params}}, &v1.Route{
+			Options: &v1.RouteOptions{
+				ExtProc: &extproc.RouteSettings{
+					Override: &extproc.RouteSettings_Overrides{
+						Overrides: &extproc.Overrides{
+							ProcessingMode: &solo_ext_proc.ProcessingMode{
+								ResponseBodyMode: solo_ext_proc.ProcessingMode_STREAMED,
+							},
+							GrpcService: &extproc.GrpcService{
+								ExtProcServerRef: upstream.GetMetadata().Ref(),
+								Authority:        &wrapperspb.StringValue{Value: "ext-proc.example.com"},
+							},
+						},
+					},
+				},
+			},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+
+		var perRoute envoyextproc.ExtProcPerRoute
+		Expect(out.GetTypedPerFilterConfig()[FilterName].UnmarshalTo(&perRoute)).To(Succeed())
+		Expect(perRoute.GetOverrides().GetProcessingMode().GetResponseBodyMode()).To(Equal(envoyextproc.ProcessingMode_STREAMED))
+		Expect(perRoute.GetOverrides().GetGrpcService().GetEnvoyGrpc().GetAuthority()).To(Equal("ext-proc.example.com"))
+	})
+})
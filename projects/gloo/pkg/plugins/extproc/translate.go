@@ -0,0 +1,215 @@
+package extproc
+
+import (
+	"context"
+
+	envoy_config_common_mutation_rules_v3 "github.com/envoyproxy/go-control-plane/envoy/config/common/mutation_rules/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyextproc "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/regexutils"
+	solo_mutation_rules "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/common/mutation_rules/v3"
+	solo_core "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	solo_ext_proc "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/ext_proc/v3"
+	solo_matcher "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extproc"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// ClusterNameFunc returns the name of the cluster of the Upstream that runs the external processor gRPC server
+type ClusterNameFunc func(ref *core.ResourceRef) (string, error)
+
+var (
+	NoGrpcServiceError = eris.New("ext_proc requires a gRPC service")
+	NoFilterStageError = eris.New("ext_proc requires a filter stage")
+)
+
+// FilterStage returns the stage of the HTTP filter chain the settings insert the ext_proc filter at
+func FilterStage(settings *extproc.Settings) (plugins.HTTPFilterStage, error) {
+	stage := plugins.ConvertFilterStage(settings.GetFilterStage())
+	if stage == nil {
+		return plugins.HTTPFilterStage{}, NoFilterStageError
+	}
+	return *stage, nil
+}
+
+// NewExternalProcessor translates the ext_proc settings into the config of the ext_proc filter
+func NewExternalProcessor(ctx context.Context, settings *extproc.Settings, clusterName ClusterNameFunc) (*envoyextproc.ExternalProcessor, error) {
+	grpcService, err := translateGrpcService(settings.GetGrpcService(), clusterName)
+	if err != nil {
+		return nil, err
+	}
+	if grpcService == nil {
+		return nil, NoGrpcServiceError
+	}
+
+	return &envoyextproc.ExternalProcessor{
+		GrpcService:            grpcService,
+		FailureModeAllow:       settings.GetFailureModeAllow().GetValue(),
+		ProcessingMode:         TranslateProcessingMode(settings.GetProcessingMode()),
+		MessageTimeout:         settings.GetMessageTimeout(),
+		StatPrefix:             settings.GetStatPrefix().GetValue(),
+		MutationRules:          translateMutationRules(ctx, settings.GetMutationRules()),
+		MaxMessageTimeout:      settings.GetMaxMessageTimeout(),
+		DisableClearRouteCache: settings.GetDisableClearRouteCache().GetValue(),
+		ForwardRules:           translateForwardRules(settings.GetForwardRules()),
+		FilterMetadata:         settings.GetFilterMetadata(),
+		AllowModeOverride:      settings.GetAllowModeOverride().GetValue(),
+		MetadataOptions:        translateMetadataOptions(settings.GetMetadataContextNamespaces(), settings.GetTypedMetadataContextNamespaces()),
+	}, nil
+}
+
+// NewExtProcPerRoute translates the ext_proc settings of a virtual host or route into the per-route config
+// of the ext_proc filter
+func NewExtProcPerRoute(routeSettings *extproc.RouteSettings, clusterName ClusterNameFunc) (*envoyextproc.ExtProcPerRoute, error) {
+	if routeSettings.GetDisabled().GetValue() {
+		return &envoyextproc.ExtProcPerRoute{
+			Override: &envoyextproc.ExtProcPerRoute_Disabled{Disabled: true},
+		}, nil
+	}
+
+	overrides := routeSettings.GetOverrides()
+	if overrides == nil {
+		return nil, nil
+	}
+	grpcService, err := translateGrpcService(overrides.GetGrpcService(), clusterName)
+	if err != nil {
+		return nil, err
+	}
+	return &envoyextproc.ExtProcPerRoute{
+		Override: &envoyextproc.ExtProcPerRoute_Overrides{
+			Overrides: &envoyextproc.ExtProcOverrides{
+				ProcessingMode:  TranslateProcessingMode(overrides.GetProcessingMode()),
+				GrpcService:     grpcService,
+				MetadataOptions: translateMetadataOptions(overrides.GetMetadataContextNamespaces(), overrides.GetTypedMetadataContextNamespaces()),
+			},
+		},
+	}, nil
+}
+
+// TranslateProcessingMode converts a processing mode, whose enums have the same values as Envoy's
+func TranslateProcessingMode(mode *solo_ext_proc.ProcessingMode) *envoyextproc.ProcessingMode {
+	if mode == nil {
+		return nil
+	}
+	return &envoyextproc.ProcessingMode{
+		RequestHeaderMode:   envoyextproc.ProcessingMode_HeaderSendMode(mode.GetRequestHeaderMode()),
+		ResponseHeaderMode:  envoyextproc.ProcessingMode_HeaderSendMode(mode.GetResponseHeaderMode()),
+		RequestBodyMode:     envoyextproc.ProcessingMode_BodySendMode(mode.GetRequestBodyMode()),
+		ResponseBodyMode:    envoyextproc.ProcessingMode_BodySendMode(mode.GetResponseBodyMode()),
+		RequestTrailerMode:  envoyextproc.ProcessingMode_HeaderSendMode(mode.GetRequestTrailerMode()),
+		ResponseTrailerMode: envoyextproc.ProcessingMode_HeaderSendMode(mode.GetResponseTrailerMode()),
+	}
+}
+
+func translateGrpcService(in *extproc.GrpcService, clusterName ClusterNameFunc) (*envoy_config_core_v3.GrpcService, error) {
+	if in == nil {
+		return nil, nil
+	}
+	cluster, err := clusterName(in.GetExtProcServerRef())
+	if err != nil {
+		return nil, err
+	}
+
+	out := &envoy_config_core_v3.GrpcService{
+		TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
+			EnvoyGrpc: &envoy_config_core_v3.GrpcService_EnvoyGrpc{
+				ClusterName: cluster,
+				Authority:   in.GetAuthority().GetValue(),
+				RetryPolicy: translateRetryPolicy(in.GetRetryPolicy()),
+			},
+		},
+		Timeout: in.GetTimeout(),
+	}
+	for _, header := range in.GetInitialMetadata() {
+		out.InitialMetadata = append(out.GetInitialMetadata(), &envoy_config_core_v3.HeaderValue{
+			Key:   header.GetKey(),
+			Value: header.GetValue(),
+		})
+	}
+	return out, nil
+}
+
+func translateRetryPolicy(in *solo_core.RetryPolicy) *envoy_config_core_v3.RetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := &envoy_config_core_v3.RetryPolicy{
+		NumRetries: in.GetNumRetries(),
+	}
+	if backOff := in.GetRetryBackOff(); backOff != nil {
+		out.RetryBackOff = &envoy_config_core_v3.BackoffStrategy{
+			BaseInterval: backOff.GetBaseInterval(),
+			MaxInterval:  backOff.GetMaxInterval(),
+		}
+	}
+	return out
+}
+
+func translateMutationRules(ctx context.Context, in *solo_mutation_rules.HeaderMutationRules) *envoy_config_common_mutation_rules_v3.HeaderMutationRules {
+	if in == nil {
+		return nil
+	}
+	out := &envoy_config_common_mutation_rules_v3.HeaderMutationRules{
+		AllowAllRouting: in.GetAllowAllRouting(),
+		AllowEnvoy:      in.GetAllowEnvoy(),
+		DisallowSystem:  in.GetDisallowSystem(),
+		DisallowAll:     in.GetDisallowAll(),
+		DisallowIsError: in.GetDisallowIsError(),
+	}
+	if in.GetAllowExpression() != nil {
+		out.AllowExpression = regexutils.NewRegex(ctx, in.GetAllowExpression().GetRegex())
+	}
+	if in.GetDisallowExpression() != nil {
+		out.DisallowExpression = regexutils.NewRegex(ctx, in.GetDisallowExpression().GetRegex())
+	}
+	return out
+}
+
+func translateForwardRules(in *extproc.HeaderForwardingRules) *envoyextproc.HeaderForwardingRules {
+	if in == nil {
+		return nil
+	}
+	return &envoyextproc.HeaderForwardingRules{
+		AllowedHeaders:    translateListStringMatcher(in.GetAllowedHeaders()),
+		DisallowedHeaders: translateListStringMatcher(in.GetDisallowedHeaders()),
+	}
+}
+
+func translateListStringMatcher(in *solo_matcher.ListStringMatcher) *envoy_type_matcher_v3.ListStringMatcher {
+	if in == nil {
+		return nil
+	}
+	out := &envoy_type_matcher_v3.ListStringMatcher{}
+	for _, pattern := range in.GetPatterns() {
+		matcher := &envoy_type_matcher_v3.StringMatcher{IgnoreCase: pattern.GetIgnoreCase()}
+		switch typed := pattern.GetMatchPattern().(type) {
+		case *solo_matcher.StringMatcher_Exact:
+			matcher.MatchPattern = &envoy_type_matcher_v3.StringMatcher_Exact{Exact: typed.Exact}
+		case *solo_matcher.StringMatcher_Prefix:
+			matcher.MatchPattern = &envoy_type_matcher_v3.StringMatcher_Prefix{Prefix: typed.Prefix}
+		case *solo_matcher.StringMatcher_Suffix:
+			matcher.MatchPattern = &envoy_type_matcher_v3.StringMatcher_Suffix{Suffix: typed.Suffix}
+		case *solo_matcher.StringMatcher_SafeRegex:
+			matcher.MatchPattern = &envoy_type_matcher_v3.StringMatcher_SafeRegex{
+				SafeRegex: &envoy_type_matcher_v3.RegexMatcher{Regex: typed.SafeRegex.GetRegex()},
+			}
+		}
+		out.Patterns = append(out.GetPatterns(), matcher)
+	}
+	return out
+}
+
+func translateMetadataOptions(untyped, typed []string) *envoyextproc.MetadataOptions {
+	if len(untyped) == 0 && len(typed) == 0 {
+		return nil
+	}
+	return &envoyextproc.MetadataOptions{
+		ForwardingNamespaces: &envoyextproc.MetadataOptions_MetadataNamespaces{
+			Untyped: untyped,
+			Typed:   typed,
+		},
+	}
+}
@@ -0,0 +1,396 @@
+package jwt
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyjwt "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	_ plugins.Plugin            = new(plugin)
+	_ plugins.HttpFilterPlugin  = new(plugin)
+	_ plugins.VirtualHostPlugin = new(plugin)
+	_ plugins.RoutePlugin       = new(plugin)
+)
+
+const (
+	ExtensionName = "jwt"
+
+	// BeforeExtAuthFilterName is the name of the jwt_authn filter that runs before the ext_authz filter
+	BeforeExtAuthFilterName = "envoy.filters.http.jwt_authn.before_ext_auth"
+	// AfterExtAuthFilterName is the name of the jwt_authn filter that runs after the ext_authz filter
+	AfterExtAuthFilterName = "envoy.filters.http.jwt_authn.after_ext_auth"
+
+	// PayloadMetadataNamespace is the dynamic metadata namespace the jwt_authn filters write the verified payloads to,
+	// each under the name of the provider that verified it
+	PayloadMetadataNamespace = "envoy.filters.http.jwt_authn"
+
+	// remoteJwksTimeout is the timeout of the requests that fetch a remote JWKS
+	remoteJwksTimeout = 5 * time.Second
+
+	// DefaultJwksSecretKey is the key of the data of the secrets that hold a local JWKS
+	DefaultJwksSecretKey = "jwks"
+)
+
+var (
+	// the ext_authz filter runs during the AuthNStage
+	beforeExtAuthStage = plugins.BeforeStage(plugins.AuthNStage)
+	afterExtAuthStage  = plugins.AfterStage(plugins.AuthNStage)
+)
+
+var (
+	UpstreamNotFoundError = func(providerName string, err error) error {
+		return eris.Wrapf(err, "finding the JWKS upstream of jwt provider %s", providerName)
+	}
+	SecretNotFoundError = func(providerName string, err error) error {
+		return eris.Wrapf(err, "finding the JWKS secret of jwt provider %s", providerName)
+	}
+	JwksNotInSecretError = func(providerName, key string) error {
+		return eris.Errorf("the JWKS secret of jwt provider %s has no %s key", providerName, key)
+	}
+	InlineAndSecretJwksError = func(providerName string) error {
+		return eris.Errorf("jwt provider %s has both an inline key and a secret ref for its local JWKS", providerName)
+	}
+	NoJwksError = func(providerName string) error {
+		return eris.Errorf("jwt provider %s has no JWKS", providerName)
+	}
+	ClaimAppendNotSupportedError = func(providerName, claim string) error {
+		return eris.Errorf("jwt provider %s: appending claim %s to a header is not supported, the header is always overwritten", providerName, claim)
+	}
+)
+
+// stageConfig accumulates the providers and requirements of the virtual hosts and routes of a listener,
+// for one of the jwt_authn filters
+type stageConfig struct {
+	providers    map[string]*envoyjwt.JwtProvider
+	requirements map[string]*envoyjwt.JwtRequirement
+	// routes counts the routes of the listener with their own providers, to name their requirements
+	routes int
+}
+
+type plugin struct {
+	beforeExtAuth map[*v1.HttpListener]*stageConfig
+	afterExtAuth  map[*v1.HttpListener]*stageConfig
+}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) {
+	p.beforeExtAuth = make(map[*v1.HttpListener]*stageConfig)
+	p.afterExtAuth = make(map[*v1.HttpListener]*stageConfig)
+}
+
+func (p *plugin) ProcessVirtualHost(
+	params plugins.VirtualHostParams,
+	in *v1.VirtualHost,
+	out *envoy_config_route_v3.VirtualHost,
+) error {
+	var beforeExtAuth, afterExtAuth *jwt.VhostExtension
+	switch jwtConfig := in.GetOptions().GetJwtConfig().(type) {
+	case *v1.VirtualHostOptions_Jwt:
+		afterExtAuth = jwtConfig.Jwt
+	case *v1.VirtualHostOptions_JwtStaged:
+		beforeExtAuth = jwtConfig.JwtStaged.GetBeforeExtAuth()
+		afterExtAuth = jwtConfig.JwtStaged.GetAfterExtAuth()
+	default:
+		return nil
+	}
+
+	if beforeExtAuth != nil {
+		perRoute, err := p.stage(p.beforeExtAuth, params.HttpListener).addRequirement(params.Params, in.GetName(), beforeExtAuth)
+		if err != nil {
+			return err
+		}
+		if err := pluginutils.SetVhostPerFilterConfig(out, BeforeExtAuthFilterName, perRoute); err != nil {
+			return err
+		}
+	}
+	if afterExtAuth != nil {
+		perRoute, err := p.stage(p.afterExtAuth, params.HttpListener).addRequirement(params.Params, in.GetName(), afterExtAuth)
+		if err != nil {
+			return err
+		}
+		if err := pluginutils.SetVhostPerFilterConfig(out, AfterExtAuthFilterName, perRoute); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	switch jwtConfig := in.GetOptions().GetJwtConfig().(type) {
+	case *v1.RouteOptions_Jwt:
+		return p.disableOnRoute(params, AfterExtAuthFilterName, p.afterExtAuth, jwtConfig.Jwt, out)
+	case *v1.RouteOptions_JwtStaged:
+		if err := p.disableOnRoute(params, BeforeExtAuthFilterName, p.beforeExtAuth, jwtConfig.JwtStaged.GetBeforeExtAuth(), out); err != nil {
+			return err
+		}
+		return p.disableOnRoute(params, AfterExtAuthFilterName, p.afterExtAuth, jwtConfig.JwtStaged.GetAfterExtAuth(), out)
+	case *v1.RouteOptions_JwtProvidersStaged:
+		if err := p.requireOnRoute(params, BeforeExtAuthFilterName, p.beforeExtAuth, jwtConfig.JwtProvidersStaged.GetBeforeExtAuth(), out); err != nil {
+			return err
+		}
+		return p.requireOnRoute(params, AfterExtAuthFilterName, p.afterExtAuth, jwtConfig.JwtProvidersStaged.GetAfterExtAuth(), out)
+	}
+	return nil
+}
+
+// disableOnRoute disables a jwt_authn filter on a route, if the route extension says so
+func (p *plugin) disableOnRoute(
+	params plugins.RouteParams,
+	filterName string,
+	stages map[*v1.HttpListener]*stageConfig,
+	routeExtension *jwt.RouteExtension,
+	out *envoy_config_route_v3.Route,
+) error {
+	if !routeExtension.GetDisable() {
+		return nil
+	}
+	// the filter is added to the listener, so that the route can be translated even if no virtual host requires a JWT
+	p.stage(stages, params.HttpListener)
+	return pluginutils.SetRoutePerFilterConfig(out, filterName, &envoyjwt.PerRouteConfig{
+		RequirementSpecifier: &envoyjwt.PerRouteConfig_Disabled{Disabled: true},
+	})
+}
+
+// requireOnRoute requires a route to present a JWT of the providers of the extension, instead of the ones of its virtual host
+func (p *plugin) requireOnRoute(
+	params plugins.RouteParams,
+	filterName string,
+	stages map[*v1.HttpListener]*stageConfig,
+	vhostExtension *jwt.VhostExtension,
+	out *envoy_config_route_v3.Route,
+) error {
+	if vhostExtension == nil {
+		return nil
+	}
+	stage := p.stage(stages, params.HttpListener)
+	stage.routes++
+	requirementName := fmt.Sprintf("%s_route_%d", params.VirtualHost.GetName(), stage.routes)
+	perRoute, err := stage.addRequirement(params.Params, requirementName, vhostExtension)
+	if err != nil {
+		return err
+	}
+	return pluginutils.SetRoutePerFilterConfig(out, filterName, perRoute)
+}
+
+func (p *plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	var filters []plugins.StagedHttpFilter
+	if stage, ok := p.beforeExtAuth[listener]; ok {
+		filter, err := plugins.NewStagedFilter(BeforeExtAuthFilterName, stage.filterConfig(), beforeExtAuthStage)
+		if err != nil {
+			return nil, eris.Wrap(err, "generating filter config")
+		}
+		filters = append(filters, filter)
+	}
+	if stage, ok := p.afterExtAuth[listener]; ok {
+		filter, err := plugins.NewStagedFilter(AfterExtAuthFilterName, stage.filterConfig(), afterExtAuthStage)
+		if err != nil {
+			return nil, eris.Wrap(err, "generating filter config")
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+func (p *plugin) stage(stages map[*v1.HttpListener]*stageConfig, listener *v1.HttpListener) *stageConfig {
+	stage, ok := stages[listener]
+	if !ok {
+		stage = &stageConfig{
+			providers:    map[string]*envoyjwt.JwtProvider{},
+			requirements: map[string]*envoyjwt.JwtRequirement{},
+		}
+		stages[listener] = stage
+	}
+	return stage
+}
+
+// addRequirement translates the providers of the extension, and adds a requirement that a request presents a JWT
+// of any of them, as allowed by the validation policy. It returns the config that applies the requirement to a
+// virtual host or route.
+func (s *stageConfig) addRequirement(params plugins.Params, requirementName string, vhostExtension *jwt.VhostExtension) (*envoyjwt.PerRouteConfig, error) {
+	names := make([]string, 0, len(vhostExtension.GetProviders()))
+	for name := range vhostExtension.GetProviders() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var requirements []*envoyjwt.JwtRequirement
+	for _, name := range names {
+		provider, err := translateProvider(params, name, vhostExtension.GetProviders()[name])
+		if err != nil {
+			return nil, err
+		}
+		// providers are named by requirement, since providers of different virtual hosts may share a name
+		providerName := requirementName + "_" + name
+		s.providers[providerName] = provider
+		requirements = append(requirements, &envoyjwt.JwtRequirement{
+			RequiresType: &envoyjwt.JwtRequirement_ProviderName{ProviderName: providerName},
+		})
+	}
+
+	policy := vhostExtension.GetValidationPolicy()
+	if vhostExtension.GetAllowMissingOrFailedJwt() { //nolint:staticcheck // the deprecated field takes precedence
+		policy = jwt.VhostExtension_ALLOW_MISSING_OR_FAILED
+	}
+	switch policy {
+	case jwt.VhostExtension_ALLOW_MISSING:
+		requirements = append(requirements, &envoyjwt.JwtRequirement{
+			RequiresType: &envoyjwt.JwtRequirement_AllowMissing{AllowMissing: &emptypb.Empty{}},
+		})
+	case jwt.VhostExtension_ALLOW_MISSING_OR_FAILED:
+		requirements = append(requirements, &envoyjwt.JwtRequirement{
+			RequiresType: &envoyjwt.JwtRequirement_AllowMissingOrFailed{AllowMissingOrFailed: &emptypb.Empty{}},
+		})
+	}
+
+	switch len(requirements) {
+	case 0:
+		// no provider can verify a JWT, so none is required
+		return &envoyjwt.PerRouteConfig{
+			RequirementSpecifier: &envoyjwt.PerRouteConfig_Disabled{Disabled: true},
+		}, nil
+	case 1:
+		s.requirements[requirementName] = requirements[0]
+	default:
+		s.requirements[requirementName] = &envoyjwt.JwtRequirement{
+			RequiresType: &envoyjwt.JwtRequirement_RequiresAny{
+				RequiresAny: &envoyjwt.JwtRequirementOrList{Requirements: requirements},
+			},
+		}
+	}
+	return &envoyjwt.PerRouteConfig{
+		RequirementSpecifier: &envoyjwt.PerRouteConfig_RequirementName{RequirementName: requirementName},
+	}, nil
+}
+
+func (s *stageConfig) filterConfig() *envoyjwt.JwtAuthentication {
+	return &envoyjwt.JwtAuthentication{
+		Providers:      s.providers,
+		RequirementMap: s.requirements,
+		// CORS preflight requests never carry a JWT
+		BypassCorsPreflight: true,
+	}
+}
+
+func translateProvider(params plugins.Params, name string, provider *jwt.Provider) (*envoyjwt.JwtProvider, error) {
+	out := &envoyjwt.JwtProvider{
+		Issuer:    provider.GetIssuer(),
+		Audiences: provider.GetAudiences(),
+		Forward:   provider.GetKeepToken(),
+		// the verified payload can be matched by other filters, e.g. rbac
+		PayloadInMetadata: name,
+		ClockSkewSeconds:  provider.GetClockSkewSeconds().GetValue(),
+	}
+	if provider.GetClockSkewSeconds() == nil {
+		out.ClockSkewSeconds = 60
+	}
+
+	for _, header := range provider.GetTokenSource().GetHeaders() {
+		out.FromHeaders = append(out.FromHeaders, &envoyjwt.JwtHeader{
+			Name:        header.GetHeader(),
+			ValuePrefix: header.GetPrefix(),
+		})
+	}
+	out.FromParams = provider.GetTokenSource().GetQueryParams()
+
+	for _, claimToHeader := range provider.GetClaimsToHeaders() {
+		if claimToHeader.GetAppend() {
+			return nil, ClaimAppendNotSupportedError(name, claimToHeader.GetClaim())
+		}
+		out.ClaimToHeaders = append(out.ClaimToHeaders, &envoyjwt.JwtClaimToHeader{
+			HeaderName: claimToHeader.GetHeader(),
+			ClaimName:  claimToHeader.GetClaim(),
+		})
+	}
+
+	switch jwks := provider.GetJwks().GetJwks().(type) {
+	case *jwt.Jwks_Remote:
+		remoteJwks, err := translateRemoteJwks(params, name, jwks.Remote)
+		if err != nil {
+			return nil, err
+		}
+		out.JwksSourceSpecifier = &envoyjwt.JwtProvider_RemoteJwks{RemoteJwks: remoteJwks}
+	case *jwt.Jwks_Local:
+		key, err := localJwksKey(params, name, jwks.Local)
+		if err != nil {
+			return nil, err
+		}
+		out.JwksSourceSpecifier = &envoyjwt.JwtProvider_LocalJwks{
+			LocalJwks: &envoy_config_core_v3.DataSource{
+				Specifier: &envoy_config_core_v3.DataSource_InlineString{InlineString: key},
+			},
+		}
+	default:
+		return nil, NoJwksError(name)
+	}
+	return out, nil
+}
+
+// localJwksKey returns the inline key of a local JWKS, or the one of its secret.
+// Opaque Kubernetes secrets are header secrets in the snapshot, whose headers are the data of the secret.
+func localJwksKey(params plugins.Params, name string, local *jwt.LocalJwks) (string, error) {
+	secretRef := local.GetSecretRef()
+	if secretRef == nil {
+		return local.GetKey(), nil
+	}
+	if local.GetKey() != "" {
+		return "", InlineAndSecretJwksError(name)
+	}
+
+	secret, err := params.Snapshot.Secrets.Find(secretRef.GetNamespace(), secretRef.GetName())
+	if err != nil {
+		return "", SecretNotFoundError(name, err)
+	}
+	secretKey := local.GetSecretKey()
+	if secretKey == "" {
+		secretKey = DefaultJwksSecretKey
+	}
+	key, ok := secret.GetHeader().GetHeaders()[secretKey]
+	if !ok {
+		return "", JwksNotInSecretError(name, secretKey)
+	}
+	return key, nil
+}
+
+func translateRemoteJwks(params plugins.Params, name string, remote *jwt.RemoteJwks) (*envoyjwt.RemoteJwks, error) {
+	upstreamRef := remote.GetUpstreamRef()
+	if _, err := params.Snapshot.Upstreams.Find(upstreamRef.GetNamespace(), upstreamRef.GetName()); err != nil {
+		return nil, UpstreamNotFoundError(name, err)
+	}
+
+	out := &envoyjwt.RemoteJwks{
+		HttpUri: &envoy_config_core_v3.HttpUri{
+			Uri: remote.GetUrl(),
+			HttpUpstreamType: &envoy_config_core_v3.HttpUri_Cluster{
+				Cluster: translator.UpstreamToClusterName(upstreamRef),
+			},
+			Timeout: durationpb.New(remoteJwksTimeout),
+		},
+		CacheDuration: remote.GetCacheDuration(),
+	}
+	if remote.GetAsyncFetch() != nil {
+		out.AsyncFetch = &envoyjwt.JwksAsyncFetch{
+			FastListener: remote.GetAsyncFetch().GetFastListener(),
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,280 @@
+package jwt_test
+
+import (
+	"context"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyjwt "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/test/matchers"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		p            plugins.Plugin
+		listener     *v1.HttpListener
+		upstream     *v1.Upstream
+		vhostParams  plugins.VirtualHostParams
+		routeParams  plugins.RouteParams
+		provider     *jwt.Provider
+		virtualHost  *v1.VirtualHost
+		outVhost     *envoy_config_route_v3.VirtualHost
+		outRoute     *envoy_config_route_v3.Route
+		filterConfig func(filterName string) *envoyjwt.JwtAuthentication
+	)
+
+	BeforeEach(func() {
+		p = NewPlugin()
+		p.Init(plugins.InitParams{Ctx: context.TODO()})
+
+		listener = &v1.HttpListener{}
+		upstream = &v1.Upstream{
+			Metadata: &core.Metadata{Name: "jwks", Namespace: "gloo-system"},
+		}
+		vhostParams = plugins.VirtualHostParams{
+			Params: plugins.Params{
+				Ctx:      context.TODO(),
+				Snapshot: &v1snap.ApiSnapshot{Upstreams: v1.UpstreamList{upstream}},
+			},
+			HttpListener: listener,
+		}
+		provider = &jwt.Provider{
+			Jwks: &jwt.Jwks{
+				Jwks: &jwt.Jwks_Remote{
+					Remote: &jwt.RemoteJwks{
+						Url:         "https://auth.example.com/jwks",
+						UpstreamRef: upstream.GetMetadata().Ref(),
+					},
+				},
+			},
+			Issuer:    "https://auth.example.com",
+			Audiences: []string{"api"},
+			TokenSource: &jwt.TokenSource{
+				Headers: []*jwt.TokenSource_HeaderSource{{Header: "authorization", Prefix: "Bearer "}},
+			},
+			ClaimsToHeaders: []*jwt.ClaimToHeader{{Claim: "sub", Header: "x-sub"}},
+		}
+		virtualHost = &v1.VirtualHost{
+			Name: "vhost",
+			Options: &v1.VirtualHostOptions{
+				JwtConfig: &v1.VirtualHostOptions_JwtStaged{
+					JwtStaged: &jwt.JwtStagedVhostExtension{
+						BeforeExtAuth: &jwt.VhostExtension{
+							Providers: map[string]*jwt.Provider{"auth": provider},
+						},
+					},
+				},
+			},
+		}
+		routeParams = plugins.RouteParams{
+			VirtualHostParams: vhostParams,
+			VirtualHost:       virtualHost,
+		}
+		outVhost = &envoy_config_route_v3.VirtualHost{}
+		outRoute = &envoy_config_route_v3.Route{}
+
+		filterConfig = func(filterName string) *envoyjwt.JwtAuthentication {
+			filters, err := p.(plugins.HttpFilterPlugin).HttpFilters(plugins.Params{}, listener)
+			Expect(err).NotTo(HaveOccurred())
+			for _, filter := range filters {
+				if filter.Filter.GetName() == filterName {
+					var config envoyjwt.JwtAuthentication
+					Expect(filter.Filter.GetTypedConfig().UnmarshalTo(&config)).To(Succeed())
+					return &config
+				}
+			}
+			return nil
+		}
+	})
+
+	It("does not add filters when jwt is not configured", func() {
+		err := p.(plugins.VirtualHostPlugin).ProcessVirtualHost(vhostParams, &v1.VirtualHost{Name: "vhost"}, outVhost)
+		Expect(err).NotTo(HaveOccurred())
+
+		filters, err := p.(plugins.HttpFilterPlugin).HttpFilters(plugins.Params{}, listener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("translates the providers of a virtual host into a requirement of the filter of their stage", func() {
+		err := p.(plugins.VirtualHostPlugin).ProcessVirtualHost(vhostParams, virtualHost, outVhost)
+		Expect(err).NotTo(HaveOccurred())
+
+		var perRoute envoyjwt.PerRouteConfig
+		Expect(outVhost.GetTypedPerFilterConfig()[BeforeExtAuthFilterName].UnmarshalTo(&perRoute)).To(Succeed())
+		Expect(perRoute.GetRequirementName()).To(Equal("vhost"))
+		Expect(outVhost.GetTypedPerFilterConfig()).NotTo(HaveKey(AfterExtAuthFilterName))
+
+		Expect(filterConfig(AfterExtAuthFilterName)).To(BeNil())
+		Expect(filterConfig(BeforeExtAuthFilterName)).To(matchers.MatchProto(&envoyjwt.JwtAuthentication{
+			Providers: map[string]*envoyjwt.JwtProvider{
+				"vhost_auth": {
+					Issuer:    "https://auth.example.com",
+					Audiences: []string{"api"},
+					JwksSourceSpecifier: &envoyjwt.JwtProvider_RemoteJwks{
+						RemoteJwks: &envoyjwt.RemoteJwks{
+							HttpUri: &envoy_config_core_v3.HttpUri{
+								Uri: "https://auth.example.com/jwks",
+								HttpUpstreamType: &envoy_config_core_v3.HttpUri_Cluster{
+									Cluster: translator.UpstreamToClusterName(upstream.GetMetadata().Ref()),
+								},
+								Timeout: durationpb.New(5 * time.Second),
+							},
+						},
+					},
+					FromHeaders:       []*envoyjwt.JwtHeader{{Name: "authorization", ValuePrefix: "Bearer "}},
+					PayloadInMetadata: "auth",
+					ClockSkewSeconds:  60,
+					ClaimToHeaders:    []*envoyjwt.JwtClaimToHeader{{HeaderName: "x-sub", ClaimName: "sub"}},
+				},
+			},
+			RequirementMap: map[string]*envoyjwt.JwtRequirement{
+				"vhost": {RequiresType: &envoyjwt.JwtRequirement_ProviderName{ProviderName: "vhost_auth"}},
+			},
+			BypassCorsPreflight: true,
+		}))
+	})
+
+	It("translates a local JWKS", func() {
+		provider.Jwks = &jwt.Jwks{
+			Jwks: &jwt.Jwks_Local{Local: &jwt.LocalJwks{Key: `{"keys":[]}`}},
+		}
+		err := p.(plugins.VirtualHostPlugin).ProcessVirtualHost(vhostParams, virtualHost, outVhost)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(filterConfig(BeforeExtAuthFilterName).GetProviders()["vhost_auth"].GetLocalJwks().GetInlineString()).To(Equal(`{"keys":[]}`))
+	})
+
+	Context("local JWKS secret", func() {
+
+		BeforeEach(func() {
+			// opaque Kubernetes secrets are converted into header secrets
+			vhostParams.Snapshot.Secrets = v1.SecretList{{
+				Metadata: &core.Metadata{Name: "jwks", Namespace: "gloo-system"},
+				Kind: &v1.Secret_Header{
+					Header: &v1.HeaderSecret{Headers: map[string]string{"jwks": `{"keys":[]}`, "other": `{"keys":[{}]}`}},
+				},
+			}}
+			provider.Jwks = &jwt.Jwks{
+				Jwks: &jwt.Jwks_Local{Local: &jwt.LocalJwks{
+					SecretRef: &core.ResourceRef{Name: "jwks", Namespace: "gloo-system"},
+				}},
+			}
+		})
+
+		It("inlines the JWKS of the secret", func() {
+			err := p.(plugins.VirtualHostPlugin).ProcessVirtualHost(vhostParams, virtualHost, outVhost)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(filterConfig(BeforeExtAuthFilterName).GetProviders()["vhost_auth"].GetLocalJwks().GetInlineString()).To(Equal(`{"keys":[]}`))
+		})
+
+		It("inlines the JWKS of the configured key of the secret", func() {
+			provider.GetJwks().GetLocal().SecretKey = "other"
+			err := p.(plugins.VirtualHostPlugin).ProcessVirtualHost(vhostParams, virtualHost, outVhost)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(filterConfig(BeforeExtAuthFilterName).GetProviders()["vhost_auth"].GetLocalJwks().GetInlineString()).To(Equal(`{"keys":[{}]}`))
+		})
+
+		It("errors when the secret does not exist", func() {
+			vhostParams.Snapshot.Secrets = nil
+			err := p.(plugins.VirtualHostPlugin).ProcessVirtualHost(vhostParams, virtualHost, outVhost)
+			Expect(err).To(MatchError(ContainSubstring("finding the JWKS secret of jwt provider auth")))
+		})
+
+		It("errors when the secret has no JWKS", func() {
+			provider.GetJwks().GetLocal().SecretKey = "missing"
+			err := p.(plugins.VirtualHostPlugin).ProcessVirtualHost(vhostParams, virtualHost, outVhost)
+			Expect(err).To(MatchError(ContainSubstring("the JWKS secret of jwt provider auth has no missing key")))
+		})
+
+		It("errors when the JWKS is also inline", func() {
+			provider.GetJwks().GetLocal().Key = `{"keys":[]}`
+			err := p.(plugins.VirtualHostPlugin).ProcessVirtualHost(vhostParams, virtualHost, outVhost)
+			Expect(err).To(MatchError(ContainSubstring("jwt provider auth has both an inline key and a secret ref")))
+		})
+	})
+
+	It("allows missing JWTs as configured by the validation policy", func() {
+		virtualHost.GetOptions().GetJwtStaged().GetBeforeExtAuth().ValidationPolicy = jwt.VhostExtension_ALLOW_MISSING
+		err := p.(plugins.VirtualHostPlugin).ProcessVirtualHost(vhostParams, virtualHost, outVhost)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(filterConfig(BeforeExtAuthFilterName).GetRequirementMap()["vhost"]).To(matchers.MatchProto(&envoyjwt.JwtRequirement{
+			RequiresType: &envoyjwt.JwtRequirement_RequiresAny{
+				RequiresAny: &envoyjwt.JwtRequirementOrList{
+					Requirements: []*envoyjwt.JwtRequirement{
+						{RequiresType: &envoyjwt.JwtRequirement_ProviderName{ProviderName: "vhost_auth"}},
+						{RequiresType: &envoyjwt.JwtRequirement_AllowMissing{AllowMissing: &emptypb.Empty{}}},
+					},
+				},
+			},
+		}))
+	})
+
+	It("requires the providers of a route instead of the ones of its virtual host", func() {
+		route := &v1.Route{
+			Options: &v1.RouteOptions{
+				JwtConfig: &v1.RouteOptions_JwtProvidersStaged{
+					JwtProvidersStaged: &jwt.JwtStagedRouteProvidersExtension{
+						AfterExtAuth: &jwt.VhostExtension{
+							Providers: map[string]*jwt.Provider{"route-auth": provider},
+						},
+					},
+				},
+			},
+		}
+		err := p.(plugins.RoutePlugin).ProcessRoute(routeParams, route, outRoute)
+		Expect(err).NotTo(HaveOccurred())
+
+		var perRoute envoyjwt.PerRouteConfig
+		Expect(outRoute.GetTypedPerFilterConfig()[AfterExtAuthFilterName].UnmarshalTo(&perRoute)).To(Succeed())
+		Expect(perRoute.GetRequirementName()).To(Equal("vhost_route_1"))
+		Expect(filterConfig(AfterExtAuthFilterName).GetProviders()).To(HaveKey("vhost_route_1_route-auth"))
+	})
+
+	It("disables the filter of a stage on a route", func() {
+		route := &v1.Route{
+			Options: &v1.RouteOptions{
+				JwtConfig: &v1.RouteOptions_JwtStaged{
+					JwtStaged: &jwt.JwtStagedRouteExtension{
+						BeforeExtAuth: &jwt.RouteExtension{Disable: true},
+					},
+				},
+			},
+		}
+		err := p.(plugins.RoutePlugin).ProcessRoute(routeParams, route, outRoute)
+		Expect(err).NotTo(HaveOccurred())
+
+		var perRoute envoyjwt.PerRouteConfig
+		Expect(outRoute.GetTypedPerFilterConfig()[BeforeExtAuthFilterName].UnmarshalTo(&perRoute)).To(Succeed())
+		Expect(perRoute.GetDisabled()).To(BeTrue())
+		Expect(outRoute.GetTypedPerFilterConfig()).NotTo(HaveKey(AfterExtAuthFilterName))
+	})
+
+	It("errors when the JWKS upstream does not exist", func() {
+		vhostParams.Snapshot = &v1snap.ApiSnapshot{}
+		err := p.(plugins.VirtualHostPlugin).ProcessVirtualHost(vhostParams, virtualHost, outVhost)
+		Expect(err).To(MatchError(ContainSubstring("finding the JWKS upstream of jwt provider auth")))
+	})
+
+	It("errors when a claim is appended to a header", func() {
+		provider.ClaimsToHeaders[0].Append = true
+		err := p.(plugins.VirtualHostPlugin).ProcessVirtualHost(vhostParams, virtualHost, outVhost)
+		Expect(err).To(MatchError(ContainSubstring("appending claim sub to a header is not supported")))
+	})
+})
@@ -0,0 +1,299 @@
+package nomad
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/solo-io/gloo/projects/gloo/constants"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/nomad"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/errutils"
+	"github.com/solo-io/go-utils/hashutils"
+	"github.com/solo-io/k8s-utils/kubeutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// TODO[eds enhancement] - update the EDS interface to include a registration function which would ensure uniqueness among prefixes
+const nomadEndpointNamePrefix = "nomad"
+
+// a Nomad service is identified by its name and its namespace
+type serviceKey struct {
+	namespace, name string
+}
+
+func (k serviceKey) String() string {
+	return k.name + "." + k.namespace
+}
+
+type serviceRegistrationsTuple struct {
+	service       serviceKey
+	registrations []*nomad.ServiceRegistration
+}
+
+// Starts a blocking watch of the registrations of each service of the tracked upstreams.
+// Once the registrations of all the services have been read, it converts them to endpoints, and sends the result on the
+// returned channel whenever it changes.
+func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.UpstreamList, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
+
+	// Filter out non-nomad upstreams
+	trackedServiceToUpstreams := make(map[serviceKey][]*v1.Upstream)
+	for _, us := range upstreamsToTrack {
+		if nomadUsSpec := us.GetNomad(); nomadUsSpec != nil {
+			service := serviceKey{namespace: namespaceOrDefault(nomadUsSpec.GetNamespace()), name: nomadUsSpec.GetServiceName()}
+			trackedServiceToUpstreams[service] = append(trackedServiceToUpstreams[service], us)
+		}
+	}
+
+	var (
+		wg                   sync.WaitGroup
+		errChan              = make(chan error)
+		allRegistrationsChan = make(chan *serviceRegistrationsTuple)
+	)
+
+	for service := range trackedServiceToUpstreams {
+		registrationsChan, registrationErrChan := p.client.WatchService(opts.Ctx, service.namespace, service.name)
+
+		// Collect errors
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errutils.AggregateErrs(opts.Ctx, errChan, registrationErrChan, "nomad eds, service: "+service.String())
+		}()
+
+		// Collect registrations
+		go func() {
+			for {
+				select {
+				case registrations, ok := <-registrationsChan:
+					if !ok {
+						return
+					}
+					select {
+					case allRegistrationsChan <- &serviceRegistrationsTuple{service: service, registrations: registrations}:
+					case <-opts.Ctx.Done():
+						return
+					}
+				case <-opts.Ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	endpointsChan := make(chan v1.EndpointList)
+	go func() {
+		defer close(endpointsChan)
+
+		var (
+			previousHash uint64
+			published    bool
+		)
+		publishEndpoints := func(endpoints v1.EndpointList) bool {
+			currentHash := hashutils.MustHash(endpoints)
+			if published && previousHash == currentHash {
+				return true
+			}
+			previousHash, published = currentHash, true
+			select {
+			case endpointsChan <- endpoints:
+				return true
+			case <-opts.Ctx.Done():
+				return false
+			}
+		}
+
+		// with no services to watch, the endpoints are known right away
+		if len(trackedServiceToUpstreams) == 0 && !publishEndpoints(v1.EndpointList{}) {
+			return
+		}
+
+		registrationsByService := make(map[serviceKey][]*nomad.ServiceRegistration)
+		for {
+			select {
+			case tuple := <-allRegistrationsChan:
+				registrationsByService[tuple.service] = tuple.registrations
+
+				// wait for the first read of all the services, to avoid marking EDS as ready with partial endpoints
+				if len(registrationsByService) < len(trackedServiceToUpstreams) {
+					continue
+				}
+
+				endpoints := buildEndpointsFromRegistrations(opts.Ctx, writeNamespace, registrationsByService, trackedServiceToUpstreams)
+				if !publishEndpoints(endpoints) {
+					return
+				}
+			case <-opts.Ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(errChan)
+	}()
+	return endpointsChan, errChan, nil
+}
+
+func namespaceOrDefault(namespace string) string {
+	if namespace == "" {
+		return nomad.DefaultNamespace
+	}
+	return namespace
+}
+
+// build gloo endpoints out of the registrations of the nomad services, for the gloo upstreams of each service
+func buildEndpointsFromRegistrations(
+	ctx context.Context,
+	writeNamespace string,
+	registrationsByService map[serviceKey][]*nomad.ServiceRegistration,
+	trackedServiceToUpstreams map[serviceKey][]*v1.Upstream,
+) v1.EndpointList {
+	var endpoints v1.EndpointList
+	for service, registrations := range registrationsByService {
+		upstreams := trackedServiceToUpstreams[service]
+		for _, registration := range registrations {
+			eps, err := buildEndpoints(ctx, writeNamespace, registration, upstreams)
+			if err != nil {
+				contextutils.LoggerFrom(ctx).Warnf("nomad eds plugin encountered error resolving the address of the registration %s of nomad service %s: %v", registration.ID, service, err)
+				continue
+			}
+			endpoints = append(endpoints, eps...)
+		}
+	}
+
+	// Sort by name in ascending order for idempotency
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		return endpoints[i].GetMetadata().GetName() < endpoints[j].GetMetadata().GetName()
+	})
+	return endpoints
+}
+
+// Construct gloo endpoints for one nomad registration and the gloo upstreams of its service.
+// Produces 1 endpoint for each ip address of the registration, unless it is not selected by any of the upstreams.
+func buildEndpoints(ctx context.Context, namespace string, registration *nomad.ServiceRegistration, upstreams []*v1.Upstream) ([]*v1.Endpoint, error) {
+	upstreamRefs := toResourceRefs(upstreams, registration)
+	if len(upstreamRefs) == 0 {
+		return nil, nil
+	}
+
+	ipAddresses, err := getIpAddresses(ctx, registration.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	var endpoints []*v1.Endpoint
+	for _, ipAddress := range ipAddresses {
+		hostname := ""
+		if ipAddress != registration.Address {
+			// we don't want to override the hostname if we didn't resolve the address
+			hostname = registration.Address
+		}
+		endpoints = append(endpoints, &v1.Endpoint{
+			Metadata: &core.Metadata{
+				Namespace:       namespace,
+				Name:            buildEndpointName(ipAddress, registration),
+				Labels:          buildLabels(registration, upstreams),
+				ResourceVersion: strconv.FormatUint(registration.ModifyIndex, 10),
+			},
+			Upstreams: upstreamRefs,
+			Address:   ipAddress,
+			Port:      uint32(registration.Port),
+			Hostname:  hostname,
+		})
+	}
+	return endpoints, nil
+}
+
+// resolves the address of the registration if it is a hostname, as EDS can't resolve hostnames
+func getIpAddresses(ctx context.Context, address string) ([]string, error) {
+	if net.ParseIP(address) != nil {
+		return []string{address}, nil
+	}
+	ipAddrs, err := net.DefaultResolver.LookupIPAddr(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	var ipAddresses []string
+	for _, ipAddr := range ipAddrs {
+		ipAddresses = append(ipAddresses, ipAddr.String())
+	}
+	return ipAddresses, nil
+}
+
+func buildEndpointName(ipAddress string, registration *nomad.ServiceRegistration) string {
+	unsanitizedName := fmt.Sprintf("%s-%s-%s-%s", nomadEndpointNamePrefix, ipAddress, registration.ServiceName, registration.ID)
+	unsanitizedName = strings.ReplaceAll(unsanitizedName, "_", "")
+	return kubeutils.SanitizeNameV2(unsanitizedName)
+}
+
+// The labels will be used to match the endpoint to the subsets of the cluster represented by the upstream.
+// The labels map contains all of the upstreams' subset tags and data centers as keys, prefixed to avoid shadowing,
+// with a 1 as the value if the registration has that tag / is in that data center, and a 0 otherwise.
+func buildLabels(registration *nomad.ServiceRegistration, upstreams []*v1.Upstream) map[string]string {
+	registrationTags := make(map[string]bool)
+	for _, tag := range registration.Tags {
+		registrationTags[tag] = true
+	}
+
+	labels := make(map[string]string)
+	for _, us := range upstreams {
+		tags := us.GetNomad().GetSubsetTags()
+		if len(tags) == 0 {
+			tags = us.GetNomad().GetServiceTags()
+		}
+		for _, tag := range tags {
+			labels[constants.NomadTagKeyPrefix+tag] = matchValue(registrationTags[tag])
+		}
+		for _, dc := range us.GetNomad().GetDataCenters() {
+			labels[constants.NomadDataCenterKeyPrefix+dc] = matchValue(registration.Datacenter == dc)
+		}
+	}
+	return labels
+}
+
+func matchValue(match bool) string {
+	if match {
+		return constants.NomadEndpointMetadataMatchTrue
+	}
+	return constants.NomadEndpointMetadataMatchFalse
+}
+
+// the registration belongs to the upstreams whose instance tags are a subset of its tags, and whose data centers,
+// if any, include its data center
+func toResourceRefs(upstreams []*v1.Upstream, registration *nomad.ServiceRegistration) []*core.ResourceRef {
+	var out []*core.ResourceRef
+	for _, us := range upstreams {
+		if !containsAll(registration.Tags, us.GetNomad().GetInstanceTags()) {
+			continue
+		}
+		if dataCenters := us.GetNomad().GetDataCenters(); len(dataCenters) > 0 && !containsAll(dataCenters, []string{registration.Datacenter}) {
+			continue
+		}
+		out = append(out, us.GetMetadata().Ref())
+	}
+	return out
+}
+
+func containsAll(values, subset []string) bool {
+	for _, s := range subset {
+		found := false
+		for _, v := range values {
+			if v == s {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
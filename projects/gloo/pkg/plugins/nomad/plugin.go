@@ -0,0 +1,65 @@
+package nomad
+
+import (
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/nomad"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+)
+
+var (
+	_ discovery.DiscoveryPlugin = new(plugin)
+	_ plugins.UpstreamPlugin    = new(plugin)
+)
+
+const (
+	ExtensionName = "nomad"
+)
+
+/*
+Steps:
+- Discovery watches the Nomad services API, and creates an upstream for each service of each namespace
+- User can also create Nomad upstreams, e.g. to restrict them to the registrations of some data centers or with some tags
+- Discovery watches the registrations of the services of the upstreams, and creates an endpoint for each registration,
+  labeled with the tags and the data center of the registration
+- Gloo plugin configures the clusters of the upstreams to use EDS, with subsets selecting the labels of the endpoints
+*/
+
+type plugin struct {
+	client   nomad.NomadWatcher
+	settings *v1.Settings
+}
+
+func NewPlugin(client nomad.NomadWatcher) *plugin {
+	return &plugin{client: client}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(params plugins.InitParams) {
+	p.settings = params.Settings
+}
+
+func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
+	spec := in.GetNomad()
+	if spec == nil {
+		return nil
+	}
+	if spec.GetServiceName() == "" {
+		return MissingServiceNameError
+	}
+
+	// nomad upstreams use EDS
+	xds.SetEdsOnCluster(out, p.settings)
+
+	return nil
+}
+
+var (
+	MissingServiceNameError = eris.New("a Nomad upstream must have the name of its service")
+)
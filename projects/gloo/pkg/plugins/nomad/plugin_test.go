@@ -0,0 +1,246 @@
+package nomad
+
+import (
+	"context"
+	"sync"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/constants"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooplugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	nomadplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/nomad"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/nomad"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// fakeNomadClient serves registrations from memory, and blocks queries until their index changes
+type fakeNomadClient struct {
+	lock          sync.Mutex
+	index         uint64
+	changed       chan struct{}
+	services      []*nomad.NamespacedServices
+	registrations map[string][]*nomad.ServiceRegistration
+}
+
+func newFakeNomadClient() *fakeNomadClient {
+	return &fakeNomadClient{index: 1, changed: make(chan struct{}), registrations: map[string][]*nomad.ServiceRegistration{}}
+}
+
+func (f *fakeNomadClient) update(fn func()) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	fn()
+	f.index++
+	close(f.changed)
+	f.changed = make(chan struct{})
+}
+
+func (f *fakeNomadClient) wait(ctx context.Context, q *nomad.QueryOptions) {
+	f.lock.Lock()
+	index, changed := f.index, f.changed
+	f.lock.Unlock()
+	if q != nil && q.WaitIndex >= index {
+		select {
+		case <-changed:
+		case <-ctx.Done():
+		}
+	}
+}
+
+func (f *fakeNomadClient) Services(ctx context.Context, q *nomad.QueryOptions) ([]*nomad.NamespacedServices, *nomad.QueryMeta, error) {
+	f.wait(ctx, q)
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.services, &nomad.QueryMeta{LastIndex: f.index}, nil
+}
+
+func (f *fakeNomadClient) Service(ctx context.Context, namespace, name string, q *nomad.QueryOptions) ([]*nomad.ServiceRegistration, *nomad.QueryMeta, error) {
+	f.wait(ctx, q)
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.registrations[name+"."+namespace], &nomad.QueryMeta{LastIndex: f.index}, nil
+}
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+
+		client   *fakeNomadClient
+		p        *plugin
+		upstream *v1.Upstream
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		client = newFakeNomadClient()
+		client.services = []*nomad.NamespacedServices{{
+			Namespace: "default",
+			Services:  []*nomad.ServiceStub{{ServiceName: "web", Tags: []string{"v1"}}, {ServiceName: "web", Tags: []string{"v2"}}},
+		}}
+		client.registrations["web.default"] = []*nomad.ServiceRegistration{
+			{ID: "_nomad-task-1-web-http", ServiceName: "web", Namespace: "default", Datacenter: "dc1", Tags: []string{"v1"}, Address: "10.0.0.1", Port: 8080, ModifyIndex: 3},
+			{ID: "_nomad-task-2-web-http", ServiceName: "web", Namespace: "default", Datacenter: "dc2", Tags: []string{"v2"}, Address: "10.0.0.2", Port: 8080, ModifyIndex: 4},
+		}
+
+		p = NewPlugin(nomad.NewNomadWatcherFromClient(client, 0))
+		p.Init(plugins.InitParams{Ctx: ctx, Settings: &v1.Settings{}})
+		upstream = &v1.Upstream{
+			Metadata: &core.Metadata{Name: "web", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Nomad{
+				Nomad: &nomadplugin.UpstreamSpec{
+					ServiceName: "web",
+					ServiceTags: []string{"v1", "v2"},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	Context("ProcessUpstream", func() {
+
+		It("configures the cluster to use EDS", func() {
+			out := &envoy_config_cluster_v3.Cluster{}
+			err := p.ProcessUpstream(plugins.Params{}, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetType()).To(Equal(envoy_config_cluster_v3.Cluster_EDS))
+			Expect(out.GetEdsClusterConfig()).NotTo(BeNil())
+		})
+
+		It("errors without a service name", func() {
+			upstream.GetNomad().ServiceName = ""
+			err := p.ProcessUpstream(plugins.Params{}, upstream, &envoy_config_cluster_v3.Cluster{})
+			Expect(err).To(MatchError(MissingServiceNameError))
+		})
+	})
+
+	Context("subsets", func() {
+
+		It("partitions the endpoints by tag", func() {
+			Expect(upstream.GetUpstreamType().(v1.SubsetSpecGetter).GetSubsetSpec()).To(Equal(&glooplugins.SubsetSpec{
+				Selectors: []*glooplugins.Selector{{Keys: []string{"tag_v1", "tag_v2"}}},
+			}))
+		})
+
+		It("partitions the endpoints by data center when the upstream has some", func() {
+			upstream.GetNomad().DataCenters = []string{"dc1"}
+			upstream.GetNomad().SubsetTags = []string{"v2"}
+			Expect(upstream.GetUpstreamType().(v1.SubsetSpecGetter).GetSubsetSpec()).To(Equal(&glooplugins.SubsetSpec{
+				Selectors: []*glooplugins.Selector{{Keys: []string{"dc_dc1"}}, {Keys: []string{"tag_v2"}}, {Keys: []string{"dc_dc1", "tag_v2"}}},
+			}))
+		})
+	})
+
+	Context("UDS", func() {
+
+		It("discovers an upstream for each service", func() {
+			upstreams, errs, err := p.DiscoverUpstreams(nil, "gloo-system", clients.WatchOpts{Ctx: ctx}, discovery.Opts{})
+			Expect(err).NotTo(HaveOccurred())
+			Consistently(errs).ShouldNot(Receive())
+
+			var list v1.UpstreamList
+			Eventually(upstreams).Should(Receive(&list))
+			Expect(list).To(HaveLen(1))
+			Expect(list[0].GetMetadata().GetName()).To(Equal("web.default"))
+			Expect(list[0].GetMetadata().GetNamespace()).To(Equal("gloo-system"))
+			Expect(list[0].GetNomad()).To(Equal(&nomadplugin.UpstreamSpec{ServiceName: "web", Namespace: "default", ServiceTags: []string{"v1", "v2"}}))
+		})
+
+		It("keeps the fields set by the user when updating an upstream", func() {
+			original := upstream.Clone().(*v1.Upstream)
+			original.GetNomad().DataCenters = []string{"dc1"}
+			original.GetNomad().InstanceTags = []string{"v1"}
+			desired := upstream.Clone().(*v1.Upstream)
+			desired.GetNomad().ServiceTags = []string{"v1", "v2", "v3"}
+
+			updated, err := p.UpdateUpstream(original, desired)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeTrue())
+			Expect(desired.GetNomad().GetDataCenters()).To(Equal([]string{"dc1"}))
+			Expect(desired.GetNomad().GetInstanceTags()).To(Equal([]string{"v1"}))
+
+			updated, err = p.UpdateUpstream(original, original.Clone().(*v1.Upstream))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeFalse())
+		})
+	})
+
+	Context("EDS", func() {
+
+		watchEndpoints := func(upstreams v1.UpstreamList) <-chan v1.EndpointList {
+			endpoints, errs, err := p.WatchEndpoints("gloo-system", upstreams, clients.WatchOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			go func() {
+				defer GinkgoRecover()
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case err, ok := <-errs:
+						if ok {
+							Fail(err.Error())
+						}
+					}
+				}
+			}()
+			return endpoints
+		}
+
+		It("creates an endpoint for each registration, labeled with its tags", func() {
+			var endpoints v1.EndpointList
+			Eventually(watchEndpoints(v1.UpstreamList{upstream})).Should(Receive(&endpoints))
+			Expect(endpoints).To(HaveLen(2))
+
+			Expect(endpoints[0].GetAddress()).To(Equal("10.0.0.1"))
+			Expect(endpoints[0].GetPort()).To(BeEquivalentTo(8080))
+			Expect(endpoints[0].GetUpstreams()).To(ConsistOf(upstream.GetMetadata().Ref()))
+			Expect(endpoints[0].GetMetadata().GetNamespace()).To(Equal("gloo-system"))
+			Expect(endpoints[0].GetMetadata().GetName()).To(Equal("nomad-10-0-0-1-web-nomad-task-1-web-http"))
+			Expect(endpoints[0].GetMetadata().GetResourceVersion()).To(Equal("3"))
+			Expect(endpoints[0].GetMetadata().GetLabels()).To(Equal(map[string]string{
+				constants.NomadTagKeyPrefix + "v1": constants.NomadEndpointMetadataMatchTrue,
+				constants.NomadTagKeyPrefix + "v2": constants.NomadEndpointMetadataMatchFalse,
+			}))
+			Expect(endpoints[1].GetAddress()).To(Equal("10.0.0.2"))
+		})
+
+		It("only adds the registrations with the instance tags and in the data centers of an upstream to it", func() {
+			v1Upstream := upstream.Clone().(*v1.Upstream)
+			v1Upstream.GetMetadata().Name = "web-v1"
+			v1Upstream.GetNomad().InstanceTags = []string{"v1"}
+			dc2Upstream := upstream.Clone().(*v1.Upstream)
+			dc2Upstream.GetMetadata().Name = "web-dc2"
+			dc2Upstream.GetNomad().DataCenters = []string{"dc2"}
+
+			var endpoints v1.EndpointList
+			Eventually(watchEndpoints(v1.UpstreamList{v1Upstream, dc2Upstream})).Should(Receive(&endpoints))
+			Expect(endpoints).To(HaveLen(2))
+			Expect(endpoints[0].GetUpstreams()).To(ConsistOf(v1Upstream.GetMetadata().Ref()))
+			Expect(endpoints[1].GetUpstreams()).To(ConsistOf(dc2Upstream.GetMetadata().Ref()))
+			Expect(endpoints[1].GetMetadata().GetLabels()).To(HaveKeyWithValue(constants.NomadDataCenterKeyPrefix+"dc2", constants.NomadEndpointMetadataMatchTrue))
+		})
+
+		It("sends the endpoints again when the registrations change", func() {
+			endpoints := watchEndpoints(v1.UpstreamList{upstream})
+			Eventually(endpoints).Should(Receive(HaveLen(2)))
+
+			client.update(func() {
+				client.registrations["web.default"] = client.registrations["web.default"][:1]
+			})
+			Eventually(endpoints).Should(Receive(HaveLen(1)))
+		})
+
+		It("sends empty endpoints without nomad upstreams", func() {
+			Eventually(watchEndpoints(v1.UpstreamList{{UpstreamType: &v1.Upstream_Static{}}})).Should(Receive(BeEmpty()))
+		})
+	})
+})
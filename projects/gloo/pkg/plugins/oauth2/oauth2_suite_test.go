@@ -0,0 +1,13 @@
+package oauth2_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestOAuth2(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "OAuth2 Suite")
+}
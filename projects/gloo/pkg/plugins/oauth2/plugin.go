@@ -0,0 +1,313 @@
+package oauth2
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyoauth2 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/oauth2/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/oauth2"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var (
+	_ plugins.Plugin                = new(plugin)
+	_ plugins.HttpFilterPlugin      = new(plugin)
+	_ plugins.VirtualHostPlugin     = new(plugin)
+	_ plugins.RoutePlugin           = new(plugin)
+	_ plugins.SecretGeneratorPlugin = new(plugin)
+)
+
+const (
+	ExtensionName = "oauth2"
+	FilterName    = "envoy.filters.http.oauth2"
+
+	// DefaultRedirectPath is the path the authorization server redirects to, when the config doesn't set one
+	DefaultRedirectPath = "/oauth-gloo-callback"
+
+	// defaultTokenEndpointTimeout is the timeout of the requests to the token endpoint, when the config doesn't set one
+	defaultTokenEndpointTimeout = 5 * time.Second
+)
+
+// the filter runs alongside the ext_authz filter
+var filterStage = plugins.DuringStage(plugins.AuthNStage)
+
+var (
+	NoTokenEndpointUpstreamError = eris.New("oauth2 requires the upstream of the token endpoint or of the authorization endpoint")
+	UpstreamNotFoundError        = func(err error) error {
+		return eris.Wrapf(err, "finding the upstream of the oauth2 token endpoint")
+	}
+	SecretNotFoundError = func(ref *core.ResourceRef, err error) error {
+		return eris.Wrapf(err, "finding the secret %s of the oauth2 filter", ref.Key())
+	}
+	WrongSecretKindError = func(ref *core.ResourceRef, kind string) error {
+		return eris.Errorf("secret %s used by the oauth2 filter is not of kind %s", ref.Key(), kind)
+	}
+)
+
+type listenerConfig struct {
+	// filters are the configs of the oauth2 filters of the listener, by filter name
+	filters map[string]*envoyoauth2.OAuth2
+}
+
+type plugin struct {
+	listeners map[*v1.HttpListener]*listenerConfig
+	// secrets are the secrets fetched with SDS by the filters of all the listeners, by name
+	secrets map[string]*envoyauth.Secret
+}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) {
+	p.listeners = make(map[*v1.HttpListener]*listenerConfig)
+	p.secrets = make(map[string]*envoyauth.Secret)
+}
+
+func (p *plugin) ProcessVirtualHost(
+	params plugins.VirtualHostParams,
+	in *v1.VirtualHost,
+	out *envoy_config_route_v3.VirtualHost,
+) error {
+	config := in.GetOptions().GetOauth2()
+	if config == nil {
+		return nil
+	}
+	name, err := p.filterFor(params.Params, params.HttpListener, config)
+	if err != nil {
+		return err
+	}
+	// the filter is disabled on the listener, and enabled on the virtual hosts that use it
+	return pluginutils.SetVhostPerFilterConfig(out, name, &envoy_config_route_v3.FilterConfig{})
+}
+
+// ProcessRoute enables the filter of the config of a route, in place of the one of its virtual host.
+// Routes without a config of their own use the filter of their virtual host.
+func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	config := in.GetOptions().GetOauth2()
+	if config == nil {
+		return nil
+	}
+	name, err := p.filterFor(params.Params, params.HttpListener, config)
+	if err != nil {
+		return err
+	}
+	if vhostConfig := params.VirtualHost.GetOptions().GetOauth2(); vhostConfig != nil {
+		vhostName, err := filterName(vhostConfig)
+		if err != nil {
+			return err
+		}
+		if vhostName != name {
+			if err := pluginutils.SetRoutePerFilterConfig(out, vhostName, &envoy_config_route_v3.FilterConfig{Disabled: true}); err != nil {
+				return err
+			}
+		}
+	}
+	return pluginutils.SetRoutePerFilterConfig(out, name, &envoy_config_route_v3.FilterConfig{})
+}
+
+func (p *plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	config, ok := p.listeners[listener]
+	if !ok {
+		return nil, nil
+	}
+	names := make([]string, 0, len(config.filters))
+	for name := range config.filters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var filters []plugins.StagedHttpFilter
+	for _, name := range names {
+		filter, err := plugins.NewStagedFilter(name, config.filters[name], filterStage)
+		if err != nil {
+			return nil, eris.Wrap(err, "generating filter config")
+		}
+		filter.Filter.Disabled = true
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+// GeneratedSecrets returns the client and HMAC secrets the filters fetch with SDS
+func (p *plugin) GeneratedSecrets(_ plugins.Params) ([]*envoyauth.Secret, error) {
+	names := make([]string, 0, len(p.secrets))
+	for name := range p.secrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	secrets := make([]*envoyauth.Secret, 0, len(names))
+	for _, name := range names {
+		secrets = append(secrets, p.secrets[name])
+	}
+	return secrets, nil
+}
+
+// filterName returns the name of the filter of a config. The virtual hosts and routes of a listener with the same
+// config share its filter.
+func filterName(config *oauth2.OAuth2) (string, error) {
+	hash, err := config.Hash(nil)
+	if err != nil {
+		return "", eris.Wrap(err, "hashing the oauth2 config")
+	}
+	return fmt.Sprintf("%s.%d", FilterName, hash), nil
+}
+
+// filterFor returns the name of the filter of the listener that enforces a config, translating it the first time
+func (p *plugin) filterFor(params plugins.Params, listener *v1.HttpListener, config *oauth2.OAuth2) (string, error) {
+	name, err := filterName(config)
+	if err != nil {
+		return "", err
+	}
+	listenerFilters, ok := p.listeners[listener]
+	if !ok {
+		listenerFilters = &listenerConfig{filters: map[string]*envoyoauth2.OAuth2{}}
+		p.listeners[listener] = listenerFilters
+	}
+	if _, ok := listenerFilters.filters[name]; ok {
+		return name, nil
+	}
+	filterConfig, err := p.translate(params, config)
+	if err != nil {
+		return "", err
+	}
+	listenerFilters.filters[name] = filterConfig
+	return name, nil
+}
+
+func (p *plugin) translate(params plugins.Params, config *oauth2.OAuth2) (*envoyoauth2.OAuth2, error) {
+	tokenSecretName, err := p.addSecret(params, "client-secret", "oauth", config.GetClientSecretRef(), func(secret *v1.Secret) (string, bool) {
+		return secret.GetOauth().GetClientSecret(), secret.GetOauth() != nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	hmacSecretName, err := p.addSecret(params, "hmac", "encryption", config.GetHmacSecretRef(), func(secret *v1.Secret) (string, bool) {
+		return secret.GetEncryption().GetKey(), secret.GetEncryption() != nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tokenEndpoint, err := translateTokenEndpoint(params, config)
+	if err != nil {
+		return nil, err
+	}
+
+	redirectPath := config.GetRedirectPath()
+	if redirectPath == "" {
+		redirectPath = DefaultRedirectPath
+	}
+	// without a redirect uri, the authorization server redirects to the redirect path of the host of the request
+	redirectUri := config.GetRedirectUri()
+	if redirectUri == "" {
+		redirectUri = "%REQ(x-forwarded-proto)%://%REQ(:authority)%" + redirectPath
+	}
+
+	return &envoyoauth2.OAuth2{
+		Config: &envoyoauth2.OAuth2Config{
+			TokenEndpoint:         tokenEndpoint,
+			AuthorizationEndpoint: config.GetAuthorizationEndpoint().GetUri(),
+			Credentials: &envoyoauth2.OAuth2Credentials{
+				ClientId:    config.GetClientId(),
+				TokenSecret: xds.NewAdsSdsSecretConfig(tokenSecretName),
+				TokenFormation: &envoyoauth2.OAuth2Credentials_HmacSecret{
+					HmacSecret: xds.NewAdsSdsSecretConfig(hmacSecretName),
+				},
+				CookieDomain: config.GetCookieDomain(),
+			},
+			RedirectUri:         redirectUri,
+			RedirectPathMatcher: exactPath(redirectPath),
+			// the filter always handles a sign out path. Without one in the config, it is one no request has,
+			// so that users can't sign out.
+			SignoutPath:        exactPath(config.GetSignoutPath()),
+			PassThroughMatcher: pluginutils.EnvoyHeaderMatcher(params.Ctx, config.GetPassThroughMatcher()),
+			ForwardBearerToken: config.GetForwardBearerToken(),
+			AuthScopes:         config.GetAuthScopes(),
+		},
+	}, nil
+}
+
+// addSecret adds the SDS secret that holds a value of a Gloo secret, and returns its name
+func (p *plugin) addSecret(
+	params plugins.Params,
+	use string,
+	kind string,
+	ref *core.ResourceRef,
+	value func(secret *v1.Secret) (string, bool),
+) (string, error) {
+	secret, err := params.Snapshot.Secrets.Find(ref.GetNamespace(), ref.GetName())
+	if err != nil {
+		return "", SecretNotFoundError(ref, err)
+	}
+	secretValue, ok := value(secret)
+	if !ok {
+		return "", WrongSecretKindError(ref, kind)
+	}
+	name := fmt.Sprintf("%s/%s/%s/%s", ExtensionName, use, ref.GetNamespace(), ref.GetName())
+	p.secrets[name] = &envoyauth.Secret{
+		Name: name,
+		Type: &envoyauth.Secret_GenericSecret{
+			GenericSecret: &envoyauth.GenericSecret{
+				Secret: &envoy_config_core_v3.DataSource{
+					Specifier: &envoy_config_core_v3.DataSource_InlineString{InlineString: secretValue},
+				},
+			},
+		},
+	}
+	return name, nil
+}
+
+// translateTokenEndpoint returns the token endpoint of the filter, sending its requests to the cluster of the
+// upstream of the token endpoint, or else of the authorization endpoint
+func translateTokenEndpoint(params plugins.Params, config *oauth2.OAuth2) (*envoy_config_core_v3.HttpUri, error) {
+	ref := config.GetTokenEndpoint().GetUpstream()
+	if ref == nil {
+		ref = config.GetAuthorizationEndpoint().GetUpstream()
+	}
+	if ref == nil {
+		return nil, NoTokenEndpointUpstreamError
+	}
+	if _, err := params.Snapshot.Upstreams.Find(ref.GetNamespace(), ref.GetName()); err != nil {
+		return nil, UpstreamNotFoundError(err)
+	}
+
+	timeout := config.GetTokenEndpoint().GetTimeout()
+	if timeout == nil {
+		timeout = durationpb.New(defaultTokenEndpointTimeout)
+	}
+	return &envoy_config_core_v3.HttpUri{
+		Uri: config.GetTokenEndpoint().GetUri(),
+		HttpUpstreamType: &envoy_config_core_v3.HttpUri_Cluster{
+			Cluster: translator.UpstreamToClusterName(ref),
+		},
+		Timeout: timeout,
+	}, nil
+}
+
+func exactPath(path string) *envoy_type_matcher_v3.PathMatcher {
+	return &envoy_type_matcher_v3.PathMatcher{
+		Rule: &envoy_type_matcher_v3.PathMatcher_Path{
+			Path: &envoy_type_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: path},
+			},
+		},
+	}
+}
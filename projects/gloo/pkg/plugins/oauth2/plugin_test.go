@@ -0,0 +1,267 @@
+package oauth2_test
+
+import (
+	"context"
+	"time"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyoauth2 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/oauth2/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/testutils"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/oauth2"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/oauth2"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// oauth2Plugin is the set of plugin interfaces the oauth2 plugin implements
+type oauth2Plugin interface {
+	plugins.VirtualHostPlugin
+	plugins.RoutePlugin
+	plugins.HttpFilterPlugin
+	plugins.SecretGeneratorPlugin
+}
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		p            oauth2Plugin
+		listener     *v1.HttpListener
+		upstream     *v1.Upstream
+		config       *oauth2.OAuth2
+		vhostParams  plugins.VirtualHostParams
+		virtualHost  *v1.VirtualHost
+		outVhost     *envoy_config_route_v3.VirtualHost
+		filterConfig func() *envoyoauth2.OAuth2
+	)
+
+	BeforeEach(func() {
+		p = NewPlugin()
+		p.Init(plugins.InitParams{Ctx: context.TODO()})
+
+		// the secrets are the ones glooctl creates
+		helpers.UseMemoryClients()
+		Expect(testutils.Glooctl("create secret oauth --name client --namespace gloo-system --client-secret client-secret")).To(Succeed())
+		Expect(testutils.Glooctl("create secret encryptionkey --name hmac --namespace gloo-system --key an-encryption-key-of-32-bytes...")).To(Succeed())
+		secrets, err := helpers.MustSecretClient(context.TODO()).List("gloo-system", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		listener = &v1.HttpListener{}
+		upstream = &v1.Upstream{
+			Metadata: &core.Metadata{Name: "idp", Namespace: "gloo-system"},
+		}
+		config = &oauth2.OAuth2{
+			ClientId:        "client-id",
+			ClientSecretRef: &core.ResourceRef{Name: "client", Namespace: "gloo-system"},
+			HmacSecretRef:   &core.ResourceRef{Name: "hmac", Namespace: "gloo-system"},
+			TokenEndpoint: &oauth2.TokenEndpoint{
+				Uri:      "https://idp.example.com/token",
+				Upstream: upstream.GetMetadata().Ref(),
+				Timeout:  durationpb.New(3 * time.Second),
+			},
+			AuthorizationEndpoint: &oauth2.AuthorizationEndpoint{
+				Uri: "https://idp.example.com/authorize?prompt=login",
+			},
+			RedirectPath:       "/callback",
+			RedirectUri:        "https://app.example.com/callback",
+			SignoutPath:        "/logout",
+			AuthScopes:         []string{"openid", "email"},
+			PassThroughMatcher: []*matchers.HeaderMatcher{{Name: "x-api-key"}},
+			ForwardBearerToken: true,
+			CookieDomain:       "example.com",
+		}
+		vhostParams = plugins.VirtualHostParams{
+			Params: plugins.Params{
+				Ctx: context.TODO(),
+				Snapshot: &v1snap.ApiSnapshot{
+					Upstreams: v1.UpstreamList{upstream},
+					Secrets:   secrets,
+				},
+			},
+			HttpListener: listener,
+		}
+		virtualHost = &v1.VirtualHost{
+			Name:    "vhost",
+			Options: &v1.VirtualHostOptions{Oauth2: config},
+		}
+		outVhost = &envoy_config_route_v3.VirtualHost{}
+
+		filterConfig = func() *envoyoauth2.OAuth2 {
+			filters, err := p.HttpFilters(vhostParams.Params, listener)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			Expect(filters[0].Filter.GetName()).To(HavePrefix(FilterName + "."))
+			Expect(filters[0].Filter.GetDisabled()).To(BeTrue())
+			Expect(filters[0].Stage).To(Equal(plugins.DuringStage(plugins.AuthNStage)))
+
+			var config envoyoauth2.OAuth2
+			Expect(filters[0].Filter.GetTypedConfig().UnmarshalTo(&config)).To(Succeed())
+			return &config
+		}
+	})
+
+	perFilterConfig := func(typedPerFilterConfig map[string]*anypb.Any, name string) *envoy_config_route_v3.FilterConfig {
+		ExpectWithOffset(1, typedPerFilterConfig).To(HaveKey(name))
+		var config envoy_config_route_v3.FilterConfig
+		ExpectWithOffset(1, typedPerFilterConfig[name].UnmarshalTo(&config)).To(Succeed())
+		return &config
+	}
+
+	filterNames := func() []string {
+		filters, err := p.HttpFilters(vhostParams.Params, listener)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		var names []string
+		for _, filter := range filters {
+			names = append(names, filter.Filter.GetName())
+		}
+		return names
+	}
+
+	It("does not add the filter when no virtual host configures oauth2", func() {
+		Expect(p.ProcessVirtualHost(vhostParams, &v1.VirtualHost{}, outVhost)).To(Succeed())
+		filters, err := p.HttpFilters(vhostParams.Params, listener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+		Expect(outVhost.GetTypedPerFilterConfig()).To(BeEmpty())
+	})
+
+	It("enforces the oauth2 config of a virtual host", func() {
+		Expect(p.ProcessVirtualHost(vhostParams, virtualHost, outVhost)).To(Succeed())
+		names := filterNames()
+		Expect(names).To(HaveLen(1))
+		Expect(perFilterConfig(outVhost.GetTypedPerFilterConfig(), names[0]).GetDisabled()).To(BeFalse())
+
+		config := filterConfig().GetConfig()
+		Expect(config.GetTokenEndpoint().GetUri()).To(Equal("https://idp.example.com/token"))
+		Expect(config.GetTokenEndpoint().GetCluster()).To(Equal(translator.UpstreamToClusterName(upstream.GetMetadata().Ref())))
+		Expect(config.GetTokenEndpoint().GetTimeout().AsDuration()).To(Equal(3 * time.Second))
+		Expect(config.GetAuthorizationEndpoint()).To(Equal("https://idp.example.com/authorize?prompt=login"))
+		Expect(config.GetRedirectUri()).To(Equal("https://app.example.com/callback"))
+		Expect(config.GetRedirectPathMatcher().GetPath().GetExact()).To(Equal("/callback"))
+		Expect(config.GetSignoutPath().GetPath().GetExact()).To(Equal("/logout"))
+		Expect(config.GetForwardBearerToken()).To(BeTrue())
+		Expect(config.GetAuthScopes()).To(Equal([]string{"openid", "email"}))
+		Expect(config.GetPassThroughMatcher()).To(HaveLen(1))
+		Expect(config.GetPassThroughMatcher()[0].GetName()).To(Equal("x-api-key"))
+		Expect(config.GetPassThroughMatcher()[0].GetPresentMatch()).To(BeTrue())
+
+		credentials := config.GetCredentials()
+		Expect(credentials.GetClientId()).To(Equal("client-id"))
+		Expect(credentials.GetCookieDomain()).To(Equal("example.com"))
+		Expect(credentials.GetTokenSecret().GetSdsConfig().GetAds()).NotTo(BeNil())
+		Expect(credentials.GetHmacSecret().GetSdsConfig().GetAds()).NotTo(BeNil())
+
+		secrets, err := p.GeneratedSecrets(vhostParams.Params)
+		Expect(err).NotTo(HaveOccurred())
+		secretValues := map[string]string{}
+		for _, secret := range secrets {
+			secretValues[secret.GetName()] = secret.GetGenericSecret().GetSecret().GetInlineString()
+		}
+		Expect(secretValues).To(Equal(map[string]string{
+			credentials.GetTokenSecret().GetName(): "client-secret",
+			credentials.GetHmacSecret().GetName():  "an-encryption-key-of-32-bytes...",
+		}))
+	})
+
+	It("defaults the redirect uri, the token endpoint timeout, and keeps the bearer token from the upstream", func() {
+		config.RedirectPath = ""
+		config.RedirectUri = ""
+		config.SignoutPath = ""
+		config.ForwardBearerToken = false
+		config.GetTokenEndpoint().Timeout = nil
+		Expect(p.ProcessVirtualHost(vhostParams, virtualHost, outVhost)).To(Succeed())
+
+		config := filterConfig().GetConfig()
+		Expect(config.GetRedirectUri()).To(Equal("%REQ(x-forwarded-proto)%://%REQ(:authority)%" + DefaultRedirectPath))
+		Expect(config.GetRedirectPathMatcher().GetPath().GetExact()).To(Equal(DefaultRedirectPath))
+		Expect(config.GetSignoutPath().GetPath().GetExact()).To(BeEmpty())
+		Expect(config.GetForwardBearerToken()).To(BeFalse())
+		Expect(config.GetTokenEndpoint().GetTimeout().AsDuration()).To(Equal(5 * time.Second))
+	})
+
+	It("sends the requests to the token endpoint to the upstream of the authorization endpoint by default", func() {
+		config.GetTokenEndpoint().Upstream = nil
+		config.GetAuthorizationEndpoint().Upstream = upstream.GetMetadata().Ref()
+		Expect(p.ProcessVirtualHost(vhostParams, virtualHost, outVhost)).To(Succeed())
+
+		Expect(filterConfig().GetConfig().GetTokenEndpoint().GetCluster()).To(Equal(translator.UpstreamToClusterName(upstream.GetMetadata().Ref())))
+	})
+
+	Context("routes", func() {
+
+		var (
+			routeParams plugins.RouteParams
+			outRoute    *envoy_config_route_v3.Route
+		)
+
+		BeforeEach(func() {
+			routeParams = plugins.RouteParams{VirtualHostParams: vhostParams, VirtualHost: virtualHost}
+			outRoute = &envoy_config_route_v3.Route{}
+		})
+
+		It("uses the filter of the virtual host for routes without an oauth2 config", func() {
+			Expect(p.ProcessRoute(routeParams, &v1.Route{}, outRoute)).To(Succeed())
+			Expect(outRoute.GetTypedPerFilterConfig()).To(BeEmpty())
+		})
+
+		It("enforces the oauth2 config of a route in place of the one of its virtual host", func() {
+			routeConfig := config.Clone().(*oauth2.OAuth2)
+			routeConfig.AuthScopes = []string{"admin"}
+
+			Expect(p.ProcessRoute(routeParams, &v1.Route{
+				Options: &v1.RouteOptions{Oauth2: routeConfig},
+			}, outRoute)).To(Succeed())
+			Expect(p.ProcessVirtualHost(vhostParams, virtualHost, outVhost)).To(Succeed())
+
+			names := filterNames()
+			Expect(names).To(HaveLen(2))
+			Expect(outVhost.GetTypedPerFilterConfig()).To(HaveLen(1))
+			for _, name := range names {
+				if _, ok := outVhost.GetTypedPerFilterConfig()[name]; ok {
+					Expect(perFilterConfig(outRoute.GetTypedPerFilterConfig(), name).GetDisabled()).To(BeTrue())
+				} else {
+					Expect(perFilterConfig(outRoute.GetTypedPerFilterConfig(), name).GetDisabled()).To(BeFalse())
+				}
+			}
+		})
+
+		It("shares the filter of the virtual host with routes of the same config", func() {
+			Expect(p.ProcessRoute(routeParams, &v1.Route{
+				Options: &v1.RouteOptions{Oauth2: config.Clone().(*oauth2.OAuth2)},
+			}, outRoute)).To(Succeed())
+			Expect(p.ProcessVirtualHost(vhostParams, virtualHost, outVhost)).To(Succeed())
+
+			names := filterNames()
+			Expect(names).To(HaveLen(1))
+			Expect(outRoute.GetTypedPerFilterConfig()).To(HaveLen(1))
+			Expect(perFilterConfig(outRoute.GetTypedPerFilterConfig(), names[0]).GetDisabled()).To(BeFalse())
+		})
+	})
+
+	It("errors when the token endpoint has no upstream", func() {
+		config.GetTokenEndpoint().Upstream = nil
+		err := p.ProcessVirtualHost(vhostParams, virtualHost, outVhost)
+		Expect(err).To(MatchError(NoTokenEndpointUpstreamError))
+	})
+
+	It("errors when the upstream of the token endpoint does not exist", func() {
+		vhostParams.Snapshot.Upstreams = nil
+		err := p.ProcessVirtualHost(vhostParams, virtualHost, outVhost)
+		Expect(err).To(MatchError(ContainSubstring("finding the upstream of the oauth2 token endpoint")))
+	})
+
+	It("errors when the client secret is not an oauth secret", func() {
+		config.ClientSecretRef = config.GetHmacSecretRef()
+		err := p.ProcessVirtualHost(vhostParams, virtualHost, outVhost)
+		Expect(err).To(MatchError(ContainSubstring("is not of kind oauth")))
+	})
+})
@@ -10,6 +10,7 @@ import (
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
 )
@@ -187,6 +188,13 @@ type ResourceGeneratorPlugin interface {
 	) ([]*envoy_config_cluster_v3.Cluster, []*envoy_config_endpoint_v3.ClusterLoadAssignment, []*envoy_config_route_v3.RouteConfiguration, []*envoy_config_listener_v3.Listener, error)
 }
 
+// SecretGeneratorPlugin generates the Secrets that the filters it configured during translation fetch with SDS.
+// It is called after the listeners of a Proxy are translated.
+type SecretGeneratorPlugin interface {
+	Plugin
+	GeneratedSecrets(params Params) ([]*envoy_extensions_transport_sockets_tls_v3.Secret, error)
+}
+
 // A PluginRegistry is used to provide Plugins to relevant translators
 // Historically, all plugins were passed around as an argument, and each translator
 // would iterate over all plugins, and only apply the relevant ones.
@@ -201,6 +209,7 @@ type PluginRegistry interface {
 	GetHttpConnectionManagerPlugins() []HttpConnectionManagerPlugin
 	GetVirtualHostPlugins() []VirtualHostPlugin
 	GetResourceGeneratorPlugins() []ResourceGeneratorPlugin
+	GetSecretGeneratorPlugins() []SecretGeneratorPlugin
 	GetUpstreamPlugins() []UpstreamPlugin
 	GetEndpointPlugins() []EndpointPlugin
 	GetRoutePlugins() []RoutePlugin
@@ -0,0 +1,45 @@
+package pluginutils_test
+
+import (
+	"context"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/pkg/utils/regexutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	skMatchers "github.com/solo-io/solo-kit/test/matchers"
+)
+
+var _ = Describe("EnvoyHeaderMatcher", func() {
+
+	It("converts present, exact, regex and inverted matchers", func() {
+		ctx := context.Background()
+		out := EnvoyHeaderMatcher(ctx, []*matchers.HeaderMatcher{
+			{Name: "present"},
+			{Name: "exact", Value: "value"},
+			{Name: "regex", Value: "val.*", Regex: true},
+			{Name: "inverted", Value: "value", InvertMatch: true},
+		})
+
+		Expect(out).To(HaveLen(4))
+		Expect(out[0]).To(skMatchers.MatchProto(&envoy_config_route_v3.HeaderMatcher{
+			Name:                 "present",
+			HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_PresentMatch{PresentMatch: true},
+		}))
+		Expect(out[1]).To(skMatchers.MatchProto(&envoy_config_route_v3.HeaderMatcher{
+			Name:                 "exact",
+			HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_ExactMatch{ExactMatch: "value"},
+		}))
+		Expect(out[2]).To(skMatchers.MatchProto(&envoy_config_route_v3.HeaderMatcher{
+			Name:                 "regex",
+			HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_SafeRegexMatch{SafeRegexMatch: regexutils.NewRegex(ctx, "val.*")},
+		}))
+		Expect(out[3]).To(skMatchers.MatchProto(&envoy_config_route_v3.HeaderMatcher{
+			Name:                 "inverted",
+			HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_ExactMatch{ExactMatch: "value"},
+			InvertMatch:          true,
+		}))
+	})
+})
@@ -31,6 +31,10 @@ func MarkHeaders(ctx context.Context, snap *v1snap.ApiSnapshot, in *v1.Route, ou
 		if err != nil {
 			return NewUpstreamGroupNotFoundErr(dest.UpstreamGroup)
 		}
+		if upstreamGroup.GetFailover().GetMode() == v1.UpstreamGroup_Failover_PRIORITY {
+			// intentionally ignored because the destination is picked at runtime by the aggregate cluster
+			return nil
+		}
 		return configureHeadersMultiDest(upstreamGroup.GetDestinations(), outAction, headers)
 	case *v1.RouteAction_Multi:
 		return configureHeadersMultiDest(dest.Multi.GetDestinations(), outAction, headers)
@@ -100,6 +100,10 @@ func ModifyPerFilterConfig(
 		if err != nil {
 			return NewUpstreamGroupNotFoundErr(dest.UpstreamGroup)
 		}
+		if upstreamGroup.GetFailover().GetMode() == v1.UpstreamGroup_Failover_PRIORITY {
+			// intentionally ignored because the destination is picked at runtime by the aggregate cluster
+			return nil
+		}
 		return configureMultiDest(upstreamGroup.GetDestinations(), outAction, filterName, perFilterConfig)
 	case *v1.RouteAction_Multi:
 		return configureMultiDest(dest.Multi.GetDestinations(), outAction, filterName, perFilterConfig)
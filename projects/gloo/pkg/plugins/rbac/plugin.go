@@ -0,0 +1,475 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_rbac_v3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyrbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/regexutils"
+	solo_matcher "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/rbac"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	jwtplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+)
+
+var (
+	_ plugins.Plugin            = new(plugin)
+	_ plugins.HttpFilterPlugin  = new(plugin)
+	_ plugins.VirtualHostPlugin = new(plugin)
+	_ plugins.RoutePlugin       = new(plugin)
+)
+
+const (
+	ExtensionName = "rbac"
+	FilterName    = "envoy.filters.http.rbac"
+
+	// ShadowRulesStatPrefix prefixes the stats of the decisions of the shadow policies
+	ShadowRulesStatPrefix = "gloo_shadow_"
+)
+
+// the principals are matched against the payloads that the jwt_authn filters verify, during the AuthNStage
+var pluginStage = plugins.DuringStage(plugins.AuthZStage)
+
+var (
+	NoJwtProviderError = func(policyName string) error {
+		return eris.Errorf("rbac policy %s has a jwt principal but no jwt provider is configured", policyName)
+	}
+	EmptyPrincipalError = func(policyName, principalKind string) error {
+		return eris.Errorf("rbac policy %s has a %s principal without any value to match", policyName, principalKind)
+	}
+	InvalidCidrRangeError = func(err error, policyName string) error {
+		return eris.Wrapf(err, "rbac policy %s has an invalid cidr range", policyName)
+	}
+)
+
+type plugin struct {
+	requireRbac bool
+	// filterRequiredForListener are the listeners with a virtual host or route that has rbac options
+	filterRequiredForListener map[*v1.HttpListener]struct{}
+}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(params plugins.InitParams) {
+	p.requireRbac = params.Settings.GetRbac().GetRequireRbac()
+	p.filterRequiredForListener = make(map[*v1.HttpListener]struct{})
+}
+
+func (p *plugin) ProcessVirtualHost(
+	params plugins.VirtualHostParams,
+	in *v1.VirtualHost,
+	out *envoy_config_route_v3.VirtualHost,
+) error {
+	rbacSettings := in.GetOptions().GetRbac()
+	if rbacSettings == nil && !p.requireRbac {
+		return nil
+	}
+
+	// a virtual host without policies falls back to a deny-all policy when rbac is required
+	perRoute, err := translateExtensionSettings(params.Ctx, rbacSettings, jwtProviderNames(nil, in))
+	if err != nil {
+		return err
+	}
+	p.filterRequiredForListener[params.HttpListener] = struct{}{}
+	return pluginutils.SetVhostPerFilterConfig(out, FilterName, perRoute)
+}
+
+func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	rbacSettings := in.GetOptions().GetRbac()
+	if rbacSettings == nil {
+		return nil
+	}
+
+	perRoute, err := translateExtensionSettings(params.Ctx, rbacSettings, jwtProviderNames(in, params.VirtualHost))
+	if err != nil {
+		return err
+	}
+	p.filterRequiredForListener[params.HttpListener] = struct{}{}
+	return pluginutils.SetRoutePerFilterConfig(out, FilterName, perRoute)
+}
+
+func (p *plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	if _, ok := p.filterRequiredForListener[listener]; !ok {
+		return []plugins.StagedHttpFilter{}, nil
+	}
+
+	// the filter allows all requests, unless the virtual host or route configures policies
+	rbacFilter, err := plugins.NewStagedFilter(FilterName, &envoyrbac.RBAC{}, pluginStage)
+	if err != nil {
+		return nil, eris.Wrap(err, "generating filter config")
+	}
+	return []plugins.StagedHttpFilter{rbacFilter}, nil
+}
+
+// translateExtensionSettings translates the rbac settings of a virtual host or route. The enforced policies allow the
+// requests that match any of them, and deny the others. The shadow policies only record their decisions, so the
+// filter allows all requests when every policy is a shadow one.
+func translateExtensionSettings(ctx context.Context, settings *rbac.ExtensionSettings, providerNames []string) (*envoyrbac.RBACPerRoute, error) {
+	if settings.GetDisable() {
+		// the filter is disabled on the resources whose per route config has no rules
+		return &envoyrbac.RBACPerRoute{}, nil
+	}
+
+	rules := &envoy_config_rbac_v3.RBAC{
+		Action:   envoy_config_rbac_v3.RBAC_ALLOW,
+		Policies: map[string]*envoy_config_rbac_v3.Policy{},
+	}
+	shadowRules := &envoy_config_rbac_v3.RBAC{
+		Action:   envoy_config_rbac_v3.RBAC_ALLOW,
+		Policies: map[string]*envoy_config_rbac_v3.Policy{},
+	}
+	shadowOnly := len(settings.GetPolicies()) > 0
+	for name, policy := range settings.GetPolicies() {
+		if !policy.GetShadow() {
+			shadowOnly = false
+		}
+		envoyPolicy, err := translatePolicy(ctx, name, policy, providerNames)
+		if err != nil {
+			return nil, err
+		}
+		if envoyPolicy == nil {
+			continue
+		}
+		if policy.GetShadow() {
+			shadowRules.Policies[name] = envoyPolicy
+		} else {
+			rules.Policies[name] = envoyPolicy
+		}
+	}
+
+	config := &envoyrbac.RBAC{}
+	if !shadowOnly {
+		config.Rules = rules
+	}
+	if len(shadowRules.GetPolicies()) > 0 {
+		config.ShadowRules = shadowRules
+		config.ShadowRulesStatPrefix = ShadowRulesStatPrefix
+	}
+	return &envoyrbac.RBACPerRoute{Rbac: config}, nil
+}
+
+// translatePolicy returns nil for a policy without principals, since it doesn't grant any permission
+func translatePolicy(ctx context.Context, name string, policy *rbac.Policy, providerNames []string) (*envoy_config_rbac_v3.Policy, error) {
+	var principals []*envoy_config_rbac_v3.Principal
+	for _, principal := range policy.GetPrincipals() {
+		envoyPrincipal, err := translatePrincipal(ctx, name, policy, principal, providerNames)
+		if err != nil {
+			return nil, err
+		}
+		if envoyPrincipal != nil {
+			principals = append(principals, envoyPrincipal)
+		}
+	}
+	if len(principals) == 0 {
+		return nil, nil
+	}
+
+	return &envoy_config_rbac_v3.Policy{
+		Permissions: []*envoy_config_rbac_v3.Permission{translatePermissions(ctx, policy.GetPermissions())},
+		Principals:  principals,
+	}, nil
+}
+
+// translatePrincipal matches all the fields of the principal. It returns nil for a principal without fields.
+func translatePrincipal(
+	ctx context.Context,
+	policyName string,
+	policy *rbac.Policy,
+	principal *rbac.Principal,
+	providerNames []string,
+) (*envoy_config_rbac_v3.Principal, error) {
+	var ids []*envoy_config_rbac_v3.Principal
+	if jwtPrincipal := principal.GetJwtPrincipal(); jwtPrincipal != nil {
+		providers := providerNames
+		if jwtPrincipal.GetProvider() != "" {
+			providers = []string{jwtPrincipal.GetProvider()}
+		}
+		if len(providers) == 0 {
+			return nil, NoJwtProviderError(policyName)
+		}
+		ids = append(ids, translateJwtPrincipal(jwtPrincipal, providers, policy.GetNestedClaimDelimiter()))
+	}
+
+	if sourceIpPrincipal := principal.GetSourceIpPrincipal(); sourceIpPrincipal != nil {
+		if len(sourceIpPrincipal.GetCidrRanges()) == 0 {
+			return nil, EmptyPrincipalError(policyName, "source ip")
+		}
+		var rangeIds []*envoy_config_rbac_v3.Principal
+		for _, cidrRange := range sourceIpPrincipal.GetCidrRanges() {
+			_, _, err := net.ParseCIDR(fmt.Sprintf("%s/%d", cidrRange.GetAddressPrefix(), cidrRange.GetPrefixLen().GetValue()))
+			if err != nil {
+				return nil, InvalidCidrRangeError(err, policyName)
+			}
+			envoyRange := &envoy_config_core_v3.CidrRange{
+				AddressPrefix: cidrRange.GetAddressPrefix(),
+				PrefixLen:     cidrRange.GetPrefixLen(),
+			}
+			if sourceIpPrincipal.GetDirectRemoteAddress() {
+				rangeIds = append(rangeIds, &envoy_config_rbac_v3.Principal{
+					Identifier: &envoy_config_rbac_v3.Principal_DirectRemoteIp{DirectRemoteIp: envoyRange},
+				})
+			} else {
+				rangeIds = append(rangeIds, &envoy_config_rbac_v3.Principal{
+					Identifier: &envoy_config_rbac_v3.Principal_RemoteIp{RemoteIp: envoyRange},
+				})
+			}
+		}
+		ids = append(ids, anyPrincipal(rangeIds))
+	}
+
+	if headerPrincipal := principal.GetHeaderPrincipal(); headerPrincipal != nil {
+		if len(headerPrincipal.GetHeaders()) == 0 {
+			return nil, EmptyPrincipalError(policyName, "header")
+		}
+		var headerIds []*envoy_config_rbac_v3.Principal
+		for _, header := range pluginutils.EnvoyHeaderMatcher(ctx, headerPrincipal.GetHeaders()) {
+			headerIds = append(headerIds, &envoy_config_rbac_v3.Principal{
+				Identifier: &envoy_config_rbac_v3.Principal_Header{Header: header},
+			})
+		}
+		ids = append(ids, allPrincipals(headerIds))
+	}
+
+	if mtlsPrincipal := principal.GetMtlsPrincipal(); mtlsPrincipal != nil {
+		if len(mtlsPrincipal.GetSans()) == 0 {
+			return nil, EmptyPrincipalError(policyName, "mtls")
+		}
+		// the authenticated principal matches the URI SANs of the peer certificate, then its DNS SANs, then its subject
+		var sanIds []*envoy_config_rbac_v3.Principal
+		for _, san := range mtlsPrincipal.GetSans() {
+			sanIds = append(sanIds, &envoy_config_rbac_v3.Principal{
+				Identifier: &envoy_config_rbac_v3.Principal_Authenticated_{
+					Authenticated: &envoy_config_rbac_v3.Principal_Authenticated{
+						PrincipalName: translateStringMatcher(ctx, san),
+					},
+				},
+			})
+		}
+		ids = append(ids, anyPrincipal(sanIds))
+	}
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return allPrincipals(ids), nil
+}
+
+// translateJwtPrincipal matches the claims of the payload verified by any of the providers.
+// The jwt_authn filters write each payload to the dynamic metadata, under the name of the provider.
+func translateJwtPrincipal(principal *rbac.JWTPrincipal, providers []string, nestedClaimDelimiter string) *envoy_config_rbac_v3.Principal {
+	claims := make([]string, 0, len(principal.GetClaims()))
+	for claim := range principal.GetClaims() {
+		claims = append(claims, claim)
+	}
+	sort.Strings(claims)
+
+	var providerIds []*envoy_config_rbac_v3.Principal
+	for _, provider := range providers {
+		var claimIds []*envoy_config_rbac_v3.Principal
+		for _, claim := range claims {
+			path := []*envoy_type_matcher_v3.MetadataMatcher_PathSegment{metadataKey(provider)}
+			claimPath := []string{claim}
+			if nestedClaimDelimiter != "" {
+				claimPath = strings.Split(claim, nestedClaimDelimiter)
+			}
+			for _, segment := range claimPath {
+				path = append(path, metadataKey(segment))
+			}
+			claimIds = append(claimIds, &envoy_config_rbac_v3.Principal{
+				Identifier: &envoy_config_rbac_v3.Principal_Metadata{
+					Metadata: &envoy_type_matcher_v3.MetadataMatcher{
+						Filter: jwtplugin.PayloadMetadataNamespace,
+						Path:   path,
+						Value:  claimValueMatcher(principal.GetMatcher(), principal.GetClaims()[claim]),
+					},
+				},
+			})
+		}
+		providerIds = append(providerIds, &envoy_config_rbac_v3.Principal{
+			Identifier: &envoy_config_rbac_v3.Principal_AndIds{
+				AndIds: &envoy_config_rbac_v3.Principal_Set{Ids: claimIds},
+			},
+		})
+	}
+
+	return anyPrincipal(providerIds)
+}
+
+// anyPrincipal matches any of the ids, which must not be empty
+func anyPrincipal(ids []*envoy_config_rbac_v3.Principal) *envoy_config_rbac_v3.Principal {
+	if len(ids) == 1 {
+		return ids[0]
+	}
+	return &envoy_config_rbac_v3.Principal{
+		Identifier: &envoy_config_rbac_v3.Principal_OrIds{
+			OrIds: &envoy_config_rbac_v3.Principal_Set{Ids: ids},
+		},
+	}
+}
+
+// allPrincipals matches all the ids, which must not be empty
+func allPrincipals(ids []*envoy_config_rbac_v3.Principal) *envoy_config_rbac_v3.Principal {
+	if len(ids) == 1 {
+		return ids[0]
+	}
+	return &envoy_config_rbac_v3.Principal{
+		Identifier: &envoy_config_rbac_v3.Principal_AndIds{
+			AndIds: &envoy_config_rbac_v3.Principal_Set{Ids: ids},
+		},
+	}
+}
+
+func claimValueMatcher(matcher rbac.JWTPrincipal_ClaimMatcher, value string) *envoy_type_matcher_v3.ValueMatcher {
+	exactMatch := &envoy_type_matcher_v3.ValueMatcher{
+		MatchPattern: &envoy_type_matcher_v3.ValueMatcher_StringMatch{
+			StringMatch: &envoy_type_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: value},
+			},
+		},
+	}
+	switch matcher {
+	case rbac.JWTPrincipal_BOOLEAN:
+		return &envoy_type_matcher_v3.ValueMatcher{
+			MatchPattern: &envoy_type_matcher_v3.ValueMatcher_BoolMatch{BoolMatch: value == "true"},
+		}
+	case rbac.JWTPrincipal_LIST_CONTAINS:
+		return &envoy_type_matcher_v3.ValueMatcher{
+			MatchPattern: &envoy_type_matcher_v3.ValueMatcher_ListMatch{
+				ListMatch: &envoy_type_matcher_v3.ListMatcher{
+					MatchPattern: &envoy_type_matcher_v3.ListMatcher_OneOf{OneOf: exactMatch},
+				},
+			},
+		}
+	default:
+		return exactMatch
+	}
+}
+
+func metadataKey(key string) *envoy_type_matcher_v3.MetadataMatcher_PathSegment {
+	return &envoy_type_matcher_v3.MetadataMatcher_PathSegment{
+		Segment: &envoy_type_matcher_v3.MetadataMatcher_PathSegment_Key{Key: key},
+	}
+}
+
+// translatePermissions matches all the fields of the permissions. Permissions without fields match any request.
+func translatePermissions(ctx context.Context, permissions *rbac.Permissions) *envoy_config_rbac_v3.Permission {
+	var rules []*envoy_config_rbac_v3.Permission
+	if prefix := permissions.GetPathPrefix(); prefix != "" {
+		rules = append(rules, &envoy_config_rbac_v3.Permission{
+			Rule: &envoy_config_rbac_v3.Permission_UrlPath{
+				UrlPath: &envoy_type_matcher_v3.PathMatcher{
+					Rule: &envoy_type_matcher_v3.PathMatcher_Path{
+						Path: &envoy_type_matcher_v3.StringMatcher{
+							MatchPattern: &envoy_type_matcher_v3.StringMatcher_Prefix{Prefix: prefix},
+						},
+					},
+				},
+			},
+		})
+	}
+	if len(permissions.GetMethods()) > 0 {
+		var methodRules []*envoy_config_rbac_v3.Permission
+		for _, method := range permissions.GetMethods() {
+			methodRules = append(methodRules, &envoy_config_rbac_v3.Permission{
+				Rule: &envoy_config_rbac_v3.Permission_Header{
+					Header: &envoy_config_route_v3.HeaderMatcher{
+						Name: ":method",
+						HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_StringMatch{
+							StringMatch: &envoy_type_matcher_v3.StringMatcher{
+								MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: method},
+							},
+						},
+					},
+				},
+			})
+		}
+		rules = append(rules, &envoy_config_rbac_v3.Permission{
+			Rule: &envoy_config_rbac_v3.Permission_OrRules{
+				OrRules: &envoy_config_rbac_v3.Permission_Set{Rules: methodRules},
+			},
+		})
+	}
+	for _, header := range pluginutils.EnvoyHeaderMatcher(ctx, permissions.GetHeaders()) {
+		rules = append(rules, &envoy_config_rbac_v3.Permission{
+			Rule: &envoy_config_rbac_v3.Permission_Header{Header: header},
+		})
+	}
+
+	switch len(rules) {
+	case 0:
+		return &envoy_config_rbac_v3.Permission{
+			Rule: &envoy_config_rbac_v3.Permission_Any{Any: true},
+		}
+	case 1:
+		return rules[0]
+	default:
+		return &envoy_config_rbac_v3.Permission{
+			Rule: &envoy_config_rbac_v3.Permission_AndRules{
+				AndRules: &envoy_config_rbac_v3.Permission_Set{Rules: rules},
+			},
+		}
+	}
+}
+
+func translateStringMatcher(ctx context.Context, in *solo_matcher.StringMatcher) *envoy_type_matcher_v3.StringMatcher {
+	out := &envoy_type_matcher_v3.StringMatcher{IgnoreCase: in.GetIgnoreCase()}
+	switch typed := in.GetMatchPattern().(type) {
+	case *solo_matcher.StringMatcher_Exact:
+		out.MatchPattern = &envoy_type_matcher_v3.StringMatcher_Exact{Exact: typed.Exact}
+	case *solo_matcher.StringMatcher_Prefix:
+		out.MatchPattern = &envoy_type_matcher_v3.StringMatcher_Prefix{Prefix: typed.Prefix}
+	case *solo_matcher.StringMatcher_Suffix:
+		out.MatchPattern = &envoy_type_matcher_v3.StringMatcher_Suffix{Suffix: typed.Suffix}
+	case *solo_matcher.StringMatcher_SafeRegex:
+		out.MatchPattern = &envoy_type_matcher_v3.StringMatcher_SafeRegex{
+			SafeRegex: regexutils.NewRegex(ctx, typed.SafeRegex.GetRegex()),
+		}
+	}
+	return out
+}
+
+// jwtProviderNames returns the sorted names of the jwt providers that verify the requests of a route, or of a
+// virtual host if the route is nil. A route with its own providers doesn't use the ones of its virtual host.
+func jwtProviderNames(route *v1.Route, virtualHost *v1.VirtualHost) []string {
+	var extensions []*jwt.VhostExtension
+	if routeProviders := route.GetOptions().GetJwtProvidersStaged(); routeProviders != nil {
+		extensions = append(extensions, routeProviders.GetBeforeExtAuth(), routeProviders.GetAfterExtAuth())
+	} else {
+		switch jwtConfig := virtualHost.GetOptions().GetJwtConfig().(type) {
+		case *v1.VirtualHostOptions_Jwt:
+			extensions = append(extensions, jwtConfig.Jwt)
+		case *v1.VirtualHostOptions_JwtStaged:
+			extensions = append(extensions, jwtConfig.JwtStaged.GetBeforeExtAuth(), jwtConfig.JwtStaged.GetAfterExtAuth())
+		}
+	}
+
+	names := map[string]struct{}{}
+	for _, extension := range extensions {
+		for name := range extension.GetProviders() {
+			names[name] = struct{}{}
+		}
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
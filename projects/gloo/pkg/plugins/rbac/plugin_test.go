@@ -0,0 +1,360 @@
+package rbac_test
+
+import (
+	"context"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_rbac_v3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyrbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	solo_core_v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	solo_matcher "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	gloo_matchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/rbac"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	jwtplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/jwt"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/rbac"
+	"github.com/solo-io/solo-kit/test/matchers"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		p           plugins.Plugin
+		settings    *v1.Settings
+		listener    *v1.HttpListener
+		vhostParams plugins.VirtualHostParams
+		virtualHost *v1.VirtualHost
+		outVhost    *envoy_config_route_v3.VirtualHost
+	)
+
+	BeforeEach(func() {
+		p = NewPlugin()
+		settings = &v1.Settings{}
+		listener = &v1.HttpListener{}
+		vhostParams = plugins.VirtualHostParams{
+			Params:       plugins.Params{Ctx: context.TODO()},
+			HttpListener: listener,
+		}
+		virtualHost = &v1.VirtualHost{
+			Name: "vhost",
+			Options: &v1.VirtualHostOptions{
+				JwtConfig: &v1.VirtualHostOptions_JwtStaged{
+					JwtStaged: &jwt.JwtStagedVhostExtension{
+						BeforeExtAuth: &jwt.VhostExtension{
+							Providers: map[string]*jwt.Provider{"auth": {}},
+						},
+					},
+				},
+				Rbac: &rbac.ExtensionSettings{
+					Policies: map[string]*rbac.Policy{
+						"admins": {
+							Principals: []*rbac.Principal{{
+								JwtPrincipal: &rbac.JWTPrincipal{
+									Claims: map[string]string{"groups": "admin"},
+									// the provider is chosen from the jwt options of the virtual host
+									Matcher: rbac.JWTPrincipal_LIST_CONTAINS,
+								},
+							}},
+							Permissions: &rbac.Permissions{
+								PathPrefix: "/admin",
+								Methods:    []string{"GET"},
+							},
+						},
+					},
+				},
+			},
+		}
+		outVhost = &envoy_config_route_v3.VirtualHost{}
+	})
+
+	processVirtualHost := func() *envoyrbac.RBACPerRoute {
+		p.Init(plugins.InitParams{Ctx: context.TODO(), Settings: settings})
+		err := p.(plugins.VirtualHostPlugin).ProcessVirtualHost(vhostParams, virtualHost, outVhost)
+		Expect(err).NotTo(HaveOccurred())
+
+		var perRoute envoyrbac.RBACPerRoute
+		Expect(outVhost.GetTypedPerFilterConfig()[FilterName].UnmarshalTo(&perRoute)).To(Succeed())
+		return &perRoute
+	}
+
+	It("does not add the filter when rbac is not configured", func() {
+		p.Init(plugins.InitParams{Ctx: context.TODO(), Settings: settings})
+		err := p.(plugins.VirtualHostPlugin).ProcessVirtualHost(vhostParams, &v1.VirtualHost{Name: "vhost"}, outVhost)
+		Expect(err).NotTo(HaveOccurred())
+
+		filters, err := p.(plugins.HttpFilterPlugin).HttpFilters(plugins.Params{}, listener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("translates the policies of a virtual host into rules that match the jwt claims", func() {
+		perRoute := processVirtualHost()
+
+		Expect(perRoute.GetRbac().GetRules()).To(matchers.MatchProto(&envoy_config_rbac_v3.RBAC{
+			Action: envoy_config_rbac_v3.RBAC_ALLOW,
+			Policies: map[string]*envoy_config_rbac_v3.Policy{
+				"admins": {
+					Permissions: []*envoy_config_rbac_v3.Permission{{
+						Rule: &envoy_config_rbac_v3.Permission_AndRules{
+							AndRules: &envoy_config_rbac_v3.Permission_Set{
+								Rules: []*envoy_config_rbac_v3.Permission{
+									{
+										Rule: &envoy_config_rbac_v3.Permission_UrlPath{
+											UrlPath: &envoy_type_matcher_v3.PathMatcher{
+												Rule: &envoy_type_matcher_v3.PathMatcher_Path{
+													Path: &envoy_type_matcher_v3.StringMatcher{
+														MatchPattern: &envoy_type_matcher_v3.StringMatcher_Prefix{Prefix: "/admin"},
+													},
+												},
+											},
+										},
+									},
+									{
+										Rule: &envoy_config_rbac_v3.Permission_OrRules{
+											OrRules: &envoy_config_rbac_v3.Permission_Set{
+												Rules: []*envoy_config_rbac_v3.Permission{{
+													Rule: &envoy_config_rbac_v3.Permission_Header{
+														Header: &envoy_config_route_v3.HeaderMatcher{
+															Name: ":method",
+															HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_StringMatch{
+																StringMatch: &envoy_type_matcher_v3.StringMatcher{
+																	MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: "GET"},
+																},
+															},
+														},
+													},
+												}},
+											},
+										},
+									},
+								},
+							},
+						},
+					}},
+					Principals: []*envoy_config_rbac_v3.Principal{{
+						Identifier: &envoy_config_rbac_v3.Principal_AndIds{
+							AndIds: &envoy_config_rbac_v3.Principal_Set{
+								Ids: []*envoy_config_rbac_v3.Principal{{
+									Identifier: &envoy_config_rbac_v3.Principal_Metadata{
+										Metadata: &envoy_type_matcher_v3.MetadataMatcher{
+											Filter: jwtplugin.PayloadMetadataNamespace,
+											Path: []*envoy_type_matcher_v3.MetadataMatcher_PathSegment{
+												{Segment: &envoy_type_matcher_v3.MetadataMatcher_PathSegment_Key{Key: "auth"}},
+												{Segment: &envoy_type_matcher_v3.MetadataMatcher_PathSegment_Key{Key: "groups"}},
+											},
+											Value: &envoy_type_matcher_v3.ValueMatcher{
+												MatchPattern: &envoy_type_matcher_v3.ValueMatcher_ListMatch{
+													ListMatch: &envoy_type_matcher_v3.ListMatcher{
+														MatchPattern: &envoy_type_matcher_v3.ListMatcher_OneOf{
+															OneOf: &envoy_type_matcher_v3.ValueMatcher{
+																MatchPattern: &envoy_type_matcher_v3.ValueMatcher_StringMatch{
+																	StringMatch: &envoy_type_matcher_v3.StringMatcher{
+																		MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: "admin"},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								}},
+							},
+						},
+					}},
+				},
+			},
+		}))
+		Expect(perRoute.GetRbac().GetShadowRules()).To(BeNil())
+
+		filters, err := p.(plugins.HttpFilterPlugin).HttpFilters(plugins.Params{}, listener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].Filter.GetName()).To(Equal(FilterName))
+	})
+
+	It("splits nested claims by the delimiter of the policy", func() {
+		policy := virtualHost.GetOptions().GetRbac().GetPolicies()["admins"]
+		policy.NestedClaimDelimiter = "."
+		policy.GetPrincipals()[0].GetJwtPrincipal().Claims = map[string]string{"realm.role": "admin"}
+		perRoute := processVirtualHost()
+
+		principal := perRoute.GetRbac().GetRules().GetPolicies()["admins"].GetPrincipals()[0]
+		var path []string
+		for _, segment := range principal.GetAndIds().GetIds()[0].GetMetadata().GetPath() {
+			path = append(path, segment.GetKey())
+		}
+		Expect(path).To(Equal([]string{"auth", "realm", "role"}))
+	})
+
+	It("evaluates shadow policies as shadow rules, without enforcing any rule when all policies are shadow ones", func() {
+		virtualHost.GetOptions().GetRbac().GetPolicies()["admins"].Shadow = true
+		perRoute := processVirtualHost()
+
+		Expect(perRoute.GetRbac().GetRules()).To(BeNil())
+		Expect(perRoute.GetRbac().GetShadowRules().GetPolicies()).To(HaveKey("admins"))
+		Expect(perRoute.GetRbac().GetShadowRulesStatPrefix()).To(Equal(ShadowRulesStatPrefix))
+	})
+
+	It("enforces the policies that are not shadow ones", func() {
+		policies := virtualHost.GetOptions().GetRbac().GetPolicies()
+		policies["internal"] = &rbac.Policy{
+			Shadow: true,
+			Principals: []*rbac.Principal{{
+				HeaderPrincipal: &rbac.HeaderPrincipal{
+					Headers: []*gloo_matchers.HeaderMatcher{{Name: "x-internal"}},
+				},
+			}},
+		}
+		perRoute := processVirtualHost()
+
+		Expect(perRoute.GetRbac().GetRules().GetPolicies()).To(HaveLen(1))
+		Expect(perRoute.GetRbac().GetRules().GetPolicies()).To(HaveKey("admins"))
+		Expect(perRoute.GetRbac().GetShadowRules().GetPolicies()).To(HaveLen(1))
+		Expect(perRoute.GetRbac().GetShadowRules().GetPolicies()).To(HaveKey("internal"))
+	})
+
+	It("matches all the fields of a principal", func() {
+		virtualHost.GetOptions().GetRbac().GetPolicies()["admins"] = &rbac.Policy{
+			Principals: []*rbac.Principal{{
+				SourceIpPrincipal: &rbac.SourceIPPrincipal{
+					CidrRanges: []*solo_core_v3.CidrRange{
+						{AddressPrefix: "10.0.0.0", PrefixLen: &wrappers.UInt32Value{Value: 8}},
+						{AddressPrefix: "192.168.0.0", PrefixLen: &wrappers.UInt32Value{Value: 16}},
+					},
+					DirectRemoteAddress: true,
+				},
+				HeaderPrincipal: &rbac.HeaderPrincipal{
+					Headers: []*gloo_matchers.HeaderMatcher{{Name: "x-team", Value: "ops"}},
+				},
+				MtlsPrincipal: &rbac.MTLSPrincipal{
+					Sans: []*solo_matcher.StringMatcher{{
+						MatchPattern: &solo_matcher.StringMatcher_Prefix{Prefix: "spiffe://cluster.local/ns/ops/"},
+					}},
+				},
+			}},
+			Permissions: &rbac.Permissions{
+				Headers: []*gloo_matchers.HeaderMatcher{{Name: "x-api-version", Value: "v[12]", Regex: true}},
+			},
+		}
+		perRoute := processVirtualHost()
+
+		policy := perRoute.GetRbac().GetRules().GetPolicies()["admins"]
+		Expect(policy.GetPrincipals()).To(ConsistOf(matchers.MatchProto(&envoy_config_rbac_v3.Principal{
+			Identifier: &envoy_config_rbac_v3.Principal_AndIds{
+				AndIds: &envoy_config_rbac_v3.Principal_Set{
+					Ids: []*envoy_config_rbac_v3.Principal{
+						{
+							Identifier: &envoy_config_rbac_v3.Principal_OrIds{
+								OrIds: &envoy_config_rbac_v3.Principal_Set{
+									Ids: []*envoy_config_rbac_v3.Principal{
+										{Identifier: &envoy_config_rbac_v3.Principal_DirectRemoteIp{
+											DirectRemoteIp: &envoy_config_core_v3.CidrRange{AddressPrefix: "10.0.0.0", PrefixLen: &wrappers.UInt32Value{Value: 8}},
+										}},
+										{Identifier: &envoy_config_rbac_v3.Principal_DirectRemoteIp{
+											DirectRemoteIp: &envoy_config_core_v3.CidrRange{AddressPrefix: "192.168.0.0", PrefixLen: &wrappers.UInt32Value{Value: 16}},
+										}},
+									},
+								},
+							},
+						},
+						{
+							Identifier: &envoy_config_rbac_v3.Principal_Header{
+								Header: &envoy_config_route_v3.HeaderMatcher{
+									Name:                 "x-team",
+									HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_ExactMatch{ExactMatch: "ops"},
+								},
+							},
+						},
+						{
+							Identifier: &envoy_config_rbac_v3.Principal_Authenticated_{
+								Authenticated: &envoy_config_rbac_v3.Principal_Authenticated{
+									PrincipalName: &envoy_type_matcher_v3.StringMatcher{
+										MatchPattern: &envoy_type_matcher_v3.StringMatcher_Prefix{Prefix: "spiffe://cluster.local/ns/ops/"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})))
+
+		Expect(policy.GetPermissions()).To(HaveLen(1))
+		header := policy.GetPermissions()[0].GetHeader()
+		Expect(header.GetName()).To(Equal("x-api-version"))
+		Expect(header.GetSafeRegexMatch().GetRegex()).To(Equal("v[12]"))
+	})
+
+	It("matches the remote address computed from the forwarded headers by default", func() {
+		virtualHost.GetOptions().GetRbac().GetPolicies()["admins"].Principals = []*rbac.Principal{{
+			SourceIpPrincipal: &rbac.SourceIPPrincipal{
+				CidrRanges: []*solo_core_v3.CidrRange{{AddressPrefix: "10.0.0.0", PrefixLen: &wrappers.UInt32Value{Value: 8}}},
+			},
+		}}
+		perRoute := processVirtualHost()
+
+		principal := perRoute.GetRbac().GetRules().GetPolicies()["admins"].GetPrincipals()[0]
+		Expect(principal.GetRemoteIp().GetAddressPrefix()).To(Equal("10.0.0.0"))
+	})
+
+	It("denies all requests to virtual hosts without policies when rbac is required", func() {
+		settings.Rbac = &rbac.Settings{RequireRbac: true}
+		virtualHost = &v1.VirtualHost{Name: "vhost"}
+		perRoute := processVirtualHost()
+
+		Expect(perRoute.GetRbac().GetRules()).To(matchers.MatchProto(&envoy_config_rbac_v3.RBAC{
+			Action: envoy_config_rbac_v3.RBAC_ALLOW,
+		}))
+	})
+
+	It("disables the filter on a route", func() {
+		p.Init(plugins.InitParams{Ctx: context.TODO(), Settings: settings})
+		outRoute := &envoy_config_route_v3.Route{}
+		err := p.(plugins.RoutePlugin).ProcessRoute(plugins.RouteParams{VirtualHostParams: vhostParams, VirtualHost: virtualHost}, &v1.Route{
+			Options: &v1.RouteOptions{
+				Rbac: &rbac.ExtensionSettings{Disable: true},
+			},
+		}, outRoute)
+		Expect(err).NotTo(HaveOccurred())
+
+		var perRoute envoyrbac.RBACPerRoute
+		Expect(outRoute.GetTypedPerFilterConfig()[FilterName].UnmarshalTo(&perRoute)).To(Succeed())
+		Expect(perRoute.GetRbac()).To(BeNil())
+	})
+
+	It("errors when a jwt principal has no provider", func() {
+		virtualHost.GetOptions().JwtConfig = nil
+		p.Init(plugins.InitParams{Ctx: context.TODO(), Settings: settings})
+		err := p.(plugins.VirtualHostPlugin).ProcessVirtualHost(vhostParams, virtualHost, outVhost)
+		Expect(err).To(MatchError(ContainSubstring("rbac policy admins has a jwt principal but no jwt provider is configured")))
+	})
+
+	It("errors when a principal has nothing to match", func() {
+		virtualHost.GetOptions().GetRbac().GetPolicies()["admins"].Principals = []*rbac.Principal{{
+			MtlsPrincipal: &rbac.MTLSPrincipal{},
+		}}
+		p.Init(plugins.InitParams{Ctx: context.TODO(), Settings: settings})
+		err := p.(plugins.VirtualHostPlugin).ProcessVirtualHost(vhostParams, virtualHost, outVhost)
+		Expect(err).To(MatchError(ContainSubstring("rbac policy admins has a mtls principal without any value to match")))
+	})
+
+	It("errors when a source ip principal has an invalid cidr range", func() {
+		virtualHost.GetOptions().GetRbac().GetPolicies()["admins"].Principals = []*rbac.Principal{{
+			SourceIpPrincipal: &rbac.SourceIPPrincipal{
+				CidrRanges: []*solo_core_v3.CidrRange{{AddressPrefix: "10.0.0", PrefixLen: &wrappers.UInt32Value{Value: 8}}},
+			},
+		}}
+		p.Init(plugins.InitParams{Ctx: context.TODO(), Settings: settings})
+		err := p.(plugins.VirtualHostPlugin).ProcessVirtualHost(vhostParams, virtualHost, outVhost)
+		Expect(err).To(MatchError(ContainSubstring("rbac policy admins has an invalid cidr range")))
+	})
+})
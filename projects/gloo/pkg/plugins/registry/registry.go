@@ -20,10 +20,13 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/cors"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/csrf"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/deprecated_cipher_passthrough"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/dnssrv"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/dynamic_forward_proxy"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/edsupstream"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/enterprise_warning"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/eureka"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/extauth"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/extproc"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/faultinjection"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpc"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpcjson"
@@ -34,20 +37,25 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/healthcheck"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/istio_automtls"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/istio_integration"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/jwt"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/linkerd"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/listener"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/loadbalancer"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/local_ratelimit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/metadata"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/nomad"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/oauth2"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pipe"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/protocoloptions"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/proxyprotocol"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/ratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/rbac"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/rest"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/shadowing"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/static"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/stats"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tap"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tcp"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tls_inspector"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tracing"
@@ -55,6 +63,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tunneling"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/upstreamconn"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/virtualhost"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
@@ -71,12 +80,15 @@ type PluginOpts struct {
 	Secrets                 factory.ResourceClientFactory
 	SidecarOnGatewayEnabled bool
 	Consul                  bootstrap.Consul
+	Nomad                   bootstrap.Nomad
 	// If plugins do NOT run in GGv2/KRT (i.e. in legacy edge), then we will this kube cache set.
 	KubeClient    k8skube.Interface
 	KubeCoreCache corecache.KubeCoreCache
 	// If plugins run in GGv2/KRT, then we will have these collections.
 	// it is currently used for the k8s plugin. in the future plugins may own their own collections.
 	SvcCollection krt.Collection[*corev1.Service]
+	// If the control plane pulls the OCI images of WASM filters, then we will have this cache.
+	WasmImageCache *wasm.ImageCache
 }
 
 func FromBootstrap(opts bootstrap.Opts) PluginOpts {
@@ -85,6 +97,7 @@ func FromBootstrap(opts bootstrap.Opts) PluginOpts {
 		Secrets:                 opts.Secrets,
 		SidecarOnGatewayEnabled: opts.GlooGateway.IstioValues.SidecarOnGatewayEnabled,
 		Consul:                  opts.Consul,
+		Nomad:                   opts.Nomad,
 		KubeClient:              opts.KubeClient,
 		KubeCoreCache:           opts.KubeCoreCache,
 	}
@@ -117,6 +130,8 @@ func Plugins(opts PluginOpts) []plugins.Plugin {
 		tcp.NewPlugin(utils.NewSslConfigTranslator()),
 		connection_limit.NewPlugin(),
 		static.NewPlugin(),
+		eureka.NewPlugin(),
+		dnssrv.NewPlugin(),
 		transformation.NewPlugin(),
 		grpcweb.NewPlugin(),
 		grpc.NewPlugin(),
@@ -135,6 +150,12 @@ func Plugins(opts PluginOpts) []plugins.Plugin {
 		headers.NewPlugin(),
 		healthcheck.NewPlugin(),
 		extauth.NewPlugin(),
+		jwt.NewPlugin(),
+		oauth2.NewPlugin(),
+		rbac.NewPlugin(),
+		extproc.NewPlugin(),
+		tap.NewPlugin(),
+		wasm.NewPlugin(opts.WasmImageCache),
 		ratelimit.NewPlugin(),
 		gzip.NewPlugin(),
 		buffer.NewPlugin(),
@@ -158,6 +179,9 @@ func Plugins(opts PluginOpts) []plugins.Plugin {
 	if opts.Consul.ConsulWatcher != nil {
 		glooPlugins = append(glooPlugins, consul.NewPlugin(opts.Consul.ConsulWatcher, consul.NewConsulDnsResolver(opts.Consul.DnsServer), opts.Consul.DnsPollingInterval))
 	}
+	if opts.Nomad.NomadWatcher != nil {
+		glooPlugins = append(glooPlugins, nomad.NewPlugin(opts.Nomad.NomadWatcher))
+	}
 	lookupResult, found := os.LookupEnv(constants.IstioInjectionEnabled)
 	istioEnabled := found && strings.ToLower(lookupResult) == "true"
 	if istioEnabled {
@@ -187,6 +211,7 @@ type pluginRegistry struct {
 	httpConnectionManagerPlugins []plugins.HttpConnectionManagerPlugin
 	virtualHostPlugins           []plugins.VirtualHostPlugin
 	resourceGeneratorPlugins     []plugins.ResourceGeneratorPlugin
+	secretGeneratorPlugins       []plugins.SecretGeneratorPlugin
 	upstreamPlugins              []plugins.UpstreamPlugin
 	endpointPlugins              []plugins.EndpointPlugin
 	routePlugins                 []plugins.RoutePlugin
@@ -207,6 +232,7 @@ func NewPluginRegistry(registeredPlugins []plugins.Plugin) *pluginRegistry {
 	var httpConnectionManagerPlugins []plugins.HttpConnectionManagerPlugin
 	var virtualHostPlugins []plugins.VirtualHostPlugin
 	var resourceGeneratorPlugins []plugins.ResourceGeneratorPlugin
+	var secretGeneratorPlugins []plugins.SecretGeneratorPlugin
 	var upstreamPlugins []plugins.UpstreamPlugin
 	var endpointPlugins []plugins.EndpointPlugin
 	var routePlugins []plugins.RoutePlugin
@@ -260,6 +286,11 @@ func NewPluginRegistry(registeredPlugins []plugins.Plugin) *pluginRegistry {
 			resourceGeneratorPlugins = append(resourceGeneratorPlugins, resourceGeneratorPlugin)
 		}
 
+		secretGeneratorPlugin, ok := plugin.(plugins.SecretGeneratorPlugin)
+		if ok {
+			secretGeneratorPlugins = append(secretGeneratorPlugins, secretGeneratorPlugin)
+		}
+
 		upstreamPlugin, ok := plugin.(plugins.UpstreamPlugin)
 		if ok {
 			upstreamPlugins = append(upstreamPlugins, upstreamPlugin)
@@ -296,6 +327,7 @@ func NewPluginRegistry(registeredPlugins []plugins.Plugin) *pluginRegistry {
 		httpConnectionManagerPlugins: httpConnectionManagerPlugins,
 		virtualHostPlugins:           virtualHostPlugins,
 		resourceGeneratorPlugins:     resourceGeneratorPlugins,
+		secretGeneratorPlugins:       secretGeneratorPlugins,
 		upstreamPlugins:              upstreamPlugins,
 		endpointPlugins:              endpointPlugins,
 		routePlugins:                 routePlugins,
@@ -349,6 +381,11 @@ func (p *pluginRegistry) GetResourceGeneratorPlugins() []plugins.ResourceGenerat
 	return p.resourceGeneratorPlugins
 }
 
+// GetSecretGeneratorPlugins returns the plugins that were registered which act on SecretGenerator.
+func (p *pluginRegistry) GetSecretGeneratorPlugins() []plugins.SecretGeneratorPlugin {
+	return p.secretGeneratorPlugins
+}
+
 // GetUpstreamPlugins returns the plugins that were registered which act on Upstream.
 func (p *pluginRegistry) GetUpstreamPlugins() []plugins.UpstreamPlugin {
 	return p.upstreamPlugins
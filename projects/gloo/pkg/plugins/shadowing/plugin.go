@@ -64,18 +64,36 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 }
 
 func applyShadowSpec(out *envoy_config_route_v3.RouteAction, spec *shadowing.RouteShadowing) error {
-	if spec.GetUpstream() == nil {
-		return UnspecifiedUpstreamError
+	targets := spec.GetTargets()
+	if spec.GetUpstream() != nil {
+		// the upstream of the spec is shadowed first, as it was before targets were supported
+		targets = append([]*shadowing.RouteShadowing_Target{{
+			Upstream:   spec.GetUpstream(),
+			Percentage: spec.GetPercentage(),
+		}}, targets...)
 	}
-	if spec.GetPercentage() < 0 || spec.GetPercentage() > 100 {
-		return InvalidNumeratorError(spec.GetPercentage())
+	if len(targets) == 0 {
+		return UnspecifiedUpstreamError
 	}
-	out.RequestMirrorPolicies = []*envoy_config_route_v3.RouteAction_RequestMirrorPolicy{
-		{
-			Cluster:         translator.UpstreamToClusterName(spec.GetUpstream()),
-			RuntimeFraction: getFractionalPercent(spec.GetPercentage()),
-		},
+
+	var policies []*envoy_config_route_v3.RouteAction_RequestMirrorPolicy
+	for _, target := range targets {
+		if target.GetUpstream() == nil {
+			return UnspecifiedUpstreamError
+		}
+		if target.GetPercentage() < 0 || target.GetPercentage() > 100 {
+			return InvalidNumeratorError(target.GetPercentage())
+		}
+		runtimeFraction := getFractionalPercent(target.GetPercentage())
+		runtimeFraction.RuntimeKey = target.GetRuntimeKey()
+		policies = append(policies, &envoy_config_route_v3.RouteAction_RequestMirrorPolicy{
+			Cluster:                       translator.UpstreamToClusterName(target.GetUpstream()),
+			RuntimeFraction:               runtimeFraction,
+			TraceSampled:                  target.GetTraceSampled(),
+			DisableShadowHostSuffixAppend: target.GetDisableHostSuffixAppend(),
+		})
 	}
+	out.RequestMirrorPolicies = policies
 	return nil
 }
 
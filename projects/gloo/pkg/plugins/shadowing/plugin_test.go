@@ -3,6 +3,7 @@ package shadowing
 import (
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -65,6 +66,91 @@ var _ = Describe("Plugin", func() {
 		Expect(out.GetRoute().PrefixRewrite).To(Equal("/something/set/by/another/plugin"))
 	})
 
+	It("should shadow to each target, after the upstream of the spec", func() {
+		p := NewPlugin()
+
+		in := &v1.Route{
+			Options: &v1.RouteOptions{
+				Shadowing: &shadowing.RouteShadowing{
+					Upstream: &core.ResourceRef{
+						Name:      "some-upstream",
+						Namespace: "default",
+					},
+					Percentage: 100,
+					Targets: []*shadowing.RouteShadowing_Target{
+						{
+							Upstream: &core.ResourceRef{
+								Name:      "candidate-a",
+								Namespace: "default",
+							},
+							Percentage:   50,
+							RuntimeKey:   "shadowing.candidate_a",
+							TraceSampled: &wrappers.BoolValue{Value: false},
+						},
+						{
+							Upstream: &core.ResourceRef{
+								Name:      "candidate-b",
+								Namespace: "default",
+							},
+							Percentage:              10,
+							DisableHostSuffixAppend: true,
+						},
+					},
+				},
+			},
+		}
+		out := &envoy_config_route_v3.Route{}
+		err := p.ProcessRoute(plugins.RouteParams{}, in, out)
+		Expect(err).NotTo(HaveOccurred())
+
+		policies := out.GetRoute().GetRequestMirrorPolicies()
+		Expect(policies).To(HaveLen(3))
+		Expect(policies[0].GetCluster()).To(Equal("some-upstream_default"))
+		checkFraction(policies[0].GetRuntimeFraction(), 100)
+		Expect(policies[0].GetRuntimeFraction().GetRuntimeKey()).To(BeEmpty())
+		Expect(policies[0].GetTraceSampled()).To(BeNil())
+
+		Expect(policies[1].GetCluster()).To(Equal("candidate-a_default"))
+		checkFraction(policies[1].GetRuntimeFraction(), 50)
+		Expect(policies[1].GetRuntimeFraction().GetRuntimeKey()).To(Equal("shadowing.candidate_a"))
+		Expect(policies[1].GetTraceSampled().GetValue()).To(BeFalse())
+		Expect(policies[1].GetDisableShadowHostSuffixAppend()).To(BeFalse())
+
+		Expect(policies[2].GetCluster()).To(Equal("candidate-b_default"))
+		checkFraction(policies[2].GetRuntimeFraction(), 10)
+		Expect(policies[2].GetDisableShadowHostSuffixAppend()).To(BeTrue())
+	})
+
+	It("should error when given invalid targets", func() {
+		p := NewPlugin()
+
+		in := &v1.Route{
+			Options: &v1.RouteOptions{
+				Shadowing: &shadowing.RouteShadowing{
+					Targets: []*shadowing.RouteShadowing_Target{
+						{
+							Upstream: &core.ResourceRef{
+								Name:      "candidate-a",
+								Namespace: "default",
+							},
+							Percentage: -1,
+						},
+					},
+				},
+			},
+		}
+		out := &envoy_config_route_v3.Route{}
+		err := p.ProcessRoute(plugins.RouteParams{}, in, out)
+		Expect(err).To(HaveOccurred())
+		Expect(err).To(HaveInErrorChain(InvalidNumeratorError(-1)))
+
+		in.GetOptions().GetShadowing().GetTargets()[0].Upstream = nil
+		out = &envoy_config_route_v3.Route{}
+		err = p.ProcessRoute(plugins.RouteParams{}, in, out)
+		Expect(err).To(HaveOccurred())
+		Expect(err).To(HaveInErrorChain(UnspecifiedUpstreamError))
+	})
+
 	It("should not error on empty configs", func() {
 		p := NewPlugin()
 		in := &v1.Route{}
@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strings"
 
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	"github.com/golang/protobuf/proto"
@@ -179,6 +180,48 @@ func NewStagedFilter(name string, config proto.Message, stage FilterStage[WellKn
 	return s, nil
 }
 
+// NewDiscoveredStagedFilter creates an instance of the named filter with the desired stage,
+// whose config is delivered through ECDS rather than inline. See DiscoverStagedFilterConfig.
+func NewDiscoveredStagedFilter(name string, config proto.Message, stage FilterStage[WellKnownFilterStage]) (StagedHttpFilter, error) {
+	s, err := NewStagedFilter(name, config, stage)
+	if err != nil {
+		return s, err
+	}
+	return DiscoverStagedFilterConfig(s), nil
+}
+
+// DiscoverStagedFilterConfig returns a copy of the filter that fetches its config through ECDS, so that changes
+// to the config don't update the Listener and drain its connections. Only edge proxies support it.
+// The config is held as the default config of the config source, until the translator serves it as
+// a separate resource named after the filter.
+func DiscoverStagedFilterConfig(s StagedHttpFilter) StagedHttpFilter {
+	typedConfig := s.Filter.GetTypedConfig()
+	if typedConfig == nil {
+		// the filter has no config to discover
+		return s
+	}
+	return StagedHttpFilter{
+		Filter: &envoyhttp.HttpFilter{
+			Name: s.Filter.GetName(),
+			ConfigType: &envoyhttp.HttpFilter_ConfigDiscovery{
+				ConfigDiscovery: &envoy_config_core_v3.ExtensionConfigSource{
+					ConfigSource: &envoy_config_core_v3.ConfigSource{
+						ResourceApiVersion: envoy_config_core_v3.ApiVersion_V3,
+						ConfigSourceSpecifier: &envoy_config_core_v3.ConfigSource_Ads{
+							Ads: &envoy_config_core_v3.AggregatedConfigSource{},
+						},
+					},
+					DefaultConfig: typedConfig,
+					TypeUrls:      []string{typedConfig.GetTypeUrl()},
+				},
+			},
+			IsOptional: s.Filter.GetIsOptional(),
+			Disabled:   s.Filter.GetDisabled(),
+		},
+		Stage: s.Stage,
+	}
+}
+
 // StagedFilterListContainsName checks for a given named filter.
 // This is not a check of the type url but rather the now mostly unused name
 func StagedFilterListContainsName(filters StagedHttpFilterList, filterName string) bool {
@@ -109,6 +109,25 @@ var _ = Describe("Staged Filters", func() {
 		sort.Sort(filters)
 		ExpectListenerFilterNameOrder(filters, []string{"A", "B", "Waf", "C", "D", "E", "F", "G", "H"})
 	})
+
+	It("should deliver the config of a discovered filter through ECDS", func() {
+		filter, err := NewDiscoveredStagedFilter("A", &envoyhttp.HttpConnectionManager{StatPrefix: "a"}, DuringStage(RouteStage))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filter.Stage).To(Equal(DuringStage(RouteStage)))
+		Expect(filter.Filter.GetName()).To(Equal("A"))
+		Expect(filter.Filter.GetTypedConfig()).To(BeNil())
+
+		configDiscovery := filter.Filter.GetConfigDiscovery()
+		Expect(configDiscovery.GetConfigSource().GetAds()).NotTo(BeNil())
+		Expect(configDiscovery.GetTypeUrls()).To(ConsistOf("type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager"))
+		var config envoyhttp.HttpConnectionManager
+		Expect(configDiscovery.GetDefaultConfig().UnmarshalTo(&config)).To(Succeed())
+		Expect(config.GetStatPrefix()).To(Equal("a"))
+
+		By("leaving filters without config inline")
+		filter = StagedHttpFilter{&envoyhttp.HttpFilter{Name: "B"}, DuringStage(RouteStage)}
+		Expect(DiscoverStagedFilterConfig(filter)).To(Equal(filter))
+	})
 })
 
 func ExpectListenerFilterNameOrder(filters StagedNetworkFilterList, names []string) {
@@ -0,0 +1,261 @@
+package tap
+
+import (
+	"context"
+
+	envoy_config_common_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/config/common/matcher/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_config_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/tap/v3"
+	envoy_extensions_common_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/tap/v3"
+	envoytap "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/tap/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/regexutils"
+	output_sink_v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/config/tap/output_sink/v3"
+	solo_core_v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	solo_matcher "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tap"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var (
+	_ plugins.Plugin           = new(plugin)
+	_ plugins.HttpFilterPlugin = new(plugin)
+)
+
+const (
+	ExtensionName = "tap"
+	FilterName    = "envoy.filters.http.tap"
+
+	// the sinks of envoy-gloo, since upstream Envoy doesn't implement its streaming gRPC sink
+	GrpcSinkName = "envoy.tap.sinks.solo.grpc_output_sink"
+	HttpSinkName = "envoy.tap.sinks.solo.http_output_sink"
+)
+
+// tap as early as possible, so that traces hold the requests and responses of the downstream
+var filterStage = plugins.BeforeStage(plugins.FaultStage)
+
+var (
+	NoSinkError           = eris.New("tap requires a sink")
+	UpstreamNotFoundError = func(err error) error {
+		return eris.Wrapf(err, "finding the tap server upstream")
+	}
+)
+
+type plugin struct{}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) {
+}
+
+func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	in := listener.GetOptions().GetTap()
+	if in == nil {
+		return []plugins.StagedHttpFilter{}, nil
+	}
+
+	var sinks []*envoy_config_tap_v3.OutputSink
+	for _, sink := range in.GetSinks() {
+		outputSink, err := translateSink(params, sink)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, outputSink)
+	}
+	if len(sinks) == 0 {
+		return nil, NoSinkError
+	}
+
+	tapConfig := &envoytap.Tap{
+		CommonConfig: &envoy_extensions_common_tap_v3.CommonExtensionConfig{
+			ConfigType: &envoy_extensions_common_tap_v3.CommonExtensionConfig_StaticConfig{
+				StaticConfig: &envoy_config_tap_v3.TapConfig{
+					Match: translateMatch(params.Ctx, in.GetMatch()),
+					OutputConfig: &envoy_config_tap_v3.OutputConfig{
+						Sinks:              sinks,
+						MaxBufferedRxBytes: in.GetMaxBufferedRxBytes(),
+						MaxBufferedTxBytes: in.GetMaxBufferedTxBytes(),
+					},
+				},
+			},
+		},
+		RecordHeadersReceivedTime:  in.GetRecordHeadersReceivedTime().GetValue(),
+		RecordDownstreamConnection: in.GetRecordDownstreamConnection().GetValue(),
+	}
+	tapFilter, err := plugins.NewStagedFilter(FilterName, tapConfig, filterStage)
+	if err != nil {
+		return nil, eris.Wrap(err, "generating filter config")
+	}
+	return []plugins.StagedHttpFilter{tapFilter}, nil
+}
+
+// translateMatch translates the match conditions of the tap options into a predicate
+// that holds when all of them match, tapping every request when there are none
+func translateMatch(ctx context.Context, in *tap.Match) *envoy_config_common_matcher_v3.MatchPredicate {
+	var rules []*envoy_config_common_matcher_v3.MatchPredicate
+
+	requestHeaders := pluginutils.EnvoyHeaderMatcher(ctx, in.GetRequestHeaders())
+	if in.GetPath() != nil {
+		requestHeaders = append(requestHeaders, &envoy_config_route_v3.HeaderMatcher{
+			Name: ":path",
+			HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_StringMatch{
+				StringMatch: translateStringMatcher(ctx, in.GetPath()),
+			},
+		})
+	}
+	if len(requestHeaders) > 0 {
+		rules = append(rules, &envoy_config_common_matcher_v3.MatchPredicate{
+			Rule: &envoy_config_common_matcher_v3.MatchPredicate_HttpRequestHeadersMatch{
+				HttpRequestHeadersMatch: &envoy_config_common_matcher_v3.HttpHeadersMatch{Headers: requestHeaders},
+			},
+		})
+	}
+
+	if responseHeaders := pluginutils.EnvoyHeaderMatcher(ctx, in.GetResponseHeaders()); len(responseHeaders) > 0 {
+		rules = append(rules, responseHeadersMatch(responseHeaders...))
+	}
+
+	// a response matches when its status code falls within any of the ranges
+	var statusRules []*envoy_config_common_matcher_v3.MatchPredicate
+	for _, codes := range in.GetResponseCodes() {
+		statusRules = append(statusRules, responseHeadersMatch(&envoy_config_route_v3.HeaderMatcher{
+			Name: ":status",
+			HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_RangeMatch{
+				RangeMatch: &envoy_type_v3.Int64Range{Start: codes.GetStart(), End: codes.GetEnd()},
+			},
+		}))
+	}
+	switch len(statusRules) {
+	case 0:
+	case 1:
+		rules = append(rules, statusRules[0])
+	default:
+		rules = append(rules, &envoy_config_common_matcher_v3.MatchPredicate{
+			Rule: &envoy_config_common_matcher_v3.MatchPredicate_OrMatch{
+				OrMatch: &envoy_config_common_matcher_v3.MatchPredicate_MatchSet{Rules: statusRules},
+			},
+		})
+	}
+
+	switch len(rules) {
+	case 0:
+		return &envoy_config_common_matcher_v3.MatchPredicate{
+			Rule: &envoy_config_common_matcher_v3.MatchPredicate_AnyMatch{AnyMatch: true},
+		}
+	case 1:
+		return rules[0]
+	default:
+		return &envoy_config_common_matcher_v3.MatchPredicate{
+			Rule: &envoy_config_common_matcher_v3.MatchPredicate_AndMatch{
+				AndMatch: &envoy_config_common_matcher_v3.MatchPredicate_MatchSet{Rules: rules},
+			},
+		}
+	}
+}
+
+func responseHeadersMatch(headers ...*envoy_config_route_v3.HeaderMatcher) *envoy_config_common_matcher_v3.MatchPredicate {
+	return &envoy_config_common_matcher_v3.MatchPredicate{
+		Rule: &envoy_config_common_matcher_v3.MatchPredicate_HttpResponseHeadersMatch{
+			HttpResponseHeadersMatch: &envoy_config_common_matcher_v3.HttpHeadersMatch{Headers: headers},
+		},
+	}
+}
+
+func translateStringMatcher(ctx context.Context, in *solo_matcher.StringMatcher) *envoy_type_matcher_v3.StringMatcher {
+	out := &envoy_type_matcher_v3.StringMatcher{IgnoreCase: in.GetIgnoreCase()}
+	switch typed := in.GetMatchPattern().(type) {
+	case *solo_matcher.StringMatcher_Exact:
+		out.MatchPattern = &envoy_type_matcher_v3.StringMatcher_Exact{Exact: typed.Exact}
+	case *solo_matcher.StringMatcher_Prefix:
+		out.MatchPattern = &envoy_type_matcher_v3.StringMatcher_Prefix{Prefix: typed.Prefix}
+	case *solo_matcher.StringMatcher_Suffix:
+		out.MatchPattern = &envoy_type_matcher_v3.StringMatcher_Suffix{Suffix: typed.Suffix}
+	case *solo_matcher.StringMatcher_SafeRegex:
+		out.MatchPattern = &envoy_type_matcher_v3.StringMatcher_SafeRegex{
+			SafeRegex: regexutils.NewRegex(ctx, typed.SafeRegex.GetRegex()),
+		}
+	}
+	return out
+}
+
+func translateSink(params plugins.Params, in *tap.Sink) (*envoy_config_tap_v3.OutputSink, error) {
+	var (
+		name   string
+		config proto.Message
+		format envoy_config_tap_v3.OutputSink_Format
+	)
+	switch sink := in.GetSinkType().(type) {
+	case *tap.Sink_GrpcService:
+		cluster, err := upstreamClusterName(params, sink.GrpcService.GetTapServer())
+		if err != nil {
+			return nil, err
+		}
+		name, format = GrpcSinkName, envoy_config_tap_v3.OutputSink_PROTO_BINARY
+		config = &output_sink_v3.GrpcOutputSink{
+			GrpcService: &solo_core_v3.GrpcService{
+				TargetSpecifier: &solo_core_v3.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &solo_core_v3.GrpcService_EnvoyGrpc{ClusterName: cluster},
+				},
+			},
+		}
+	case *tap.Sink_HttpService:
+		cluster, err := upstreamClusterName(params, sink.HttpService.GetTapServer())
+		if err != nil {
+			return nil, err
+		}
+		name, format = HttpSinkName, envoy_config_tap_v3.OutputSink_JSON_BODY_AS_STRING
+		config = &output_sink_v3.HttpOutputSink{
+			ServerUri: &solo_core_v3.HttpUri{
+				Uri:              "http://" + cluster,
+				HttpUpstreamType: &solo_core_v3.HttpUri_Cluster{Cluster: cluster},
+				Timeout:          sink.HttpService.GetTimeout(),
+			},
+		}
+	case *tap.Sink_FilePerTap:
+		return &envoy_config_tap_v3.OutputSink{
+			Format: envoy_config_tap_v3.OutputSink_JSON_BODY_AS_STRING,
+			OutputSinkType: &envoy_config_tap_v3.OutputSink_FilePerTap{
+				FilePerTap: &envoy_config_tap_v3.FilePerTapSink{PathPrefix: sink.FilePerTap.GetPathPrefix()},
+			},
+		}, nil
+	default:
+		return nil, NoSinkError
+	}
+
+	typedConfig, err := utils.MessageToAny(config)
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_config_tap_v3.OutputSink{
+		Format: format,
+		OutputSinkType: &envoy_config_tap_v3.OutputSink_CustomSink{
+			CustomSink: &envoy_config_core_v3.TypedExtensionConfig{
+				Name:        name,
+				TypedConfig: typedConfig,
+			},
+		},
+	}, nil
+}
+
+// upstreamClusterName resolves the cluster of an Upstream of the snapshot
+func upstreamClusterName(params plugins.Params, ref *core.ResourceRef) (string, error) {
+	if _, err := params.Snapshot.Upstreams.Find(ref.GetNamespace(), ref.GetName()); err != nil {
+		return "", UpstreamNotFoundError(err)
+	}
+	return translator.UpstreamToClusterName(ref), nil
+}
@@ -0,0 +1,218 @@
+package tap_test
+
+import (
+	"context"
+	"time"
+
+	envoy_config_common_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/config/common/matcher/v3"
+	envoy_config_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/tap/v3"
+	envoytap "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/tap/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	output_sink_v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/config/tap/output_sink/v3"
+	solo_matcher "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
+	solo_type_v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tap"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/tap"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		p        plugins.HttpFilterPlugin
+		upstream *v1.Upstream
+		params   plugins.Params
+	)
+
+	BeforeEach(func() {
+		p = NewPlugin()
+		p.Init(plugins.InitParams{})
+		upstream = &v1.Upstream{
+			Metadata: &core.Metadata{Name: "tap-sink", Namespace: "gloo-system"},
+		}
+		params = plugins.Params{
+			Ctx:      context.TODO(),
+			Snapshot: &v1snap.ApiSnapshot{Upstreams: v1.UpstreamList{upstream}},
+		}
+	})
+
+	listenerWith := func(sink *tap.Sink) *v1.HttpListener {
+		return &v1.HttpListener{
+			Options: &v1.HttpListenerOptions{
+				Tap: &tap.Tap{
+					Sinks:                      []*tap.Sink{sink},
+					MaxBufferedRxBytes:         &wrapperspb.UInt32Value{Value: 4096},
+					MaxBufferedTxBytes:         &wrapperspb.UInt32Value{Value: 8192},
+					RecordHeadersReceivedTime:  &wrapperspb.BoolValue{Value: true},
+					RecordDownstreamConnection: &wrapperspb.BoolValue{Value: true},
+				},
+			},
+		}
+	}
+
+	tapConfig := func(listener *v1.HttpListener) *envoytap.Tap {
+		filters, err := p.HttpFilters(params, listener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].Filter.GetName()).To(Equal(FilterName))
+		Expect(filters[0].Stage).To(Equal(plugins.BeforeStage(plugins.FaultStage)))
+
+		var config envoytap.Tap
+		Expect(filters[0].Filter.GetTypedConfig().UnmarshalTo(&config)).To(Succeed())
+		return &config
+	}
+
+	It("does not add the filter when tap is not configured", func() {
+		filters, err := p.HttpFilters(params, &v1.HttpListener{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("streams traces to a gRPC tap server", func() {
+		config := tapConfig(listenerWith(&tap.Sink{
+			SinkType: &tap.Sink_GrpcService{
+				GrpcService: &tap.GrpcService{TapServer: upstream.GetMetadata().Ref()},
+			},
+		}))
+		Expect(config.GetRecordHeadersReceivedTime()).To(BeTrue())
+		Expect(config.GetRecordDownstreamConnection()).To(BeTrue())
+
+		tapConfig := config.GetCommonConfig().GetStaticConfig()
+		Expect(tapConfig.GetMatch().GetAnyMatch()).To(BeTrue())
+		Expect(tapConfig.GetOutputConfig().GetMaxBufferedRxBytes().GetValue()).To(BeEquivalentTo(4096))
+		Expect(tapConfig.GetOutputConfig().GetMaxBufferedTxBytes().GetValue()).To(BeEquivalentTo(8192))
+
+		sinks := tapConfig.GetOutputConfig().GetSinks()
+		Expect(sinks).To(HaveLen(1))
+		Expect(sinks[0].GetFormat()).To(Equal(envoy_config_tap_v3.OutputSink_PROTO_BINARY))
+		Expect(sinks[0].GetCustomSink().GetName()).To(Equal(GrpcSinkName))
+		var grpcSink output_sink_v3.GrpcOutputSink
+		Expect(sinks[0].GetCustomSink().GetTypedConfig().UnmarshalTo(&grpcSink)).To(Succeed())
+		Expect(grpcSink.GetGrpcService().GetEnvoyGrpc().GetClusterName()).To(Equal(translator.UpstreamToClusterName(upstream.GetMetadata().Ref())))
+	})
+
+	It("posts traces to an HTTP tap server", func() {
+		config := tapConfig(listenerWith(&tap.Sink{
+			SinkType: &tap.Sink_HttpService{
+				HttpService: &tap.HttpService{
+					TapServer: upstream.GetMetadata().Ref(),
+					Timeout:   durationpb.New(time.Second),
+				},
+			},
+		}))
+
+		sinks := config.GetCommonConfig().GetStaticConfig().GetOutputConfig().GetSinks()
+		Expect(sinks).To(HaveLen(1))
+		Expect(sinks[0].GetFormat()).To(Equal(envoy_config_tap_v3.OutputSink_JSON_BODY_AS_STRING))
+		Expect(sinks[0].GetCustomSink().GetName()).To(Equal(HttpSinkName))
+		var httpSink output_sink_v3.HttpOutputSink
+		Expect(sinks[0].GetCustomSink().GetTypedConfig().UnmarshalTo(&httpSink)).To(Succeed())
+		Expect(httpSink.GetServerUri().GetCluster()).To(Equal(translator.UpstreamToClusterName(upstream.GetMetadata().Ref())))
+		Expect(httpSink.GetServerUri().GetTimeout().AsDuration()).To(Equal(time.Second))
+	})
+
+	It("writes each trace to its own file", func() {
+		config := tapConfig(listenerWith(&tap.Sink{
+			SinkType: &tap.Sink_FilePerTap{
+				FilePerTap: &tap.FilePerTap{PathPrefix: "/tmp/tap/trace"},
+			},
+		}))
+
+		sinks := config.GetCommonConfig().GetStaticConfig().GetOutputConfig().GetSinks()
+		Expect(sinks).To(HaveLen(1))
+		Expect(sinks[0].GetFormat()).To(Equal(envoy_config_tap_v3.OutputSink_JSON_BODY_AS_STRING))
+		Expect(sinks[0].GetFilePerTap().GetPathPrefix()).To(Equal("/tmp/tap/trace"))
+	})
+
+	Context("match", func() {
+
+		var listener *v1.HttpListener
+
+		BeforeEach(func() {
+			listener = listenerWith(&tap.Sink{
+				SinkType: &tap.Sink_FilePerTap{
+					FilePerTap: &tap.FilePerTap{PathPrefix: "/tmp/tap/trace"},
+				},
+			})
+		})
+
+		match := func() *envoy_config_common_matcher_v3.MatchPredicate {
+			return tapConfig(listener).GetCommonConfig().GetStaticConfig().GetMatch()
+		}
+
+		It("taps the requests that match a single condition", func() {
+			listener.GetOptions().GetTap().Match = &tap.Match{
+				ResponseHeaders: []*matchers.HeaderMatcher{{Name: "x-tap"}},
+			}
+
+			headers := match().GetHttpResponseHeadersMatch().GetHeaders()
+			Expect(headers).To(HaveLen(1))
+			Expect(headers[0].GetName()).To(Equal("x-tap"))
+			Expect(headers[0].GetPresentMatch()).To(BeTrue())
+		})
+
+		It("taps the requests that match every condition", func() {
+			listener.GetOptions().GetTap().Match = &tap.Match{
+				RequestHeaders: []*matchers.HeaderMatcher{
+					{Name: "x-user", Value: "admin"},
+					{Name: "x-debug", Value: "true|yes", Regex: true, InvertMatch: true},
+				},
+				Path: &solo_matcher.StringMatcher{
+					MatchPattern: &solo_matcher.StringMatcher_Prefix{Prefix: "/api"},
+				},
+				ResponseCodes: []*solo_type_v3.Int64Range{
+					{Start: 400, End: 404},
+					{Start: 500, End: 600},
+				},
+			}
+
+			rules := match().GetAndMatch().GetRules()
+			Expect(rules).To(HaveLen(2))
+
+			requestHeaders := rules[0].GetHttpRequestHeadersMatch().GetHeaders()
+			Expect(requestHeaders).To(HaveLen(3))
+			Expect(requestHeaders[0].GetName()).To(Equal("x-user"))
+			Expect(requestHeaders[0].GetExactMatch()).To(Equal("admin"))
+			Expect(requestHeaders[1].GetName()).To(Equal("x-debug"))
+			Expect(requestHeaders[1].GetSafeRegexMatch().GetRegex()).To(Equal("true|yes"))
+			Expect(requestHeaders[1].GetInvertMatch()).To(BeTrue())
+			Expect(requestHeaders[2].GetName()).To(Equal(":path"))
+			Expect(requestHeaders[2].GetStringMatch().GetPrefix()).To(Equal("/api"))
+
+			statusRules := rules[1].GetOrMatch().GetRules()
+			Expect(statusRules).To(HaveLen(2))
+			for i, codes := range [][2]int64{{400, 404}, {500, 600}} {
+				status := statusRules[i].GetHttpResponseHeadersMatch().GetHeaders()
+				Expect(status).To(HaveLen(1))
+				Expect(status[0].GetName()).To(Equal(":status"))
+				Expect(status[0].GetRangeMatch().GetStart()).To(Equal(codes[0]))
+				Expect(status[0].GetRangeMatch().GetEnd()).To(Equal(codes[1]))
+			}
+		})
+	})
+
+	It("errors when the upstream of the tap server does not exist", func() {
+		params.Snapshot = &v1snap.ApiSnapshot{}
+		_, err := p.HttpFilters(params, listenerWith(&tap.Sink{
+			SinkType: &tap.Sink_GrpcService{
+				GrpcService: &tap.GrpcService{TapServer: upstream.GetMetadata().Ref()},
+			},
+		}))
+		Expect(err).To(MatchError(ContainSubstring("finding the tap server upstream")))
+	})
+
+	It("errors when there is no sink", func() {
+		_, err := p.HttpFilters(params, &v1.HttpListener{
+			Options: &v1.HttpListenerOptions{Tap: &tap.Tap{}},
+		})
+		Expect(err).To(MatchError(NoSinkError))
+	})
+})
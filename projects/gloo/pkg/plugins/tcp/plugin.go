@@ -171,10 +171,27 @@ func (p *plugin) tcpProxyFilters(
 			Destinations: upstreamGroup.GetDestinations(),
 		}
 
+		failover := upstreamGroup.GetFailover()
+		if failover.GetMode() == v1.UpstreamGroup_Failover_PRIORITY {
+			if len(md.GetDestinations()) == 0 {
+				return nil, translatorutil.NoDestinationSpecifiedError
+			}
+			cfg.ClusterSpecifier = &envoytcp.TcpProxy_Cluster{
+				Cluster: translatorutil.UpstreamGroupToClusterName(upstreamGroup.GetMetadata().Ref()),
+			}
+			break
+		}
+
 		wc, err := p.convertToWeightedCluster(md)
 		if err != nil {
 			return nil, err
 		}
+		if failover != nil {
+			// the share of traffic of each destination goes to the aggregate cluster that fails it over to the others
+			for i, clusterWeight := range wc.GetClusters() {
+				clusterWeight.Name = translatorutil.UpstreamGroupDestinationToClusterName(upstreamGroup.GetMetadata().Ref(), i)
+			}
+		}
 		cfg.ClusterSpecifier = &envoytcp.TcpProxy_WeightedClusters{
 			WeightedClusters: wc,
 		}
@@ -300,6 +300,50 @@ var _ = Describe("Plugin", func() {
 			Expect(clusters.Clusters[1].Weight).To(Equal(uint32(1)))
 		})
 
+		It("can transform an upstream group with failover", func() {
+			upstreamGroup := &v1.UpstreamGroup{
+				Destinations: wd,
+				Metadata: &core.Metadata{
+					Name:      "one",
+					Namespace: ns,
+				},
+				Failover: &v1.UpstreamGroup_Failover{},
+			}
+			snap.UpstreamGroups = append(snap.UpstreamGroups, upstreamGroup)
+			tcpListener.TcpHosts = append(tcpListener.TcpHosts, &v1.TcpHost{
+				Name: "one",
+				Destination: &v1.TcpHost_TcpAction{
+					Destination: &v1.TcpHost_TcpAction_UpstreamGroup{
+						UpstreamGroup: upstreamGroup.GetMetadata().Ref(),
+					},
+				},
+			})
+
+			filterChains, err := createFilterChains()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filterChains).To(HaveLen(1))
+
+			var cfg envoytcp.TcpProxy
+			err = translatorutil.ParseTypedConfig(filterChains[0].Filters[0], &cfg)
+			Expect(err).NotTo(HaveOccurred())
+			clusters := cfg.GetWeightedClusters()
+			Expect(clusters.Clusters).To(HaveLen(2))
+			Expect(clusters.Clusters[0].Name).To(Equal(translatorutil.UpstreamGroupDestinationToClusterName(upstreamGroup.GetMetadata().Ref(), 0)))
+			Expect(clusters.Clusters[0].Weight).To(Equal(uint32(5)))
+			Expect(clusters.Clusters[1].Name).To(Equal(translatorutil.UpstreamGroupDestinationToClusterName(upstreamGroup.GetMetadata().Ref(), 1)))
+			Expect(clusters.Clusters[1].Weight).To(Equal(uint32(1)))
+
+			upstreamGroup.Failover.Mode = v1.UpstreamGroup_Failover_PRIORITY
+
+			filterChains, err = createFilterChains()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filterChains).To(HaveLen(1))
+
+			err = translatorutil.ParseTypedConfig(filterChains[0].Filters[0], &cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.GetCluster()).To(Equal(translatorutil.UpstreamGroupToClusterName(upstreamGroup.GetMetadata().Ref())))
+		})
+
 		It("can add the forward sni cluster name filter", func() {
 			sslConfig := &ssl.SslConfig{
 				SslSecrets: &ssl.SslConfig_SecretRef{
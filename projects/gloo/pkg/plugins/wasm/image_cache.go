@@ -0,0 +1,278 @@
+package wasm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+const (
+	// ModulesPath is the path the image cache serves the modules under, by their sha256
+	ModulesPath = "/modules/"
+
+	pullTimeout = time.Minute
+	// pulls that failed are not retried sooner, so that an unreachable registry isn't queried on every translation
+	pullRetryInterval = time.Minute
+	// tags are resolved again once this old, so that filters follow tags that are moved to another image
+	tagResolveInterval = 5 * time.Minute
+)
+
+var (
+	sha256Regex = regexp.MustCompile("^[0-9a-f]{64}$")
+
+	// PullPendingError is returned for the images that are being pulled
+	PullPendingError = eris.New("the image is being pulled")
+)
+
+// ImageCache pulls the WASM modules of OCI images, stores them in a directory, and serves them to the proxies over HTTP.
+// Images are pulled in the background, so that translation never waits for a registry.
+type ImageCache struct {
+	ctx        context.Context
+	dir        string
+	serverAddr string
+	client     *http.Client
+	// onPulled is called when a pull completes or fails, so that the filters of the image are translated again
+	onPulled func()
+
+	lock sync.Mutex
+	// images are the digests the images resolved to, by image reference
+	images map[string]resolvedImage
+	// modules are the sha256 of the modules that were stored, by image digest
+	modules  map[string]string
+	pulling  map[string]struct{}
+	failures map[string]pullFailure
+}
+
+type resolvedImage struct {
+	digest string
+	at     time.Time
+}
+
+type pullFailure struct {
+	err error
+	at  time.Time
+}
+
+// NewImageCache returns an image cache that stores modules in dir and that proxies reach at serverAddr.
+// Images are pulled with client until ctx is done, and onPulled, if non-nil, is called each time a pull completes.
+func NewImageCache(ctx context.Context, dir, serverAddr string, client *http.Client, onPulled func()) *ImageCache {
+	if onPulled == nil {
+		onPulled = func() {}
+	}
+	return &ImageCache{
+		ctx:        ctx,
+		dir:        dir,
+		serverAddr: serverAddr,
+		client:     client,
+		onPulled:   onPulled,
+		images:     map[string]resolvedImage{},
+		modules:    map[string]string{},
+		pulling:    map[string]struct{}{},
+		failures:   map[string]pullFailure{},
+	}
+}
+
+// ImageCacheFromSettings returns the image cache configured by the Settings, which pulls images and serves modules
+// until ctx is done, or nil if pulling images is not enabled
+func ImageCacheFromSettings(ctx context.Context, wasmImages *v1.GlooOptions_WasmImageOptions, onPulled func()) (*ImageCache, error) {
+	serverAddr := wasmImages.GetServerAddr()
+	if serverAddr == "" {
+		return nil, nil
+	}
+	dir := wasmImages.GetCacheDir()
+	if dir == "" {
+		dir = filepath.Join(os.TempDir(), "gloo-wasm")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, eris.Wrapf(err, "creating wasm image cache directory %s", dir)
+	}
+	imageCache := NewImageCache(ctx, dir, serverAddr, &http.Client{}, onPulled)
+	if err := imageCache.Start(ctx); err != nil {
+		return nil, err
+	}
+	return imageCache, nil
+}
+
+// ServerAddr is the "host:port" address proxies fetch the modules from
+func (c *ImageCache) ServerAddr() string {
+	return c.serverAddr
+}
+
+// ModuleUrl is the URL proxies fetch the module with the given sha256 from
+func (c *ImageCache) ModuleUrl(sha string) string {
+	return "http://" + c.serverAddr + ModulesPath + sha
+}
+
+// Module returns the sha256 of the WASM module of an image. It never blocks on a registry: images that are not
+// cached yet are pulled in the background, and PullPendingError is returned until the pull completes.
+// The module of a tag is kept while the tag is resolved again, so that filters aren't left out when it is moved.
+func (c *ImageCache) Module(image string) (string, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if resolved, ok := c.images[image]; ok {
+		if sha, ok := c.modules[resolved.digest]; ok {
+			if !isDigestReference(image) && time.Since(resolved.at) >= tagResolveInterval {
+				c.startPull(image)
+			}
+			return sha, nil
+		}
+	}
+	if failure, ok := c.failures[image]; ok && time.Since(failure.at) < pullRetryInterval {
+		return "", failure.err
+	}
+	c.startPull(image)
+	return "", eris.Wrapf(PullPendingError, "wasm image %s", image)
+}
+
+// startPull pulls an image in the background, unless it is already being pulled, or the last pull failed recently.
+// The lock must be held.
+func (c *ImageCache) startPull(image string) {
+	if _, ok := c.pulling[image]; ok {
+		return
+	}
+	if failure, ok := c.failures[image]; ok && time.Since(failure.at) < pullRetryInterval {
+		return
+	}
+	c.pulling[image] = struct{}{}
+	go c.pull(image)
+}
+
+func (c *ImageCache) pull(image string) {
+	logger := contextutils.LoggerFrom(c.ctx)
+	digest, sha, err := c.pullAndStore(image)
+
+	c.lock.Lock()
+	delete(c.pulling, image)
+	previous := c.images[image]
+	if err != nil {
+		err = eris.Wrapf(err, "pulling wasm image %s", image)
+		c.failures[image] = pullFailure{err: err, at: time.Now()}
+		c.lock.Unlock()
+		logger.Warnf("%v", err)
+		if previous.digest == "" {
+			// the filters of the image are reported with the error rather than pending
+			c.onPulled()
+		}
+		return
+	}
+	delete(c.failures, image)
+	c.images[image] = resolvedImage{digest: digest, at: time.Now()}
+	c.modules[digest] = sha
+	c.lock.Unlock()
+
+	if previous.digest != digest {
+		logger.Infof("pulled wasm image %s (%s), module sha256 %s", image, digest, sha)
+		c.onPulled()
+	}
+}
+
+// pullAndStore resolves an image to its digest, and stores its module unless the module of the digest is stored
+// already. It returns the digest and the sha256 of the module.
+func (c *ImageCache) pullAndStore(image string) (string, string, error) {
+	ctx, cancel := context.WithTimeout(c.ctx, pullTimeout)
+	defer cancel()
+
+	registry, err := newRegistryClient(c.client, image)
+	if err != nil {
+		return "", "", err
+	}
+	m, digest, err := resolveImage(ctx, registry)
+	if err != nil {
+		return "", "", err
+	}
+	c.lock.Lock()
+	sha, ok := c.modules[digest]
+	c.lock.Unlock()
+	if ok {
+		return digest, sha, nil
+	}
+
+	module, err := pullModule(ctx, registry, m)
+	if err != nil {
+		return "", "", err
+	}
+	sum := sha256.Sum256(module)
+	sha = hex.EncodeToString(sum[:])
+
+	// write the module atomically, since it may be served while it is written
+	tmp, err := os.CreateTemp(c.dir, sha+".*.tmp")
+	if err != nil {
+		return "", "", err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(module); err != nil {
+		tmp.Close()
+		return "", "", err
+	}
+	if err := tmp.Close(); err != nil {
+		return "", "", err
+	}
+	if err := os.Rename(tmp.Name(), c.modulePath(sha)); err != nil {
+		return "", "", err
+	}
+	return digest, sha, nil
+}
+
+// isDigestReference returns true for the references of images by digest, which always resolve to the same image
+func isDigestReference(image string) bool {
+	return strings.Contains(image, "@")
+}
+
+func (c *ImageCache) modulePath(sha string) string {
+	return filepath.Join(c.dir, sha+".wasm")
+}
+
+// ServeHTTP serves the modules of the cache, by their sha256
+func (c *ImageCache) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	sha := strings.TrimPrefix(r.URL.Path, ModulesPath)
+	if r.Method != http.MethodGet || !sha256Regex.MatchString(sha) {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/wasm")
+	http.ServeFile(w, r, c.modulePath(sha))
+}
+
+// Start serves the modules on the port of the server address, until ctx is done
+func (c *ImageCache) Start(ctx context.Context) error {
+	_, port, err := net.SplitHostPort(c.serverAddr)
+	if err != nil {
+		return eris.Wrapf(err, "invalid wasm image server address %q", c.serverAddr)
+	}
+	mux := http.NewServeMux()
+	mux.Handle(ModulesPath, c)
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%s", port),
+		Handler: mux,
+	}
+	contextutils.LoggerFrom(ctx).Infof("WASM image server starting at %s", server.Addr)
+	go func() {
+		err := server.ListenAndServe()
+		if err == http.ErrServerClosed {
+			contextutils.LoggerFrom(ctx).Infof("WASM image server closed")
+		} else {
+			contextutils.LoggerFrom(ctx).Warnf("WASM image server closed with unexpected error: %v", err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		if err := server.Close(); err != nil {
+			contextutils.LoggerFrom(ctx).Warnf("WASM image server shutdown returned error: %v", err)
+		}
+	}()
+	return nil
+}
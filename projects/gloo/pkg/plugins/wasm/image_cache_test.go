@@ -0,0 +1,229 @@
+package wasm_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm"
+)
+
+var wasmModule = []byte("\x00asm\x01\x00\x00\x00")
+
+func digest(blob []byte) string {
+	sum := sha256.Sum256(blob)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// fakeRegistry serves a single image under any tag, whose layer has the given media type, to clients with the token
+// it issues
+type fakeRegistry struct {
+	*httptest.Server
+	manifest []byte
+	layer    []byte
+	// the number of manifests and layers pulled
+	manifestPulls atomic.Int32
+	layerPulls    atomic.Int32
+}
+
+func newFakeRegistry(layerMediaType string, layer []byte) *fakeRegistry {
+	registry := &fakeRegistry{layer: layer}
+	registry.manifest, _ = json.Marshal(map[string]any{
+		"schemaVersion": 2,
+		"mediaType":     "application/vnd.oci.image.manifest.v1+json",
+		"layers": []map[string]any{{
+			"mediaType": layerMediaType,
+			"digest":    digest(layer),
+			"size":      len(layer),
+		}},
+	})
+	registry.Server = httptest.NewTLSServer(http.HandlerFunc(registry.serve))
+	return registry
+}
+
+func (r *fakeRegistry) host() string {
+	return strings.TrimPrefix(r.URL, "https://")
+}
+
+func (r *fakeRegistry) serve(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == "/token" {
+		if req.URL.Query().Get("scope") != "repository:org/filter:pull" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"token":"anonymous"}`))
+		return
+	}
+	if req.Header.Get("Authorization") != "Bearer anonymous" {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry"`, r.URL))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	switch {
+	case strings.HasPrefix(req.URL.Path, "/v2/org/filter/manifests/"):
+		r.manifestPulls.Add(1)
+		_, _ = w.Write(r.manifest)
+	case req.URL.Path == "/v2/org/filter/blobs/"+digest(r.layer):
+		r.layerPulls.Add(1)
+		_, _ = w.Write(r.layer)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func tarGz(name string, content []byte) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	Expect(tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg})).To(Succeed())
+	_, err := tw.Write(content)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(tw.Close()).To(Succeed())
+	Expect(gz.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+var _ = Describe("ImageCache", func() {
+
+	var (
+		registry *fakeRegistry
+		cache    *ImageCache
+		pulled   chan struct{}
+	)
+
+	AfterEach(func() {
+		registry.Close()
+	})
+
+	newCache := func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		DeferCleanup(cancel)
+		pulled = make(chan struct{}, 10)
+		cache = NewImageCache(ctx, GinkgoT().TempDir(), "gloo.gloo-system.svc.cluster.local:9979", registry.Client(), func() {
+			pulled <- struct{}{}
+		})
+	}
+
+	// module returns the module of an image once it is pulled
+	module := func(image string) (string, error) {
+		_, err := cache.Module(image)
+		if err == nil || !eris.Is(err, PullPendingError) {
+			return "", fmt.Errorf("expected the image to be pulled in the background, got %v", err)
+		}
+		Eventually(pulled).Should(Receive())
+		return cache.Module(image)
+	}
+
+	serve := func(sha string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		cache.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, ModulesPath+sha, nil))
+		return recorder
+	}
+
+	It("pulls the wasm layer of an image once, and serves it by its sha256", func() {
+		registry = newFakeRegistry("application/vnd.wasm.content.layer.v1+wasm", wasmModule)
+		newCache()
+
+		sha, err := module(registry.host() + "/org/filter:v1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect("sha256:" + sha).To(Equal(digest(wasmModule)))
+		Expect(cache.ModuleUrl(sha)).To(Equal("http://gloo.gloo-system.svc.cluster.local:9979/modules/" + sha))
+
+		_, err = cache.Module(registry.host() + "/org/filter:v1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(registry.manifestPulls.Load()).To(BeEquivalentTo(1))
+
+		recorder := serve(sha)
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.Bytes()).To(Equal(wasmModule))
+	})
+
+	It("pulls the module of a digest once", func() {
+		registry = newFakeRegistry("application/vnd.wasm.content.layer.v1+wasm", wasmModule)
+		newCache()
+
+		sha, err := module(registry.host() + "/org/filter:v1")
+		Expect(err).NotTo(HaveOccurred())
+		// another tag of the same image
+		latestSha, err := module(registry.host() + "/org/filter:latest")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(latestSha).To(Equal(sha))
+
+		Expect(registry.manifestPulls.Load()).To(BeEquivalentTo(2))
+		Expect(registry.layerPulls.Load()).To(BeEquivalentTo(1))
+	})
+
+	It("extracts the wasm file of a container image", func() {
+		registry = newFakeRegistry("application/vnd.oci.image.layer.v1.tar+gzip", tarGz("plugin.wasm", wasmModule))
+		newCache()
+
+		sha, err := module(registry.host() + "/org/filter:v1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(serve(sha).Body.Bytes()).To(Equal(wasmModule))
+	})
+
+	It("does not retry failed pulls right away", func() {
+		registry = newFakeRegistry("application/vnd.wasm.content.layer.v1+wasm", wasmModule)
+		newCache()
+
+		_, err := module(registry.host() + "/org/missing:v1")
+		Expect(err).To(MatchError(ContainSubstring("pulling wasm image")))
+		_, err2 := cache.Module(registry.host() + "/org/missing:v1")
+		Expect(err2).To(Equal(err))
+		Consistently(pulled).ShouldNot(Receive())
+	})
+
+	It("does not serve paths that are not sha256s", func() {
+		registry = newFakeRegistry("application/vnd.wasm.content.layer.v1+wasm", wasmModule)
+		newCache()
+
+		Expect(serve("../../etc/passwd").Code).To(Equal(http.StatusNotFound))
+	})
+})
+
+var _ = Describe("ImageCacheFromSettings", func() {
+
+	It("does not pull images unless a server address is set", func() {
+		cache, err := ImageCacheFromSettings(context.Background(), &v1.GlooOptions_WasmImageOptions{
+			CacheDir: GinkgoT().TempDir(),
+		}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cache).To(BeNil())
+	})
+
+	It("creates the cache directory, and serves the modules at the server address", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		DeferCleanup(cancel)
+		cacheDir := filepath.Join(GinkgoT().TempDir(), "wasm")
+
+		cache, err := ImageCacheFromSettings(ctx, &v1.GlooOptions_WasmImageOptions{
+			ServerAddr: "gloo.gloo-system.svc.cluster.local:0",
+			CacheDir:   cacheDir,
+		}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cache.ServerAddr()).To(Equal("gloo.gloo-system.svc.cluster.local:0"))
+		Expect(cacheDir).To(BeADirectory())
+	})
+
+	It("rejects a server address without a port", func() {
+		_, err := ImageCacheFromSettings(context.Background(), &v1.GlooOptions_WasmImageOptions{
+			ServerAddr: "gloo.gloo-system.svc.cluster.local",
+			CacheDir:   GinkgoT().TempDir(),
+		}, nil)
+		Expect(err).To(MatchError(ContainSubstring("invalid wasm image server address")))
+	})
+})
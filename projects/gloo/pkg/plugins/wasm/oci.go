@@ -0,0 +1,301 @@
+package wasm
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/rotisserie/eris"
+)
+
+const (
+	// layer of the images that follow the Wasm OCI artifact spec
+	wasmLayerMediaType = "application/vnd.wasm.content.layer.v1+wasm"
+	// layer of the images built by wasme
+	wasmeLayerMediaType = "application/vnd.module.wasm.content.layer.v1+wasm"
+	// layers of container images, whose filesystem holds a .wasm file, such as the images that follow the Istio
+	// compat spec
+	ociLayerMediaType    = "application/vnd.oci.image.layer.v1.tar+gzip"
+	dockerLayerMediaType = "application/vnd.docker.image.rootfs.diff.tar.gzip"
+
+	defaultRegistry = "docker.io"
+	dockerHub       = "registry-1.docker.io"
+	defaultTag      = "latest"
+)
+
+var (
+	manifestMediaTypes = []string{
+		"application/vnd.oci.image.manifest.v1+json",
+		"application/vnd.oci.image.index.v1+json",
+		"application/vnd.docker.distribution.manifest.v2+json",
+		"application/vnd.docker.distribution.manifest.list.v2+json",
+	}
+
+	challengeParamRegex = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+	InvalidImageError = func(image string) error {
+		return eris.Errorf("invalid image reference %q", image)
+	}
+	NoWasmLayerError    = eris.New("the image has no wasm layer")
+	NoWasmFileError     = eris.New("the image layer has no .wasm file")
+	DigestMismatchError = func(expected, actual string) error {
+		return eris.Errorf("blob digest %s does not match %s", actual, expected)
+	}
+)
+
+type imageRef struct {
+	registry   string
+	repository string
+	// tag or digest
+	reference string
+}
+
+type descriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+}
+
+// manifest is either an image manifest, with layers, or an image index, with manifests
+type manifest struct {
+	Manifests []descriptor `json:"manifests"`
+	Layers    []descriptor `json:"layers"`
+}
+
+// parseImageRef parses a reference such as "ghcr.io/org/filter:v1", "org/filter@sha256:..." or "filter".
+// Images without a registry are pulled from Docker Hub.
+func parseImageRef(image string) (imageRef, error) {
+	ref := imageRef{registry: defaultRegistry, reference: defaultTag}
+	name := image
+	if i := strings.Index(name, "@"); i >= 0 {
+		name, ref.reference = name[:i], name[i+1:]
+	} else if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, ref.reference = name[:i], name[i+1:]
+	}
+
+	if first, rest, found := strings.Cut(name, "/"); found && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		ref.registry, name = first, rest
+	}
+	if ref.registry == defaultRegistry {
+		ref.registry = dockerHub
+		if !strings.Contains(name, "/") {
+			name = "library/" + name
+		}
+	}
+	ref.repository = name
+
+	if ref.repository == "" || ref.reference == "" {
+		return imageRef{}, InvalidImageError(image)
+	}
+	return ref, nil
+}
+
+// resolveImage pulls the manifest of an image, and returns it with the digest the reference of the image resolves to.
+// Tags may be moved to another image, while the module of a digest never changes.
+func resolveImage(ctx context.Context, registry *registryClient) (*manifest, string, error) {
+	m, digest, err := registry.manifest(ctx, registry.ref.reference)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(m.Manifests) > 0 {
+		// the images of WASM modules are not platform specific, so any manifest of an index will do
+		m, _, err = registry.manifest(ctx, m.Manifests[0].Digest)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	return m, digest, nil
+}
+
+// pullModule returns the WASM module of the image of a manifest
+func pullModule(ctx context.Context, registry *registryClient, m *manifest) ([]byte, error) {
+	layer, err := wasmLayer(m)
+	if err != nil {
+		return nil, err
+	}
+	blob, err := registry.blob(ctx, layer.Digest)
+	if err != nil {
+		return nil, err
+	}
+	if layer.MediaType == ociLayerMediaType || layer.MediaType == dockerLayerMediaType {
+		return extractWasmFile(blob)
+	}
+	return blob, nil
+}
+
+func wasmLayer(m *manifest) (*descriptor, error) {
+	for i, layer := range m.Layers {
+		if layer.MediaType == wasmLayerMediaType || layer.MediaType == wasmeLayerMediaType {
+			return &m.Layers[i], nil
+		}
+	}
+	// container images must have a single layer, since the .wasm file could be in any of them otherwise
+	if len(m.Layers) == 1 && (m.Layers[0].MediaType == ociLayerMediaType || m.Layers[0].MediaType == dockerLayerMediaType) {
+		return &m.Layers[0], nil
+	}
+	return nil, NoWasmLayerError
+}
+
+// extractWasmFile returns the first .wasm file of a gzipped tar layer
+func extractWasmFile(layer []byte) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(layer))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil, NoWasmFileError
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag == tar.TypeReg && path.Ext(header.Name) == ".wasm" {
+			return io.ReadAll(tr)
+		}
+	}
+}
+
+// registryClient pulls from a registry that implements the OCI distribution spec, anonymously or with the bearer
+// tokens that the registry issues to anonymous clients
+type registryClient struct {
+	client *http.Client
+	ref    imageRef
+	token  string
+}
+
+func newRegistryClient(client *http.Client, image string) (*registryClient, error) {
+	ref, err := parseImageRef(image)
+	if err != nil {
+		return nil, err
+	}
+	return &registryClient{client: client, ref: ref}, nil
+}
+
+// manifest returns a manifest, with its digest
+func (r *registryClient) manifest(ctx context.Context, reference string) (*manifest, string, error) {
+	body, err := r.get(ctx, fmt.Sprintf("/v2/%s/manifests/%s", r.ref.repository, reference), manifestMediaTypes)
+	if err != nil {
+		return nil, "", err
+	}
+	var m manifest
+	if err := json.Unmarshal(body, &m); err != nil {
+		return nil, "", eris.Wrap(err, "decoding the image manifest")
+	}
+	sum := sha256.Sum256(body)
+	return &m, "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+func (r *registryClient) blob(ctx context.Context, digest string) ([]byte, error) {
+	algorithm, expected, _ := strings.Cut(digest, ":")
+	if algorithm != "sha256" {
+		return nil, eris.Errorf("unsupported digest %s", digest)
+	}
+	body, err := r.get(ctx, fmt.Sprintf("/v2/%s/blobs/%s", r.ref.repository, digest), nil)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(body)
+	if actual := hex.EncodeToString(sum[:]); actual != expected {
+		return nil, DigestMismatchError(digest, "sha256:"+actual)
+	}
+	return body, nil
+}
+
+func (r *registryClient) get(ctx context.Context, path string, accept []string) ([]byte, error) {
+	resp, err := r.do(ctx, path, accept)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized && r.token == "" {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		if r.token, err = r.fetchToken(ctx, challenge); err != nil {
+			return nil, err
+		}
+		if resp, err = r.do(ctx, path, accept); err != nil {
+			return nil, err
+		}
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, eris.Errorf("GET %s: unexpected status %s", path, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+func (r *registryClient) do(ctx context.Context, path string, accept []string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+r.ref.registry+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(accept) > 0 {
+		req.Header.Set("Accept", strings.Join(accept, ", "))
+	}
+	if r.token != "" {
+		req.Header.Set("Authorization", "Bearer "+r.token)
+	}
+	return r.client.Do(req)
+}
+
+// fetchToken requests an anonymous pull token from the realm of a bearer challenge
+func (r *registryClient) fetchToken(ctx context.Context, challenge string) (string, error) {
+	scheme, params, _ := strings.Cut(challenge, " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return "", eris.Errorf("unsupported registry authentication %q", challenge)
+	}
+	values := map[string]string{}
+	for _, match := range challengeParamRegex.FindAllStringSubmatch(params, -1) {
+		values[match[1]] = match[2]
+	}
+	realm, err := url.Parse(values["realm"])
+	if err != nil || realm.Host == "" {
+		return "", eris.Errorf("invalid realm in registry challenge %q", challenge)
+	}
+	query := realm.Query()
+	if service := values["service"]; service != "" {
+		query.Set("service", service)
+	}
+	scope := values["scope"]
+	if scope == "" {
+		scope = fmt.Sprintf("repository:%s:pull", r.ref.repository)
+	}
+	query.Set("scope", scope)
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", eris.Errorf("requesting a registry token: unexpected status %s", resp.Status)
+	}
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", eris.Wrap(err, "decoding the registry token")
+	}
+	if token.Token != "" {
+		return token.Token, nil
+	}
+	return token.AccessToken, nil
+}
@@ -0,0 +1,306 @@
+package wasm
+
+import (
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoywasm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/wasm/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_extensions_wasm_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/wasm/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/constants"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/filters"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	_ plugins.Plugin                  = new(plugin)
+	_ plugins.HttpFilterPlugin        = new(plugin)
+	_ plugins.ResourceGeneratorPlugin = new(plugin)
+)
+
+const (
+	ExtensionName = "wasm"
+	FilterName    = "envoy.filters.http.wasm"
+
+	V8Runtime   = "envoy.wasm.runtime.v8"
+	WavmRuntime = "envoy.wasm.runtime.wavm"
+
+	// ImageServerClusterName is the cluster of the server of the image cache, which serves the modules of OCI images
+	ImageServerClusterName = constants.SoloGeneratedClusterPrefix + "wasm_image_server"
+	// UrlClusterPrefix prefixes the clusters of the hosts that serve the modules of HTTP URLs
+	UrlClusterPrefix = constants.SoloGeneratedClusterPrefix + "wasm_"
+
+	// sha256Fragment is the fragment of HTTP URLs that holds the sha256 the fetched module is verified against
+	sha256Fragment = "sha256="
+
+	remoteFetchTimeout = 30 * time.Second
+	remoteFetchRetries = 3
+)
+
+var (
+	// filters without a stage run once the request passed all the checks
+	defaultFilterStage = plugins.DuringStage(plugins.AcceptedStage)
+
+	NoModuleError = func(name string) error {
+		return eris.Errorf("wasm filter %s has neither an image nor a file path", name)
+	}
+	MissingSha256Error = func(name string) error {
+		return eris.Errorf("the URL of wasm filter %s must end with a #sha256=<hex> fragment, to verify the module", name)
+	}
+	ImagePullingDisabledError = func(name string) error {
+		return eris.Errorf("wasm filter %s uses an OCI image, but pulling images is not enabled; "+
+			"set gloo.wasmImages.serverAddr in the Settings", name)
+	}
+)
+
+type plugin struct {
+	imageCache *ImageCache
+	// clusters the proxies fetch remote modules from
+	clusters map[string]*envoy_config_cluster_v3.Cluster
+}
+
+// NewPlugin returns the wasm plugin, which pulls OCI images with imageCache, if it is non-nil
+func NewPlugin(imageCache *ImageCache) *plugin {
+	return &plugin{imageCache: imageCache}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) {
+	p.clusters = map[string]*envoy_config_cluster_v3.Cluster{}
+}
+
+func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	var wasmFilters []plugins.StagedHttpFilter
+	var pending error
+	for _, wasmFilter := range listener.GetOptions().GetWasm().GetFilters() {
+		code, err := p.moduleSource(wasmFilter)
+		if eris.Is(err, PullPendingError) {
+			// the filter is left out until its image is pulled, which triggers a new translation
+			pending = multierror.Append(pending, eris.Wrapf(err, "wasm filter %s is pending", wasmFilter.GetName()))
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		config := &envoywasm.Wasm{
+			Config: &envoy_extensions_wasm_v3.PluginConfig{
+				Name:   wasmFilter.GetName(),
+				RootId: wasmFilter.GetRootId(),
+				Vm: &envoy_extensions_wasm_v3.PluginConfig_VmConfig{
+					VmConfig: &envoy_extensions_wasm_v3.VmConfig{
+						VmId:    wasmFilter.GetName(),
+						Runtime: runtime(wasmFilter.GetVmType()),
+						Code:    code,
+					},
+				},
+				Configuration: wasmFilter.GetConfig(),
+				FailOpen:      wasmFilter.GetFailOpen(),
+			},
+		}
+		stagedFilter, err := plugins.NewStagedFilter(FilterName, config, filterStage(wasmFilter.GetFilterStage()))
+		if err != nil {
+			return nil, eris.Wrap(err, "generating filter config")
+		}
+		wasmFilters = append(wasmFilters, stagedFilter)
+	}
+	return wasmFilters, pending
+}
+
+// GeneratedResources adds the clusters the proxies fetch remote modules from
+func (p *plugin) GeneratedResources(params plugins.Params,
+	_ []*envoy_config_cluster_v3.Cluster,
+	_ []*envoy_config_endpoint_v3.ClusterLoadAssignment,
+	_ []*envoy_config_route_v3.RouteConfiguration,
+	_ []*envoy_config_listener_v3.Listener) (
+	[]*envoy_config_cluster_v3.Cluster,
+	[]*envoy_config_endpoint_v3.ClusterLoadAssignment,
+	[]*envoy_config_route_v3.RouteConfiguration,
+	[]*envoy_config_listener_v3.Listener, error) {
+	var generatedClusters []*envoy_config_cluster_v3.Cluster
+	for _, cluster := range p.clusters {
+		generatedClusters = append(generatedClusters, cluster)
+	}
+	sort.Slice(generatedClusters, func(i, j int) bool {
+		return generatedClusters[i].GetName() < generatedClusters[j].GetName()
+	})
+	return generatedClusters, nil, nil, nil, nil
+}
+
+// moduleSource returns where the proxies load the module of a filter from: a local file, such as a mounted ConfigMap,
+// an HTTP URL, or the server of the image cache
+func (p *plugin) moduleSource(wasmFilter *wasm.WasmFilter) (*envoy_config_core_v3.AsyncDataSource, error) {
+	if filePath := wasmFilter.GetFilePath(); filePath != "" {
+		return &envoy_config_core_v3.AsyncDataSource{
+			Specifier: &envoy_config_core_v3.AsyncDataSource_Local{
+				Local: &envoy_config_core_v3.DataSource{
+					Specifier: &envoy_config_core_v3.DataSource_Filename{Filename: filePath},
+				},
+			},
+		}, nil
+	}
+
+	image := wasmFilter.GetImage()
+	if image == "" {
+		return nil, NoModuleError(wasmFilter.GetName())
+	}
+
+	if strings.HasPrefix(image, "http://") || strings.HasPrefix(image, "https://") {
+		moduleUrl, err := url.Parse(image)
+		if err != nil {
+			return nil, eris.Wrapf(err, "parsing the URL of wasm filter %s", wasmFilter.GetName())
+		}
+		sha, found := strings.CutPrefix(moduleUrl.Fragment, sha256Fragment)
+		if !found || !sha256Regex.MatchString(sha) {
+			return nil, MissingSha256Error(wasmFilter.GetName())
+		}
+		moduleUrl.Fragment = ""
+
+		secure := moduleUrl.Scheme == "https"
+		port := moduleUrl.Port()
+		if port == "" {
+			port = "80"
+			if secure {
+				port = "443"
+			}
+		}
+		clusterName := UrlClusterPrefix + moduleUrl.Hostname() + "_" + port
+		if _, ok := p.clusters[clusterName]; !ok {
+			cluster, err := hostCluster(clusterName, moduleUrl.Hostname(), port, secure)
+			if err != nil {
+				return nil, err
+			}
+			p.clusters[clusterName] = cluster
+		}
+		return remoteSource(moduleUrl.String(), clusterName, sha), nil
+	}
+
+	if p.imageCache == nil {
+		return nil, ImagePullingDisabledError(wasmFilter.GetName())
+	}
+	sha, err := p.imageCache.Module(image)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := p.clusters[ImageServerClusterName]; !ok {
+		host, port, err := net.SplitHostPort(p.imageCache.ServerAddr())
+		if err != nil {
+			return nil, eris.Wrapf(err, "invalid wasm image server address %q", p.imageCache.ServerAddr())
+		}
+		cluster, err := hostCluster(ImageServerClusterName, host, port, false)
+		if err != nil {
+			return nil, err
+		}
+		p.clusters[ImageServerClusterName] = cluster
+	}
+	return remoteSource(p.imageCache.ModuleUrl(sha), ImageServerClusterName, sha), nil
+}
+
+// remoteSource fetches a module over HTTP, and verifies it against its sha256
+func remoteSource(uri, cluster, sha string) *envoy_config_core_v3.AsyncDataSource {
+	return &envoy_config_core_v3.AsyncDataSource{
+		Specifier: &envoy_config_core_v3.AsyncDataSource_Remote{
+			Remote: &envoy_config_core_v3.RemoteDataSource{
+				HttpUri: &envoy_config_core_v3.HttpUri{
+					Uri: uri,
+					HttpUpstreamType: &envoy_config_core_v3.HttpUri_Cluster{
+						Cluster: cluster,
+					},
+					Timeout: durationpb.New(remoteFetchTimeout),
+				},
+				Sha256: sha,
+				RetryPolicy: &envoy_config_core_v3.RetryPolicy{
+					NumRetries: wrapperspb.UInt32(remoteFetchRetries),
+				},
+			},
+		},
+	}
+}
+
+// hostCluster returns a cluster of a single host. The certificate of secure hosts is not validated, since the
+// modules are verified against their sha256.
+func hostCluster(name, host, port string, secure bool) (*envoy_config_cluster_v3.Cluster, error) {
+	portValue, err := net.LookupPort("tcp", port)
+	if err != nil {
+		return nil, eris.Wrapf(err, "invalid port %s", port)
+	}
+	discoveryType := envoy_config_cluster_v3.Cluster_STRICT_DNS
+	if net.ParseIP(host) != nil {
+		discoveryType = envoy_config_cluster_v3.Cluster_STATIC
+	}
+	cluster := &envoy_config_cluster_v3.Cluster{
+		Name:           name,
+		ConnectTimeout: durationpb.New(5 * time.Second),
+		ClusterDiscoveryType: &envoy_config_cluster_v3.Cluster_Type{
+			Type: discoveryType,
+		},
+		LoadAssignment: &envoy_config_endpoint_v3.ClusterLoadAssignment{
+			ClusterName: name,
+			Endpoints: []*envoy_config_endpoint_v3.LocalityLbEndpoints{{
+				LbEndpoints: []*envoy_config_endpoint_v3.LbEndpoint{{
+					HostIdentifier: &envoy_config_endpoint_v3.LbEndpoint_Endpoint{
+						Endpoint: &envoy_config_endpoint_v3.Endpoint{
+							Address: &envoy_config_core_v3.Address{
+								Address: &envoy_config_core_v3.Address_SocketAddress{
+									SocketAddress: &envoy_config_core_v3.SocketAddress{
+										Address: host,
+										PortSpecifier: &envoy_config_core_v3.SocketAddress_PortValue{
+											PortValue: uint32(portValue),
+										},
+									},
+								},
+							},
+						},
+					},
+				}},
+			}},
+		},
+	}
+	if secure {
+		typedConfig, err := utils.MessageToAny(&envoyauth.UpstreamTlsContext{Sni: host})
+		if err != nil {
+			return nil, err
+		}
+		cluster.TransportSocket = &envoy_config_core_v3.TransportSocket{
+			Name:       wellknown.TransportSocketTls,
+			ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
+		}
+	}
+	return cluster, nil
+}
+
+func runtime(vmType wasm.WasmFilter_VmType) string {
+	if vmType == wasm.WasmFilter_WAVM {
+		return WavmRuntime
+	}
+	return V8Runtime
+}
+
+// filterStage converts the stage of a filter, whose enums have the same values as the well known filter stages
+func filterStage(in *wasm.FilterStage) plugins.HTTPFilterStage {
+	if in == nil {
+		return defaultFilterStage
+	}
+	return *plugins.ConvertFilterStage(&filters.FilterStage{
+		Stage:     filters.FilterStage_Stage(in.GetStage()),
+		Predicate: filters.FilterStage_Predicate(in.GetPredicate()),
+	})
+}
@@ -0,0 +1,171 @@
+package wasm_test
+
+import (
+	"context"
+	"strings"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoywasm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/wasm/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm"
+)
+
+var _ = Describe("Plugin", func() {
+
+	const sha = "2f1c4e8ac1ab2a1bd3d7e93e8e4b8a7e9d1f5d2d0b3ad6e6bba2cdb5b0b7a4c1"
+
+	var (
+		p      plugins.Plugin
+		params plugins.Params
+	)
+
+	BeforeEach(func() {
+		p = NewPlugin(nil)
+		p.Init(plugins.InitParams{})
+		params = plugins.Params{Ctx: context.Background()}
+	})
+
+	listenerWith := func(filters ...*wasm.WasmFilter) *v1.HttpListener {
+		return &v1.HttpListener{
+			Options: &v1.HttpListenerOptions{
+				Wasm: &wasm.PluginSource{Filters: filters},
+			},
+		}
+	}
+
+	httpFilters := func(listener *v1.HttpListener) []*envoywasm.Wasm {
+		stagedFilters, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, listener)
+		Expect(err).NotTo(HaveOccurred())
+		var configs []*envoywasm.Wasm
+		for _, stagedFilter := range stagedFilters {
+			Expect(stagedFilter.Filter.GetName()).To(Equal(FilterName))
+			var config envoywasm.Wasm
+			Expect(stagedFilter.Filter.GetTypedConfig().UnmarshalTo(&config)).To(Succeed())
+			configs = append(configs, &config)
+		}
+		return configs
+	}
+
+	generatedClusters := func() []*envoy_config_cluster_v3.Cluster {
+		clusters, _, _, _, err := p.(plugins.ResourceGeneratorPlugin).GeneratedResources(params, nil, nil, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		return clusters
+	}
+
+	It("loads modules from local files in the stage of the filter", func() {
+		listener := listenerWith(&wasm.WasmFilter{
+			Name:        "add-header",
+			RootId:      "root",
+			Src:         &wasm.WasmFilter_FilePath{FilePath: "/etc/wasm/filter.wasm"},
+			VmType:      wasm.WasmFilter_WAVM,
+			FailOpen:    true,
+			FilterStage: &wasm.FilterStage{Stage: wasm.FilterStage_AuthNStage, Predicate: wasm.FilterStage_Before},
+		})
+		stagedFilters, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, listener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stagedFilters).To(HaveLen(1))
+		Expect(stagedFilters[0].Stage).To(Equal(plugins.BeforeStage(plugins.AuthNStage)))
+
+		config := httpFilters(listener)[0].GetConfig()
+		Expect(config.GetName()).To(Equal("add-header"))
+		Expect(config.GetRootId()).To(Equal("root"))
+		Expect(config.GetFailOpen()).To(BeTrue())
+		Expect(config.GetVmConfig().GetRuntime()).To(Equal(WavmRuntime))
+		Expect(config.GetVmConfig().GetCode().GetLocal().GetFilename()).To(Equal("/etc/wasm/filter.wasm"))
+		Expect(generatedClusters()).To(BeEmpty())
+	})
+
+	It("fetches modules from HTTP URLs, verifying their sha256", func() {
+		listener := listenerWith(&wasm.WasmFilter{
+			Name: "add-header",
+			Src:  &wasm.WasmFilter_Image{Image: "https://example.com/filters/filter.wasm#sha256=" + sha},
+		})
+		stagedFilters, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, listener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stagedFilters[0].Stage).To(Equal(plugins.DuringStage(plugins.AcceptedStage)))
+
+		config := httpFilters(listener)[0].GetConfig()
+		Expect(config.GetVmConfig().GetRuntime()).To(Equal(V8Runtime))
+		remote := config.GetVmConfig().GetCode().GetRemote()
+		Expect(remote.GetSha256()).To(Equal(sha))
+		Expect(remote.GetHttpUri().GetUri()).To(Equal("https://example.com/filters/filter.wasm"))
+		Expect(remote.GetHttpUri().GetCluster()).To(Equal(UrlClusterPrefix + "example.com_443"))
+
+		clusters := generatedClusters()
+		Expect(clusters).To(HaveLen(1))
+		Expect(clusters[0].GetName()).To(Equal(UrlClusterPrefix + "example.com_443"))
+		Expect(clusters[0].GetType()).To(Equal(envoy_config_cluster_v3.Cluster_STRICT_DNS))
+		Expect(clusters[0].GetTransportSocket()).NotTo(BeNil())
+		Expect(clusters[0].GetLoadAssignment().GetEndpoints()[0].GetLbEndpoints()[0].GetEndpoint().GetAddress().GetSocketAddress()).To(And(
+			HaveField("Address", "example.com"),
+			HaveField("PortSpecifier", &envoy_config_core_v3.SocketAddress_PortValue{PortValue: 443}),
+		))
+	})
+
+	It("errors when the HTTP URL of a module has no sha256", func() {
+		listener := listenerWith(&wasm.WasmFilter{
+			Name: "add-header",
+			Src:  &wasm.WasmFilter_Image{Image: "https://example.com/filters/filter.wasm"},
+		})
+		_, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, listener)
+		Expect(err).To(MatchError(MissingSha256Error("add-header")))
+	})
+
+	It("errors when pulling images is not enabled", func() {
+		listener := listenerWith(&wasm.WasmFilter{
+			Name: "add-header",
+			Src:  &wasm.WasmFilter_Image{Image: "ghcr.io/org/filter:v1"},
+		})
+		_, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, listener)
+		Expect(err).To(MatchError(ImagePullingDisabledError("add-header")))
+	})
+
+	It("fetches the modules of images from the server of the image cache, once they are pulled", func() {
+		registry := newFakeRegistry("application/vnd.wasm.content.layer.v1+wasm", wasmModule)
+		defer registry.Close()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		pulled := make(chan struct{}, 1)
+		imageCache := NewImageCache(ctx, GinkgoT().TempDir(), "10.0.0.1:9979", registry.Client(), func() {
+			pulled <- struct{}{}
+		})
+		p = NewPlugin(imageCache)
+		p.Init(plugins.InitParams{})
+
+		listener := listenerWith(
+			&wasm.WasmFilter{
+				Name: "add-header",
+				Src:  &wasm.WasmFilter_Image{Image: registry.host() + "/org/filter:v1"},
+			},
+			&wasm.WasmFilter{
+				Name: "local",
+				Src:  &wasm.WasmFilter_FilePath{FilePath: "/etc/wasm/local.wasm"},
+			},
+		)
+
+		// the filter is left out while its image is pulled
+		stagedFilters, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, listener)
+		Expect(err).To(MatchError(ContainSubstring("wasm filter add-header is pending")))
+		Expect(stagedFilters).To(HaveLen(1))
+		Eventually(pulled).Should(Receive())
+
+		configs := httpFilters(listener)
+		Expect(configs).To(HaveLen(2))
+		remote := configs[0].GetConfig().GetVmConfig().GetCode().GetRemote()
+		Expect("sha256:" + remote.GetSha256()).To(Equal(digest(wasmModule)))
+		Expect(remote.GetHttpUri().GetUri()).To(Equal(imageCache.ModuleUrl(remote.GetSha256())))
+		Expect(remote.GetHttpUri().GetCluster()).To(Equal(ImageServerClusterName))
+
+		clusters := generatedClusters()
+		Expect(clusters).To(HaveLen(1))
+		Expect(clusters[0].GetName()).To(Equal(ImageServerClusterName))
+		Expect(clusters[0].GetType()).To(Equal(envoy_config_cluster_v3.Cluster_STATIC))
+		Expect(clusters[0].GetTransportSocket()).To(BeNil())
+		Expect(strings.HasPrefix(remote.GetHttpUri().GetUri(), "http://10.0.0.1:9979/")).To(BeTrue())
+	})
+})
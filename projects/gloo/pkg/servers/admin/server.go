@@ -7,8 +7,10 @@ import (
 	"fmt"
 	"net/http"
 	"sort"
+	"strconv"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/servers/iosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	"github.com/solo-io/go-utils/contextutils"
 	"istio.io/istio/pkg/kube/krt"
 )
@@ -19,7 +21,7 @@ const (
 
 // ServerHandlers returns the custom handlers for the Admin Server, which will be bound to the http.ServeMux
 // These endpoints serve as the basis for an Admin Interface for the Control Plane (https://github.com/solo-io/gloo/issues/6494)
-func ServerHandlers(ctx context.Context, history iosnapshot.History, dbg *krt.DebugHandler) func(mux *http.ServeMux, profiles map[string]string) {
+func ServerHandlers(ctx context.Context, history iosnapshot.History, xdsStatuses *xds.AckTracker, dbg *krt.DebugHandler) func(mux *http.ServeMux, profiles map[string]string) {
 	return func(m *http.ServeMux, profiles map[string]string) {
 
 		// The Input Snapshot is intended to return a list of resources that are persisted in the Kubernetes DB, etcD
@@ -55,6 +57,44 @@ func ServerHandlers(ctx context.Context, history iosnapshot.History, dbg *krt.De
 		})
 		profiles["/snapshots/xds"] = "XDS Snapshot"
 
+		// The xDS Status is intended to return whether each connected proxy accepted (ACK) or rejected (NACK)
+		// the last resources of each type it was sent, along with the error of the rejections.
+		m.HandleFunc("/snapshots/xds-status", func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, xdsStatuses.Statuses(), r)
+		})
+		profiles["/snapshots/xds-status"] = "XDS ACK/NACK Status"
+
+		// The Canary Diff is intended to return, for each proxy, the xDS resources that a candidate translator
+		// configured with other Settings would add, remove or change, without serving them.
+		m.HandleFunc("/snapshots/canary-diff", func(w http.ResponseWriter, r *http.Request) {
+			response := history.GetCanaryDiff(ctx)
+			respondJson(w, response)
+		})
+		profiles["/snapshots/canary-diff"] = "Canary Translation Diff"
+
+		// The Snapshot History is intended to return the syncs whose input and xDS snapshots are kept, with their
+		// timestamps and hashes, so that the snapshots of two syncs can be diffed
+		m.HandleFunc("/snapshots/history", func(w http.ResponseWriter, r *http.Request) {
+			response := history.GetSnapshotHistory(ctx)
+			respondJson(w, response)
+		})
+		profiles["/snapshots/history"] = "Snapshot History"
+
+		// The Snapshot Diff is intended to return the input resources and xDS resources that changed between two
+		// syncs of the history, identified by the `from` and `to` query parameters
+		m.HandleFunc("/snapshots/diff", func(w http.ResponseWriter, r *http.Request) {
+			from, fromErr := strconv.ParseUint(r.URL.Query().Get("from"), 10, 64)
+			to, toErr := strconv.ParseUint(r.URL.Query().Get("to"), 10, 64)
+			if fromErr != nil || toErr != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte("the from and to query parameters must be the IDs of syncs of /snapshots/history"))
+				return
+			}
+			response := history.GetSnapshotDiff(ctx, from, to)
+			respondJson(w, response)
+		})
+		profiles["/snapshots/diff"] = "Snapshot Diff"
+
 		m.HandleFunc("/snapshots/krt", func(w http.ResponseWriter, r *http.Request) {
 			writeJSON(w, dbg, r)
 		})
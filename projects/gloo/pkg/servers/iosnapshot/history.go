@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"slices"
 	"sync"
+	"time"
 
 	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/pkg/schemes"
@@ -18,6 +19,7 @@ import (
 
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -43,8 +45,27 @@ type History interface {
 	GetProxySnapshot(ctx context.Context) SnapshotResponseData
 
 	// GetXdsSnapshot returns the entire cache of xDS snapshots
-	// NOTE: This contains sensitive data, as it is the exact inputs that used by Envoy
+	// NOTE: This contains sensitive data, as it is the exact inputs that used by Envoy.
+	// The contents of the Secrets served by SDS are redacted.
 	GetXdsSnapshot(ctx context.Context) SnapshotResponseData
+
+	// SetCanaryDiff sets the latest diff between the xDS snapshots served to each proxy, and the ones produced
+	// by the candidate translator, keyed by proxy
+	SetCanaryDiff(latestDiff map[string]xds.SnapshotDiff)
+
+	// GetCanaryDiff returns the latest diff of the candidate translator, keyed by proxy
+	GetCanaryDiff(ctx context.Context) SnapshotResponseData
+
+	// RecordSnapshots adds the input ApiSnapshot of a completed sync, and the xDS snapshots of the cache,
+	// to the history of the last syncs
+	RecordSnapshots(latestInput *v1snap.ApiSnapshot)
+
+	// GetSnapshotHistory returns the summaries of the syncs in the history, oldest first
+	GetSnapshotHistory(ctx context.Context) SnapshotResponseData
+
+	// GetSnapshotDiff returns the diff between the snapshots of two syncs in the history, identified by their ID
+	// NOTE: The input resources are redacted, as are the Secrets and sensitive fields of the xDS resources
+	GetSnapshotDiff(ctx context.Context, from, to uint64) SnapshotResponseData
 }
 
 // HistoryFactoryParameters are the inputs used to create a History object
@@ -96,6 +117,7 @@ func NewHistory(cache cache.SnapshotCache, settings *gloov1.Settings, kubeClient
 		settings:            settings,
 		inputSnapshotClient: kubeClient,
 		inputSnapshotGvks:   kubeGatewayGvks,
+		snapshotRing:        newSnapshotRing(int(settings.GetGloo().GetSnapshotHistorySize())),
 	}
 }
 
@@ -105,6 +127,7 @@ type historyImpl struct {
 	//	We should instead use channels to coordinate this
 	sync.RWMutex
 	latestApiSnapshot *v1snap.ApiSnapshot
+	latestCanaryDiff  map[string]xds.SnapshotDiff
 	xdsCache          cache.SnapshotCache
 	settings          *gloov1.Settings
 
@@ -113,6 +136,9 @@ type historyImpl struct {
 
 	inputSnapshotClient client.Client
 	inputSnapshotGvks   []schema.GroupVersionKind
+
+	// snapshotRing keeps the snapshots of the last syncs, whose size is configured by gloo.snapshotHistorySize in the Settings
+	snapshotRing *snapshotRing
 }
 
 // SetApiSnapshot sets the latest input ApiSnapshot
@@ -127,6 +153,101 @@ func (h *historyImpl) SetApiSnapshot(latestApiSnapshot *v1snap.ApiSnapshot) {
 	}()
 }
 
+// SetCanaryDiff sets the latest diff of the candidate translator
+func (h *historyImpl) SetCanaryDiff(latestDiff map[string]xds.SnapshotDiff) {
+	// see SetApiSnapshot
+	go func() {
+		h.Lock()
+		defer h.Unlock()
+
+		h.latestCanaryDiff = latestDiff
+	}()
+}
+
+func (h *historyImpl) GetCanaryDiff(_ context.Context) SnapshotResponseData {
+	h.RLock()
+	defer h.RUnlock()
+	if h.latestCanaryDiff == nil {
+		return errorSnapshotResponse(eris.New("No canary translation has completed; it runs when gloo.canarySettingsFile is set in the Settings"))
+	}
+	return completeSnapshotResponse(h.latestCanaryDiff)
+}
+
+// RecordSnapshots adds the snapshots of a completed sync to the history
+func (h *historyImpl) RecordSnapshots(latestInput *v1snap.ApiSnapshot) {
+	if h.snapshotRing.size <= 0 {
+		return
+	}
+	// the xDS snapshots are read now, since the cache may change before the goroutine runs.
+	// Snapshots are replaced in the cache rather than modified, so they are kept without being copied,
+	// apart from their Secrets, which are redacted so that the history never holds their contents.
+	xdsSnapshots := map[string]cache.Snapshot{}
+	for _, key := range h.xdsCache.GetStatusKeys() {
+		xdsSnapshot, err := getXdsSnapshot(h.xdsCache, key)
+		if err == nil && xdsSnapshot != nil {
+			xdsSnapshots[key] = redactXdsSnapshot(xdsSnapshot)
+		}
+	}
+	timestamp := time.Now()
+
+	// see SetApiSnapshot
+	go func() {
+		inputHash, _ := latestInput.Hash(nil)
+		entry := &snapshotEntry{
+			summary: SnapshotSummary{
+				Timestamp: timestamp,
+				InputHash: inputHash,
+				XdsHash:   xdsSnapshotsHash(xdsSnapshots),
+			},
+			input:        latestInput,
+			xdsSnapshots: xdsSnapshots,
+		}
+
+		h.Lock()
+		defer h.Unlock()
+		h.snapshotRing.add(entry)
+	}()
+}
+
+func (h *historyImpl) GetSnapshotHistory(_ context.Context) SnapshotResponseData {
+	h.RLock()
+	defer h.RUnlock()
+	return completeSnapshotResponse(h.snapshotRing.summaries())
+}
+
+func (h *historyImpl) GetSnapshotDiff(_ context.Context, from, to uint64) SnapshotResponseData {
+	h.RLock()
+	fromEntry, fromErr := h.snapshotRing.get(from)
+	toEntry, toErr := h.snapshotRing.get(to)
+	h.RUnlock()
+	if err := multierror.Append(nil, fromErr, toErr).ErrorOrNil(); err != nil {
+		return errorSnapshotResponse(err)
+	}
+
+	// the snapshots are cloned before they are redacted, so that the history is not modified
+	fromInput, toInput := fromEntry.input.Clone(), toEntry.input.Clone()
+	redactApiSnapshot(&fromInput)
+	redactApiSnapshot(&toInput)
+	inputDiff, err := diffInputs(&fromInput, &toInput)
+	if err != nil {
+		return errorSnapshotResponse(err)
+	}
+
+	xdsDiff := map[string]xds.SnapshotDiff{}
+	for _, key := range unionKeys(fromEntry.xdsSnapshots, toEntry.xdsSnapshots) {
+		if diff := xds.DiffSnapshots(fromEntry.xdsSnapshots[key], toEntry.xdsSnapshots[key]); diff.Len() > 0 {
+			xdsDiff[key] = diff
+		}
+	}
+
+	return completeSnapshotResponse(HistoryDiff{
+		From:  fromEntry.summary,
+		To:    toEntry.summary,
+		Input: inputDiff,
+		Xds:   xdsDiff,
+	})
+}
+
 func (h *historyImpl) GetEdgeApiSnapshot(_ context.Context) SnapshotResponseData {
 	snap := h.getRedactedApiSnapshot()
 	return completeSnapshotResponse(snap)
@@ -180,7 +301,8 @@ func (h *historyImpl) GetProxySnapshot(_ context.Context) SnapshotResponseData {
 }
 
 // GetXdsSnapshot returns the entire cache of xDS snapshots
-// NOTE: This contains sensitive data, as it is the exact inputs that used by Envoy
+// NOTE: This contains sensitive data, as it is the exact inputs that used by Envoy.
+// The contents of the Secrets served by SDS are redacted.
 func (h *historyImpl) GetXdsSnapshot(_ context.Context) SnapshotResponseData {
 	return GetXdsSnapshotDataFromCache(h.xdsCache)
 }
@@ -194,7 +316,7 @@ func GetXdsSnapshotDataFromCache(xdsCache cache.SnapshotCache) SnapshotResponseD
 		if err != nil {
 			cacheEntries[k] = err.Error()
 		} else {
-			cacheEntries[k] = xdsSnapshot
+			cacheEntries[k] = redactXdsSnapshot(xdsSnapshot)
 		}
 	}
 
@@ -5,10 +5,19 @@ import (
 	"fmt"
 	"time"
 
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	gomegatypes "github.com/onsi/gomega/types"
 	"github.com/solo-io/gloo/pkg/schemes"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/kube/apis/gloo.solo.io/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
 	"github.com/solo-io/gloo/projects/gloo/pkg/servers/iosnapshot"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+	envoyresource "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/types"
+	"google.golang.org/protobuf/types/known/structpb"
 	apiv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	wellknownkube "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/kube/wellknown"
@@ -793,6 +802,263 @@ var _ = Describe("History", func() {
 
 	})
 
+	Context("Snapshot history", func() {
+
+		upstream := func(host string) *v1.Upstream {
+			return &v1.Upstream{
+				Metadata: &core.Metadata{Name: "upstream", Namespace: defaults.GlooSystem},
+				UpstreamType: &v1.Upstream_Static{
+					Static: &static.UpstreamSpec{
+						Hosts: []*static.Host{{Addr: host, Port: 80}},
+					},
+				},
+			}
+		}
+		secret := func(key string) *v1.Secret {
+			return &v1.Secret{
+				Metadata: &core.Metadata{Name: "secret", Namespace: defaults.GlooSystem},
+				Kind: &v1.Secret_Tls{
+					Tls: &v1.TlsSecret{PrivateKey: key},
+				},
+			}
+		}
+		getHistory := func(g Gomega, history iosnapshot.History) []iosnapshot.SnapshotSummary {
+			response := history.GetSnapshotHistory(ctx)
+			g.Expect(response.Error).NotTo(HaveOccurred())
+			return response.Data.([]iosnapshot.SnapshotSummary)
+		}
+
+		BeforeEach(func() {
+			historyFactorParams.Settings.Gloo = &v1.GlooOptions{
+				SnapshotHistorySize: 10,
+			}
+			history = iosnapshot.NewHistory(
+				historyFactorParams.Cache,
+				historyFactorParams.Settings,
+				clientBuilder.Build(),
+				iosnapshot.CompleteInputSnapshotGVKs,
+			)
+		})
+
+		It("keeps no snapshots by default", func() {
+			historyFactorParams.Settings.Gloo = nil
+			history = iosnapshot.NewHistory(historyFactorParams.Cache, historyFactorParams.Settings, clientBuilder.Build(), iosnapshot.CompleteInputSnapshotGVKs)
+
+			history.RecordSnapshots(&v1snap.ApiSnapshot{})
+			Consistently(func(g Gomega) {
+				g.Expect(getHistory(g, history)).To(BeEmpty())
+			}, "0.5s", "0.1s").Should(Succeed())
+		})
+
+		It("diffs the redacted input resources of two syncs", func() {
+			history.RecordSnapshots(&v1snap.ApiSnapshot{
+				Upstreams: v1.UpstreamList{upstream("1.2.3.4")},
+				Secrets:   v1.SecretList{secret("first-key")},
+			})
+			Eventually(func(g Gomega) {
+				g.Expect(getHistory(g, history)).To(HaveLen(1))
+			}, "2s", "0.1s").Should(Succeed())
+			history.RecordSnapshots(&v1snap.ApiSnapshot{
+				Upstreams: v1.UpstreamList{upstream("5.6.7.8")},
+				Secrets:   v1.SecretList{secret("second-key")},
+				Proxies: v1.ProxyList{
+					{Metadata: &core.Metadata{Name: "proxy", Namespace: defaults.GlooSystem}},
+				},
+			})
+
+			var summaries []iosnapshot.SnapshotSummary
+			Eventually(func(g Gomega) {
+				summaries = getHistory(g, history)
+				g.Expect(summaries).To(HaveLen(2))
+			}, "2s", "0.1s").Should(Succeed())
+			Expect(summaries[0].ID).To(BeNumerically("<", summaries[1].ID))
+			Expect(summaries[0].InputHash).NotTo(Equal(summaries[1].InputHash))
+
+			response := history.GetSnapshotDiff(ctx, summaries[0].ID, summaries[1].ID)
+			Expect(response.Error).NotTo(HaveOccurred())
+			diff := response.Data.(iosnapshot.HistoryDiff)
+			Expect(diff.From).To(Equal(summaries[0]))
+			Expect(diff.To).To(Equal(summaries[1]))
+			Expect(diff.Input).To(HaveKeyWithValue("Proxies", iosnapshot.ResourceListDiff{
+				Added: []string{defaults.GlooSystem + ".proxy"},
+			}))
+			Expect(diff.Input).To(HaveKeyWithValue("Upstreams", iosnapshot.ResourceListDiff{
+				Changed: map[string][]iosnapshot.FieldDiff{
+					defaults.GlooSystem + ".upstream": {
+						{Path: "UpstreamType.Static.hosts[0].addr", From: "1.2.3.4", To: "5.6.7.8"},
+					},
+				},
+			}))
+			Expect(diff.Input).NotTo(HaveKey("Secrets"), "the data of secrets is redacted")
+			Expect(response.MarshalJSONString()).NotTo(ContainSubstring("first-key"))
+			Expect(response.MarshalJSONString()).NotTo(ContainSubstring("second-key"))
+		})
+
+		It("keeps the snapshots of the last syncs", func() {
+			historyFactorParams.Settings.Gloo.SnapshotHistorySize = 2
+			history = iosnapshot.NewHistory(historyFactorParams.Cache, historyFactorParams.Settings, clientBuilder.Build(), iosnapshot.CompleteInputSnapshotGVKs)
+
+			for i := 0; i < 3; i++ {
+				history.RecordSnapshots(&v1snap.ApiSnapshot{})
+				Eventually(func(g Gomega) {
+					summaries := getHistory(g, history)
+					g.Expect(summaries).NotTo(BeEmpty())
+					g.Expect(summaries[len(summaries)-1].ID).To(BeEquivalentTo(i + 1))
+				}, "2s", "0.1s").Should(Succeed())
+			}
+
+			Eventually(func(g Gomega) {
+				summaries := getHistory(g, history)
+				g.Expect(summaries).To(HaveLen(2))
+				g.Expect(summaries[0].ID).To(BeEquivalentTo(2))
+				g.Expect(summaries[1].ID).To(BeEquivalentTo(3))
+			}, "2s", "0.1s").Should(Succeed())
+			Expect(history.GetSnapshotDiff(ctx, 1, 3).Error).To(MatchError(ContainSubstring("snapshot 1 is not in the history")))
+		})
+
+		It("diffs the xDS snapshots of two syncs", func() {
+			const nodeKey = "gloo-system~gateway-proxy"
+			xdsCache := xds.NewAdsSnapshotCache(ctx)
+			// the snapshots of the cache are kept for the nodes that connected
+			_, cancelWatch := xdsCache.CreateWatch(envoy_service_discovery_v3.DiscoveryRequest{
+				Node: &envoy_config_core_v3.Node{
+					Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{
+						xds.RoleKey: structpb.NewStringValue(nodeKey),
+					}},
+				},
+				TypeUrl: envoyresource.ClusterTypeV3,
+			})
+			DeferCleanup(cancelWatch)
+			history = iosnapshot.NewHistory(xdsCache, historyFactorParams.Settings, clientBuilder.Build(), iosnapshot.CompleteInputSnapshotGVKs)
+
+			clusterSnapshot := func(version string, clusterNames ...string) envoycache.Snapshot {
+				var clusters []envoycache.Resource
+				for _, name := range clusterNames {
+					clusters = append(clusters, resource.NewEnvoyResource(&envoy_config_cluster_v3.Cluster{Name: name}))
+				}
+				return xds.NewSnapshotFromResources(
+					envoycache.NewResources(version, nil),
+					envoycache.NewResources(version, clusters),
+					envoycache.NewResources(version, nil),
+					envoycache.NewResources(version, nil),
+					envoycache.NewResources(version, nil),
+					envoycache.NewResources(version, nil),
+					envoycache.NewResources(version, nil),
+					envoycache.NewResources(version, nil),
+				)
+			}
+
+			xdsCache.SetSnapshot(nodeKey, clusterSnapshot("1", "a", "b"))
+			history.RecordSnapshots(&v1snap.ApiSnapshot{})
+			Eventually(func(g Gomega) {
+				g.Expect(getHistory(g, history)).To(HaveLen(1))
+			}, "2s", "0.1s").Should(Succeed())
+			xdsCache.SetSnapshot(nodeKey, clusterSnapshot("2", "b", "c"))
+			history.RecordSnapshots(&v1snap.ApiSnapshot{})
+
+			var summaries []iosnapshot.SnapshotSummary
+			Eventually(func(g Gomega) {
+				summaries = getHistory(g, history)
+				g.Expect(summaries).To(HaveLen(2))
+			}, "2s", "0.1s").Should(Succeed())
+			Expect(summaries[0].XdsHash).NotTo(Equal(summaries[1].XdsHash))
+
+			response := history.GetSnapshotDiff(ctx, summaries[0].ID, summaries[1].ID)
+			Expect(response.Error).NotTo(HaveOccurred())
+			diff := response.Data.(iosnapshot.HistoryDiff)
+			Expect(diff.Input).To(BeEmpty())
+			Expect(diff.Xds).To(Equal(map[string]xds.SnapshotDiff{
+				nodeKey: {
+					envoyresource.ClusterTypeV3: {
+						Added:   []string{"c"},
+						Removed: []string{"a"},
+					},
+				},
+			}))
+		})
+
+		It("redacts the Secrets of the xDS snapshots", func() {
+			const (
+				nodeKey       = "gloo-system~gateway-proxy"
+				clientSecret  = "oauth-client-secret-value"
+				hmacSecret    = "oauth-hmac-secret-value"
+				newHmacSecret = "oauth-new-hmac-secret-value"
+			)
+			xdsCache := xds.NewAdsSnapshotCache(ctx)
+			_, cancelWatch := xdsCache.CreateWatch(envoy_service_discovery_v3.DiscoveryRequest{
+				Node: &envoy_config_core_v3.Node{
+					Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{
+						xds.RoleKey: structpb.NewStringValue(nodeKey),
+					}},
+				},
+				TypeUrl: xds.SecretTypeV3,
+			})
+			DeferCleanup(cancelWatch)
+			history = iosnapshot.NewHistory(xdsCache, historyFactorParams.Settings, clientBuilder.Build(), iosnapshot.CompleteInputSnapshotGVKs)
+
+			secretSnapshot := func(version string, secretValues map[string]string) envoycache.Snapshot {
+				var secrets []envoycache.Resource
+				for name, value := range secretValues {
+					secrets = append(secrets, xds.NewSecretResource(&envoy_extensions_transport_sockets_tls_v3.Secret{
+						Name: name,
+						Type: &envoy_extensions_transport_sockets_tls_v3.Secret_GenericSecret{
+							GenericSecret: &envoy_extensions_transport_sockets_tls_v3.GenericSecret{
+								Secret: &envoy_config_core_v3.DataSource{
+									Specifier: &envoy_config_core_v3.DataSource_InlineString{InlineString: value},
+								},
+							},
+						},
+					}))
+				}
+				return xds.NewSnapshotFromResources(
+					envoycache.NewResources(version, nil),
+					envoycache.NewResources(version, nil),
+					envoycache.NewResources(version, nil),
+					envoycache.NewResources(version, nil),
+					envoycache.NewResources(version, nil),
+					envoycache.NewResources(version, nil),
+					envoycache.NewResources(version, nil),
+					envoycache.NewResources(version, secrets),
+				)
+			}
+			expectRedacted := func(response iosnapshot.SnapshotResponseData) {
+				Expect(response.Error).NotTo(HaveOccurred())
+				responseJson := response.MarshalJSONString()
+				Expect(responseJson).NotTo(Or(
+					ContainSubstring(clientSecret),
+					ContainSubstring(hmacSecret),
+					ContainSubstring(newHmacSecret),
+				))
+			}
+
+			firstSnapshot := secretSnapshot("1", map[string]string{"client": clientSecret, "hmac": hmacSecret})
+			xdsCache.SetSnapshot(nodeKey, firstSnapshot)
+			history.RecordSnapshots(&v1snap.ApiSnapshot{})
+			Eventually(func(g Gomega) {
+				g.Expect(getHistory(g, history)).To(HaveLen(1))
+			}, "2s", "0.1s").Should(Succeed())
+
+			response := history.GetXdsSnapshot(ctx)
+			expectRedacted(response)
+			Expect(response.MarshalJSONString()).To(And(ContainSubstring("client"), ContainSubstring("hmac")), "secrets should be kept by name")
+
+			xdsCache.SetSnapshot(nodeKey, secretSnapshot("2", map[string]string{"client": clientSecret, "hmac": newHmacSecret}))
+			history.RecordSnapshots(&v1snap.ApiSnapshot{})
+			var summaries []iosnapshot.SnapshotSummary
+			Eventually(func(g Gomega) {
+				summaries = getHistory(g, history)
+				g.Expect(summaries).To(HaveLen(2))
+			}, "2s", "0.1s").Should(Succeed())
+
+			expectRedacted(history.GetXdsSnapshot(ctx))
+			expectRedacted(history.GetSnapshotDiff(ctx, summaries[0].ID, summaries[1].ID))
+
+			// the snapshot served to Envoy is not modified
+			servedSecret := firstSnapshot.GetResources(xds.SecretTypeV3).Items["client"].ResourceProto().(*envoy_extensions_transport_sockets_tls_v3.Secret)
+			Expect(servedSecret.GetGenericSecret().GetSecret().GetInlineString()).To(Equal(clientSecret))
+		})
+	})
+
 })
 
 func getInputSnapshotObjects(ctx context.Context, history iosnapshot.History) []client.Object {
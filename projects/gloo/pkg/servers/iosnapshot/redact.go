@@ -1,7 +1,10 @@
 package iosnapshot
 
 import (
+	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -52,6 +55,27 @@ func redactGlooArtifactData(element *gloov1.Artifact) {
 	redactAnnotations(element.GetMetadata().GetAnnotations())
 }
 
+// redactXdsSnapshot returns a copy of the xDS snapshot without the contents of the Secrets served by SDS,
+// which hold credentials such as OAuth client secrets and HMAC keys.
+// As with Gloo Secrets, each Secret is kept by name, and the rest of it is removed.
+// The snapshot itself is not modified, since it is shared with the xDS cache.
+func redactXdsSnapshot(snapshot cache.Snapshot) cache.Snapshot {
+	envoySnapshot, ok := snapshot.(*xds.EnvoySnapshot)
+	if !ok || len(envoySnapshot.Secrets.Items) == 0 {
+		return snapshot
+	}
+
+	redacted := *envoySnapshot
+	redacted.Secrets = cache.Resources{
+		Version: envoySnapshot.Secrets.Version,
+		Items:   make(map[string]cache.Resource, len(envoySnapshot.Secrets.Items)),
+	}
+	for name := range envoySnapshot.Secrets.Items {
+		redacted.Secrets.Items[name] = xds.NewSecretResource(&envoy_extensions_transport_sockets_tls_v3.Secret{Name: name})
+	}
+	return &redacted
+}
+
 // redactGlooResourceMetadata modifies the metadata to remove any sensitive information
 // ref: https://github.com/solo-io/skv2/blob/1583cb716c04eb3f8d01ecb179b0deeabaa6e42b/contrib/pkg/snapshot/redact.go#L20-L26
 func redactAnnotations(annotations map[string]string) {
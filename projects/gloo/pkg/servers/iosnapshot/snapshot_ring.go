@@ -0,0 +1,238 @@
+package iosnapshot
+
+import (
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/rotisserie/eris"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+)
+
+// SnapshotSummary identifies the snapshots of a sync in the history
+type SnapshotSummary struct {
+	// ID increases with each sync, and is used to request diffs
+	ID        uint64    `json:"id"`
+	Timestamp time.Time `json:"timestamp"`
+	// InputHash is the hash of the input ApiSnapshot
+	InputHash uint64 `json:"inputHash"`
+	// XdsHash is the hash of the versions of the xDS snapshots
+	XdsHash uint64 `json:"xdsHash"`
+}
+
+// HistoryDiff is the diff between the snapshots of two syncs of the history
+type HistoryDiff struct {
+	From SnapshotSummary `json:"from"`
+	To   SnapshotSummary `json:"to"`
+	// Input is the diff of the redacted resources of the input ApiSnapshots, keyed by resource type.
+	// Types whose resources are the same in both snapshots are omitted.
+	Input map[string]ResourceListDiff `json:"input"`
+	// Xds is the diff of the xDS snapshots, keyed by xDS cache key.
+	// Proxies whose xDS resources are the same in both snapshots are omitted.
+	Xds map[string]xds.SnapshotDiff `json:"xds"`
+}
+
+// ResourceListDiff is the diff of the resources of a type, identified by "namespace.name"
+type ResourceListDiff struct {
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+	// Changed are the fields that differ for each resource of both snapshots
+	Changed map[string][]FieldDiff `json:"changed,omitempty"`
+}
+
+// FieldDiff is a field of a resource that differs between two snapshots, as for the xDS resources.
+// The paths of the input resources are the ones of their JSON fields.
+type FieldDiff = xds.FieldDiff
+
+// snapshotEntry holds the snapshots of a sync
+type snapshotEntry struct {
+	summary      SnapshotSummary
+	input        *v1snap.ApiSnapshot
+	xdsSnapshots map[string]cache.Snapshot
+}
+
+// snapshotRing keeps the snapshots of the last syncs, up to its size
+type snapshotRing struct {
+	entries []*snapshotEntry
+	size    int
+	nextID  uint64
+}
+
+func newSnapshotRing(size int) *snapshotRing {
+	return &snapshotRing{
+		size:   size,
+		nextID: 1,
+	}
+}
+
+func (r *snapshotRing) add(entry *snapshotEntry) {
+	if r.size <= 0 {
+		return
+	}
+	entry.summary.ID = r.nextID
+	r.nextID++
+	if len(r.entries) == r.size {
+		r.entries = append(r.entries[:0], r.entries[1:]...)
+	}
+	r.entries = append(r.entries, entry)
+}
+
+func (r *snapshotRing) summaries() []SnapshotSummary {
+	summaries := make([]SnapshotSummary, 0, len(r.entries))
+	for _, entry := range r.entries {
+		summaries = append(summaries, entry.summary)
+	}
+	return summaries
+}
+
+func (r *snapshotRing) get(id uint64) (*snapshotEntry, error) {
+	for _, entry := range r.entries {
+		if entry.summary.ID == id {
+			return entry, nil
+		}
+	}
+	return nil, eris.Errorf("snapshot %d is not in the history", id)
+}
+
+// xdsSnapshotsHash hashes the versions of the resources of the xDS snapshots
+func xdsSnapshotsHash(xdsSnapshots map[string]cache.Snapshot) uint64 {
+	keys := make([]string, 0, len(xdsSnapshots))
+	for key := range xdsSnapshots {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	hasher := fnv.New64()
+	for _, key := range keys {
+		_, _ = hasher.Write([]byte(key))
+		for _, typeURL := range xds.EnvoySnapshotTypeURLs {
+			_, _ = hasher.Write([]byte(xdsSnapshots[key].GetResources(typeURL).Version))
+		}
+	}
+	return hasher.Sum64()
+}
+
+// diffInputs diffs the resources of two ApiSnapshots, which must already be redacted
+func diffInputs(from, to *v1snap.ApiSnapshot) (map[string]ResourceListDiff, error) {
+	fromResources, err := resourcesByType(from)
+	if err != nil {
+		return nil, err
+	}
+	toResources, err := resourcesByType(to)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs := map[string]ResourceListDiff{}
+	for _, resourceType := range unionKeys(fromResources, toResources) {
+		fromByName, toByName := fromResources[resourceType], toResources[resourceType]
+		var diff ResourceListDiff
+		for _, name := range unionKeys(fromByName, toByName) {
+			fromResource, inFrom := fromByName[name]
+			toResource, inTo := toByName[name]
+			switch {
+			case !inFrom:
+				diff.Added = append(diff.Added, name)
+			case !inTo:
+				diff.Removed = append(diff.Removed, name)
+			default:
+				if fields := diffFields("", fromResource, toResource); len(fields) > 0 {
+					if diff.Changed == nil {
+						diff.Changed = map[string][]FieldDiff{}
+					}
+					diff.Changed[name] = fields
+				}
+			}
+		}
+		if len(diff.Added)+len(diff.Removed)+len(diff.Changed) > 0 {
+			diffs[resourceType] = diff
+		}
+	}
+	return diffs, nil
+}
+
+// resourcesByType converts the resources of an ApiSnapshot into generic maps, keyed by type and "namespace.name"
+func resourcesByType(snap *v1snap.ApiSnapshot) (map[string]map[string]interface{}, error) {
+	genericMap, err := apiSnapshotToGenericMap(snap)
+	if err != nil {
+		return nil, err
+	}
+	resources := make(map[string]map[string]interface{}, len(genericMap))
+	for resourceType, list := range genericMap {
+		items, ok := list.([]interface{})
+		if !ok {
+			continue
+		}
+		byName := make(map[string]interface{}, len(items))
+		for _, item := range items {
+			byName[resourceName(item)] = item
+		}
+		resources[resourceType] = byName
+	}
+	return resources, nil
+}
+
+func resourceName(resource interface{}) string {
+	metadata, _ := resource.(map[string]interface{})["metadata"].(map[string]interface{})
+	namespace, _ := metadata["namespace"].(string)
+	name, _ := metadata["name"].(string)
+	return namespace + "." + name
+}
+
+// diffFields recursively compares two values decoded from JSON, and returns the paths of the fields that differ
+func diffFields(path string, from, to interface{}) []FieldDiff {
+	fromMap, fromIsMap := from.(map[string]interface{})
+	toMap, toIsMap := to.(map[string]interface{})
+	if fromIsMap && toIsMap {
+		var diffs []FieldDiff
+		for _, key := range unionKeys(fromMap, toMap) {
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+			diffs = append(diffs, diffFields(fieldPath, fromMap[key], toMap[key])...)
+		}
+		return diffs
+	}
+
+	fromList, fromIsList := from.([]interface{})
+	toList, toIsList := to.([]interface{})
+	if fromIsList && toIsList {
+		var diffs []FieldDiff
+		for i := 0; i < max(len(fromList), len(toList)); i++ {
+			var fromItem, toItem interface{}
+			if i < len(fromList) {
+				fromItem = fromList[i]
+			}
+			if i < len(toList) {
+				toItem = toList[i]
+			}
+			diffs = append(diffs, diffFields(fmt.Sprintf("%s[%d]", path, i), fromItem, toItem)...)
+		}
+		return diffs
+	}
+
+	if reflect.DeepEqual(from, to) {
+		return nil
+	}
+	return []FieldDiff{{Path: path, From: from, To: to}}
+}
+
+// unionKeys returns the sorted keys of both maps
+func unionKeys[V any](a, b map[string]V) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,135 @@
+package syncer
+
+import (
+	"context"
+	"sync"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"github.com/solo-io/go-utils/contextutils"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/servers/iosnapshot"
+	syncerstats "github.com/solo-io/gloo/projects/gloo/pkg/syncer/stats"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+)
+
+var (
+	canaryDiffs = stats.Int64("api.gloo.solo.io/translator/canary_diffs", "The number of xDS resources of a proxy that the candidate translator translates differently", "1")
+
+	canaryDiffsView = &view.View{
+		Name:        "api.gloo.solo.io/translator/canary_diffs",
+		Measure:     canaryDiffs,
+		Description: "The number of xDS resources of a proxy that the candidate translator translates differently",
+		Aggregation: view.LastValue(),
+		TagKeys:     []tag.Key{syncerstats.ProxyNameKey},
+	}
+)
+
+func init() {
+	_ = view.Register(canaryDiffsView)
+}
+
+// CanaryTranslation configures a candidate translator, that shadow-translates the edge Proxies after each sync.
+// Its xDS snapshots are diffed with the ones served to the proxies, but are never served.
+type CanaryTranslation struct {
+	// Translator is the candidate translator
+	Translator translator.Translator
+	// Settings are the Settings the candidate translator is configured with
+	Settings *v1.Settings
+}
+
+// canaryInput is the input of a canary translation: the proxies of an ApiSnapshot, and the xDS snapshots
+// the primary translator produced for them, keyed by the xDS cache key of the proxy
+type canaryInput struct {
+	snap      *v1snap.ApiSnapshot
+	proxies   v1.ProxyList
+	snapshots map[string]envoycache.Snapshot
+}
+
+// canaryTranslator runs the canary translations in the background, so that they don't delay the syncs.
+// Only the latest input is translated if several syncs complete while a canary translation runs.
+type canaryTranslator struct {
+	canary          *CanaryTranslation
+	snapshotHistory iosnapshot.History
+
+	lock    sync.Mutex
+	pending *canaryInput
+	notify  chan struct{}
+}
+
+func newCanaryTranslator(ctx context.Context, canary *CanaryTranslation, snapshotHistory iosnapshot.History) *canaryTranslator {
+	c := &canaryTranslator{
+		canary:          canary,
+		snapshotHistory: snapshotHistory,
+		notify:          make(chan struct{}, 1),
+	}
+	go c.translateOnNotify(ctx)
+	return c
+}
+
+func (c *canaryTranslator) enqueue(input *canaryInput) {
+	c.lock.Lock()
+	c.pending = input
+	c.lock.Unlock()
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (c *canaryTranslator) translateOnNotify(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-c.notify:
+		}
+		c.lock.Lock()
+		input := c.pending
+		c.pending = nil
+		c.lock.Unlock()
+		if input != nil {
+			c.snapshotHistory.SetCanaryDiff(c.translate(ctx, input))
+		}
+	}
+}
+
+// translate translates the proxies with the candidate translator, and returns the diff of its xDS snapshots
+// with the ones of the primary translator, keyed by proxy
+func (c *canaryTranslator) translate(ctx context.Context, input *canaryInput) map[string]xds.SnapshotDiff {
+	logger := contextutils.LoggerFrom(ctx)
+	diffs := make(map[string]xds.SnapshotDiff, len(input.proxies))
+	for _, proxy := range input.proxies {
+		key := xds.SnapshotCacheKey(proxy)
+		proxyCtx := ctx
+		if ctxWithTags, err := tag.New(proxyCtx, tag.Insert(syncerstats.ProxyNameKey, key)); err == nil {
+			proxyCtx = ctxWithTags
+		}
+
+		params := plugins.Params{
+			Ctx:      proxyCtx,
+			Settings: c.canary.Settings,
+			Snapshot: input.snap,
+			Messages: map[*core.ResourceRef][]string{},
+		}
+		candidate, _, _ := c.canary.Translator.Translate(params, proxy)
+		// the primary snapshots are made consistent before they are served
+		candidate.MakeConsistent()
+
+		diff := xds.DiffSnapshots(input.snapshots[key], candidate)
+		diffs[key] = diff
+		stats.Record(proxyCtx, canaryDiffs.M(int64(diff.Len())))
+		if diff.Len() > 0 {
+			logger.Infof("candidate translator translates %d xDS resources of proxy %s differently", diff.Len(), key)
+		}
+	}
+	return diffs
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sync"
 
 	"github.com/gorilla/mux"
 	"go.opencensus.io/stats"
@@ -27,6 +28,7 @@ import (
 	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	syncerstats "github.com/solo-io/gloo/projects/gloo/pkg/syncer/stats"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 )
@@ -48,10 +50,20 @@ var (
 		Aggregation: view.LastValue(),
 		TagKeys:     []tag.Key{syncerstats.ProxyNameKey, resourceNameKey},
 	}
+
+	proxyTranslationTime = stats.Float64("api.gloo.solo.io/translator/proxy_translation_time_sec", "How long the translation of a proxy takes in seconds", "s")
+
+	proxyTranslationTimeView = &view.View{
+		Name:        "api.gloo.solo.io/translator/proxy_translation_time_sec",
+		Measure:     proxyTranslationTime,
+		Description: "How long the translation of a proxy takes in seconds",
+		Aggregation: view.Distribution(0.01, 0.05, 0.1, 0.25, 0.5, 1, 5, 10, 60),
+		TagKeys:     []tag.Key{syncerstats.ProxyNameKey},
+	}
 )
 
 func init() {
-	_ = view.Register(envoySnapshotOutView)
+	_ = view.Register(envoySnapshotOutView, proxyTranslationTimeView)
 }
 
 // empty resources to give to envoy when a proxy was deleted
@@ -67,6 +79,10 @@ var (
 		emptyResource,
 		emptyResource,
 		emptyResource,
+		emptyResource,
+		emptyResource,
+		emptyResource,
+		emptyResource,
 	)
 )
 
@@ -153,27 +169,24 @@ func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1snap.ApiSnapsh
 		allReports.Accept(snap.UpstreamGroups.AsInputResources()...)
 	}
 
-	// sync non-kube gw proxies
-	for _, proxy := range nonKubeProxies {
-		proxyCtx := ctx
-		metaKey := xds.SnapshotCacheKey(proxy)
-		if ctxWithTags, err := tag.New(proxyCtx, tag.Insert(syncerstats.ProxyNameKey, metaKey)); err == nil {
-			proxyCtx = ctxWithTags
-		}
-
-		params := plugins.Params{
-			Ctx:      proxyCtx,
-			Settings: s.settings,
-			Snapshot: snap,
-			Messages: map[*core.ResourceRef][]string{},
-		}
+	// translate non-kube gw proxies concurrently; the translator bounds how many are translated at a time.
+	// The translation of each proxy defaults the namespaces of the upstream group destinations, so do it once
+	// beforehand, so that the translations only read the snapshot.
+	translator.DefaultUpstreamGroupNamespaces(snap.UpstreamGroups)
+	translations := make([]proxyTranslation, len(nonKubeProxies))
+	var wg sync.WaitGroup
+	for i, proxy := range nonKubeProxies {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			translations[i] = s.translateProxy(ctx, snap, proxy)
+		}()
+	}
+	wg.Wait()
 
-		xdsSnapshot, reports, _ := s.translator.Translate(params, proxy)
-
-		// Messages are aggregated during translation, and need to be added to reports
-		for _, messages := range params.Messages {
-			reports.AddMessages(proxy, messages...)
-		}
+	// sanitize and merge reports in the order of the proxies, so that the results are deterministic
+	for i, proxy := range nonKubeProxies {
+		proxyCtx, xdsSnapshot, reports := translations[i].ctx, translations[i].xdsSnapshot, translations[i].reports
 
 		if validateErr := reports.ValidateStrict(); validateErr != nil {
 			logger.Warnw("Proxy had invalid config", zap.Any("proxy", proxy.GetMetadata().Ref()), zap.Error(validateErr))
@@ -212,9 +225,65 @@ func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1snap.ApiSnapsh
 		logger.Debugf("Full snapshot for proxy %v: %+v", proxy.GetMetadata().GetName(), xdsSnapshot)
 	}
 
+	// keep the snapshots of this sync in the history, to diff them with the ones of other syncs
+	s.snapshotHistory.RecordSnapshots(snap)
+
+	if s.canary != nil {
+		snapshots := make(map[string]envoycache.Snapshot, len(nonKubeProxies))
+		for i, proxy := range nonKubeProxies {
+			snapshots[xds.SnapshotCacheKey(proxy)] = translations[i].xdsSnapshot
+		}
+		s.canary.enqueue(&canaryInput{
+			snap:      snap,
+			proxies:   nonKubeProxies,
+			snapshots: snapshots,
+		})
+	}
+
 	logger.Debugf("gloo reports to be written: %v", allReports)
 }
 
+// proxyTranslation is the result of the translation of a Proxy, before sanitization
+type proxyTranslation struct {
+	// ctx is tagged with the name of the proxy, to record metrics for it
+	ctx         context.Context
+	xdsSnapshot envoycache.Snapshot
+	reports     reporter.ResourceReports
+}
+
+// translateProxy translates a Proxy into an xDS snapshot, and records how long it took.
+// It is called concurrently for all the Proxies of the snapshot.
+func (s *translatorSyncer) translateProxy(ctx context.Context, snap *v1snap.ApiSnapshot, proxy *v1.Proxy) proxyTranslation {
+	proxyCtx := ctx
+	metaKey := xds.SnapshotCacheKey(proxy)
+	if ctxWithTags, err := tag.New(proxyCtx, tag.Insert(syncerstats.ProxyNameKey, metaKey)); err == nil {
+		proxyCtx = ctxWithTags
+	}
+
+	params := plugins.Params{
+		Ctx:      proxyCtx,
+		Settings: s.settings,
+		Snapshot: snap,
+		Messages: map[*core.ResourceRef][]string{},
+	}
+
+	stopwatch := statsutils.NewStopWatch(proxyTranslationTime)
+	stopwatch.Start()
+	xdsSnapshot, reports, _ := s.translator.Translate(params, proxy)
+	stopwatch.Stop(proxyCtx)
+
+	// Messages are aggregated during translation, and need to be added to reports
+	for _, messages := range params.Messages {
+		reports.AddMessages(proxy, messages...)
+	}
+
+	return proxyTranslation{
+		ctx:         proxyCtx,
+		xdsSnapshot: xdsSnapshot,
+		reports:     reports,
+	}
+}
+
 // ServeXdsSnapshots exposes Gloo configuration as an API when `devMode` in Settings is True.
 // Deprecated: https://github.com/solo-io/gloo/issues/6494
 // Prefer to use the iosnapshot.History and pkg/servers/admin
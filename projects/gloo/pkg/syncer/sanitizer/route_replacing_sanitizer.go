@@ -195,7 +195,9 @@ func (s *RouteReplacingSanitizer) SanitizeSnapshot(
 	proxyReports := reports.FilterByKind("*v1.Proxy")
 	erroredRouteNames := s.removeErroredRoutesFromReport(proxyReports, reports)
 
-	replacedRouteConfigs, needsListener := s.replaceRoutes(ctx, validClusters, routeConfigs, erroredRouteNames)
+	virtualHosts := getVirtualHosts(ctx, xdsSnapshot)
+
+	replacedRouteConfigs, replacedVirtualHosts, needsListener := s.replaceRoutes(ctx, validClusters, routeConfigs, virtualHosts, erroredRouteNames)
 
 	clusters := xdsSnapshot.GetResources(types.ClusterTypeV3)
 	listeners := xdsSnapshot.GetResources(types.ListenerTypeV3)
@@ -210,6 +212,10 @@ func (s *RouteReplacingSanitizer) SanitizeSnapshot(
 		clusters,
 		translator.MakeRdsResources(replacedRouteConfigs),
 		listeners,
+		xdsSnapshot.GetResources(xds.ScopedRouteTypeV3),
+		translator.MakeVhdsResources(replacedVirtualHosts),
+		xdsSnapshot.GetResources(xds.ExtensionConfigTypeV3),
+		xdsSnapshot.GetResources(xds.SecretTypeV3),
 	)
 
 	return newXdsSnapshot
@@ -237,6 +243,29 @@ func getRoutes(ctx context.Context, snap envoycache.Snapshot) []*envoy_config_ro
 	return routeConfigs
 }
 
+// getVirtualHosts returns the virtual hosts that are served by VHDS, rather than in their route config
+func getVirtualHosts(ctx context.Context, snap envoycache.Snapshot) []*envoy_config_route_v3.VirtualHost {
+	virtualHostProtos := snap.GetResources(xds.VirtualHostTypeV3)
+	var virtualHosts []*envoy_config_route_v3.VirtualHost
+
+	for _, virtualHostProto := range virtualHostProtos.Items {
+		virtualHost, ok := virtualHostProto.ResourceProto().(*envoy_config_route_v3.VirtualHost)
+		if !ok {
+			// should never happen
+			contextutils.LoggerFrom(ctx).DPanicf("error: xds snapshot resources of type VirtualHostTypeV3 were not "+
+				"converted to *envoy_config_route_v3.VirtualHost, instead found %T", virtualHostProto.ResourceProto())
+			return nil
+		}
+		virtualHosts = append(virtualHosts, virtualHost)
+	}
+
+	sort.SliceStable(virtualHosts, func(i, j int) bool {
+		return virtualHosts[i].GetName() < virtualHosts[j].GetName()
+	})
+
+	return virtualHosts
+}
+
 func getClusters(glooSnapshot *v1snap.ApiSnapshot, xdsSnapshot envoycache.Snapshot) map[string]struct{} {
 	// mark all valid destination clusters, i.e. those that are in both the gloo snapshot and xds snapshot
 	validClusters := make(map[string]struct{})
@@ -267,8 +296,9 @@ func (s *RouteReplacingSanitizer) replaceRoutes(
 	ctx context.Context,
 	validClusters map[string]struct{},
 	routeConfigs []*envoy_config_route_v3.RouteConfiguration,
+	virtualHosts []*envoy_config_route_v3.VirtualHost,
 	erroredRoutes map[string]struct{},
-) ([]*envoy_config_route_v3.RouteConfiguration, bool) {
+) ([]*envoy_config_route_v3.RouteConfiguration, []*envoy_config_route_v3.VirtualHost, bool) {
 	var sanitizedRouteConfigs []*envoy_config_route_v3.RouteConfiguration
 
 	isInvalid := func(cluster string, name string) bool {
@@ -277,51 +307,73 @@ func (s *RouteReplacingSanitizer) replaceRoutes(
 		return !valid || errored
 	}
 
-	debugW := contextutils.LoggerFrom(ctx).Debugw
-
 	var anyRoutesReplaced bool
 
+	// the virtual hosts served by VHDS count towards the routes replaced in their route config
+	replacedByRouteConfig := make(map[string]int64)
+	for _, vh := range virtualHosts {
+		routeConfigName, _, _ := xds.SplitVirtualHostName(vh.GetName())
+		replaced := s.replaceVirtualHostRoutes(ctx, vh, isInvalid)
+		replacedByRouteConfig[routeConfigName] += replaced
+		anyRoutesReplaced = anyRoutesReplaced || replaced > 0
+	}
+
 	// replace any routes which do not point to a valid destination cluster
 	for _, cfg := range routeConfigs {
-		var replaced int64
+		replaced := replacedByRouteConfig[cfg.GetName()]
 
-		for i, vh := range cfg.GetVirtualHosts() {
-			for j, route := range vh.GetRoutes() {
-				routeAction := route.GetRoute()
-				if routeAction == nil {
-					continue
-				}
-				switch action := routeAction.GetClusterSpecifier().(type) {
-				case *envoy_config_route_v3.RouteAction_Cluster:
-					if isInvalid(action.Cluster, route.GetName()) {
-						debugW("replacing route in virtual host with invalid cluster",
-							zap.Any("cluster", action.Cluster), zap.Any("route", j), zap.Any("virtualhost", i))
-						action.Cluster = s.fallbackCluster.GetName()
-						replaced++
-						anyRoutesReplaced = true
-					}
-				case *envoy_config_route_v3.RouteAction_WeightedClusters:
-					for _, weightedCluster := range action.WeightedClusters.GetClusters() {
-						if isInvalid(weightedCluster.GetName(), route.GetName()) {
-							debugW("replacing route in virtual host with invalid weighted cluster",
-								zap.Any("cluster", weightedCluster.GetName()), zap.Any("route", j), zap.Any("virtualhost", i))
-
-							weightedCluster.Name = s.fallbackCluster.GetName()
-							replaced++
-							anyRoutesReplaced = true
-						}
-					}
-				default:
-					continue
-				}
-			}
+		for _, vh := range cfg.GetVirtualHosts() {
+			vhReplaced := s.replaceVirtualHostRoutes(ctx, vh, isInvalid)
+			replaced += vhReplaced
+			anyRoutesReplaced = anyRoutesReplaced || vhReplaced > 0
 		}
 
 		statsutils.Measure(ctx, mRoutesReplaced, replaced, tag.Insert(routeConfigKey, cfg.GetName()))
 		sanitizedRouteConfigs = append(sanitizedRouteConfigs, cfg)
 	}
 
-	return sanitizedRouteConfigs, anyRoutesReplaced
+	return sanitizedRouteConfigs, virtualHosts, anyRoutesReplaced
+}
+
+// replaceVirtualHostRoutes points the routes of the virtual host which do not point to a valid destination
+// cluster to the fallback cluster, and returns the number of replaced destinations
+func (s *RouteReplacingSanitizer) replaceVirtualHostRoutes(
+	ctx context.Context,
+	vh *envoy_config_route_v3.VirtualHost,
+	isInvalid func(cluster string, name string) bool,
+) int64 {
+	debugW := contextutils.LoggerFrom(ctx).Debugw
+
+	var replaced int64
+	for j, route := range vh.GetRoutes() {
+		routeAction := route.GetRoute()
+		if routeAction == nil {
+			continue
+		}
+		switch action := routeAction.GetClusterSpecifier().(type) {
+		case *envoy_config_route_v3.RouteAction_Cluster:
+			if isInvalid(action.Cluster, route.GetName()) {
+				debugW("replacing route in virtual host with invalid cluster",
+					zap.Any("cluster", action.Cluster), zap.Any("route", j), zap.Any("virtualhost", vh.GetName()))
+				action.Cluster = s.fallbackCluster.GetName()
+				replaced++
+			}
+		case *envoy_config_route_v3.RouteAction_WeightedClusters:
+			for _, weightedCluster := range action.WeightedClusters.GetClusters() {
+				if isInvalid(weightedCluster.GetName(), route.GetName()) {
+					debugW("replacing route in virtual host with invalid weighted cluster",
+						zap.Any("cluster", weightedCluster.GetName()), zap.Any("route", j), zap.Any("virtualhost", vh.GetName()))
+
+					weightedCluster.Name = s.fallbackCluster.GetName()
+					replaced++
+				}
+			}
+		default:
+			continue
+		}
+	}
+
+	return replaced
 }
 
 func (s *RouteReplacingSanitizer) removeErroredRoutesFromReport(
@@ -233,6 +233,10 @@ var _ = Describe("RouteReplacingSanitizer", func() {
 			envoycache.NewResources("listeners", []envoycache.Resource{
 				resource.NewEnvoyResource(listener),
 			}),
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
 		)
 
 		sanitizer, err := NewRouteReplacingSanitizer(invalidCfgPolicy)
@@ -263,6 +267,72 @@ var _ = Describe("RouteReplacingSanitizer", func() {
 		Expect(listenersWithFallback.ResourceProto()).To(Equal(sanitizer.fallbackListener))
 		Expect(clustersWithFallback.ResourceProto()).To(Equal(sanitizer.fallbackCluster))
 	})
+	It("replaces routes of virtual hosts served by VHDS which point to a missing cluster", func() {
+		routeToCluster := func(cluster string) *envoy_config_route_v3.Route {
+			return &envoy_config_route_v3.Route{
+				Action: &envoy_config_route_v3.Route_Route{
+					Route: &envoy_config_route_v3.RouteAction{
+						ClusterSpecifier: &envoy_config_route_v3.RouteAction_Cluster{
+							Cluster: cluster,
+						},
+					},
+				},
+			}
+		}
+		routeCfg := &envoy_config_route_v3.RouteConfiguration{
+			Name: routeCfgName,
+			Vhds: &envoy_config_route_v3.Vhds{},
+		}
+		virtualHost := &envoy_config_route_v3.VirtualHost{
+			Name: xds.VirtualHostName(routeCfgName, "vhost"),
+			Routes: []*envoy_config_route_v3.Route{
+				routeToCluster(clusterName),
+				routeToCluster(missingCluster),
+			},
+		}
+		expectedVirtualHost := &envoy_config_route_v3.VirtualHost{
+			Name: xds.VirtualHostName(routeCfgName, "vhost"),
+			Routes: []*envoy_config_route_v3.Route{
+				routeToCluster(clusterName),
+				routeToCluster(fallbackClusterName),
+			},
+		}
+
+		xdsSnapshot := xds.NewSnapshotFromResources(
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("clusters", []envoycache.Resource{
+				resource.NewEnvoyResource(cluster),
+			}),
+			envoycache.NewResources("routes", []envoycache.Resource{
+				resource.NewEnvoyResource(routeCfg),
+			}),
+			envoycache.NewResources("listeners", []envoycache.Resource{
+				resource.NewEnvoyResource(listener),
+			}),
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("virtual-hosts", []envoycache.Resource{
+				xds.NewVirtualHostResource(virtualHost),
+			}),
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
+		)
+
+		sanitizer, err := NewRouteReplacingSanitizer(invalidCfgPolicy)
+		Expect(err).NotTo(HaveOccurred())
+
+		glooSnapshot := &v1snap.ApiSnapshot{
+			Upstreams: v1.UpstreamList{us},
+		}
+
+		snap := sanitizer.SanitizeSnapshot(context.TODO(), glooSnapshot, xdsSnapshot, reporter.ResourceReports{})
+
+		// the route config without virtual hosts is kept
+		Expect(snap.GetResources(types.RouteTypeV3).Items[routeCfgName].ResourceProto()).To(matchers.MatchProto(routeCfg))
+		sanitizedVirtualHost := snap.GetResources(xds.VirtualHostTypeV3).Items[virtualHost.GetName()]
+		Expect(sanitizedVirtualHost.ResourceProto()).To(matchers.MatchProto(expectedVirtualHost))
+		Expect(snap.GetResources(types.ListenerTypeV3).Items).To(HaveKey(fallbackListenerName))
+		Expect(snap.GetResources(types.ClusterTypeV3).Items).To(HaveKey(fallbackClusterName))
+	})
 	It("replaces routes that have errored", func() {
 		var multiErr *multierror.Error
 		baseError := eris.Errorf("abc. Reason: plugin. %s: %s", validationutils.RouteIdentifierTxt, erroredRouteName)
@@ -307,6 +377,10 @@ var _ = Describe("RouteReplacingSanitizer", func() {
 			envoycache.NewResources("listeners", []envoycache.Resource{
 				resource.NewEnvoyResource(listener),
 			}),
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
 		)
 
 		sanitizer, err := NewRouteReplacingSanitizer(invalidCfgPolicy)
@@ -89,6 +89,10 @@ func (s *UpstreamRemovingSanitizer) SanitizeSnapshot(
 		clusters,
 		xdsSnapshot.GetResources(types.RouteTypeV3),
 		xdsSnapshot.GetResources(types.ListenerTypeV3),
+		xdsSnapshot.GetResources(xds.ScopedRouteTypeV3),
+		xdsSnapshot.GetResources(xds.VirtualHostTypeV3),
+		xdsSnapshot.GetResources(xds.ExtensionConfigTypeV3),
+		xdsSnapshot.GetResources(xds.SecretTypeV3),
 	)
 
 	// Convert errors related to upstreams to warnings
@@ -76,6 +76,10 @@ var _ = Describe("UpstreamRemovingSanitizer", func() {
 			}),
 			envoycache.NewResources("", nil),
 			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
 		)
 		sanitizer := NewUpstreamRemovingSanitizer()
 
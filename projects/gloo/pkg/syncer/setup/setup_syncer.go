@@ -6,6 +6,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"runtime"
 	"slices"
 	"sort"
 	"strconv"
@@ -18,6 +19,8 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/servers/iosnapshot"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+	"sigs.k8s.io/yaml"
 
 	"github.com/golang/protobuf/ptypes/duration"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -78,6 +81,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	consulplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/consul"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/registry"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm"
 	"github.com/solo-io/gloo/projects/gloo/pkg/syncer"
 	extauthExt "github.com/solo-io/gloo/projects/gloo/pkg/syncer/extauth"
 	ratelimitExt "github.com/solo-io/gloo/projects/gloo/pkg/syncer/ratelimit"
@@ -86,6 +90,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/nomad"
 	"github.com/solo-io/gloo/projects/gloo/pkg/validation"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 )
@@ -170,6 +175,8 @@ type setupSyncer struct {
 
 func NewControlPlane(ctx context.Context, snapshotCache cache.SnapshotCache, grpcServer *grpc.Server, bindAddr net.Addr, kubeControlPlaneCfg bootstrap.KubernetesControlPlaneConfig,
 	callbacks xdsserver.Callbacks, start bool) bootstrap.ControlPlane {
+	xdsStatuses := xds.NewAckTracker()
+	callbacks = multiCallbacks(callbacks, xdsStatuses)
 	xdsServer := server.NewServer(ctx, snapshotCache, callbacks)
 	reflection.Register(grpcServer)
 
@@ -180,9 +187,11 @@ func NewControlPlane(ctx context.Context, snapshotCache cache.SnapshotCache, grp
 			BindAddr:        bindAddr,
 			Ctx:             ctx,
 		},
-		SnapshotCache: snapshotCache,
-		XDSServer:     xdsServer,
-		Kube:          kubeControlPlaneCfg,
+		SnapshotCache:  snapshotCache,
+		XDSServer:      xdsServer,
+		DeltaXDSServer: xds.NewDeltaServer(ctx, snapshotCache, callbacks),
+		XdsStatuses:    xdsStatuses,
+		Kube:           kubeControlPlaneCfg,
 	}
 }
 
@@ -396,7 +405,15 @@ func (s *setupSyncer) Setup(ctx context.Context, kubeCache kube.SharedCache, mem
 		if s.extensions != nil {
 			callbacks = s.extensions.XdsCallbacks
 		}
-		s.controlPlane = NewControlPlane(ctx, s.setupOpts.Cache, s.makeGrpcServer(ctx), xdsTcpAddress,
+		// the xDS server requires mTLS if it is enabled by the settings
+		xdsGrpcServerOpts, err := xds.MTLSServerOptionsFromSettings(ctx, settings.GetGloo().GetXdsTls())
+		if err != nil {
+			cancel()
+			return errors.Wrapf(err, "configuring xds mTLS")
+		}
+		// the cache is warm-started from the persisted snapshots if persistence is enabled by the settings
+		snapshotCache := xds.NewPersistentSnapshotCacheFromSettings(ctx, s.setupOpts.Cache, settings.GetGloo().GetXdsSnapshotPersistence())
+		s.controlPlane = NewControlPlane(ctx, snapshotCache, s.makeGrpcServer(ctx, xdsGrpcServerOpts...), xdsTcpAddress,
 			bootstrap.KubernetesControlPlaneConfig{XdsHost: xdsHost, XdsPort: xdsPort}, multiCallbacks(s.setupOpts.ExtraCallbacks, callbacks), true)
 
 		s.setupOpts.SetXdsAddress(xdsHost, xdsPort)
@@ -466,6 +483,15 @@ func (s *setupSyncer) Setup(ctx context.Context, kubeCache kube.SharedCache, mem
 		opts.Consul.ConsulWatcher = consulClientWrapper
 	}
 
+	// if nomad service discovery specified, initialize nomad watcher
+	if nomadSettings := settings.GetNomad(); nomadSettings != nil {
+		nomadClient, err := bootstrap_clients.NomadClientForSettings(nomadSettings)
+		if err != nil {
+			return err
+		}
+		opts.Nomad.NomadWatcher = nomad.NewNomadWatcher(nomadClient, nomadSettings.GetNamespaces(), nomadSettings.GetDataCenters(), nomadSettings.GetServiceTagsAllowlist(), nomadSettings.GetWaitTime().AsDuration())
+	}
+
 	err = s.runFunc(opts)
 
 	s.validationServer.StartGrpcServer = opts.ValidationServer.StartGrpcServer
@@ -475,14 +501,29 @@ func (s *setupSyncer) Setup(ctx context.Context, kubeCache kube.SharedCache, mem
 }
 
 func RunGloo(opts bootstrap.Opts) error {
+	pluginOpts := registry.FromBootstrap(opts)
+	apiEmitterChannel := make(chan struct{})
+	// the image cache serves the modules of WASM filters until the Settings change, like the admin server.
+	// Images are pulled in the background, and the proxies are translated again once a pull completes.
+	wasmImageCache, err := wasm.ImageCacheFromSettings(opts.WatchOpts.Ctx, opts.Settings.GetGloo().GetWasmImages(), func() {
+		select {
+		case apiEmitterChannel <- struct{}{}:
+		case <-opts.WatchOpts.Ctx.Done():
+		}
+	})
+	if err != nil {
+		return err
+	}
+	pluginOpts.WasmImageCache = wasmImageCache
+
 	glooExtensions := Extensions{
 		//K8sGatewayExtensionsFactory: extensions.NewK8sGatewayExtensions,
-		PluginRegistryFactory: registry.GetPluginRegistryFactory(registry.FromBootstrap(opts)),
+		PluginRegistryFactory: registry.GetPluginRegistryFactory(pluginOpts),
 		SyncerExtensions: []syncer.TranslatorSyncerExtensionFactory{
 			ratelimitExt.NewTranslatorSyncerExtension,
 			extauthExt.NewTranslatorSyncerExtension,
 		},
-		ApiEmitterChannel:      make(chan struct{}),
+		ApiEmitterChannel:      apiEmitterChannel,
 		XdsCallbacks:           nil,
 		SnapshotHistoryFactory: iosnapshot.GetHistoryFactory(),
 	}
@@ -522,7 +563,7 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 	if opts.Settings.GetGloo().GetDisableKubernetesDestinations() {
 		kubeServiceClient = nil
 	}
-	hybridUsClient, err := upstreams.NewHybridUpstreamClient(upstreamClient, kubeServiceClient, opts.Consul.ConsulWatcher, opts.Settings)
+	hybridUsClient, err := upstreams.NewHybridUpstreamClient(upstreamClient, kubeServiceClient, opts.Consul.ConsulWatcher, opts.Nomad.NomadWatcher, opts.Settings)
 	if err != nil {
 		return err
 	}
@@ -649,7 +690,7 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 	statusClient := gloostatusutils.GetStatusClientForNamespace(opts.StatusReporterNamespace)
 
 	// Register grpc endpoints to the grpc server
-	xds.SetupEnvoyXds(opts.ControlPlane.GrpcServer, opts.ControlPlane.XDSServer, opts.ControlPlane.SnapshotCache)
+	xds.SetupEnvoyXds(opts.ControlPlane.GrpcServer, opts.ControlPlane.XDSServer, opts.ControlPlane.DeltaXDSServer, opts.ControlPlane.SnapshotCache)
 
 	pluginRegistry := extensions.PluginRegistryFactory(watchOpts.Ctx)
 	var discoveryPlugins []discovery.DiscoveryPlugin
@@ -797,8 +838,8 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 	}
 
 	// MARK: build gloo translator
-	sharedTranslator := TranslatorFactory{PluginRegistry: extensions.PluginRegistryFactory}.NewTranslator(watchOpts.Ctx,
-		opts.Settings)
+	sharedTranslator := TranslatorFactory{PluginRegistry: extensions.PluginRegistryFactory}.NewTranslatorPool(watchOpts.Ctx,
+		opts.Settings, translationConcurrency(opts.Settings))
 	routeReplacingSanitizer, err := sanitizer.NewRouteReplacingSanitizer(opts.Settings.GetGloo().GetInvalidConfigPolicy())
 	if err != nil {
 		return err
@@ -903,7 +944,7 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 		EnableK8sGatewayIntegration: opts.GlooGateway.EnableK8sGatewayController,
 	})
 
-	startFuncs["admin-server"] = AdminServerStartFunc(snapshotHistory, opts.KrtDebugger)
+	startFuncs["admin-server"] = AdminServerStartFunc(snapshotHistory, opts.ControlPlane.XdsStatuses, opts.KrtDebugger)
 
 	if opts.ProxyReconcileQueue != nil {
 		go runQueue(watchOpts.Ctx, opts.ProxyReconcileQueue, opts.WriteNamespace, proxyClient)
@@ -928,6 +969,8 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 		opts.WriteNamespace,
 		opts.Identity,
 		snapshotHistory,
+		opts.ControlPlane.XdsStatuses,
+		canaryTranslation(watchOpts.Ctx, opts.Settings.GetGloo().GetCanarySettingsFile(), extensions),
 	)
 
 	// MARK: build & run api snap loop
@@ -1085,6 +1128,49 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 	return nil
 }
 
+// translationConcurrency returns the maximum number of edge Proxies to translate concurrently
+func translationConcurrency(settings *v1.Settings) int {
+	if concurrency := settings.GetGloo().GetTranslationConcurrency(); concurrency > 0 {
+		return int(concurrency)
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
+// canaryTranslation returns the candidate translator configured with the Settings of settingsFile, or nil if canary
+// translation is not enabled. The candidate translator translates one proxy at a time, since it doesn't delay the syncs.
+func canaryTranslation(ctx context.Context, settingsFile string, extensions Extensions) *syncer.CanaryTranslation {
+	if settingsFile == "" {
+		return nil
+	}
+	canarySettings, err := readSettingsFile(settingsFile)
+	if err != nil {
+		contextutils.LoggerFrom(ctx).Errorf("canary translation is disabled: invalid canary settings file %s: %v", settingsFile, err)
+		return nil
+	}
+	contextutils.LoggerFrom(ctx).Infof("shadow-translating proxies with the Settings of %s", settingsFile)
+	return &syncer.CanaryTranslation{
+		Translator: TranslatorFactory{PluginRegistry: extensions.PluginRegistryFactory}.NewTranslator(ctx, canarySettings),
+		Settings:   canarySettings,
+	}
+}
+
+// readSettingsFile reads a Settings manifest, in YAML or JSON
+func readSettingsFile(path string) (*v1.Settings, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	jsn, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	settings := &v1.Settings{}
+	if err := protoutils.UnmarshalResource(jsn, settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
 func startRestXdsServer(opts bootstrap.Opts) {
 	restClient := server.NewHTTPGateway(
 		contextutils.LoggerFrom(opts.WatchOpts.Ctx),
@@ -22,6 +22,16 @@ func (tf TranslatorFactory) NewTranslator(ctx context.Context, settings *v1.Sett
 	)
 }
 
+// NewTranslatorPool returns a Translator that translates up to concurrency proxies at a time.
+// Each translator of the pool has its own PluginRegistry, since plugins are stateful.
+func (tf TranslatorFactory) NewTranslatorPool(ctx context.Context, settings *v1.Settings, concurrency int) translator.Translator {
+	translators := make([]translator.Translator, 0, concurrency)
+	for i := 0; i < max(concurrency, 1); i++ {
+		translators = append(translators, tf.NewTranslator(ctx, settings))
+	}
+	return translator.NewTranslatorPool(translators...)
+}
+
 func (tf TranslatorFactory) NewClusterTranslator(ctx context.Context, settings *v1.Settings) translator.ClusterTranslator {
 	return translator.NewTranslatorWithHasher(
 		sslutils.NewSslConfigTranslator(),
@@ -2,12 +2,14 @@ package syncer
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
 	"github.com/solo-io/gloo/pkg/utils/statsutils/metrics"
 	"github.com/solo-io/gloo/projects/gloo/pkg/servers/iosnapshot"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/rotisserie/eris"
@@ -47,6 +49,9 @@ type translatorSyncer struct {
 	// The syncer updates the History each time it runs, and the History is then used by the Admin Server
 	snapshotHistory iosnapshot.History
 
+	// canary shadow-translates the proxies with a candidate translator, if one is configured
+	canary *canaryTranslator
+
 	statusSyncer *statusSyncer
 }
 
@@ -60,6 +65,9 @@ type statusSyncer struct {
 	leaderStartupAction *leaderelector.LeaderStartupAction
 	reportsLock         sync.RWMutex
 	latestReports       reporter.ResourceReports
+
+	// xdsStatuses is used to warn on the Proxies whose xDS resources envoy rejected
+	xdsStatuses *xds.AckTracker
 }
 
 func NewTranslatorSyncer(
@@ -77,6 +85,8 @@ func NewTranslatorSyncer(
 	writeNamespace string,
 	identity leaderelector.Identity,
 	snapshotHistory iosnapshot.History,
+	xdsStatuses *xds.AckTracker,
+	canary *CanaryTranslation,
 ) v1snap.ApiSyncer {
 	s := &translatorSyncer{
 		translator:       translator,
@@ -95,15 +105,23 @@ func NewTranslatorSyncer(
 			identity:            identity,
 			leaderStartupAction: leaderelector.NewLeaderStartupAction(identity),
 			reportsLock:         sync.RWMutex{},
+			xdsStatuses:         xdsStatuses,
 		},
 		snapshotHistory: snapshotHistory,
 	}
+	if canary != nil {
+		s.canary = newCanaryTranslator(ctx, canary, snapshotHistory)
+	}
 	if devMode {
 		// TODO(ilackarms): move this somewhere else?
 		go func() {
 			_ = s.ContextuallyServeXdsSnapshots(ctx)
 		}()
 	}
+	// write the statuses again when envoy rejects or accepts the resources of a proxy
+	xdsStatuses.OnRejectionsChanged(func(string) {
+		s.statusSyncer.forceSync()
+	})
 	go s.statusSyncer.syncStatusOnEmit(ctx)
 	s.statusSyncer.leaderStartupAction.WatchElectionResults(ctx)
 	return s
@@ -220,6 +238,29 @@ func (s *statusSyncer) forceSync() {
 	s.syncNeeded <- struct{}{}
 }
 
+// addXdsRejectionWarnings adds a warning to the report of each Proxy for each type of xDS resources its envoys rejected.
+func (s *statusSyncer) addXdsRejectionWarnings(reports reporter.ResourceReports) {
+	for resource, report := range reports {
+		proxy, ok := resource.(*v1.Proxy)
+		if !ok {
+			continue
+		}
+		rejections := s.xdsStatuses.Rejections(xds.SnapshotCacheKey(proxy))
+		if len(rejections) == 0 {
+			continue
+		}
+		// copy the warnings, the report is shared with latestReports
+		warnings := make([]string, 0, len(report.Warnings)+len(rejections))
+		warnings = append(warnings, report.Warnings...)
+		for _, rejection := range rejections {
+			warnings = append(warnings, fmt.Sprintf("envoy rejected the %s resources (version %q): %s",
+				rejection.TypeUrl, rejection.RejectedVersion, rejection.Error))
+		}
+		report.Warnings = warnings
+		reports[resource] = report
+	}
+}
+
 func (s *statusSyncer) syncStatus(ctx context.Context) error {
 	s.reportsLock.RLock()
 	// deep copy the reports so we can release the lock
@@ -228,6 +269,7 @@ func (s *statusSyncer) syncStatus(ctx context.Context) error {
 		reports[k] = v
 	}
 	s.reportsLock.RUnlock()
+	s.addXdsRejectionWarnings(reports)
 
 	if len(reports) == 0 {
 		return nil
@@ -3,6 +3,9 @@ package syncer_test
 import (
 	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/solo-io/gloo/pkg/utils/statsutils/metrics"
 	"github.com/solo-io/gloo/projects/gloo/pkg/servers/iosnapshot"
@@ -11,6 +14,9 @@ import (
 
 	gloo_translator "github.com/solo-io/gloo/projects/gloo/pkg/translator"
 
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/solo-io/gloo/pkg/utils/statusutils"
@@ -18,15 +24,23 @@ import (
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/registry"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/syncer"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
 	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/types"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 	"github.com/solo-io/solo-kit/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 var _ = Describe("Translate Proxy", func() {
@@ -82,7 +96,7 @@ var _ = Describe("Translate Proxy", func() {
 			Settings: settings,
 			Cache:    xdsCache,
 		})
-		syncer = NewTranslatorSyncer(ctx, &mockTranslator{true, false, nil}, xdsCache, sanitizer, rep, false, nil, settings, statusMetrics, nil, proxyClient, "", singlereplica.Identity(), history)
+		syncer = NewTranslatorSyncer(ctx, &mockTranslator{true, false, nil}, xdsCache, sanitizer, rep, false, nil, settings, statusMetrics, nil, proxyClient, "", singlereplica.Identity(), history, nil, nil)
 		snap = &v1snap.ApiSnapshot{
 			Proxies: v1.ProxyList{
 				proxy,
@@ -124,7 +138,7 @@ var _ = Describe("Translate Proxy", func() {
 			Settings: settings,
 			Cache:    xdsCache,
 		})
-		syncer = NewTranslatorSyncer(ctx, &mockTranslator{false, false, nil}, xdsCache, sanitizer, rep, false, nil, settings, statusMetrics, nil, proxyClient, "", singlereplica.Identity(), history)
+		syncer = NewTranslatorSyncer(ctx, &mockTranslator{false, false, nil}, xdsCache, sanitizer, rep, false, nil, settings, statusMetrics, nil, proxyClient, "", singlereplica.Identity(), history, nil, nil)
 
 		err = syncer.Sync(context.Background(), snap)
 		Expect(err).NotTo(HaveOccurred())
@@ -160,6 +174,117 @@ var _ = Describe("Translate Proxy", func() {
 		Expect(sanitizer.Called).To(BeTrue())
 		Expect(xdsCache.SetSnap).To(BeEquivalentTo(sanitizer.Snap))
 	})
+
+	It("warns on the proxy when envoy rejects its resources", func() {
+		xdsStatuses := xds.NewAckTracker()
+		rep := reporter.NewReporter(ref, statusClient, proxyClient.BaseClient(), upstreamClient)
+		history := iosnapshot.GetHistoryFactory()(iosnapshot.HistoryFactoryParameters{
+			Settings: settings,
+			Cache:    xdsCache,
+		})
+		syncer = NewTranslatorSyncer(ctx, &mockTranslator{false, false, nil}, xdsCache, sanitizer, rep, false, nil, settings, statusMetrics, nil, proxyClient, "", singlereplica.Identity(), history, xdsStatuses, nil)
+		err := syncer.Sync(context.Background(), snap)
+		Expect(err).NotTo(HaveOccurred())
+
+		// envoy nacks the clusters of the proxy
+		node := &envoy_config_core_v3.Node{
+			Metadata: &structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					xds.RoleKey: structpb.NewStringValue(xds.SnapshotCacheKey(snap.Proxies[0])),
+				},
+			},
+		}
+		err = xdsStatuses.OnStreamRequest(1, &envoy_service_discovery_v3.DiscoveryRequest{
+			Node:          node,
+			TypeUrl:       types.ClusterTypeV3,
+			ResponseNonce: "1",
+			ErrorDetail:   &status.Status{Message: "cluster foo: invalid"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(func(g Gomega) {
+			proxies, err := proxyClient.List(ns, clients.ListOpts{})
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(proxies).To(HaveLen(1))
+			proxyStatus := statusClient.GetStatus(proxies[0])
+			g.Expect(proxyStatus.GetState()).To(Equal(core.Status_Warning))
+			g.Expect(proxyStatus.GetReason()).To(ContainSubstring("cluster foo: invalid"))
+		}, "2s", "0.1s").Should(Succeed())
+
+		// envoy accepts the next version of the clusters
+		err = xdsStatuses.OnStreamRequest(1, &envoy_service_discovery_v3.DiscoveryRequest{
+			Node:          node,
+			TypeUrl:       types.ClusterTypeV3,
+			VersionInfo:   "2",
+			ResponseNonce: "2",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(func(g Gomega) {
+			proxies, err := proxyClient.List(ns, clients.ListOpts{})
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(proxies).To(HaveLen(1))
+			g.Expect(statusClient.GetStatus(proxies[0]).GetState()).To(Equal(core.Status_Accepted))
+		}, "2s", "0.1s").Should(Succeed())
+	})
+
+	It("diffs the snapshots of the canary translator without serving them", func() {
+		clusterSnapshot := func(version string, clusters ...*envoy_config_cluster_v3.Cluster) envoycache.Snapshot {
+			var items []envoycache.Resource
+			for _, cluster := range clusters {
+				items = append(items, resource.NewEnvoyResource(cluster))
+			}
+			return xds.NewSnapshotFromResources(
+				envoycache.NewResources(version, nil),
+				envoycache.NewResources(version, items),
+				envoycache.NewResources(version, nil),
+				envoycache.NewResources(version, nil),
+				envoycache.NewResources(version, nil),
+				envoycache.NewResources(version, nil),
+				envoycache.NewResources(version, nil),
+				envoycache.NewResources(version, nil),
+			)
+		}
+		staticCluster := func(name string, connectTimeoutSeconds int64) *envoy_config_cluster_v3.Cluster {
+			return &envoy_config_cluster_v3.Cluster{
+				Name:                 name,
+				ClusterDiscoveryType: &envoy_config_cluster_v3.Cluster_Type{Type: envoy_config_cluster_v3.Cluster_STATIC},
+				ConnectTimeout:       &durationpb.Duration{Seconds: connectTimeoutSeconds},
+			}
+		}
+		primary := clusterSnapshot("primary", staticCluster("unchanged", 1), staticCluster("changed", 1))
+		candidate := clusterSnapshot("candidate", staticCluster("unchanged", 1), staticCluster("changed", 5), staticCluster("added", 1))
+
+		rep := reporter.NewReporter(ref, statusClient, proxyClient.BaseClient(), upstreamClient)
+		history := iosnapshot.GetHistoryFactory()(iosnapshot.HistoryFactoryParameters{
+			Settings: settings,
+			Cache:    xdsCache,
+		})
+		sanitizer.Snap = primary
+		syncer = NewTranslatorSyncer(ctx, &mockTranslator{false, false, primary}, xdsCache, sanitizer, rep, false, nil, settings, statusMetrics, nil, proxyClient, "", singlereplica.Identity(), history, nil,
+			&CanaryTranslation{
+				Translator: &mockTranslator{false, false, candidate},
+				Settings:   settings,
+			})
+		err := syncer.Sync(context.Background(), snap)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(xdsCache.SetSnap).To(BeIdenticalTo(primary))
+		Eventually(func(g Gomega) {
+			response := history.GetCanaryDiff(ctx)
+			g.Expect(response.Error).NotTo(HaveOccurred())
+			g.Expect(response.Data).To(Equal(map[string]xds.SnapshotDiff{
+				xds.SnapshotCacheKey(snap.Proxies[0]): {
+					types.ClusterTypeV3: {
+						Added: []string{"added"},
+						Changed: map[string][]xds.FieldDiff{
+							"changed": {{Path: "connect_timeout.seconds", From: int64(1), To: int64(5)}},
+						},
+					},
+				},
+			}))
+		}, "2s", "0.1s").Should(Succeed())
+	})
 })
 
 var _ = Describe("Translate multiple proxies with errors", func() {
@@ -180,6 +305,7 @@ var _ = Describe("Translate multiple proxies with errors", func() {
 		ref            = "syncer-test"
 		statusClient   resources.StatusClient
 		statusMetrics  metrics.ConfigStatusMetrics
+		rep            reporter.StatusReporter
 	)
 
 	proxiesShouldHaveErrors := func(proxies v1.ProxyList, numProxies int) {
@@ -256,13 +382,13 @@ var _ = Describe("Translate multiple proxies with errors", func() {
 		statusMetrics, err = metrics.NewConfigStatusMetrics(metrics.GetDefaultConfigStatusOptions())
 		Expect(err).NotTo(HaveOccurred())
 
-		rep := reporter.NewReporter(ref, statusClient, proxyClient.BaseClient(), usClient)
+		rep = reporter.NewReporter(ref, statusClient, proxyClient.BaseClient(), usClient)
 
 		history := iosnapshot.GetHistoryFactory()(iosnapshot.HistoryFactoryParameters{
 			Settings: settings,
 			Cache:    xdsCache,
 		})
-		syncer = NewTranslatorSyncer(ctx, &mockTranslator{true, true, nil}, xdsCache, sanitizer, rep, false, nil, settings, statusMetrics, nil, proxyClient, "", singlereplica.Identity(), history)
+		syncer = NewTranslatorSyncer(ctx, &mockTranslator{true, true, nil}, xdsCache, sanitizer, rep, false, nil, settings, statusMetrics, nil, proxyClient, "", singlereplica.Identity(), history, nil, nil)
 		snap = &v1snap.ApiSnapshot{
 			Proxies: v1.ProxyList{
 				proxy1,
@@ -336,6 +462,76 @@ var _ = Describe("Translate multiple proxies with errors", func() {
 		Expect(xdsCache.Called).To(BeTrue())
 	})
 
+	It("merges the reports of proxies translated concurrently in the order of the proxies", func() {
+		// the first proxy takes longer to translate than the second one
+		translator := &delayedTranslator{
+			Translator: &mockTranslator{true, true, nil},
+			delays: map[string]time.Duration{
+				proxyName + "1": 200 * time.Millisecond,
+				proxyName + "2": 50 * time.Millisecond,
+			},
+		}
+		history := iosnapshot.GetHistoryFactory()(iosnapshot.HistoryFactoryParameters{
+			Settings: settings,
+			Cache:    xdsCache,
+		})
+		syncer = NewTranslatorSyncer(ctx, gloo_translator.NewTranslatorPool(translator, translator), xdsCache, sanitizer, rep, false, nil, settings, statusMetrics, nil, proxyClient, "", singlereplica.Identity(), history, nil, nil)
+
+		writeUniqueErrsToUpstreams()
+
+		Eventually(func() (bool, error) {
+			upstreams, err := upstreamClient.List(ns, clients.ListOpts{})
+			if err != nil {
+				return false, err
+			}
+			expectedStatus := &core.Status{
+				State:      2,
+				Reason:     "2 errors occurred:\n\t* upstream is bad - determined by proxy-name1\n\t* upstream is bad - determined by proxy-name2\n\n",
+				ReportedBy: ref,
+			}
+			return expectedStatus.Equal(statusClient.GetStatus(upstreams[0])), nil
+		}, "2s", "0.1s").Should(BeTrue())
+		Expect(translator.concurrent.Load()).To(BeEquivalentTo(2))
+	})
+
+	It("translates proxies concurrently with an upstream group whose destinations have no namespace", func() {
+		// run with -race: translating a proxy defaults the namespace of the upstream group destinations,
+		// so the syncer must do it before the translations of the two proxies start together
+		started := &sync.WaitGroup{}
+		started.Add(2)
+		translator := gloo_translator.NewTranslatorPool(
+			&barrierTranslator{Translator: gloo_translator.NewDefaultTranslator(settings, registry.NewPluginRegistry(nil)), started: started},
+			&barrierTranslator{Translator: gloo_translator.NewDefaultTranslator(settings, registry.NewPluginRegistry(nil)), started: started},
+		)
+		history := iosnapshot.GetHistoryFactory()(iosnapshot.HistoryFactoryParameters{
+			Settings: settings,
+			Cache:    xdsCache,
+		})
+		syncer = NewTranslatorSyncer(ctx, translator, xdsCache, sanitizer, rep, false, nil, settings, statusMetrics, nil, proxyClient, "", singlereplica.Identity(), history, nil, nil)
+
+		upstreamGroup := &v1.UpstreamGroup{
+			Metadata: &core.Metadata{
+				Name:      "upstream-group",
+				Namespace: ns,
+			},
+			Destinations: []*v1.WeightedDestination{{
+				Destination: &v1.Destination{
+					DestinationType: &v1.Destination_Upstream{
+						Upstream: &core.ResourceRef{Name: upstreamName},
+					},
+				},
+				Weight: &wrapperspb.UInt32Value{Value: 1},
+			}},
+		}
+		snapClone := snap.Clone()
+		snap = &snapClone
+		snap.UpstreamGroups = v1.UpstreamGroupList{upstreamGroup}
+		err := syncer.Sync(context.Background(), snap)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(upstreamGroup.GetDestinations()[0].GetDestination().GetUpstream().GetNamespace()).To(Equal(ns))
+	})
+
 	It("handles reporting errors on multiple proxies sharing an upstream, each reporting the same upstream error", func() {
 		// Testing the scenario where we have multiple proxies,
 		// each of which should report the same error on an upstream.
@@ -386,6 +582,46 @@ func (t *mockTranslator) Translate(params plugins.Params, proxy *v1.Proxy) (envo
 	return envoycache.NilSnapshot{}, nil, &validation.ProxyReport{}
 }
 
+// delayedTranslator delays the translation of proxies, and records how many were translated concurrently
+type delayedTranslator struct {
+	gloo_translator.Translator
+	delays map[string]time.Duration
+
+	inFlight   atomic.Int32
+	concurrent atomic.Int32
+}
+
+func (t *delayedTranslator) Translate(params plugins.Params, proxy *v1.Proxy) (envoycache.Snapshot, reporter.ResourceReports, *validation.ProxyReport) {
+	inFlight := t.inFlight.Add(1)
+	defer t.inFlight.Add(-1)
+	for current := t.concurrent.Load(); inFlight > current; current = t.concurrent.Load() {
+		if t.concurrent.CompareAndSwap(current, inFlight) {
+			break
+		}
+	}
+	time.Sleep(t.delays[proxy.GetMetadata().GetName()])
+	return t.Translator.Translate(params, proxy)
+}
+
+// barrierTranslator waits for the translations of all the proxies to start before translating,
+// and checks that the destinations of the upstream groups have a namespace by then
+type barrierTranslator struct {
+	gloo_translator.Translator
+	started *sync.WaitGroup
+}
+
+func (t *barrierTranslator) Translate(params plugins.Params, proxy *v1.Proxy) (envoycache.Snapshot, reporter.ResourceReports, *validation.ProxyReport) {
+	defer GinkgoRecover()
+	t.started.Done()
+	t.started.Wait()
+	for _, upstreamGroup := range params.Snapshot.UpstreamGroups {
+		for _, dest := range upstreamGroup.GetDestinations() {
+			Expect(dest.GetDestination().GetUpstream().GetNamespace()).NotTo(BeEmpty())
+		}
+	}
+	return t.Translator.Translate(params, proxy)
+}
+
 var (
 	_ envoycache.SnapshotCache   = new(MockXdsCache)
 	_ gloo_translator.Translator = new(mockTranslator)
@@ -0,0 +1,89 @@
+package translator
+
+import (
+	"context"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/go-utils/contextutils"
+	"google.golang.org/protobuf/proto"
+)
+
+// extractExtensionConfigs moves the config of the HttpFilters that fetch it with ECDS out of the listeners,
+// and returns it as the TypedExtensionConfigs to serve, in the order they were found.
+//
+// Envoy fetches an extension config by the name of its filter, so each filter is renamed to
+// `<listener>/<filter>`, which lets listeners configure the same filter differently. Envoy still finds the
+// per-route config of a renamed filter, which is keyed by the name of the filter extension.
+// A filter whose config differs from the one already served under its name, such as in another filter chain
+// of the same listener, keeps its config inline.
+func extractExtensionConfigs(ctx context.Context, listeners []*envoy_config_listener_v3.Listener) []*envoy_config_core_v3.TypedExtensionConfig {
+	var (
+		extensionConfigs       []*envoy_config_core_v3.TypedExtensionConfig
+		extensionConfigsByName = make(map[string]*envoy_config_core_v3.TypedExtensionConfig)
+	)
+	for _, listener := range listeners {
+		for _, filterChain := range listener.GetFilterChains() {
+			for _, filter := range filterChain.GetFilters() {
+				var hcm envoyhttp.HttpConnectionManager
+				if !filter.GetTypedConfig().MessageIs(&hcm) {
+					continue
+				}
+				if err := filter.GetTypedConfig().UnmarshalTo(&hcm); err != nil {
+					contextutils.LoggerFrom(ctx).DPanicf("error unmarshalling the http connection manager of listener %v: %v", listener.GetName(), err)
+					continue
+				}
+
+				var extracted bool
+				for _, httpFilter := range hcm.GetHttpFilters() {
+					defaultConfig := httpFilter.GetConfigDiscovery().GetDefaultConfig()
+					if defaultConfig == nil {
+						continue
+					}
+					extracted = true
+
+					name := extensionConfigName(listener.GetName(), httpFilter.GetName())
+					existing, ok := extensionConfigsByName[name]
+					if ok && !proto.Equal(existing.GetTypedConfig(), defaultConfig) {
+						// another filter of the listener with the same name already fetches a different config
+						httpFilter.ConfigType = &envoyhttp.HttpFilter_TypedConfig{
+							TypedConfig: defaultConfig,
+						}
+						continue
+					}
+					if !ok {
+						extensionConfig := &envoy_config_core_v3.TypedExtensionConfig{
+							Name:        name,
+							TypedConfig: defaultConfig,
+						}
+						extensionConfigsByName[extensionConfig.GetName()] = extensionConfig
+						extensionConfigs = append(extensionConfigs, extensionConfig)
+					}
+					// the listener only references the config, so that changing it doesn't update the listener
+					httpFilter.Name = name
+					httpFilter.GetConfigDiscovery().DefaultConfig = nil
+				}
+				if !extracted {
+					continue
+				}
+
+				typedConfig, err := utils.MessageToAny(&hcm)
+				if err != nil {
+					contextutils.LoggerFrom(ctx).DPanicf("error marshalling the http connection manager of listener %v: %v", listener.GetName(), err)
+					continue
+				}
+				filter.ConfigType = &envoy_config_listener_v3.Filter_TypedConfig{
+					TypedConfig: typedConfig,
+				}
+			}
+		}
+	}
+	return extensionConfigs
+}
+
+// extensionConfigName returns the name of the TypedExtensionConfig of an HttpFilter of a listener.
+func extensionConfigName(listenerName, filterName string) string {
+	return listenerName + "/" + filterName
+}
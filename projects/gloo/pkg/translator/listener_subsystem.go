@@ -2,13 +2,17 @@ package translator
 
 import (
 	"context"
+	"strings"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"github.com/rotisserie/eris"
 	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
 	"github.com/solo-io/go-utils/contextutils"
 )
 
@@ -24,6 +28,12 @@ type ListenerSubsystemTranslatorFactory struct {
 	pluginRegistry      plugins.PluginRegistry
 	sslConfigTranslator utils.SslConfigTranslator
 	settings            *v1.Settings
+	// The request header that selects the scoped routes of a proxy. Scoped routes are disabled if it is empty.
+	scopedRoutesHeader string
+	// The bootstrap cluster of the xDS server that serves VirtualHosts on demand (VHDS). VHDS is disabled if it is empty.
+	vhdsCluster string
+	// The names of the HttpFilters whose config is delivered through ECDS
+	discoveredHttpFilters map[string]struct{}
 }
 
 func NewListenerSubsystemTranslatorFactory(
@@ -32,9 +42,12 @@ func NewListenerSubsystemTranslatorFactory(
 	settings *v1.Settings,
 ) *ListenerSubsystemTranslatorFactory {
 	return &ListenerSubsystemTranslatorFactory{
-		pluginRegistry:      pluginRegistry,
-		sslConfigTranslator: sslConfigTranslator,
-		settings:            settings,
+		pluginRegistry:        pluginRegistry,
+		sslConfigTranslator:   sslConfigTranslator,
+		settings:              settings,
+		scopedRoutesHeader:    settings.GetGloo().GetScopedRoutesHeader(),
+		vhdsCluster:           settings.GetGloo().GetVirtualHostDiscoveryCluster(),
+		discoveredHttpFilters: discoveredHttpFilters(settings.GetGloo().GetExtensionConfigDiscoveryHttpFilters()),
 	}
 }
 
@@ -73,6 +86,8 @@ func (l *ListenerSubsystemTranslatorFactory) GetHttpListenerTranslators(ctx cont
 	// to an implementation of the HttpConnectionManager NetworkFilter
 	// https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_conn_man/rds#config-http-conn-man-rds
 	routeConfigurationName := utils.RouteConfigName(listener)
+	scopeKeyHeader := l.listenerScopeKeyHeader(proxy, listener, listenerReport)
+	vhdsConfigSource := l.vhdsConfigSource(proxy, scopeKeyHeader)
 
 	// This translator produces NetworkFilters
 	// Most notably, this includes the HttpConnectionManager NetworkFilter
@@ -84,7 +99,10 @@ func (l *ListenerSubsystemTranslatorFactory) GetHttpListenerTranslators(ctx cont
 		l.pluginRegistry.GetHttpFilterPlugins(),
 		l.pluginRegistry.GetUpstreamHttpFilterPlugins(),
 		l.pluginRegistry.GetHttpConnectionManagerPlugins(),
-		routeConfigurationName)
+		routeConfigurationName,
+		scopeKeyHeader,
+		vhdsConfigSource != nil,
+		l.discoveredHttpFilters)
 
 	// This translator produces FilterChains
 	// For an HttpGateway we first build a set of NetworkFilters.
@@ -124,6 +142,8 @@ func (l *ListenerSubsystemTranslatorFactory) GetHttpListenerTranslators(ctx cont
 		report:                   httpListenerReport,
 		routeConfigName:          routeConfigurationName,
 		requireTlsOnVirtualHosts: len(listener.GetSslConfigurations()) > 0,
+		scopeKeyHeader:           scopeKeyHeader,
+		vhdsConfigSource:         vhdsConfigSource,
 	}
 
 	return listenerTranslator, routeConfigurationTranslator
@@ -180,6 +200,7 @@ func (l *ListenerSubsystemTranslatorFactory) GetHybridListenerTranslators(ctx co
 	// the relevant translators and aggregate them together
 	var routeConfigurationTranslators []RouteConfigurationTranslator
 	var filterChainTranslators []FilterChainTranslator
+	scopeKeyHeader := l.listenerScopeKeyHeader(proxy, listener, listenerReport)
 
 	for _, matchedListener := range listener.GetHybridListener().GetMatchedListeners() {
 		var (
@@ -194,6 +215,7 @@ func (l *ListenerSubsystemTranslatorFactory) GetHybridListenerTranslators(ctx co
 			// to an implementation of the HttpConnectionManager NetworkFilter
 			// https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_conn_man/rds#config-http-conn-man-rds
 			routeConfigurationName := utils.MatchedRouteConfigName(listener, matcher)
+			vhdsConfigSource := l.vhdsConfigSource(proxy, scopeKeyHeader)
 
 			httpListenerReport := hybridListenerReport.GetMatchedListenerReports()[routeConfigurationName].GetHttpListenerReport()
 
@@ -207,7 +229,10 @@ func (l *ListenerSubsystemTranslatorFactory) GetHybridListenerTranslators(ctx co
 				l.pluginRegistry.GetHttpFilterPlugins(),
 				l.pluginRegistry.GetUpstreamHttpFilterPlugins(),
 				l.pluginRegistry.GetHttpConnectionManagerPlugins(),
-				routeConfigurationName)
+				routeConfigurationName,
+				scopeKeyHeader,
+				vhdsConfigSource != nil,
+				l.discoveredHttpFilters)
 
 			// This translator produces FilterChains
 			// For an HttpGateway we first build a set of NetworkFilters.
@@ -238,6 +263,8 @@ func (l *ListenerSubsystemTranslatorFactory) GetHybridListenerTranslators(ctx co
 				report:                   httpListenerReport,
 				routeConfigName:          routeConfigurationName,
 				requireTlsOnVirtualHosts: matcher.GetSslConfig() != nil,
+				scopeKeyHeader:           scopeKeyHeader,
+				vhdsConfigSource:         vhdsConfigSource,
 			}
 
 		case *v1.MatchedListener_TcpListener:
@@ -307,6 +334,7 @@ func (l *ListenerSubsystemTranslatorFactory) GetAggregateListenerTranslators(ctx
 
 	// 3. Process all the http related resources
 	httpResources := listener.GetAggregateListener().GetHttpResources()
+	scopeKeyHeader := l.listenerScopeKeyHeader(proxy, listener, listenerReport)
 
 	for _, httpFilterChain := range listener.GetAggregateListener().GetHttpFilterChains() {
 		var (
@@ -318,6 +346,7 @@ func (l *ListenerSubsystemTranslatorFactory) GetAggregateListenerTranslators(ctx
 		// to an implementation of the HttpConnectionManager NetworkFilter
 		// https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_conn_man/rds#config-http-conn-man-rds
 		routeConfigurationName := utils.MatchedRouteConfigName(listener, httpFilterChain.GetMatcher())
+		vhdsConfigSource := l.vhdsConfigSource(proxy, scopeKeyHeader)
 
 		// Build the HttpListener from the refs defined on the HttpFilterChain
 		httpListener := &v1.HttpListener{
@@ -341,7 +370,10 @@ func (l *ListenerSubsystemTranslatorFactory) GetAggregateListenerTranslators(ctx
 			l.pluginRegistry.GetHttpFilterPlugins(),
 			l.pluginRegistry.GetUpstreamHttpFilterPlugins(),
 			l.pluginRegistry.GetHttpConnectionManagerPlugins(),
-			routeConfigurationName)
+			routeConfigurationName,
+			scopeKeyHeader,
+			vhdsConfigSource != nil,
+			l.discoveredHttpFilters)
 
 		// This translator produces FilterChains
 		// For an HttpGateway we first build a set of NetworkFilters.
@@ -372,6 +404,8 @@ func (l *ListenerSubsystemTranslatorFactory) GetAggregateListenerTranslators(ctx
 			report:                   httpListenerReport,
 			routeConfigName:          routeConfigurationName,
 			requireTlsOnVirtualHosts: httpFilterChain.GetMatcher().GetSslConfig() != nil,
+			scopeKeyHeader:           scopeKeyHeader,
+			vhdsConfigSource:         vhdsConfigSource,
 		}
 
 		filterChainTranslators = append(filterChainTranslators, filterChainTranslator)
@@ -429,3 +463,136 @@ func (l *ListenerSubsystemTranslatorFactory) GetAggregateListenerTranslators(ctx
 
 	return listenerTranslator, routeConfigurationTranslator
 }
+
+// listenerScopeKeyHeader returns the request header that selects the scoped routes (SRDS) of the
+// HttpConnectionManagers of the listener, or an empty string if they must use a single RouteConfiguration.
+// When scoped routes are enabled but the proxy can't use them, the listener report is warned about it.
+func (l *ListenerSubsystemTranslatorFactory) listenerScopeKeyHeader(proxy *v1.Proxy, listener *v1.Listener, listenerReport *validationapi.ListenerReport) string {
+	scopeKeyHeader, err := l.scopeKeyHeader(proxy)
+	if err != nil && len(httpConnectionManagerVirtualHosts(listener)) > 0 {
+		validation.AppendListenerWarning(listenerReport, validationapi.ListenerReport_Warning_ScopedRoutesWarning, err.Error())
+	}
+	return scopeKeyHeader
+}
+
+// scopeKeyHeader returns the request header that selects the scoped routes (SRDS) of the proxy,
+// or an empty string if the HttpConnectionManagers of the proxy must use a single RouteConfiguration.
+// It returns an error that explains why when scoped routes are enabled but the proxy can't use them.
+//
+// Envoy subscribes to every ScopedRouteConfiguration of the xDS stream for each HttpConnectionManager
+// that uses scoped routes, so scopes can't be kept apart when a proxy has more than one HttpConnectionManager.
+// Scope keys are also matched exactly, so VirtualHosts with wildcard domains can't be scoped. When the header holds
+// the host of the request, the port is left out of the scope keys, so VirtualHosts whose domains only differ by their
+// port can't be scoped either.
+func (l *ListenerSubsystemTranslatorFactory) scopeKeyHeader(proxy *v1.Proxy) (string, error) {
+	if l.scopedRoutesHeader == "" {
+		return "", nil
+	}
+	if utils.GetTranslatorValue(proxy.GetMetadata()) == utils.GatewayApiProxyValue {
+		// the scoped routes setting only applies to edge Proxies
+		return "", nil
+	}
+
+	// find the VirtualHosts of the only HttpConnectionManager of the proxy
+	var hcmVirtualHosts [][]*v1.VirtualHost
+	for _, listener := range proxy.GetListeners() {
+		hcmVirtualHosts = append(hcmVirtualHosts, httpConnectionManagerVirtualHosts(listener)...)
+	}
+	if len(hcmVirtualHosts) == 0 {
+		return "", nil
+	}
+	if len(hcmVirtualHosts) > 1 {
+		return "", eris.Errorf("not using scoped routes: the proxy has %d http connection managers, scoped routes need exactly one", len(hcmVirtualHosts))
+	}
+
+	ignorePort := isHostHeader(l.scopedRoutesHeader)
+	// the virtual host of each scope key, since a scope selects a single RouteConfiguration
+	scopeKeyVirtualHosts := make(map[string]string)
+	for _, virtualHost := range hcmVirtualHosts[0] {
+		if len(virtualHost.GetDomains()) == 0 {
+			return "", eris.Errorf("not using scoped routes: virtual host %v matches any domain", virtualHost.GetName())
+		}
+		for _, domain := range virtualHost.GetDomains() {
+			if domain == "" || strings.Contains(domain, "*") {
+				return "", eris.Errorf("not using scoped routes: virtual host %v has wildcard domain %q", virtualHost.GetName(), domain)
+			}
+			if ignorePort && strings.HasPrefix(domain, "[") {
+				return "", eris.Errorf("not using scoped routes: virtual host %v has IPv6 domain %q, whose port can't be left out of the scope keys", virtualHost.GetName(), domain)
+			}
+			key := scopeKey(domain, ignorePort)
+			if other, ok := scopeKeyVirtualHosts[key]; ok && other != virtualHost.GetName() {
+				return "", eris.Errorf("not using scoped routes: virtual hosts %v and %v have domains with the same host %q", other, virtualHost.GetName(), key)
+			}
+			scopeKeyVirtualHosts[key] = virtualHost.GetName()
+		}
+	}
+
+	return l.scopedRoutesHeader, nil
+}
+
+// httpConnectionManagerVirtualHosts returns the VirtualHosts of each HttpConnectionManager of the listener.
+func httpConnectionManagerVirtualHosts(listener *v1.Listener) [][]*v1.VirtualHost {
+	var out [][]*v1.VirtualHost
+	switch listenerType := listener.GetListenerType().(type) {
+	case *v1.Listener_HttpListener:
+		out = append(out, listenerType.HttpListener.GetVirtualHosts())
+	case *v1.Listener_HybridListener:
+		for _, matchedListener := range listenerType.HybridListener.GetMatchedListeners() {
+			if httpListener := matchedListener.GetHttpListener(); httpListener != nil {
+				out = append(out, httpListener.GetVirtualHosts())
+			}
+		}
+	case *v1.Listener_AggregateListener:
+		httpResources := listenerType.AggregateListener.GetHttpResources()
+		for _, httpFilterChain := range listenerType.AggregateListener.GetHttpFilterChains() {
+			var virtualHosts []*v1.VirtualHost
+			for _, vhostRef := range httpFilterChain.GetVirtualHostRefs() {
+				virtualHosts = append(virtualHosts, httpResources.GetVirtualHosts()[vhostRef])
+			}
+			out = append(out, virtualHosts)
+		}
+	}
+	return out
+}
+
+// vhdsConfigSource returns the source the RouteConfigurations of the proxy fetch their VirtualHosts from on
+// demand (VHDS), or nil if the RouteConfigurations hold their VirtualHosts.
+//
+// Envoy only fetches VirtualHosts over a dedicated incremental (DELTA_GRPC) stream, not over ADS, so the
+// source is the cluster of the Envoy bootstrap that reaches the Gloo xDS server.
+// Scoped routes already give each VirtualHost its own RouteConfiguration, so they don't use VHDS.
+func (l *ListenerSubsystemTranslatorFactory) vhdsConfigSource(proxy *v1.Proxy, scopeKeyHeader string) *envoy_config_core_v3.ConfigSource {
+	if l.vhdsCluster == "" || scopeKeyHeader != "" {
+		return nil
+	}
+	if utils.GetTranslatorValue(proxy.GetMetadata()) == utils.GatewayApiProxyValue {
+		// Gateway API proxies ignore the port of the Host header, which the xDS server doesn't know
+		// about when it resolves the authorities Envoy subscribes to
+		return nil
+	}
+	return &envoy_config_core_v3.ConfigSource{
+		ResourceApiVersion: envoy_config_core_v3.ApiVersion_V3,
+		ConfigSourceSpecifier: &envoy_config_core_v3.ConfigSource_ApiConfigSource{
+			ApiConfigSource: &envoy_config_core_v3.ApiConfigSource{
+				ApiType:             envoy_config_core_v3.ApiConfigSource_DELTA_GRPC,
+				TransportApiVersion: envoy_config_core_v3.ApiVersion_V3,
+				GrpcServices: []*envoy_config_core_v3.GrpcService{{
+					TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
+						EnvoyGrpc: &envoy_config_core_v3.GrpcService_EnvoyGrpc{
+							ClusterName: l.vhdsCluster,
+						},
+					},
+				}},
+			},
+		},
+	}
+}
+
+// discoveredHttpFilters returns the set of the names of the HttpFilters whose config is fetched with ECDS.
+func discoveredHttpFilters(httpFilters []string) map[string]struct{} {
+	out := make(map[string]struct{}, len(httpFilters))
+	for _, name := range httpFilters {
+		out[name] = struct{}{}
+	}
+	return out
+}
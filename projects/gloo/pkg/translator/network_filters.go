@@ -4,6 +4,7 @@ import (
 	"sort"
 
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	on_demandv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/on_demand/v3"
 	routerv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
 	codecv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/upstream_codec/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
@@ -31,6 +32,7 @@ var _ NetworkFilterTranslator = new(httpNetworkFilterTranslator)
 const (
 	DefaultHttpStatPrefix  = "http"
 	UpstreamCodeFilterName = "envoy.filters.http.upstream_codec"
+	OnDemandFilterName     = "envoy.filters.http.on_demand"
 )
 
 type httpNetworkFilterTranslator struct {
@@ -53,19 +55,25 @@ func NewHttpListenerNetworkFilterTranslator(
 	upstreamHttpPlugins []plugins.UpstreamHttpFilterPlugin,
 	hcmPlugins []plugins.HttpConnectionManagerPlugin,
 	routeConfigName string,
+	scopeKeyHeader string,
+	onDemandVirtualHosts bool,
+	discoveredHttpFilters map[string]struct{},
 ) *httpNetworkFilterTranslator {
 	return &httpNetworkFilterTranslator{
 		listener:       listener,
 		report:         report,
 		networkPlugins: networkPlugins,
 		hcmNetworkFilterTranslator: &hcmNetworkFilterTranslator{
-			parentListener:      parentListener,
-			listener:            listener,
-			report:              report,
-			httpPlugins:         httpPlugins,
-			upstreamHttpPlugins: upstreamHttpPlugins,
-			hcmPlugins:          hcmPlugins,
-			routeConfigName:     routeConfigName,
+			parentListener:        parentListener,
+			listener:              listener,
+			report:                report,
+			httpPlugins:           httpPlugins,
+			upstreamHttpPlugins:   upstreamHttpPlugins,
+			hcmPlugins:            hcmPlugins,
+			routeConfigName:       routeConfigName,
+			scopeKeyHeader:        scopeKeyHeader,
+			onDemandVirtualHosts:  onDemandVirtualHosts,
+			discoveredHttpFilters: discoveredHttpFilters,
 		},
 	}
 }
@@ -155,6 +163,14 @@ type hcmNetworkFilterTranslator struct {
 	hcmPlugins []plugins.HttpConnectionManagerPlugin
 	// The name of the RouteConfiguration for the HttpConnectionManager
 	routeConfigName string
+	// The request header that selects the scoped RouteConfiguration of a request.
+	// If empty, the HttpConnectionManager uses the RouteConfiguration named routeConfigName.
+	scopeKeyHeader string
+	// If true, the RouteConfiguration fetches its VirtualHosts on demand (VHDS)
+	onDemandVirtualHosts bool
+	// The names of the HttpFilters whose config is delivered through ECDS, in addition to
+	// the filters the plugins opt in with plugins.DiscoverStagedFilterConfig
+	discoveredHttpFilters map[string]struct{}
 }
 
 func (h *hcmNetworkFilterTranslator) ComputeNetworkFilter(params plugins.Params) (*envoy_config_listener_v3.Filter, error) {
@@ -192,7 +208,7 @@ func (h *hcmNetworkFilterTranslator) initializeHCM() *envoyhttp.HttpConnectionMa
 		statPrefix = DefaultHttpStatPrefix
 	}
 
-	return &envoyhttp.HttpConnectionManager{
+	hcm := &envoyhttp.HttpConnectionManager{
 		CodecType:  envoyhttp.HttpConnectionManager_AUTO,
 		StatPrefix: statPrefix,
 		NormalizePath: &wrappers.BoolValue{
@@ -200,14 +216,54 @@ func (h *hcmNetworkFilterTranslator) initializeHCM() *envoyhttp.HttpConnectionMa
 		},
 		RouteSpecifier: &envoyhttp.HttpConnectionManager_Rds{
 			Rds: &envoyhttp.Rds{
-				ConfigSource: &envoy_config_core_v3.ConfigSource{
-					ResourceApiVersion: envoy_config_core_v3.ApiVersion_V3,
-					ConfigSourceSpecifier: &envoy_config_core_v3.ConfigSource_Ads{
-						Ads: &envoy_config_core_v3.AggregatedConfigSource{},
+				ConfigSource:    adsConfigSource(),
+				RouteConfigName: h.routeConfigName,
+			},
+		},
+	}
+
+	if h.scopeKeyHeader != "" {
+		// The ScopedRouteConfigurations (SRDS) select the RouteConfiguration of a request
+		// from the value of the scope key header
+		headerValueExtractor := &envoyhttp.ScopedRoutes_ScopeKeyBuilder_FragmentBuilder_HeaderValueExtractor{
+			Name: h.scopeKeyHeader,
+			// without an element separator, the whole header value is the key
+			ExtractType: &envoyhttp.ScopedRoutes_ScopeKeyBuilder_FragmentBuilder_HeaderValueExtractor_Index{
+				Index: 0,
+			},
+		}
+		if isHostHeader(h.scopeKeyHeader) {
+			// the key is the host, without the port
+			headerValueExtractor.ElementSeparator = ":"
+		}
+		hcm.RouteSpecifier = &envoyhttp.HttpConnectionManager_ScopedRoutes{
+			ScopedRoutes: &envoyhttp.ScopedRoutes{
+				Name: h.routeConfigName,
+				ScopeKeyBuilder: &envoyhttp.ScopedRoutes_ScopeKeyBuilder{
+					Fragments: []*envoyhttp.ScopedRoutes_ScopeKeyBuilder_FragmentBuilder{{
+						Type: &envoyhttp.ScopedRoutes_ScopeKeyBuilder_FragmentBuilder_HeaderValueExtractor_{
+							HeaderValueExtractor: headerValueExtractor,
+						},
+					}},
+				},
+				RdsConfigSource: adsConfigSource(),
+				ConfigSpecifier: &envoyhttp.ScopedRoutes_ScopedRds{
+					ScopedRds: &envoyhttp.ScopedRds{
+						ScopedRdsConfigSource: adsConfigSource(),
 					},
 				},
-				RouteConfigName: h.routeConfigName,
 			},
+		}
+	}
+
+	return hcm
+}
+
+func adsConfigSource() *envoy_config_core_v3.ConfigSource {
+	return &envoy_config_core_v3.ConfigSource{
+		ResourceApiVersion: envoy_config_core_v3.ApiVersion_V3,
+		ConfigSourceSpecifier: &envoy_config_core_v3.ConfigSource_Ads{
+			Ads: &envoy_config_core_v3.AggregatedConfigSource{},
 		},
 	}
 }
@@ -232,6 +288,28 @@ func (h *hcmNetworkFilterTranslator) computeHttpFilters(params plugins.Params) [
 	}
 	httpFilters = append(httpFilters, CustomHttpFilters(h.listener)...)
 
+	if h.onDemandVirtualHosts {
+		// The on demand filter pauses requests for authorities whose VirtualHost Envoy doesn't have yet,
+		// while it is fetched with VHDS. It comes first, since the other filters may use the route.
+		onDemandFilter, err := plugins.NewStagedFilter(
+			OnDemandFilterName,
+			&on_demandv3.OnDemand{},
+			plugins.BeforeStage(plugins.FaultStage),
+		)
+		if err != nil {
+			validation.AppendHTTPListenerError(h.report, validationapi.HttpListenerReport_Error_ProcessingError, err.Error())
+		} else {
+			httpFilters = append(httpFilters, onDemandFilter)
+		}
+	}
+
+	// the config of these filters is delivered through ECDS
+	for i, httpFilter := range httpFilters {
+		if _, ok := h.discoveredHttpFilters[httpFilter.Filter.GetName()]; ok {
+			httpFilters[i] = plugins.DiscoverStagedFilterConfig(httpFilter)
+		}
+	}
+
 	// https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/http/http_filters#filter-ordering
 	// HttpFilter ordering determines the order in which the HCM will execute the filter.
 
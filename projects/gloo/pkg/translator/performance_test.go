@@ -3,6 +3,7 @@ package translator_test
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/solo-io/gloo/test/ginkgo/decorators"
 	"go.uber.org/zap"
@@ -18,6 +19,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/registry"
 	mock_consul "github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul/mocks"
 	glooutils "github.com/solo-io/gloo/projects/gloo/pkg/utils"
@@ -25,6 +27,7 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -45,9 +48,10 @@ import (
 // Results can then be found in the logs for that instance of the action
 var _ = Describe("Translation - Benchmarking Tests", decorators.Performance, Label(labels.Performance), func() {
 	var (
-		ctrl       *gomock.Controller
-		settings   *v1.Settings
-		translator Translator
+		ctrl          *gomock.Controller
+		settings      *v1.Settings
+		newTranslator func() Translator
+		translator    Translator
 	)
 
 	BeforeEach(func() {
@@ -65,10 +69,14 @@ var _ = Describe("Translation - Benchmarking Tests", decorators.Performance, Lab
 				ConsulWatcher: mock_consul.NewMockConsulWatcher(ctrl), // just needed to activate the consul plugin
 			},
 		}
-		registeredPlugins := registry.Plugins(registry.FromBootstrap(opts))
-		pluginRegistry := registry.NewPluginRegistry(registeredPlugins)
+		// each translator needs its own plugins to translate concurrently with other translators
+		newTranslator = func() Translator {
+			registeredPlugins := registry.Plugins(registry.FromBootstrap(opts))
+			pluginRegistry := registry.NewPluginRegistry(registeredPlugins)
+			return NewTranslatorWithHasher(glooutils.NewSslConfigTranslator(), settings, pluginRegistry, EnvoyCacheResourcesListToFnvHash)
+		}
 
-		translator = NewTranslatorWithHasher(glooutils.NewSslConfigTranslator(), settings, pluginRegistry, EnvoyCacheResourcesListToFnvHash)
+		translator = newTranslator()
 	})
 
 	// The Benchmark table takes entries consisting of an ApiSnapshot, benchmarkConfig, and labels
@@ -157,6 +165,62 @@ var _ = Describe("Translation - Benchmarking Tests", decorators.Performance, Lab
 		Entry(nil, gloohelpers.NewScaledSnapshotBuilder().WithUpstreamCount(1).WithEndpointCount(1).WithSecretCount(10), basicConfig, "secret scale"),
 		Entry(nil, gloohelpers.NewScaledSnapshotBuilder().WithUpstreamCount(1).WithEndpointCount(1).WithSecretCount(1000), basicConfig, "secret scale"),
 	)
+	// The concurrent benchmark table measures the time it takes to translate all the proxies of a snapshot,
+	// each in its own goroutine, with a TranslatorPool of the given concurrency, like the envoyTranslatorSyncer does
+	DescribeTable("Concurrent benchmark table",
+		func(snapBuilder *gloohelpers.ScaledSnapshotBuilder, proxyCount, concurrency int, config *gloohelpers.BenchmarkConfig, labels ...string) {
+			// Translating logs at info level, which is very noisy when running repeatedly
+			originalLogLevel := contextutils.GetLogLevel()
+			contextutils.SetLogLevel(zap.ErrorLevel)
+			defer contextutils.SetLogLevel(originalLogLevel)
+
+			apiSnap := snapBuilder.Build()
+			Expect(apiSnap.Proxies).NotTo(BeEmpty())
+			proxies := make(v1.ProxyList, 0, proxyCount)
+			for i := 0; i < proxyCount; i++ {
+				proxy := apiSnap.Proxies[0].Clone().(*v1.Proxy)
+				proxy.Metadata = &core.Metadata{Name: fmt.Sprintf("proxy-%d", i), Namespace: defaults.GlooSystem}
+				proxies = append(proxies, proxy)
+			}
+			apiSnap.Proxies = proxies
+
+			translators := make([]Translator, 0, concurrency)
+			for i := 0; i < concurrency; i++ {
+				translators = append(translators, newTranslator())
+			}
+			pool := NewTranslatorPool(translators...)
+
+			desc := fmt.Sprintf("(%d proxies, concurrency %d) %s", proxyCount, concurrency, gloohelpers.GenerateBenchmarkDesc(snapBuilder, config, labels...))
+			experiment := gmeasure.NewExperiment(fmt.Sprintf("Experiment - %s", desc))
+			AddReportEntry(experiment.Name, experiment)
+
+			experiment.Sample(func(idx int) {
+				start := time.Now()
+				var wg sync.WaitGroup
+				for _, proxy := range proxies {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						params := plugins.Params{
+							Ctx:      context.Background(),
+							Snapshot: apiSnap,
+							Messages: map[*core.ResourceRef][]string{},
+						}
+						_, errs, _ := pool.Translate(params, proxy)
+						Expect(errs.Validate()).NotTo(HaveOccurred())
+					}()
+				}
+				wg.Wait()
+				experiment.RecordDuration(desc, time.Since(start))
+			}, gmeasure.SamplingConfig{N: config.Iterations, Duration: config.MaxDur})
+
+			durations := experiment.Get(desc).Durations
+
+			Expect(durations).Should(And(config.GetMatchers()...))
+		},
+		Entry("serial", gloohelpers.NewScaledSnapshotBuilder().WithUpstreamCount(100).WithEndpointCount(1).WithSecretCount(1), 16, 1, concurrentConfig, "upstream scale"),
+		Entry("concurrent", gloohelpers.NewScaledSnapshotBuilder().WithUpstreamCount(100).WithEndpointCount(1).WithSecretCount(1), 16, 4, concurrentConfig, "upstream scale"),
+	)
 })
 
 // Test assets: Add blocks for logical groupings of tests, including:
@@ -209,3 +273,17 @@ var (
 	consistentSniUsBuilder = gloohelpers.NewUpstreamBuilder().WithConsistentSni()
 	uniqueSniUsBuilder     = gloohelpers.NewUpstreamBuilder().WithUniqueSni()
 )
+
+/* Concurrent Translation Test */
+var concurrentConfig = &gloohelpers.BenchmarkConfig{
+	Iterations: 20,
+	MaxDur:     30 * time.Second,
+	GhaMatchers: []types.GomegaMatcher{
+		matchers.HaveMedianLessThan(5 * time.Second),
+		matchers.HavePercentileLessThan(90, 10*time.Second),
+	},
+	LocalMatchers: []types.GomegaMatcher{
+		matchers.HaveMedianLessThan(time.Second),
+		matchers.HavePercentileLessThan(90, 2*time.Second),
+	},
+}
@@ -13,6 +13,7 @@ import (
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/pkg/utils/regexutils"
@@ -26,6 +27,7 @@ import (
 	usconversion "github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
@@ -62,6 +64,28 @@ type RouteConfigurationTranslator interface {
 	// A Gloo listener may produce multiple filter chains. Each one may contain its own route configuration
 	// https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/http/http_routing#arch-overview-http-routing
 	ComputeRouteConfiguration(params plugins.Params) []*envoy_config_route_v3.RouteConfiguration
+
+	// ComputeRouteConfigurationResources also returns the resources that are served alongside the RouteConfigurations,
+	// when the RouteConfigurations use scoped routes (SRDS) or virtual host discovery (VHDS)
+	ComputeRouteConfigurationResources(params plugins.Params) *RouteConfigurationResources
+}
+
+// RouteConfigurationResources are the xDS resources that hold the routes of a listener
+type RouteConfigurationResources struct {
+	RouteConfigurations []*envoy_config_route_v3.RouteConfiguration
+	// ScopedRouteConfigurations select the RouteConfigurations of HttpConnectionManagers that use scoped routes (SRDS)
+	// https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_conn_man/http_conn_man#scoped-routes
+	ScopedRouteConfigurations []*envoy_config_route_v3.ScopedRouteConfiguration
+	// VirtualHosts are the VirtualHosts of the RouteConfigurations that use virtual host discovery (VHDS),
+	// which Envoy fetches on demand
+	// https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/http/http_routing#virtual-host-discovery-service
+	VirtualHosts []*envoy_config_route_v3.VirtualHost
+}
+
+func (r *RouteConfigurationResources) append(other *RouteConfigurationResources) {
+	r.RouteConfigurations = append(r.RouteConfigurations, other.RouteConfigurations...)
+	r.ScopedRouteConfigurations = append(r.ScopedRouteConfigurations, other.ScopedRouteConfigurations...)
+	r.VirtualHosts = append(r.VirtualHosts, other.VirtualHosts...)
 }
 
 var _ RouteConfigurationTranslator = new(emptyRouteConfigurationTranslator)
@@ -75,6 +99,12 @@ func (e *emptyRouteConfigurationTranslator) ComputeRouteConfiguration(params plu
 	return []*envoy_config_route_v3.RouteConfiguration{}
 }
 
+func (e *emptyRouteConfigurationTranslator) ComputeRouteConfigurationResources(params plugins.Params) *RouteConfigurationResources {
+	return &RouteConfigurationResources{
+		RouteConfigurations: []*envoy_config_route_v3.RouteConfiguration{},
+	}
+}
+
 type httpRouteConfigurationTranslator struct {
 	pluginRegistry           plugins.PluginRegistry
 	proxy                    *v1.Proxy
@@ -85,9 +115,17 @@ type httpRouteConfigurationTranslator struct {
 	report                   *validationapi.HttpListenerReport
 	routeConfigName          string
 	requireTlsOnVirtualHosts bool
+	// If set, the RouteConfiguration is split into scopes selected by the value of this request header
+	scopeKeyHeader string
+	// If set, the VirtualHosts of the RouteConfiguration are served by VHDS from this source
+	vhdsConfigSource *envoy_config_core_v3.ConfigSource
 }
 
 func (h *httpRouteConfigurationTranslator) ComputeRouteConfiguration(params plugins.Params) []*envoy_config_route_v3.RouteConfiguration {
+	return h.ComputeRouteConfigurationResources(params).RouteConfigurations
+}
+
+func (h *httpRouteConfigurationTranslator) ComputeRouteConfigurationResources(params plugins.Params) *RouteConfigurationResources {
 	params.Ctx = contextutils.WithLogger(params.Ctx, "compute_route_config."+h.routeConfigName)
 	cfg := &envoy_config_route_v3.RouteConfiguration{
 		Name:                           h.routeConfigName,
@@ -104,7 +142,104 @@ func (h *httpRouteConfigurationTranslator) ComputeRouteConfiguration(params plug
 		cfg.MostSpecificHeaderMutationsWins = mostSpecificVal.GetValue()
 	}
 
-	return []*envoy_config_route_v3.RouteConfiguration{cfg}
+	if h.scopeKeyHeader != "" {
+		routeConfigs, scopedRouteConfigs := scopeRouteConfiguration(cfg, isHostHeader(h.scopeKeyHeader))
+		return &RouteConfigurationResources{
+			RouteConfigurations:       routeConfigs,
+			ScopedRouteConfigurations: scopedRouteConfigs,
+		}
+	}
+	if h.vhdsConfigSource != nil {
+		return &RouteConfigurationResources{
+			RouteConfigurations: []*envoy_config_route_v3.RouteConfiguration{cfg},
+			VirtualHosts:        discoverVirtualHosts(cfg, h.vhdsConfigSource),
+		}
+	}
+	return &RouteConfigurationResources{
+		RouteConfigurations: []*envoy_config_route_v3.RouteConfiguration{cfg},
+	}
+}
+
+// discoverVirtualHosts moves the VirtualHosts of a RouteConfiguration to VHDS, so that Envoy only fetches
+// the VirtualHosts of the authorities it receives requests for. The returned VirtualHosts are named
+// "<route configuration>/<virtual host>", as Envoy expects.
+func discoverVirtualHosts(
+	cfg *envoy_config_route_v3.RouteConfiguration,
+	configSource *envoy_config_core_v3.ConfigSource,
+) []*envoy_config_route_v3.VirtualHost {
+	virtualHosts := cfg.GetVirtualHosts()
+	cfg.VirtualHosts = nil
+	cfg.Vhds = &envoy_config_route_v3.Vhds{
+		ConfigSource: configSource,
+	}
+	for _, virtualHost := range virtualHosts {
+		virtualHost.Name = xds.VirtualHostName(cfg.GetName(), virtualHost.GetName())
+	}
+	return virtualHosts
+}
+
+// scopeRouteConfiguration splits a RouteConfiguration into one RouteConfiguration per VirtualHost, so that
+// a change to a VirtualHost only updates its own RouteConfiguration. Each domain of a VirtualHost gets a
+// ScopedRouteConfiguration, keyed by the domain, which selects the RouteConfiguration of the VirtualHost.
+// If ignorePort is set, the port of the domains is left out of the keys.
+func scopeRouteConfiguration(cfg *envoy_config_route_v3.RouteConfiguration, ignorePort bool) (
+	[]*envoy_config_route_v3.RouteConfiguration,
+	[]*envoy_config_route_v3.ScopedRouteConfiguration,
+) {
+	var (
+		routeConfigs       []*envoy_config_route_v3.RouteConfiguration
+		scopedRouteConfigs []*envoy_config_route_v3.ScopedRouteConfiguration
+	)
+
+	// the VirtualHosts are removed before cloning, so that they are not copied once per VirtualHost
+	virtualHosts := cfg.GetVirtualHosts()
+	cfg.VirtualHosts = nil
+
+	scopeKeys := make(map[string]struct{})
+	for _, virtualHost := range virtualHosts {
+		vhostCfg := proto.Clone(cfg).(*envoy_config_route_v3.RouteConfiguration)
+		vhostCfg.Name = fmt.Sprintf("%s-%s", cfg.GetName(), virtualHost.GetName())
+		vhostCfg.VirtualHosts = []*envoy_config_route_v3.VirtualHost{virtualHost}
+		routeConfigs = append(routeConfigs, vhostCfg)
+
+		for _, domain := range virtualHost.GetDomains() {
+			// duplicate domains are reported by ValidateVirtualHostDomains, and Envoy rejects conflicting scope keys.
+			// The domains of a VirtualHost may also only differ by their port.
+			key := scopeKey(domain, ignorePort)
+			if _, ok := scopeKeys[key]; ok {
+				continue
+			}
+			scopeKeys[key] = struct{}{}
+			scopedRouteConfigs = append(scopedRouteConfigs, &envoy_config_route_v3.ScopedRouteConfiguration{
+				Name:                   fmt.Sprintf("%s-%s", cfg.GetName(), key),
+				RouteConfigurationName: vhostCfg.GetName(),
+				Key: &envoy_config_route_v3.ScopedRouteConfiguration_Key{
+					Fragments: []*envoy_config_route_v3.ScopedRouteConfiguration_Key_Fragment{{
+						Type: &envoy_config_route_v3.ScopedRouteConfiguration_Key_Fragment_StringKey{
+							StringKey: key,
+						},
+					}},
+				},
+			})
+		}
+	}
+
+	return routeConfigs, scopedRouteConfigs
+}
+
+// isHostHeader returns true if the scope key header holds the host of the request, whose port is
+// left out of the scope key.
+func isHostHeader(header string) bool {
+	return strings.EqualFold(header, ":authority") || strings.EqualFold(header, "host")
+}
+
+// scopeKey returns the scope key that selects the RouteConfiguration of a domain.
+func scopeKey(domain string, ignorePort bool) string {
+	if !ignorePort {
+		return domain
+	}
+	host, _, _ := strings.Cut(domain, ":")
+	return host
 }
 
 func (h *httpRouteConfigurationTranslator) computeVirtualHosts(params plugins.Params) []*envoy_config_route_v3.VirtualHost {
@@ -256,7 +391,7 @@ func validateEnvoyRoute(r *envoy_config_route_v3.Route, routeReport *validationa
 // utility function to transform gloo matcher to envoy route matcher
 func (h *httpRouteConfigurationTranslator) glooMatcherToEnvoyMatcher(ctx context.Context, matcher *matchers.Matcher) envoy_config_route_v3.RouteMatch {
 	match := envoy_config_route_v3.RouteMatch{
-		Headers:         envoyHeaderMatcher(ctx, matcher.GetHeaders()),
+		Headers:         pluginutils.EnvoyHeaderMatcher(ctx, matcher.GetHeaders()),
 		QueryParameters: envoyQueryMatcher(ctx, matcher.GetQueryParameters()),
 	}
 	if len(matcher.GetMethods()) > 0 {
@@ -449,6 +584,9 @@ func (h *httpRouteConfigurationTranslator) setRouteAction(
 		md := &v1.MultiDestination{
 			Destinations: upstreamGroup.GetDestinations(),
 		}
+		if upstreamGroup.GetFailover() != nil {
+			return h.setFailoverClusters(params, upstreamGroup, md, out, routeReport, route, routeName)
+		}
 		return h.setWeightedClusters(params, md, out, routeReport, route, routeName)
 	case *v1.RouteAction_ClusterHeader:
 		// ClusterHeader must use the naming convention {{namespace}}_{{clustername}}
@@ -545,6 +683,37 @@ func (h *httpRouteConfigurationTranslator) setWeightedClusters(
 	return nil
 }
 
+// setFailoverClusters routes to the aggregate clusters of an upstream group with failover
+func (h *httpRouteConfigurationTranslator) setFailoverClusters(
+	params plugins.RouteParams,
+	upstreamGroup *v1.UpstreamGroup,
+	multiDest *v1.MultiDestination,
+	out *envoy_config_route_v3.RouteAction,
+	routeReport *validationapi.RouteReport,
+	route *v1.Route,
+	routeName string,
+) error {
+	upstreamGroupRef := upstreamGroup.GetMetadata().Ref()
+	if upstreamGroup.GetFailover().GetMode() == v1.UpstreamGroup_Failover_PRIORITY {
+		if len(multiDest.GetDestinations()) == 0 {
+			return NoDestinationSpecifiedError
+		}
+		out.ClusterSpecifier = &envoy_config_route_v3.RouteAction_Cluster{
+			Cluster: UpstreamGroupToClusterName(upstreamGroupRef),
+		}
+		return nil
+	}
+
+	if err := h.setWeightedClusters(params, multiDest, out, routeReport, route, routeName); err != nil {
+		return err
+	}
+	// the share of traffic of each destination goes to the aggregate cluster that fails it over to the others
+	for i, weightedCluster := range out.GetWeightedClusters().GetClusters() {
+		weightedCluster.Name = UpstreamGroupDestinationToClusterName(upstreamGroupRef, i)
+	}
+	return nil
+}
+
 type multiRouteConfigurationTranslator struct {
 	translators []RouteConfigurationTranslator
 }
@@ -559,6 +728,16 @@ func (m *multiRouteConfigurationTranslator) ComputeRouteConfiguration(params plu
 	return outRouteConfigs
 }
 
+func (m *multiRouteConfigurationTranslator) ComputeRouteConfigurationResources(params plugins.Params) *RouteConfigurationResources {
+	out := &RouteConfigurationResources{}
+
+	for _, translator := range m.translators {
+		out.append(translator.ComputeRouteConfigurationResources(params))
+	}
+
+	return out
+}
+
 // TODO(marco): when we update the routing API we should move this to a RouteActionPlugin
 func getSubsetMatch(destination *v1.Destination) *envoy_config_core_v3.Metadata {
 	var routeMetadata *envoy_config_core_v3.Metadata
@@ -677,38 +856,6 @@ func (h *httpRouteConfigurationTranslator) setEnvoyPathMatcher(ctx context.Conte
 	}
 }
 
-func envoyHeaderMatcher(ctx context.Context, in []*matchers.HeaderMatcher) []*envoy_config_route_v3.HeaderMatcher {
-	var out []*envoy_config_route_v3.HeaderMatcher
-	for _, matcher := range in {
-
-		envoyMatch := &envoy_config_route_v3.HeaderMatcher{
-			Name: matcher.GetName(),
-		}
-		if matcher.GetValue() == "" {
-			envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_PresentMatch{
-				PresentMatch: true,
-			}
-		} else {
-			if matcher.GetRegex() {
-				envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_SafeRegexMatch{
-					SafeRegexMatch: regexutils.NewRegex(ctx, matcher.GetValue()),
-				}
-			} else {
-				envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_ExactMatch{
-					ExactMatch: matcher.GetValue(),
-				}
-			}
-		}
-
-		if matcher.GetInvertMatch() {
-			envoyMatch.InvertMatch = true
-		}
-
-		out = append(out, envoyMatch)
-	}
-	return out
-}
-
 func envoyQueryMatcher(ctx context.Context, in []*matchers.QueryParameterMatcher) []*envoy_config_route_v3.QueryParameterMatcher {
 	var out []*envoy_config_route_v3.QueryParameterMatcher
 	for _, matcher := range in {
@@ -12,9 +12,11 @@ import (
 	"github.com/solo-io/gloo/pkg/utils/statsutils"
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"github.com/golang/protobuf/proto"
 	errors "github.com/rotisserie/eris"
 	envoyvalidation "github.com/solo-io/gloo/pkg/utils/envoyutils/validation"
@@ -130,20 +132,29 @@ func (t *translatorInstance) Translate(
 	var clusters []*envoy_config_cluster_v3.Cluster
 	var endpoints []*envoy_config_endpoint_v3.ClusterLoadAssignment
 	clusters, endpoints = t.translateClusterSubsystemComponents(params, proxy, reports)
-	routeConfigs, listeners := t.translateListenerSubsystemComponents(params, proxy, proxyReport)
+	routeResources, listeners := t.translateListenerSubsystemComponents(params, proxy, proxyReport)
 	// run Resource Generator Plugins
 	for _, plugin := range t.pluginRegistry.GetResourceGeneratorPlugins() {
-		generatedClusters, generatedEndpoints, generatedRouteConfigs, generatedListeners, err := plugin.GeneratedResources(params, clusters, endpoints, routeConfigs, listeners)
+		generatedClusters, generatedEndpoints, generatedRouteConfigs, generatedListeners, err := plugin.GeneratedResources(params, clusters, endpoints, routeResources.RouteConfigurations, listeners)
 		if err != nil {
 			reports.AddError(proxy, err)
 		}
 		clusters = append(clusters, generatedClusters...)
 		endpoints = append(endpoints, generatedEndpoints...)
-		routeConfigs = append(routeConfigs, generatedRouteConfigs...)
+		routeResources.RouteConfigurations = append(routeResources.RouteConfigurations, generatedRouteConfigs...)
 		listeners = append(listeners, generatedListeners...)
 	}
+	// run Secret Generator Plugins
+	var secrets []*envoyauth.Secret
+	for _, plugin := range t.pluginRegistry.GetSecretGeneratorPlugins() {
+		generatedSecrets, err := plugin.GeneratedSecrets(params)
+		if err != nil {
+			reports.AddError(proxy, err)
+		}
+		secrets = append(secrets, generatedSecrets...)
+	}
 
-	xdsSnapshot := t.generateXDSSnapshot(params, clusters, endpoints, routeConfigs, listeners)
+	xdsSnapshot := t.generateXDSSnapshot(params, clusters, endpoints, routeResources, listeners, secrets)
 
 	if err := validation.GetProxyError(proxyReport); err != nil {
 		reports.AddError(proxy, err)
@@ -246,16 +257,19 @@ ClusterLoop:
 		endpoints = append(endpoints, emptyEndpointList)
 	}
 
+	logger.Debugf("computing envoy aggregate clusters of upstream groups for proxy: %v", proxy.GetMetadata().GetName())
+	clusters = append(clusters, t.computeUpstreamGroupClusters(params, clusters, endpoints, reports)...)
+
 	return clusters, endpoints
 }
 
 func (t *translatorInstance) translateListenerSubsystemComponents(params plugins.Params, proxy *v1.Proxy, proxyReport *validationapi.ProxyReport) (
-	[]*envoy_config_route_v3.RouteConfiguration,
+	*RouteConfigurationResources,
 	[]*envoy_config_listener_v3.Listener,
 ) {
 	var (
-		routeConfigs []*envoy_config_route_v3.RouteConfiguration
-		listeners    []*envoy_config_listener_v3.Listener
+		routeResources = &RouteConfigurationResources{}
+		listeners      []*envoy_config_listener_v3.Listener
 	)
 
 	logger := contextutils.LoggerFrom(params.Ctx)
@@ -273,7 +287,7 @@ func (t *translatorInstance) translateListenerSubsystemComponents(params plugins
 
 		// 1. Compute RouteConfiguration
 		// This way we call ProcessVirtualHost / ProcessRoute first
-		envoyRouteConfiguration := routeConfigurationTranslator.ComputeRouteConfiguration(params)
+		envoyRouteResources := routeConfigurationTranslator.ComputeRouteConfigurationResources(params)
 
 		// 2. Compute Listener
 		// This way we evaluate HttpFilters second, which allows us to avoid appending an HttpFilter
@@ -282,24 +296,26 @@ func (t *translatorInstance) translateListenerSubsystemComponents(params plugins
 
 		if envoyListener != nil {
 			listeners = append(listeners, envoyListener)
-			if len(envoyRouteConfiguration) > 0 {
-				routeConfigs = append(routeConfigs, envoyRouteConfiguration...)
-			}
+			routeResources.append(envoyRouteResources)
 		}
 	}
 
-	return routeConfigs, listeners
+	return routeResources, listeners
 }
 
 func (t *translatorInstance) generateXDSSnapshot(
 	params plugins.Params,
 	clusters []*envoy_config_cluster_v3.Cluster,
 	endpoints []*envoy_config_endpoint_v3.ClusterLoadAssignment,
-	routeConfigs []*envoy_config_route_v3.RouteConfiguration,
+	routeResources *RouteConfigurationResources,
 	listeners []*envoy_config_listener_v3.Listener,
+	secrets []*envoyauth.Secret,
 ) envoycache.Snapshot {
 	var endpointsProto, clustersProto, listenersProto []envoycache.Resource
 
+	// the listeners only reference the configs of the HttpFilters that are delivered with ECDS
+	extensionConfigs := extractExtensionConfigs(params.Ctx, listeners)
+
 	for _, ep := range endpoints {
 		endpointsProto = append(endpointsProto, resource.NewEnvoyResource(ep))
 	}
@@ -345,8 +361,12 @@ func (t *translatorInstance) generateXDSSnapshot(
 	return xds.NewSnapshotFromResources(
 		endpointsNew,
 		clustersNew,
-		MakeRdsResources(routeConfigs),
-		listenersNew)
+		MakeRdsResources(routeResources.RouteConfigurations),
+		listenersNew,
+		MakeScopedRdsResources(routeResources.ScopedRouteConfigurations),
+		MakeVhdsResources(routeResources.VirtualHosts),
+		MakeEcdsResources(extensionConfigs),
+		MakeSdsResources(secrets))
 }
 
 // deprecated, use EnvoyCacheResourcesListToFnvHash
@@ -395,7 +415,8 @@ func MakeRdsResources(routeConfigs []*envoy_config_route_v3.RouteConfiguration)
 
 	for _, routeCfg := range routeConfigs {
 		// don't add empty route configs, envoy will complain
-		if len(routeCfg.GetVirtualHosts()) < 1 {
+		// route configs that use VHDS have no virtual hosts of their own
+		if len(routeCfg.GetVirtualHosts()) < 1 && routeCfg.GetVhds() == nil {
 			continue
 		}
 		routesProto = append(routesProto, resource.NewEnvoyResource(routeCfg))
@@ -410,6 +431,66 @@ func MakeRdsResources(routeConfigs []*envoy_config_route_v3.RouteConfiguration)
 	return envoycache.NewResources(fmt.Sprintf("%v", routesVersion), routesProto)
 }
 
+func MakeScopedRdsResources(scopedRouteConfigs []*envoy_config_route_v3.ScopedRouteConfiguration) envoycache.Resources {
+	var scopedRoutesProto []envoycache.Resource
+
+	for _, scopedRouteCfg := range scopedRouteConfigs {
+		scopedRoutesProto = append(scopedRoutesProto, xds.NewScopedRouteResource(scopedRouteCfg))
+	}
+
+	scopedRoutesVersion, err := EnvoyCacheResourcesListToFnvHash(scopedRoutesProto)
+	if err != nil {
+		contextutils.LoggerFrom(context.Background()).DPanic(fmt.Sprintf("error trying to hash scopedRoutesProto: %v", err))
+		return envoycache.NewResources("scoped-routes-hashErr", scopedRoutesProto)
+	}
+	return envoycache.NewResources(fmt.Sprintf("%v", scopedRoutesVersion), scopedRoutesProto)
+}
+
+func MakeVhdsResources(virtualHosts []*envoy_config_route_v3.VirtualHost) envoycache.Resources {
+	var virtualHostsProto []envoycache.Resource
+
+	for _, virtualHost := range virtualHosts {
+		virtualHostsProto = append(virtualHostsProto, xds.NewVirtualHostResource(virtualHost))
+	}
+
+	virtualHostsVersion, err := EnvoyCacheResourcesListToFnvHash(virtualHostsProto)
+	if err != nil {
+		contextutils.LoggerFrom(context.Background()).DPanic(fmt.Sprintf("error trying to hash virtualHostsProto: %v", err))
+		return envoycache.NewResources("virtual-hosts-hashErr", virtualHostsProto)
+	}
+	return envoycache.NewResources(fmt.Sprintf("%v", virtualHostsVersion), virtualHostsProto)
+}
+
+func MakeEcdsResources(extensionConfigs []*envoy_config_core_v3.TypedExtensionConfig) envoycache.Resources {
+	var extensionConfigsProto []envoycache.Resource
+
+	for _, extensionConfig := range extensionConfigs {
+		extensionConfigsProto = append(extensionConfigsProto, xds.NewExtensionConfigResource(extensionConfig))
+	}
+
+	extensionConfigsVersion, err := EnvoyCacheResourcesListToFnvHash(extensionConfigsProto)
+	if err != nil {
+		contextutils.LoggerFrom(context.Background()).DPanic(fmt.Sprintf("error trying to hash extensionConfigsProto: %v", err))
+		return envoycache.NewResources("extension-configs-hashErr", extensionConfigsProto)
+	}
+	return envoycache.NewResources(fmt.Sprintf("%v", extensionConfigsVersion), extensionConfigsProto)
+}
+
+func MakeSdsResources(secrets []*envoyauth.Secret) envoycache.Resources {
+	var secretsProto []envoycache.Resource
+
+	for _, secret := range secrets {
+		secretsProto = append(secretsProto, xds.NewSecretResource(secret))
+	}
+
+	secretsVersion, err := EnvoyCacheResourcesListToFnvHash(secretsProto)
+	if err != nil {
+		contextutils.LoggerFrom(context.Background()).DPanic(fmt.Sprintf("error trying to hash secretsProto: %v", err))
+		return envoycache.NewResources("secrets-hashErr", secretsProto)
+	}
+	return envoycache.NewResources(fmt.Sprintf("%v", secretsVersion), secretsProto)
+}
+
 func GetEndpointClusterName(clusterName string, upstream *v1.Upstream) (string, error) {
 	hash, err := upstream.Hash(nil)
 	if err != nil {
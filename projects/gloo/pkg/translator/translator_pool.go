@@ -0,0 +1,46 @@
+package translator
+
+import (
+	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+)
+
+var (
+	_ Translator = new(translatorPool)
+)
+
+// translatorPool is a Translator that can translate as many proxies concurrently as it has translators.
+// A translatorInstance translates a single proxy at a time, since its plugins are re-initialized
+// for each translation, so each translator of the pool must have its own PluginRegistry.
+type translatorPool struct {
+	translators chan Translator
+}
+
+// NewTranslatorPool returns a Translator that translates up to len(translators) proxies concurrently,
+// with the first translator that is not busy. There must be at least one translator.
+func NewTranslatorPool(translators ...Translator) Translator {
+	if len(translators) == 1 {
+		return translators[0]
+	}
+	pool := &translatorPool{
+		translators: make(chan Translator, len(translators)),
+	}
+	for _, t := range translators {
+		pool.translators <- t
+	}
+	return pool
+}
+
+func (p *translatorPool) Translate(
+	params plugins.Params,
+	proxy *v1.Proxy,
+) (envoycache.Snapshot, reporter.ResourceReports, *validationapi.ProxyReport) {
+	t := <-p.translators
+	defer func() {
+		p.translators <- t
+	}()
+	return t.Translate(params, proxy)
+}
@@ -13,6 +13,7 @@ import (
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_extensions_clusters_aggregate_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/clusters/aggregate/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
 	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
@@ -83,6 +84,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/kubernetes"
 	glooutils "github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	validationutils "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	gloohelpers "github.com/solo-io/gloo/test/helpers"
 )
 
@@ -1612,6 +1614,313 @@ var _ = Describe("Translator", func() {
 		})
 	})
 
+	Context("scoped routes", func() {
+
+		httpListener := func(name string, port uint32, domains ...[]string) *v1.Listener {
+			var virtualHosts []*v1.VirtualHost
+			for i, vhostDomains := range domains {
+				virtualHosts = append(virtualHosts, &v1.VirtualHost{
+					Name:    fmt.Sprintf("vhost-%d", i),
+					Domains: vhostDomains,
+					Routes:  routes,
+				})
+			}
+			return &v1.Listener{
+				Name:        name,
+				BindAddress: "127.0.0.1",
+				BindPort:    port,
+				ListenerType: &v1.Listener_HttpListener{
+					HttpListener: &v1.HttpListener{
+						VirtualHosts: virtualHosts,
+					},
+				},
+			}
+		}
+
+		// translateHcm returns the snapshot of the proxy, the HttpConnectionManager of its first listener and the warnings of the proxy
+		translateHcm := func(proxy *v1.Proxy) (envoycache.Snapshot, *envoyhttp.HttpConnectionManager, []string) {
+			snap, errs, _ := translator.Translate(params, proxy)
+			ExpectWithOffset(1, errs.Validate()).NotTo(HaveOccurred())
+
+			listenerResource := snap.GetResources(types.ListenerTypeV3).Items[proxy.GetListeners()[0].GetName()]
+			hcmFilter := listenerResource.ResourceProto().(*envoy_config_listener_v3.Listener).GetFilterChains()[0].GetFilters()[0]
+			hcm := &envoyhttp.HttpConnectionManager{}
+			ExpectWithOffset(1, ParseTypedConfig(hcmFilter, hcm)).NotTo(HaveOccurred())
+			return snap, hcm, errs[proxy].Warnings
+		}
+
+		BeforeEach(func() {
+			settings.Gloo = &v1.GlooOptions{ScopedRoutesHeader: ":authority"}
+		})
+
+		It("splits the routes of a proxy with a single http listener into scopes", func() {
+			proxy.Listeners = []*v1.Listener{
+				httpListener("http-listener", 80, []string{"a.com", "www.a.com"}, []string{"b.com"}),
+			}
+
+			snap, hcm, warnings := translateHcm(proxy)
+
+			Expect(warnings).To(BeEmpty())
+
+			Expect(hcm.GetRds()).To(BeNil())
+			Expect(hcm.GetScopedRoutes().GetName()).To(Equal("http-listener-routes"))
+			Expect(hcm.GetScopedRoutes().GetScopedRds().GetScopedRdsConfigSource().GetAds()).NotTo(BeNil())
+			Expect(hcm.GetScopedRoutes().GetRdsConfigSource().GetAds()).NotTo(BeNil())
+			fragments := hcm.GetScopedRoutes().GetScopeKeyBuilder().GetFragments()
+			Expect(fragments).To(HaveLen(1))
+			Expect(fragments[0].GetHeaderValueExtractor().GetName()).To(Equal(":authority"))
+			Expect(fragments[0].GetHeaderValueExtractor().GetElementSeparator()).To(Equal(":"))
+			Expect(fragments[0].GetHeaderValueExtractor().GetIndex()).To(BeZero())
+
+			routeConfigs := snap.GetResources(types.RouteTypeV3).Items
+			Expect(routeConfigs).To(HaveLen(2))
+			Expect(routeConfigs).To(HaveKey("http-listener-routes-vhost-0"))
+			Expect(routeConfigs).To(HaveKey("http-listener-routes-vhost-1"))
+			vhosts := routeConfigs["http-listener-routes-vhost-1"].ResourceProto().(*envoy_config_route_v3.RouteConfiguration).GetVirtualHosts()
+			Expect(vhosts).To(HaveLen(1))
+			Expect(vhosts[0].GetDomains()).To(ConsistOf("b.com"))
+
+			scopedRoutes := snap.GetResources(xds.ScopedRouteTypeV3).Items
+			Expect(scopedRoutes).To(HaveLen(3))
+			expectScope := func(domain, routeConfigName string) {
+				scopedRoute := scopedRoutes["http-listener-routes-"+domain].ResourceProto().(*envoy_config_route_v3.ScopedRouteConfiguration)
+				ExpectWithOffset(1, scopedRoute.GetRouteConfigurationName()).To(Equal(routeConfigName))
+				ExpectWithOffset(1, scopedRoute.GetKey().GetFragments()).To(HaveLen(1))
+				ExpectWithOffset(1, scopedRoute.GetKey().GetFragments()[0].GetStringKey()).To(Equal(domain))
+			}
+			expectScope("a.com", "http-listener-routes-vhost-0")
+			expectScope("www.a.com", "http-listener-routes-vhost-0")
+			expectScope("b.com", "http-listener-routes-vhost-1")
+		})
+
+		It("leaves the port of the domains out of the scope keys", func() {
+			proxy.Listeners = []*v1.Listener{
+				httpListener("http-listener", 80, []string{"a.com", "a.com:8080"}, []string{"b.com:8080"}),
+			}
+
+			snap, _, warnings := translateHcm(proxy)
+
+			Expect(warnings).To(BeEmpty())
+
+			scopedRoutes := snap.GetResources(xds.ScopedRouteTypeV3).Items
+			Expect(scopedRoutes).To(HaveLen(2))
+			scopedRoute := scopedRoutes["http-listener-routes-a.com"].ResourceProto().(*envoy_config_route_v3.ScopedRouteConfiguration)
+			Expect(scopedRoute.GetRouteConfigurationName()).To(Equal("http-listener-routes-vhost-0"))
+			Expect(scopedRoute.GetKey().GetFragments()[0].GetStringKey()).To(Equal("a.com"))
+			scopedRoute = scopedRoutes["http-listener-routes-b.com"].ResourceProto().(*envoy_config_route_v3.ScopedRouteConfiguration)
+			Expect(scopedRoute.GetRouteConfigurationName()).To(Equal("http-listener-routes-vhost-1"))
+			Expect(scopedRoute.GetKey().GetFragments()[0].GetStringKey()).To(Equal("b.com"))
+		})
+
+		It("doesn't scope virtual hosts whose domains only differ by their port", func() {
+			proxy.Listeners = []*v1.Listener{
+				httpListener("http-listener", 80, []string{"a.com:80"}, []string{"a.com:8080"}),
+			}
+
+			snap, hcm, warnings := translateHcm(proxy)
+
+			Expect(warnings).To(ConsistOf(ContainSubstring(`not using scoped routes: virtual hosts vhost-0 and vhost-1 have domains with the same host "a.com"`)))
+
+			Expect(hcm.GetScopedRoutes()).To(BeNil())
+			Expect(snap.GetResources(xds.ScopedRouteTypeV3).Items).To(BeEmpty())
+		})
+
+		Context("with a header other than the host", func() {
+
+			BeforeEach(func() {
+				settings.Gloo.ScopedRoutesHeader = "x-tenant"
+			})
+
+			It("keeps the port of the domains in the scope keys", func() {
+				proxy.Listeners = []*v1.Listener{
+					httpListener("http-listener", 80, []string{"a", "a:1"}),
+				}
+
+				snap, hcm, warnings := translateHcm(proxy)
+
+				Expect(warnings).To(BeEmpty())
+
+				Expect(hcm.GetScopedRoutes().GetScopeKeyBuilder().GetFragments()[0].GetHeaderValueExtractor().GetElementSeparator()).To(BeEmpty())
+				scopedRoutes := snap.GetResources(xds.ScopedRouteTypeV3).Items
+				Expect(scopedRoutes).To(HaveLen(2))
+				Expect(scopedRoutes).To(HaveKey("http-listener-routes-a"))
+				Expect(scopedRoutes).To(HaveKey("http-listener-routes-a:1"))
+			})
+		})
+
+		It("doesn't scope virtual hosts with wildcard domains", func() {
+			proxy.Listeners = []*v1.Listener{
+				httpListener("http-listener", 80, []string{"a.com"}, []string{"*.b.com"}),
+			}
+
+			snap, hcm, warnings := translateHcm(proxy)
+
+			Expect(warnings).To(ConsistOf(ContainSubstring(`not using scoped routes: virtual host vhost-1 has wildcard domain "*.b.com"`)))
+
+			Expect(hcm.GetScopedRoutes()).To(BeNil())
+			Expect(hcm.GetRds().GetRouteConfigName()).To(Equal("http-listener-routes"))
+			Expect(snap.GetResources(types.RouteTypeV3).Items).To(HaveKey("http-listener-routes"))
+			Expect(snap.GetResources(xds.ScopedRouteTypeV3).Items).To(BeEmpty())
+		})
+
+		It("doesn't scope the routes of a proxy with more than one http listener", func() {
+			// Envoy would give the scopes of each listener to both listeners
+			proxy.Listeners = []*v1.Listener{
+				httpListener("http-listener", 80, []string{"a.com"}),
+				httpListener("other-http-listener", 81, []string{"b.com"}),
+			}
+
+			snap, hcm, warnings := translateHcm(proxy)
+
+			// each listener is warned
+			Expect(warnings).To(HaveLen(2))
+			Expect(warnings).To(HaveEach(ContainSubstring("not using scoped routes: the proxy has 2 http connection managers")))
+
+			Expect(hcm.GetScopedRoutes()).To(BeNil())
+			Expect(snap.GetResources(types.RouteTypeV3).Items).To(HaveLen(2))
+			Expect(snap.GetResources(xds.ScopedRouteTypeV3).Items).To(BeEmpty())
+		})
+	})
+
+	Context("virtual host discovery", func() {
+
+		const xdsCluster = "gloo.gloo-system.svc.cluster.local:9977"
+
+		BeforeEach(func() {
+			settings.Gloo = &v1.GlooOptions{VirtualHostDiscoveryCluster: xdsCluster}
+		})
+
+		It("serves the virtual hosts of the route configuration with VHDS", func() {
+			snap, errs, _ := translator.Translate(params, proxy)
+			Expect(errs.Validate()).NotTo(HaveOccurred())
+
+			routeConfig := snap.GetResources(types.RouteTypeV3).Items["http-listener-routes"].ResourceProto().(*envoy_config_route_v3.RouteConfiguration)
+			Expect(routeConfig.GetVirtualHosts()).To(BeEmpty())
+			apiConfigSource := routeConfig.GetVhds().GetConfigSource().GetApiConfigSource()
+			Expect(apiConfigSource.GetApiType()).To(Equal(envoy_config_core_v3.ApiConfigSource_DELTA_GRPC))
+			Expect(apiConfigSource.GetGrpcServices()).To(HaveLen(1))
+			Expect(apiConfigSource.GetGrpcServices()[0].GetEnvoyGrpc().GetClusterName()).To(Equal(xdsCluster))
+
+			// the http listener and the http listener of the hybrid listener each have a virtual host
+			virtualHosts := snap.GetResources(xds.VirtualHostTypeV3).Items
+			Expect(virtualHosts).To(HaveLen(2))
+			Expect(virtualHosts).To(HaveKey("http-listener-routes/virt1"))
+			virtualHost := virtualHosts["http-listener-routes/virt1"].ResourceProto().(*envoy_config_route_v3.VirtualHost)
+			Expect(virtualHost.GetRoutes()).NotTo(BeEmpty())
+
+			listener := snap.GetResources(types.ListenerTypeV3).Items["http-listener"].ResourceProto().(*envoy_config_listener_v3.Listener)
+			hcm := &envoyhttp.HttpConnectionManager{}
+			Expect(ParseTypedConfig(listener.GetFilterChains()[0].GetFilters()[0], hcm)).NotTo(HaveOccurred())
+			Expect(hcm.GetHttpFilters()[0].GetName()).To(Equal(OnDemandFilterName))
+		})
+
+		Context("with scoped routes", func() {
+
+			BeforeEach(func() {
+				settings.Gloo.ScopedRoutesHeader = ":authority"
+			})
+
+			It("doesn't use VHDS", func() {
+				httpListener := proxy.GetListeners()[0]
+				httpListener.GetHttpListener().GetVirtualHosts()[0].Domains = []string{"a.com"}
+				proxy.Listeners = []*v1.Listener{httpListener}
+
+				snap, errs, _ := translator.Translate(params, proxy)
+				Expect(errs.Validate()).NotTo(HaveOccurred())
+
+				Expect(snap.GetResources(xds.ScopedRouteTypeV3).Items).NotTo(BeEmpty())
+				Expect(snap.GetResources(xds.VirtualHostTypeV3).Items).To(BeEmpty())
+				for _, routeConfig := range snap.GetResources(types.RouteTypeV3).Items {
+					Expect(routeConfig.ResourceProto().(*envoy_config_route_v3.RouteConfiguration).GetVhds()).To(BeNil())
+				}
+			})
+		})
+	})
+
+	Context("extension config discovery", func() {
+
+		const filterName = "my-custom-http-filter"
+
+		customHttpFilter := func(config string) []*v1.CustomEnvoyFilter {
+			return []*v1.CustomEnvoyFilter{{
+				FilterStage: &filters.FilterStage{
+					Stage: filters.FilterStage_AuthZStage,
+				},
+				Name: filterName,
+				Config: &anypb.Any{
+					TypeUrl: "type.googleapis.com/testing.config.TestHTTPFilter",
+					Value:   []byte(config),
+				},
+			}}
+		}
+
+		// getHttpFilter returns the custom HttpFilter of the HttpConnectionManager of a filter chain of a listener
+		getHttpFilter := func(snap envoycache.Snapshot, listenerName string, filterChain int) *envoyhttp.HttpFilter {
+			listener := snap.GetResources(types.ListenerTypeV3).Items[listenerName].ResourceProto().(*envoy_config_listener_v3.Listener)
+			for _, filter := range listener.GetFilterChains()[filterChain].GetFilters() {
+				if filter.GetName() != wellknown.HTTPConnectionManager {
+					continue
+				}
+				hcm := &envoyhttp.HttpConnectionManager{}
+				ExpectWithOffset(1, ParseTypedConfig(filter, hcm)).NotTo(HaveOccurred())
+				for _, httpFilter := range hcm.GetHttpFilters() {
+					if httpFilter.GetName() == filterName || httpFilter.GetName() == listenerName+"/"+filterName {
+						return httpFilter
+					}
+				}
+			}
+			return nil
+		}
+
+		BeforeEach(func() {
+			settings.Gloo = &v1.GlooOptions{ExtensionConfigDiscoveryHttpFilters: []string{filterName}}
+		})
+
+		It("serves the config of the filter with ECDS", func() {
+			proxy.GetListeners()[0].GetHttpListener().CustomHttpFilters = customHttpFilter("config")
+			proxy.GetListeners()[2].GetHybridListener().GetMatchedListeners()[1].GetHttpListener().CustomHttpFilters = customHttpFilter("config")
+
+			snap, errs, _ := translator.Translate(params, proxy)
+			Expect(errs.Validate()).NotTo(HaveOccurred())
+
+			// each listener fetches its own config
+			extensionConfigs := snap.GetResources(xds.ExtensionConfigTypeV3).Items
+			Expect(extensionConfigs).To(HaveLen(2))
+			for _, listenerName := range []string{"http-listener", "hybrid-listener"} {
+				Expect(extensionConfigs).To(HaveKey(listenerName + "/" + filterName))
+				extensionConfig := extensionConfigs[listenerName+"/"+filterName].ResourceProto().(*envoy_config_core_v3.TypedExtensionConfig)
+				Expect(extensionConfig.GetTypedConfig().GetValue()).To(Equal([]byte("config")))
+			}
+
+			// both listeners only reference their config
+			for listenerName, httpFilter := range map[string]*envoyhttp.HttpFilter{
+				"http-listener":   getHttpFilter(snap, "http-listener", 0),
+				"hybrid-listener": getHttpFilter(snap, "hybrid-listener", 1),
+			} {
+				Expect(httpFilter.GetName()).To(Equal(listenerName + "/" + filterName))
+				Expect(httpFilter.GetTypedConfig()).To(BeNil())
+				Expect(httpFilter.GetConfigDiscovery().GetConfigSource().GetAds()).NotTo(BeNil())
+				Expect(httpFilter.GetConfigDiscovery().GetDefaultConfig()).To(BeNil())
+				Expect(httpFilter.GetConfigDiscovery().GetTypeUrls()).To(ConsistOf("type.googleapis.com/testing.config.TestHTTPFilter"))
+			}
+		})
+
+		It("serves the config of each listener when listeners configure the filter differently", func() {
+			proxy.GetListeners()[0].GetHttpListener().CustomHttpFilters = customHttpFilter("config")
+			proxy.GetListeners()[2].GetHybridListener().GetMatchedListeners()[1].GetHttpListener().CustomHttpFilters = customHttpFilter("other-config")
+
+			snap, errs, _ := translator.Translate(params, proxy)
+			Expect(errs.Validate()).NotTo(HaveOccurred())
+
+			extensionConfigs := snap.GetResources(xds.ExtensionConfigTypeV3).Items
+			Expect(extensionConfigs).To(HaveLen(2))
+			extensionConfig := extensionConfigs["hybrid-listener/"+filterName].ResourceProto().(*envoy_config_core_v3.TypedExtensionConfig)
+			Expect(extensionConfig.GetTypedConfig().GetValue()).To(Equal([]byte("other-config")))
+			Expect(getHttpFilter(snap, "http-listener", 0).GetConfigDiscovery()).NotTo(BeNil())
+			Expect(getHttpFilter(snap, "hybrid-listener", 1).GetConfigDiscovery()).NotTo(BeNil())
+		})
+	})
+
 	Context("when handling cluster_header HTTP header name", func() {
 		Context("with valid http header", func() {
 			BeforeEach(func() {
@@ -1768,6 +2077,88 @@ var _ = Describe("Translator", func() {
 			Expect(clusters.Clusters[0].Name).To(Equal(UpstreamToClusterName(upstream.Metadata.Ref())))
 			Expect(clusters.Clusters[1].Name).To(Equal(UpstreamToClusterName(upstream2.Metadata.Ref())))
 		})
+
+		Context("with failover", func() {
+
+			aggregateCluster := func(name string) []string {
+				clusterResource, ok := snapshot.GetResources(types.ClusterTypeV3).Items[name]
+				ExpectWithOffset(1, ok).To(BeTrue())
+				c := clusterResource.ResourceProto().(*envoy_config_cluster_v3.Cluster)
+				ExpectWithOffset(1, c.GetClusterType().GetName()).To(Equal(AggregateClusterType))
+				ExpectWithOffset(1, c.GetLbPolicy()).To(Equal(envoy_config_cluster_v3.Cluster_CLUSTER_PROVIDED))
+				cfg := &envoy_extensions_clusters_aggregate_v3.ClusterConfig{}
+				ExpectWithOffset(1, c.GetClusterType().GetTypedConfig().UnmarshalTo(cfg)).NotTo(HaveOccurred())
+				return cfg.GetClusters()
+			}
+
+			BeforeEach(func() {
+				upstreamGroup.Destinations[1].Weight = &wrappers.UInt32Value{Value: 3}
+				upstreamGroup.Failover = &v1.UpstreamGroup_Failover{}
+			})
+
+			It("should route the share of each destination to an aggregate cluster that fails over to the others", func() {
+				translate()
+
+				clusters := routeConfiguration.VirtualHosts[0].Routes[0].GetRoute().GetWeightedClusters()
+				Expect(clusters).ToNot(BeNil())
+				Expect(clusters.TotalWeight.Value).To(BeEquivalentTo(4))
+				Expect(clusters.Clusters).To(HaveLen(2))
+				Expect(clusters.Clusters[0].Name).To(Equal(UpstreamGroupDestinationToClusterName(upstreamGroup.Metadata.Ref(), 0)))
+				Expect(clusters.Clusters[0].Weight.Value).To(BeEquivalentTo(1))
+				Expect(clusters.Clusters[1].Name).To(Equal(UpstreamGroupDestinationToClusterName(upstreamGroup.Metadata.Ref(), 1)))
+				Expect(clusters.Clusters[1].Weight.Value).To(BeEquivalentTo(3))
+
+				upstreamCluster := UpstreamToClusterName(upstream.Metadata.Ref())
+				upstream2Cluster := UpstreamToClusterName(upstream2.Metadata.Ref())
+				Expect(aggregateCluster(clusters.Clusters[0].Name)).To(Equal([]string{upstreamCluster, upstream2Cluster}))
+				Expect(aggregateCluster(clusters.Clusters[1].Name)).To(Equal([]string{upstream2Cluster, upstreamCluster}))
+			})
+
+			It("should route to an aggregate cluster of the destinations in priority order", func() {
+				upstreamGroup.Failover.Mode = v1.UpstreamGroup_Failover_PRIORITY
+
+				translate()
+
+				route := routeConfiguration.VirtualHosts[0].Routes[0].GetRoute()
+				Expect(route.GetWeightedClusters()).To(BeNil())
+				Expect(route.GetCluster()).To(Equal(UpstreamGroupToClusterName(upstreamGroup.Metadata.Ref())))
+				Expect(aggregateCluster(route.GetCluster())).To(Equal([]string{
+					UpstreamToClusterName(upstream.Metadata.Ref()),
+					UpstreamToClusterName(upstream2.Metadata.Ref()),
+				}))
+			})
+
+			It("should set the overprovisioning factor on the load assignments of the destinations", func() {
+				upstreamGroup.Failover.OverprovisioningFactor = &wrappers.UInt32Value{Value: 120}
+
+				translate()
+
+				Expect(cluster.GetLoadAssignment().GetPolicy().GetOverprovisioningFactor().GetValue()).To(BeEquivalentTo(120))
+				clusterResource := snapshot.GetResources(types.ClusterTypeV3).Items[UpstreamToClusterName(upstream2.Metadata.Ref())]
+				cluster2 := clusterResource.ResourceProto().(*envoy_config_cluster_v3.Cluster)
+				Expect(cluster2.GetLoadAssignment().GetPolicy().GetOverprovisioningFactor().GetValue()).To(BeEquivalentTo(120))
+			})
+
+			It("should error when upstream groups set different overprovisioning factors on an upstream", func() {
+				upstreamGroup.Failover.OverprovisioningFactor = &wrappers.UInt32Value{Value: 120}
+				upstreamGroup2 := proto.Clone(upstreamGroup).(*v1.UpstreamGroup)
+				upstreamGroup2.Metadata.Name = "test2"
+				upstreamGroup2.Failover.OverprovisioningFactor = &wrappers.UInt32Value{Value: 200}
+				params.Snapshot.UpstreamGroups = append(params.Snapshot.UpstreamGroups, upstreamGroup2)
+
+				_, errs, _ := translator.Translate(params, proxy)
+				Expect(errs.Validate()).To(MatchError(ContainSubstring("destination # 1: another upstream group sets a different overprovisioning factor (120) on upstream gloo-system.test")))
+			})
+
+			It("should error when a destination selects a subset", func() {
+				upstreamGroup.Destinations[1].Destination.Subset = &v1.Subset{
+					Values: map[string]string{"version": "v1"},
+				}
+
+				_, errs, _ := translator.Translate(params, proxy)
+				Expect(errs.Validate()).To(MatchError(ContainSubstring("destination # 2: destinations of upstream groups with failover cannot select subsets")))
+			})
+		})
 	})
 
 	Context("when handling missing upstream groups", func() {
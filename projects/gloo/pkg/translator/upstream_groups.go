@@ -1,16 +1,58 @@
 package translator
 
 import (
+	"fmt"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_extensions_clusters_aggregate_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/clusters/aggregate/v3"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	usconversions "github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 )
 
+const (
+	// UpstreamGroupClusterPrefix prefixes the names of the aggregate clusters of the upstream groups with failover
+	UpstreamGroupClusterPrefix = "upstream-group:"
+
+	AggregateClusterType = "envoy.clusters.aggregate"
+)
+
+// UpstreamGroupToClusterName returns the name of the aggregate cluster of an upstream group in PRIORITY failover mode.
+func UpstreamGroupToClusterName(upstreamGroup *core.ResourceRef) string {
+	return UpstreamGroupClusterPrefix + UpstreamToClusterName(upstreamGroup)
+}
+
+// UpstreamGroupDestinationToClusterName returns the name of the aggregate cluster of the destination with the given
+// index of an upstream group in WEIGHTED failover mode.
+func UpstreamGroupDestinationToClusterName(upstreamGroup *core.ResourceRef, destinationIndex int) string {
+	return fmt.Sprintf("%s_%d", UpstreamGroupToClusterName(upstreamGroup), destinationIndex)
+}
+
+// DefaultUpstreamGroupNamespaces sets the namespace of the upstream destinations of the upstream groups that don't
+// have one to the namespace of their upstream group.
+// Translate does it too, so it must be called before the proxies of a snapshot are translated concurrently.
+func DefaultUpstreamGroupNamespaces(upstreamGroups v1.UpstreamGroupList) {
+	for _, ug := range upstreamGroups {
+		for _, dest := range ug.GetDestinations() {
+			if upstream := dest.GetDestination().GetUpstream(); upstream != nil && upstream.GetNamespace() == "" {
+				upstream.Namespace = ug.GetMetadata().GetNamespace()
+			}
+		}
+	}
+}
+
 func (t *translatorInstance) verifyUpstreamGroups(params plugins.Params, reports reporter.ResourceReports) {
 
 	upstreams := params.Snapshot.Upstreams
 	upstreamGroups := params.Snapshot.UpstreamGroups
+	DefaultUpstreamGroupNamespaces(upstreamGroups)
 
 	for _, ug := range upstreamGroups {
 		for i, dest := range ug.GetDestinations() {
@@ -19,11 +61,6 @@ func (t *translatorInstance) verifyUpstreamGroups(params plugins.Params, reports
 				continue
 			}
 
-			if upstream := dest.GetDestination().GetUpstream(); upstream != nil && upstream.GetNamespace() == "" {
-				parentMetadata := ug.GetMetadata()
-				upstream.Namespace = parentMetadata.GetNamespace()
-			}
-
 			upRef, err := usconversions.DestinationToUpstreamRef(dest.GetDestination())
 			if err != nil {
 				reports.AddError(ug, err)
@@ -34,8 +71,156 @@ func (t *translatorInstance) verifyUpstreamGroups(params plugins.Params, reports
 				reports.AddError(ug, errors.Wrapf(err, "destination # %d: upstream not found", i+1))
 				continue
 			}
+
+			if failover := ug.GetFailover(); failover != nil {
+				// aggregate clusters load balance over the hosts of their clusters, so they cannot match subsets
+				consulDest := dest.GetDestination().GetConsul()
+				if dest.GetDestination().GetSubset() != nil || len(consulDest.GetTags()) > 0 || len(consulDest.GetDataCenters()) > 0 {
+					reports.AddError(ug, errors.Errorf("destination # %d: destinations of upstream groups with failover cannot select subsets", i+1))
+					continue
+				}
+				if failover.GetMode() == v1.UpstreamGroup_Failover_PRIORITY && dest.GetOptions() != nil {
+					reports.AddWarning(ug, fmt.Sprintf("destination # %d: destination options are ignored in PRIORITY failover mode", i+1))
+				}
+			}
+		}
+
+	}
+
+}
+
+// computeUpstreamGroupClusters returns the aggregate clusters of the upstream groups with failover, and sets their
+// overprovisioning factor on the load assignments of the clusters of their destinations.
+func (t *translatorInstance) computeUpstreamGroupClusters(
+	params plugins.Params,
+	clusters []*envoy_config_cluster_v3.Cluster,
+	endpoints []*envoy_config_endpoint_v3.ClusterLoadAssignment,
+	reports reporter.ResourceReports,
+) []*envoy_config_cluster_v3.Cluster {
+	clustersByName := make(map[string]*envoy_config_cluster_v3.Cluster, len(clusters))
+	for _, c := range clusters {
+		clustersByName[c.GetName()] = c
+	}
+	endpointsByName := make(map[string][]*envoy_config_endpoint_v3.ClusterLoadAssignment, len(endpoints))
+	for _, ep := range endpoints {
+		endpointsByName[ep.GetClusterName()] = append(endpointsByName[ep.GetClusterName()], ep)
+	}
+	// the overprovisioning factor applies to the clusters of the upstreams, which upstream groups may share
+	overprovisioningFactors := make(map[string]uint32)
+
+	var aggregateClusters []*envoy_config_cluster_v3.Cluster
+	for _, ug := range params.Snapshot.UpstreamGroups {
+		failover := ug.GetFailover()
+		if failover == nil {
+			continue
+		}
+
+		// the cluster of each destination, empty when the destination is invalid, which verifyUpstreamGroups reports
+		destinationClusters := make([]string, len(ug.GetDestinations()))
+		for i, dest := range ug.GetDestinations() {
+			usRef, err := usconversions.DestinationToUpstreamRef(dest.GetDestination())
+			if err != nil {
+				continue
+			}
+			c, ok := clustersByName[UpstreamToClusterName(usRef)]
+			if !ok {
+				continue
+			}
+			destinationClusters[i] = c.GetName()
+
+			factor := failover.GetOverprovisioningFactor()
+			if factor == nil {
+				continue
+			}
+			if existing, ok := overprovisioningFactors[c.GetName()]; ok {
+				if existing != factor.GetValue() {
+					reports.AddError(ug, errors.Errorf("destination # %d: another upstream group sets a different overprovisioning factor (%d) on upstream %s", i+1, existing, usRef.Key()))
+				}
+				continue
+			}
+			overprovisioningFactors[c.GetName()] = factor.GetValue()
+			setOverprovisioningFactor(c, endpointsByName, factor.GetValue())
+		}
+
+		ugRef := ug.GetMetadata().Ref()
+		switch failover.GetMode() {
+		case v1.UpstreamGroup_Failover_PRIORITY:
+			aggregateCluster, err := newAggregateCluster(UpstreamGroupToClusterName(ugRef), destinationClusters)
+			if err != nil {
+				reports.AddError(ug, err)
+				continue
+			}
+			aggregateClusters = append(aggregateClusters, aggregateCluster)
+		default:
+			// each destination fails over to the others, in order, for its share of the traffic
+			for i, destinationCluster := range destinationClusters {
+				if destinationCluster == "" {
+					continue
+				}
+				priorities := append([]string{destinationCluster}, destinationClusters...)
+				aggregateCluster, err := newAggregateCluster(UpstreamGroupDestinationToClusterName(ugRef, i), priorities)
+				if err != nil {
+					reports.AddError(ug, err)
+					continue
+				}
+				aggregateClusters = append(aggregateClusters, aggregateCluster)
+			}
 		}
+	}
+	return aggregateClusters
+}
 
+func setOverprovisioningFactor(
+	c *envoy_config_cluster_v3.Cluster,
+	endpointsByName map[string][]*envoy_config_endpoint_v3.ClusterLoadAssignment,
+	factor uint32,
+) {
+	loadAssignments := endpointsByName[c.GetEdsClusterConfig().GetServiceName()]
+	if c.GetType() != envoy_config_cluster_v3.Cluster_EDS {
+		loadAssignments = nil
+		if c.GetLoadAssignment() != nil {
+			loadAssignments = append(loadAssignments, c.GetLoadAssignment())
+		}
 	}
+	for _, loadAssignment := range loadAssignments {
+		if loadAssignment.GetPolicy() == nil {
+			loadAssignment.Policy = &envoy_config_endpoint_v3.ClusterLoadAssignment_Policy{}
+		}
+		loadAssignment.GetPolicy().OverprovisioningFactor = &wrappers.UInt32Value{Value: factor}
+	}
+}
 
+// newAggregateCluster returns an aggregate cluster of the given clusters, in priority order.
+// Skips the empty and duplicate cluster names.
+func newAggregateCluster(name string, clusterNames []string) (*envoy_config_cluster_v3.Cluster, error) {
+	var priorities []string
+	seen := make(map[string]bool, len(clusterNames))
+	for _, clusterName := range clusterNames {
+		if clusterName == "" || seen[clusterName] {
+			continue
+		}
+		seen[clusterName] = true
+		priorities = append(priorities, clusterName)
+	}
+	if len(priorities) == 0 {
+		return nil, NoDestinationSpecifiedError
+	}
+
+	typedConfig, err := utils.MessageToAny(&envoy_extensions_clusters_aggregate_v3.ClusterConfig{
+		Clusters: priorities,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_config_cluster_v3.Cluster{
+		Name:           name,
+		ConnectTimeout: ptypes.DurationProto(ClusterConnectionTimeout),
+		LbPolicy:       envoy_config_cluster_v3.Cluster_CLUSTER_PROVIDED,
+		ClusterDiscoveryType: &envoy_config_cluster_v3.Cluster_ClusterType{
+			ClusterType: &envoy_config_cluster_v3.Cluster_CustomClusterType{
+				Name:        AggregateClusterType,
+				TypedConfig: typedConfig,
+			},
+		},
+	}, nil
 }
@@ -158,7 +158,7 @@ func (c *consul) validateDataCenter(dataCenter string) error {
 	}
 
 	// If empty, the Consul client will use the default agent data center, which we should allow
-	if _, ok := c.dataCenters[dataCenter]; dataCenter != "" && ok {
+	if _, ok := c.dataCenters[dataCenter]; dataCenter != "" && !ok {
 		return ForbiddenDataCenterErr(dataCenter)
 	}
 	return nil
@@ -92,6 +92,26 @@ var _ = Describe("ClientWrapper", func() {
 				Expect(responseServices).To(Equal(services))
 			})
 		})
+
+		Context("When Filtering By Data Centers", func() {
+			var (
+				client ClientWrapper
+			)
+			BeforeEach(func() {
+				client, _ = NewFilteredConsulClient(mockClient, []string{"dc1"}, nil)
+			})
+
+			It("queries allowed data centers", func() {
+				mockClient.EXPECT().Services(gomock.Any()).Return(services, &api.QueryMeta{}, nil)
+				_, _, err := client.Services(&api.QueryOptions{Datacenter: "dc1"})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not query other data centers", func() {
+				_, _, err := client.Services(&api.QueryOptions{Datacenter: "dc2"})
+				Expect(err).To(MatchError(ForbiddenDataCenterErr("dc2").Error()))
+			})
+		})
 	})
 
 })
@@ -5,13 +5,14 @@ import (
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/nomad"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
 // isRealUpstream returns true if the given upstream name refers to a "real" upstream that is
 // stored in etcd (as opposed a "fake"/in-memory upstream)
 func isRealUpstream(upstreamName string) bool {
-	return !(kubernetes.IsFakeKubeUpstream(upstreamName) || consul.IsConsulUpstream(upstreamName))
+	return !(kubernetes.IsFakeKubeUpstream(upstreamName) || consul.IsConsulUpstream(upstreamName) || nomad.IsNomadUpstream(upstreamName))
 }
 
 // DestinationToUpstreamRef converts a route destination to an upstream ref. The upstream ref may
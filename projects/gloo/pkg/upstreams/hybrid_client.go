@@ -12,6 +12,7 @@ import (
 	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/nomad"
 	"golang.org/x/sync/errgroup"
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -25,6 +26,7 @@ const (
 	sourceGloo           = "gloo"
 	sourceKube           = "kube"
 	sourceConsul         = "consul"
+	sourceNomad          = "nomad"
 	notImplementedErrMsg = "this operation is not supported by this client"
 )
 
@@ -37,6 +39,7 @@ func NewHybridUpstreamClient(
 	upstreamClient v1.UpstreamClient,
 	serviceClient skkube.ServiceClient,
 	consulClient consul.ConsulWatcher,
+	nomadClient nomad.NomadWatcher,
 	settings *v1.Settings) (v1.UpstreamClient, error) {
 
 	clientMap := make(map[string]v1.UpstreamClient)
@@ -54,6 +57,10 @@ func NewHybridUpstreamClient(
 		clientMap[sourceConsul] = consul.NewConsulUpstreamClient(consulClient, settings.GetConsulDiscovery())
 	}
 
+	if nomadClient != nil {
+		clientMap[sourceNomad] = nomad.NewNomadUpstreamClient(nomadClient)
+	}
+
 	return &hybridUpstreamClient{
 		clientMap: clientMap,
 	}, nil
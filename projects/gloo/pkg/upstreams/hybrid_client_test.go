@@ -93,6 +93,7 @@ var _ = Describe("Hybrid Upstream Client", func() {
 			svcClient,
 			consul.NewConsulWatcherFromClient(mockInternalConsulClient),
 			nil,
+			nil,
 		)
 		Expect(err).NotTo(HaveOccurred())
 	})
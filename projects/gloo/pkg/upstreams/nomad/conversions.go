@@ -0,0 +1,50 @@
+package nomad
+
+import (
+	"strings"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	nomadplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/nomad"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const UpstreamNamePrefix = "nomad-svc:"
+
+func IsNomadUpstream(upstreamName string) bool {
+	return strings.HasPrefix(upstreamName, UpstreamNamePrefix)
+}
+
+// the names of Nomad services are unique in their namespace
+func fakeUpstreamName(nomadSvcName, nomadNamespace string) string {
+	return UpstreamNamePrefix + nomadSvcName + "." + nomadNamespace
+}
+
+// Creates an upstream for each service in the list
+func toUpstreamList(forNamespace string, services []*ServiceMeta) v1.UpstreamList {
+	var results v1.UpstreamList
+	for _, svc := range services {
+		upstream := CreateUpstreamFromService(svc)
+		if forNamespace != "" && upstream.GetMetadata().GetNamespace() != forNamespace {
+			continue
+		}
+		results = append(results, upstream)
+	}
+	return results.Sort()
+}
+
+func CreateUpstreamFromService(service *ServiceMeta) *v1.Upstream {
+	return &v1.Upstream{
+		Metadata: &core.Metadata{
+			Name:      fakeUpstreamName(service.Name, service.Namespace),
+			Namespace: defaults.GlooSystem,
+		},
+		UpstreamType: &v1.Upstream_Nomad{
+			Nomad: &nomadplugin.UpstreamSpec{
+				ServiceName: service.Name,
+				Namespace:   service.Namespace,
+				ServiceTags: service.Tags,
+			},
+		},
+	}
+}
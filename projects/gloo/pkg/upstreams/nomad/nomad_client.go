@@ -0,0 +1,254 @@
+package nomad
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rotisserie/eris"
+)
+
+const (
+	// DefaultAddress is the address of the HTTP API of a local Nomad agent
+	DefaultAddress = "http://127.0.0.1:4646"
+	// DefaultNamespace is the namespace Nomad registers services in when the job does not set one
+	DefaultNamespace = "default"
+
+	// the wildcard namespace lists the services of all the namespaces the token can read
+	allNamespaces = "*"
+
+	tokenHeader = "X-Nomad-Token"
+	indexHeader = "X-Nomad-Index"
+)
+
+// ServiceStub is a service registered in a Nomad namespace, with the tags of all its registrations
+type ServiceStub struct {
+	ServiceName string
+	Tags        []string
+}
+
+// NamespacedServices are the services registered in a Nomad namespace
+type NamespacedServices struct {
+	Namespace string
+	Services  []*ServiceStub
+}
+
+// ServiceRegistration is a registration of a service by a Nomad allocation
+type ServiceRegistration struct {
+	ID          string
+	ServiceName string
+	Namespace   string
+	NodeID      string
+	Datacenter  string
+	JobID       string
+	AllocID     string
+	Tags        []string
+	Address     string
+	Port        int
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// QueryOptions are the options of a blocking query
+// (see [here](https://developer.hashicorp.com/nomad/api-docs#blocking-queries) for more info)
+type QueryOptions struct {
+	// WaitIndex is the index the query blocks until the data changes from. The query returns immediately when it is 0.
+	WaitIndex uint64
+	// WaitTime limits how long the query blocks. Nomad defaults it to 5 minutes.
+	WaitTime time.Duration
+}
+
+// QueryMeta is the metadata of the response to a query
+type QueryMeta struct {
+	// LastIndex is the index to block on in the next query
+	LastIndex uint64
+}
+
+// Client wraps the Nomad services API in an interface to allow mocking
+type Client interface {
+	// Services lists the services of all the namespaces, with their tags
+	Services(ctx context.Context, q *QueryOptions) ([]*NamespacedServices, *QueryMeta, error)
+	// Service lists the registrations of a service in a namespace
+	Service(ctx context.Context, namespace, name string, q *QueryOptions) ([]*ServiceRegistration, *QueryMeta, error)
+}
+
+// NewClient returns a client of the Nomad HTTP API at the given address.
+// The HTTP client must not time out its requests before the blocking queries return.
+func NewClient(address, token, region string, httpClient *http.Client) (Client, error) {
+	if address == "" {
+		address = DefaultAddress
+	}
+	baseUrl, err := url.Parse(address)
+	if err != nil {
+		return nil, eris.Wrapf(err, "invalid Nomad address %s", address)
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+	return &client{
+		baseUrl:    baseUrl,
+		token:      token,
+		region:     region,
+		httpClient: httpClient,
+	}, nil
+}
+
+type client struct {
+	baseUrl    *url.URL
+	token      string
+	region     string
+	httpClient *http.Client
+}
+
+func (c *client) Services(ctx context.Context, q *QueryOptions) ([]*NamespacedServices, *QueryMeta, error) {
+	var services []*NamespacedServices
+	meta, err := c.query(ctx, "/v1/services", allNamespaces, q, &services)
+	if err != nil {
+		return nil, nil, err
+	}
+	return services, meta, nil
+}
+
+func (c *client) Service(ctx context.Context, namespace, name string, q *QueryOptions) ([]*ServiceRegistration, *QueryMeta, error) {
+	if namespace == "" {
+		namespace = DefaultNamespace
+	}
+	var registrations []*ServiceRegistration
+	meta, err := c.query(ctx, "/v1/service/"+url.PathEscape(name), namespace, q, &registrations)
+	if err != nil {
+		return nil, nil, err
+	}
+	return registrations, meta, nil
+}
+
+func (c *client) query(ctx context.Context, path, namespace string, q *QueryOptions, out interface{}) (*QueryMeta, error) {
+	reqUrl := c.baseUrl.JoinPath(path)
+	params := url.Values{}
+	params.Set("namespace", namespace)
+	if c.region != "" {
+		params.Set("region", c.region)
+	}
+	if q != nil && q.WaitIndex > 0 {
+		params.Set("index", strconv.FormatUint(q.WaitIndex, 10))
+	}
+	if q != nil && q.WaitTime > 0 {
+		params.Set("wait", fmt.Sprintf("%dms", q.WaitTime.Milliseconds()))
+	}
+	reqUrl.RawQuery = params.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.token != "" {
+		req.Header.Set(tokenHeader, c.token)
+	}
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, eris.Wrapf(err, "querying Nomad %s", path)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return nil, eris.Errorf("querying Nomad %s: unexpected status %d: %s", path, res.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return nil, eris.Wrapf(err, "decoding the response of Nomad %s", path)
+	}
+
+	meta := &QueryMeta{}
+	if index := res.Header.Get(indexHeader); index != "" {
+		meta.LastIndex, err = strconv.ParseUint(index, 10, 64)
+		if err != nil {
+			return nil, eris.Wrapf(err, "invalid %s header of the response of Nomad %s", indexHeader, path)
+		}
+	}
+	return meta, nil
+}
+
+// NewFilteredClient returns a client that only returns the services and registrations of the given namespaces and
+// data centers, and the services with a tag in the allowlist. Empty lists do not filter.
+func NewFilteredClient(client Client, namespaces, dataCenters, serviceTagsAllowlist []string) Client {
+	return &filteredClient{
+		client:               client,
+		namespaces:           toSet(namespaces),
+		dataCenters:          toSet(dataCenters),
+		serviceTagsAllowlist: toSet(serviceTagsAllowlist),
+	}
+}
+
+type filteredClient struct {
+	client               Client
+	namespaces           map[string]bool
+	dataCenters          map[string]bool
+	serviceTagsAllowlist map[string]bool
+}
+
+func (c *filteredClient) Services(ctx context.Context, q *QueryOptions) ([]*NamespacedServices, *QueryMeta, error) {
+	namespacedServices, meta, err := c.client.Services(ctx, q)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var filtered []*NamespacedServices
+	for _, namespaceServices := range namespacedServices {
+		if !allowed(c.namespaces, namespaceServices.Namespace) {
+			continue
+		}
+		var services []*ServiceStub
+		for _, service := range namespaceServices.Services {
+			if c.hasAllowedTag(service.Tags) {
+				services = append(services, service)
+			}
+		}
+		if len(services) > 0 {
+			filtered = append(filtered, &NamespacedServices{Namespace: namespaceServices.Namespace, Services: services})
+		}
+	}
+	return filtered, meta, nil
+}
+
+func (c *filteredClient) Service(ctx context.Context, namespace, name string, q *QueryOptions) ([]*ServiceRegistration, *QueryMeta, error) {
+	registrations, meta, err := c.client.Service(ctx, namespace, name, q)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var filtered []*ServiceRegistration
+	for _, registration := range registrations {
+		if allowed(c.dataCenters, registration.Datacenter) {
+			filtered = append(filtered, registration)
+		}
+	}
+	return filtered, meta, nil
+}
+
+func (c *filteredClient) hasAllowedTag(tags []string) bool {
+	if len(c.serviceTagsAllowlist) == 0 {
+		return true
+	}
+	for _, tag := range tags {
+		if c.serviceTagsAllowlist[tag] {
+			return true
+		}
+	}
+	return false
+}
+
+func allowed(set map[string]bool, value string) bool {
+	return len(set) == 0 || set[value]
+}
+
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[value] = true
+	}
+	return set
+}
@@ -0,0 +1,170 @@
+package nomad_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+
+	. "github.com/solo-io/gloo/projects/gloo/pkg/upstreams/nomad"
+)
+
+// fakeNomad serves the services API of Nomad, and blocks queries until their index changes
+type fakeNomad struct {
+	lock     sync.Mutex
+	index    uint64
+	changed  chan struct{}
+	services string
+	requests []*http.Request
+}
+
+func newFakeNomad(services string) *fakeNomad {
+	return &fakeNomad{index: 10, changed: make(chan struct{}), services: services}
+}
+
+func (f *fakeNomad) setServices(services string) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.services = services
+	f.index++
+	close(f.changed)
+	f.changed = make(chan struct{})
+}
+
+func (f *fakeNomad) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	f.requests = append(f.requests, r)
+	index, changed := f.index, f.changed
+	f.lock.Unlock()
+
+	if r.URL.Path != "/v1/services" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if waitIndex, _ := strconv.ParseUint(r.URL.Query().Get("index"), 10, 64); waitIndex >= index {
+		select {
+		case <-changed:
+		case <-time.After(time.Second):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	w.Header().Set("X-Nomad-Index", strconv.FormatUint(f.index, 10))
+	_, _ = w.Write([]byte(f.services))
+}
+
+func (f *fakeNomad) lastRequest() *http.Request {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.requests[len(f.requests)-1]
+}
+
+var _ = Describe("Nomad client", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+
+		nomad  *fakeNomad
+		server *httptest.Server
+		client Client
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		nomad = newFakeNomad(`[
+			{"Namespace": "default", "Services": [
+				{"ServiceName": "web", "Tags": ["v1", "http"]},
+				{"ServiceName": "web", "Tags": ["v2", "http"]},
+				{"ServiceName": "db", "Tags": []}
+			]},
+			{"Namespace": "payments", "Services": [
+				{"ServiceName": "web", "Tags": ["internal"]}
+			]}
+		]`)
+		server = httptest.NewServer(nomad)
+
+		var err error
+		client, err = NewClient(server.URL, "secret", "eu", nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		cancel()
+		server.Close()
+	})
+
+	It("lists the services of all the namespaces", func() {
+		services, meta, err := client.Services(ctx, &QueryOptions{WaitIndex: 2, WaitTime: time.Minute})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(meta.LastIndex).To(BeEquivalentTo(10))
+		Expect(services).To(HaveLen(2))
+		Expect(services[0].Namespace).To(Equal("default"))
+		Expect(services[0].Services).To(HaveLen(3))
+
+		req := nomad.lastRequest()
+		Expect(req.Header.Get("X-Nomad-Token")).To(Equal("secret"))
+		Expect(req.URL.Query().Get("namespace")).To(Equal("*"))
+		Expect(req.URL.Query().Get("region")).To(Equal("eu"))
+		Expect(req.URL.Query().Get("index")).To(Equal("2"))
+		Expect(req.URL.Query().Get("wait")).To(Equal("60000ms"))
+	})
+
+	It("returns the errors of the API", func() {
+		_, _, err := client.Service(ctx, "", "web", nil)
+		Expect(err).To(MatchError(ContainSubstring("unexpected status 404")))
+		Expect(nomad.lastRequest().URL.Query().Get("namespace")).To(Equal(DefaultNamespace))
+	})
+
+	It("filters the namespaces and the service tags", func() {
+		filtered := NewFilteredClient(client, []string{"default"}, nil, []string{"v2", "internal"})
+		services, _, err := filtered.Services(ctx, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(services).To(HaveLen(1))
+		Expect(services[0].Namespace).To(Equal("default"))
+		Expect(services[0].Services).To(ConsistOf(&ServiceStub{ServiceName: "web", Tags: []string{"v2", "http"}}))
+	})
+
+	Context("upstream client", func() {
+
+		It("lists an upstream for each service of each namespace", func() {
+			upstreams, err := NewNomadUpstreamClient(NewNomadWatcherFromClient(client, 0)).List(defaults.GlooSystem, clients.ListOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(upstreams).To(Equal(v1.UpstreamList{
+				CreateUpstreamFromService(&ServiceMeta{Name: "db", Namespace: "default"}),
+				CreateUpstreamFromService(&ServiceMeta{Name: "web", Namespace: "default", Tags: []string{"http", "v1", "v2"}}),
+				CreateUpstreamFromService(&ServiceMeta{Name: "web", Namespace: "payments", Tags: []string{"internal"}}),
+			}))
+			Expect(upstreams[1].GetMetadata().GetName()).To(Equal("nomad-svc:web.default"))
+			Expect(IsNomadUpstream(upstreams[1].GetMetadata().GetName())).To(BeTrue())
+		})
+
+		It("lists no upstreams in other namespaces", func() {
+			upstreams, err := NewNomadUpstreamClient(NewNomadWatcherFromClient(client, 0)).List("default", clients.ListOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(upstreams).To(BeEmpty())
+		})
+
+		It("sends the upstreams again when the services change", func() {
+			upstreams, errs, err := NewNomadUpstreamClient(NewNomadWatcherFromClient(client, time.Second)).Watch(defaults.GlooSystem, clients.WatchOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			Consistently(errs).ShouldNot(Receive())
+			Eventually(upstreams).Should(Receive(HaveLen(3)))
+
+			nomad.setServices(`[{"Namespace": "default", "Services": [{"ServiceName": "db", "Tags": []}]}]`)
+			Eventually(upstreams).Should(Receive(HaveLen(1)))
+			Eventually(func() string { return nomad.lastRequest().URL.Query().Get("index") }).Should(Equal("11"))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package nomad_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestNomad(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Nomad Upstream Client Suite")
+}
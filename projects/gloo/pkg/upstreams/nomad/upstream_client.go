@@ -0,0 +1,89 @@
+package nomad
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/NoOpUpstreamClient"
+	"github.com/solo-io/go-utils/contextutils"
+	skclients "github.com/solo-io/solo-kit/pkg/api/v1/clients"
+)
+
+const notImplementedErrMsg = "this operation is not supported by this client"
+
+// This client can list and watch Nomad services. A Gloo upstream will be generated for each Nomad
+// service of each namespace. The Nomad EDS will discover the registrations of each one of these upstreams.
+//
+// NOTE: any method except List and Watch will panic!
+func NewNomadUpstreamClient(nomad NomadWatcher) v1.UpstreamClient {
+	return &nomadUpstreamClient{nomad: nomad}
+}
+
+type nomadUpstreamClient struct {
+	nomad NomadWatcher
+}
+
+func (*nomadUpstreamClient) BaseClient() skclients.ResourceClient {
+	contextutils.LoggerFrom(context.Background()).DPanic(notImplementedErrMsg)
+	return &NoOpUpstreamClient.NoOpUpstreamClient{}
+}
+
+func (*nomadUpstreamClient) Register() error {
+	contextutils.LoggerFrom(context.Background()).DPanic(notImplementedErrMsg)
+	return fmt.Errorf(notImplementedErrMsg)
+}
+
+func (*nomadUpstreamClient) Read(namespace, name string, opts skclients.ReadOpts) (*v1.Upstream, error) {
+	contextutils.LoggerFrom(context.Background()).DPanic(notImplementedErrMsg)
+	return nil, fmt.Errorf(notImplementedErrMsg)
+}
+
+func (*nomadUpstreamClient) Write(resource *v1.Upstream, opts skclients.WriteOpts) (*v1.Upstream, error) {
+	contextutils.LoggerFrom(context.Background()).DPanic(notImplementedErrMsg)
+	return nil, fmt.Errorf(notImplementedErrMsg)
+}
+
+func (*nomadUpstreamClient) Delete(namespace, name string, opts skclients.DeleteOpts) error {
+	contextutils.LoggerFrom(context.Background()).DPanic(notImplementedErrMsg)
+	return fmt.Errorf(notImplementedErrMsg)
+}
+
+func (c *nomadUpstreamClient) List(namespace string, opts skclients.ListOpts) (v1.UpstreamList, error) {
+	ctx := opts.Ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	services, _, err := c.nomad.Services(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return toUpstreamList(namespace, toServiceMetaSlice(services)), nil
+}
+
+func (c *nomadUpstreamClient) Watch(namespace string, opts skclients.WatchOpts) (<-chan v1.UpstreamList, <-chan error, error) {
+	servicesChan, errorChan := c.nomad.WatchServices(opts.Ctx)
+
+	upstreamsChan := make(chan v1.UpstreamList)
+	go func() {
+		defer close(upstreamsChan)
+		for {
+			select {
+			case services, ok := <-servicesChan:
+				if !ok {
+					return
+				}
+				//  Transform to upstreams
+				select {
+				case upstreamsChan <- toUpstreamList(namespace, services):
+				case <-opts.Ctx.Done():
+					return
+				}
+			case <-opts.Ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return upstreamsChan, errorChan, nil
+}
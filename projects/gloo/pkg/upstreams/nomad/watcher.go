@@ -0,0 +1,185 @@
+package nomad
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/avast/retry-go"
+)
+
+// Data for a single Nomad service (not registration)
+type ServiceMeta struct {
+	Name      string
+	Namespace string
+	Tags      []string
+}
+
+type NomadWatcher interface {
+	Client
+	// WatchServices sends the services of all the namespaces whenever they change
+	WatchServices(ctx context.Context) (<-chan []*ServiceMeta, <-chan error)
+	// WatchService sends the registrations of a service whenever they change
+	WatchService(ctx context.Context, namespace, name string) (<-chan []*ServiceRegistration, <-chan error)
+}
+
+// NewNomadWatcher returns a watcher of the services of the given namespaces and data centers, with a tag in the
+// allowlist. Empty lists do not filter.
+func NewNomadWatcher(client Client, namespaces, dataCenters, serviceTagsAllowlist []string, waitTime time.Duration) NomadWatcher {
+	return NewNomadWatcherFromClient(NewFilteredClient(client, namespaces, dataCenters, serviceTagsAllowlist), waitTime)
+}
+
+func NewNomadWatcherFromClient(client Client, waitTime time.Duration) NomadWatcher {
+	return &nomadWatcher{Client: client, waitTime: waitTime}
+}
+
+var _ NomadWatcher = &nomadWatcher{}
+
+type nomadWatcher struct {
+	Client
+	waitTime time.Duration
+}
+
+func (w *nomadWatcher) WatchServices(ctx context.Context) (<-chan []*ServiceMeta, <-chan error) {
+	return watch(ctx, w.waitTime, func(ctx context.Context, q *QueryOptions) ([]*ServiceMeta, *QueryMeta, error) {
+		services, meta, err := w.Services(ctx, q)
+		if err != nil {
+			return nil, nil, err
+		}
+		return toServiceMetaSlice(services), meta, nil
+	})
+}
+
+func (w *nomadWatcher) WatchService(ctx context.Context, namespace, name string) (<-chan []*ServiceRegistration, <-chan error) {
+	return watch(ctx, w.waitTime, func(ctx context.Context, q *QueryOptions) ([]*ServiceRegistration, *QueryMeta, error) {
+		return w.Service(ctx, namespace, name, q)
+	})
+}
+
+// watch runs the blocking query until the context is done, and sends its result whenever its index changes.
+// Honors the contract of Watch functions to open with an initial read.
+func watch[T any](ctx context.Context, waitTime time.Duration, query func(ctx context.Context, q *QueryOptions) (T, *QueryMeta, error)) (<-chan T, <-chan error) {
+	resultsChan := make(chan T)
+	errsChan := make(chan error)
+
+	go func() {
+		defer close(resultsChan)
+		defer close(errsChan)
+
+		lastIndex := uint64(0)
+
+		for {
+			var (
+				result    T
+				queryMeta *QueryMeta
+			)
+
+			// This is a blocking query (see [here](https://developer.hashicorp.com/nomad/api-docs#blocking-queries) for more info)
+			// The first invocation (with lastIndex equal to zero) will return immediately
+			ctxDead := false
+
+			// Use a back-off retry strategy to avoid flooding the error channel
+			err := retry.Do(
+				func() error {
+					var err error
+					if ctx.Err() != nil {
+						// intentionally return early if context is already done
+						// this is a backoff loop; by the time we get here ctx may be done
+						ctxDead = true
+						return nil
+					}
+					result, queryMeta, err = query(ctx, &QueryOptions{WaitIndex: lastIndex, WaitTime: waitTime})
+					return err
+				},
+				retry.Attempts(6),
+				//  Last delay is 2^6 * 100ms = 3.2s
+				retry.Delay(100*time.Millisecond),
+				retry.DelayType(retry.BackOffDelay),
+			)
+
+			if ctxDead {
+				return
+			}
+
+			if err != nil {
+				select {
+				case errsChan <- err:
+				case <-ctx.Done():
+					return
+				}
+				continue
+			}
+
+			// If index is the same, there have been no changes since last query
+			if lastIndex != 0 && queryMeta.LastIndex == lastIndex {
+				continue
+			}
+
+			// Update the last index, resetting it if it goes backwards, per the Nomad blocking query docs.
+			// The index must be greater than 0 for the next query to block.
+			if queryMeta.LastIndex < lastIndex {
+				lastIndex = 0
+			} else {
+				lastIndex = max(queryMeta.LastIndex, 1)
+			}
+
+			select {
+			case resultsChan <- result:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return resultsChan, errsChan
+}
+
+func toServiceMetaSlice(namespacedServices []*NamespacedServices) []*ServiceMeta {
+	var result []*ServiceMeta
+	for _, namespaceServices := range namespacedServices {
+		for _, service := range namespaceServices.Services {
+			// Nomad lists a service once per distinct set of tags of its registrations
+			var serviceMeta *ServiceMeta
+			for _, existing := range result {
+				if existing.Name == service.ServiceName && existing.Namespace == namespaceServices.Namespace {
+					serviceMeta = existing
+					break
+				}
+			}
+			if serviceMeta == nil {
+				serviceMeta = &ServiceMeta{Name: service.ServiceName, Namespace: namespaceServices.Namespace}
+				result = append(result, serviceMeta)
+			}
+			serviceMeta.Tags = mergeTags(serviceMeta.Tags, service.Tags)
+		}
+	}
+
+	for _, serviceMeta := range result {
+		sort.Strings(serviceMeta.Tags)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Namespace != result[j].Namespace {
+			return result[i].Namespace < result[j].Namespace
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+func mergeTags(existingTags []string, newTags []string) []string {
+
+	// Index tags to avoid O(n^2)
+	tagMap := make(map[string]bool)
+	for _, tag := range existingTags {
+		tagMap[tag] = true
+	}
+
+	// Add only missing tags
+	for _, newTag := range newTags {
+		if _, ok := tagMap[newTag]; !ok {
+			tagMap[newTag] = true
+			existingTags = append(existingTags, newTag)
+		}
+	}
+	return existingTags
+}
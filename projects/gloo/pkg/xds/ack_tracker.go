@@ -0,0 +1,253 @@
+package xds
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	envoyxdsserver "github.com/envoyproxy/go-control-plane/pkg/server/v3"
+	syncerstats "github.com/solo-io/gloo/projects/gloo/pkg/syncer/stats"
+	xdsserver "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/server"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+var (
+	xdsNacks      = stats.Int64("api.gloo.solo.io/xds/nacks", "The number of xDS responses rejected by envoy", "1")
+	typeUrlKey, _ = tag.NewKey("type_url")
+
+	xdsNacksView = &view.View{
+		Name:        "api.gloo.solo.io/xds/nacks",
+		Measure:     xdsNacks,
+		Description: "The number of xDS responses rejected by envoy",
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{syncerstats.ProxyNameKey, typeUrlKey},
+	}
+)
+
+func init() {
+	_ = view.Register(xdsNacksView)
+}
+
+var (
+	// Compile-time assertion
+	_ xdsserver.Callbacks = new(AckTracker)
+)
+
+// ResourceTypeStatus is the state of the resources of a type URL on a node, as last acked or nacked by envoy.
+type ResourceTypeStatus struct {
+	TypeUrl string `json:"typeUrl"`
+	// AckedVersion is the last version of the resources the node accepted
+	AckedVersion string `json:"ackedVersion,omitempty"`
+	// RejectedVersion is the version of the resources the node rejected, if it hasn't accepted a later one since
+	RejectedVersion string `json:"rejectedVersion,omitempty"`
+	// Error is the error_detail the node rejected the resources with
+	Error       string    `json:"error,omitempty"`
+	LastUpdated time.Time `json:"lastUpdated"`
+}
+
+// Rejected returns true if the last response of this type sent to the node was nacked
+func (s ResourceTypeStatus) Rejected() bool {
+	return s.Error != ""
+}
+
+// AckTracker records the ACKs and NACKs envoy sends on xDS streams, per node and type URL.
+// Nodes are identified by their snapshot cache key, i.e. the role in their metadata (see NewNodeRoleHasher).
+// The statuses of a node are forgotten once all of its streams are closed.
+type AckTracker struct {
+	lock           sync.RWMutex
+	streams        map[int64]*ackTrackerStream
+	streamsPerNode map[string]int
+	statuses       map[string]map[string]ResourceTypeStatus
+	listeners      []func(nodeKey string)
+}
+
+type ackTrackerStream struct {
+	nodeKey string
+	// the last response sent on the stream, per type URL, so the version of a nacked response is known
+	lastResponses map[string]*envoy_service_discovery_v3.DiscoveryResponse
+}
+
+func NewAckTracker() *AckTracker {
+	return &AckTracker{
+		streams:        make(map[int64]*ackTrackerStream),
+		streamsPerNode: make(map[string]int),
+		statuses:       make(map[string]map[string]ResourceTypeStatus),
+	}
+}
+
+// OnRejectionsChanged registers a function that is called when a node nacks a response, or accepts a response
+// of a type it previously nacked.
+func (t *AckTracker) OnRejectionsChanged(listener func(nodeKey string)) {
+	if t == nil {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.listeners = append(t.listeners, listener)
+}
+
+// Statuses returns a copy of the statuses of all the nodes connected, keyed by node.
+func (t *AckTracker) Statuses() map[string][]ResourceTypeStatus {
+	out := make(map[string][]ResourceTypeStatus)
+	if t == nil {
+		return out
+	}
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	for nodeKey := range t.statuses {
+		out[nodeKey] = t.nodeStatuses(nodeKey, false)
+	}
+	return out
+}
+
+// Rejections returns the statuses of the types the node last nacked, sorted by type URL.
+func (t *AckTracker) Rejections(nodeKey string) []ResourceTypeStatus {
+	if t == nil {
+		return nil
+	}
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.nodeStatuses(nodeKey, true)
+}
+
+func (t *AckTracker) nodeStatuses(nodeKey string, rejectedOnly bool) []ResourceTypeStatus {
+	var out []ResourceTypeStatus
+	for _, status := range t.statuses[nodeKey] {
+		if rejectedOnly && !status.Rejected() {
+			continue
+		}
+		out = append(out, status)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].TypeUrl < out[j].TypeUrl
+	})
+	return out
+}
+
+// OnStreamOpen is called once an xDS stream is open with a stream ID and the type URL (or "" for ADS).
+func (t *AckTracker) OnStreamOpen(_ context.Context, _ int64, _ string) error {
+	return nil
+}
+
+// OnStreamClosed forgets the statuses of the node of the stream if it was its last stream.
+func (t *AckTracker) OnStreamClosed(sid int64) {
+	t.lock.Lock()
+	stream, ok := t.streams[sid]
+	if !ok {
+		t.lock.Unlock()
+		return
+	}
+	delete(t.streams, sid)
+	t.streamsPerNode[stream.nodeKey]--
+	if t.streamsPerNode[stream.nodeKey] > 0 {
+		t.lock.Unlock()
+		return
+	}
+	delete(t.streamsPerNode, stream.nodeKey)
+	changed := len(t.nodeStatuses(stream.nodeKey, true)) > 0
+	delete(t.statuses, stream.nodeKey)
+	listeners := t.listeners
+	t.lock.Unlock()
+
+	if changed {
+		notify(listeners, stream.nodeKey)
+	}
+}
+
+// OnStreamRequest records the ACK or NACK of the last response of the type of the request.
+func (t *AckTracker) OnStreamRequest(sid int64, req *envoy_service_discovery_v3.DiscoveryRequest) error {
+	t.lock.Lock()
+	stream, ok := t.streams[sid]
+	if !ok {
+		nodeKey := NewNodeRoleHasher().ID(req.GetNode())
+		stream = &ackTrackerStream{
+			nodeKey:       nodeKey,
+			lastResponses: make(map[string]*envoy_service_discovery_v3.DiscoveryResponse),
+		}
+		t.streams[sid] = stream
+		t.streamsPerNode[nodeKey]++
+	}
+	// the first request of a type on a stream is neither an ACK nor a NACK
+	if req.GetResponseNonce() == "" {
+		t.lock.Unlock()
+		return nil
+	}
+
+	nodeStatuses, ok := t.statuses[stream.nodeKey]
+	if !ok {
+		nodeStatuses = make(map[string]ResourceTypeStatus)
+		t.statuses[stream.nodeKey] = nodeStatuses
+	}
+	previous := nodeStatuses[req.GetTypeUrl()]
+	status := ResourceTypeStatus{
+		TypeUrl:      req.GetTypeUrl(),
+		AckedVersion: req.GetVersionInfo(),
+		LastUpdated:  time.Now(),
+	}
+	if req.GetErrorDetail() != nil {
+		// envoy keeps the version it accepted last, so the rejected version is the one of the response it nacks
+		if resp := stream.lastResponses[req.GetTypeUrl()]; resp.GetNonce() == req.GetResponseNonce() {
+			status.RejectedVersion = resp.GetVersionInfo()
+		}
+		status.Error = req.GetErrorDetail().GetMessage()
+	}
+	nodeStatuses[req.GetTypeUrl()] = status
+	changed := previous.Rejected() != status.Rejected() ||
+		previous.Error != status.Error ||
+		previous.RejectedVersion != status.RejectedVersion
+	listeners := t.listeners
+	t.lock.Unlock()
+
+	if status.Rejected() {
+		ctx, _ := tag.New(context.Background(),
+			tag.Insert(syncerstats.ProxyNameKey, stream.nodeKey),
+			tag.Insert(typeUrlKey, req.GetTypeUrl()))
+		stats.Record(ctx, xdsNacks.M(1))
+	}
+	if changed {
+		notify(listeners, stream.nodeKey)
+	}
+	return nil
+}
+
+// OnStreamResponse remembers the response, so the version it nacks is known if envoy rejects it.
+func (t *AckTracker) OnStreamResponse(sid int64, _ *envoy_service_discovery_v3.DiscoveryRequest, resp *envoy_service_discovery_v3.DiscoveryResponse) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if stream, ok := t.streams[sid]; ok {
+		stream.lastResponses[resp.GetTypeUrl()] = resp
+	}
+}
+
+// OnFetchRequest is called for each Fetch request. Fetches are not acked.
+func (t *AckTracker) OnFetchRequest(_ context.Context, _ *envoy_service_discovery_v3.DiscoveryRequest) error {
+	return nil
+}
+
+// OnFetchResponse is called immediately prior to sending a response.
+func (t *AckTracker) OnFetchResponse(_ *envoy_service_discovery_v3.DiscoveryRequest, _ *envoy_service_discovery_v3.DiscoveryResponse) {
+}
+
+// EnvoyCallbacks returns the callbacks of the tracker for a go-control-plane xDS server.
+func (t *AckTracker) EnvoyCallbacks() envoyxdsserver.Callbacks {
+	return envoyxdsserver.CallbackFuncs{
+		StreamClosedFunc: func(sid int64, _ *envoy_config_core_v3.Node) {
+			t.OnStreamClosed(sid)
+		},
+		StreamRequestFunc: t.OnStreamRequest,
+		StreamResponseFunc: func(_ context.Context, sid int64, req *envoy_service_discovery_v3.DiscoveryRequest, resp *envoy_service_discovery_v3.DiscoveryResponse) {
+			t.OnStreamResponse(sid, req, resp)
+		},
+	}
+}
+
+func notify(listeners []func(nodeKey string), nodeKey string) {
+	for _, listener := range listeners {
+		listener(nodeKey)
+	}
+}
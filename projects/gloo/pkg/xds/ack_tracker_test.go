@@ -0,0 +1,122 @@
+package xds_test
+
+import (
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/types"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var _ = Describe("AckTracker", func() {
+
+	const nodeKey = "gloo-system~gateway-proxy"
+
+	var (
+		tracker *xds.AckTracker
+		changes []string
+		node    *envoy_config_core_v3.Node
+	)
+
+	BeforeEach(func() {
+		tracker = xds.NewAckTracker()
+		changes = nil
+		tracker.OnRejectionsChanged(func(nodeKey string) {
+			changes = append(changes, nodeKey)
+		})
+		node = &envoy_config_core_v3.Node{
+			Metadata: &structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					xds.RoleKey: structpb.NewStringValue(nodeKey),
+				},
+			},
+		}
+	})
+
+	respond := func(sid int64, version, nonce string) {
+		tracker.OnStreamResponse(sid, nil, &envoy_service_discovery_v3.DiscoveryResponse{
+			TypeUrl:     types.ClusterTypeV3,
+			VersionInfo: version,
+			Nonce:       nonce,
+		})
+	}
+
+	request := func(sid int64, version, nonce, errorMessage string) {
+		req := &envoy_service_discovery_v3.DiscoveryRequest{
+			Node:          node,
+			TypeUrl:       types.ClusterTypeV3,
+			VersionInfo:   version,
+			ResponseNonce: nonce,
+		}
+		if errorMessage != "" {
+			req.ErrorDetail = &status.Status{Message: errorMessage}
+		}
+		Expect(tracker.OnStreamRequest(sid, req)).NotTo(HaveOccurred())
+	}
+
+	It("records the versions acked by a node", func() {
+		request(1, "", "", "")
+		respond(1, "v1", "1")
+		request(1, "v1", "1", "")
+
+		Expect(tracker.Statuses()).To(HaveKeyWithValue(nodeKey, ConsistOf(
+			MatchFields(IgnoreExtras, Fields{
+				"TypeUrl":      Equal(types.ClusterTypeV3),
+				"AckedVersion": Equal("v1"),
+				"Error":        BeEmpty(),
+			}),
+		)))
+		Expect(tracker.Rejections(nodeKey)).To(BeEmpty())
+		Expect(changes).To(BeEmpty())
+	})
+
+	It("records the version and error nacked by a node until it acks a later version", func() {
+		request(1, "", "", "")
+		respond(1, "v1", "1")
+		request(1, "v1", "1", "")
+		respond(1, "v2", "2")
+		request(1, "v1", "2", "cluster foo: invalid")
+
+		Expect(tracker.Rejections(nodeKey)).To(ConsistOf(
+			MatchFields(IgnoreExtras, Fields{
+				"TypeUrl":         Equal(types.ClusterTypeV3),
+				"AckedVersion":    Equal("v1"),
+				"RejectedVersion": Equal("v2"),
+				"Error":           Equal("cluster foo: invalid"),
+			}),
+		))
+		Expect(changes).To(Equal([]string{nodeKey}))
+
+		respond(1, "v3", "3")
+		request(1, "v3", "3", "")
+		Expect(tracker.Rejections(nodeKey)).To(BeEmpty())
+		Expect(changes).To(Equal([]string{nodeKey, nodeKey}))
+	})
+
+	It("forgets a node once its last stream is closed", func() {
+		request(1, "", "", "")
+		request(2, "", "", "")
+		respond(1, "v1", "1")
+		request(1, "", "1", "cluster foo: invalid")
+		Expect(tracker.Rejections(nodeKey)).To(HaveLen(1))
+
+		tracker.OnStreamClosed(1)
+		Expect(tracker.Rejections(nodeKey)).To(HaveLen(1))
+
+		tracker.OnStreamClosed(2)
+		Expect(tracker.Rejections(nodeKey)).To(BeEmpty())
+		Expect(tracker.Statuses()).To(BeEmpty())
+		Expect(changes).To(Equal([]string{nodeKey, nodeKey}))
+	})
+
+	It("is a no-op when nil", func() {
+		var nilTracker *xds.AckTracker
+		nilTracker.OnRejectionsChanged(func(string) {})
+		Expect(nilTracker.Rejections(nodeKey)).To(BeEmpty())
+		Expect(nilTracker.Statuses()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,596 @@
+package xds
+
+import (
+	"context"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"sync/atomic"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	golangproto "github.com/golang/protobuf/proto"
+	"github.com/solo-io/go-utils/contextutils"
+	sk_discovery "github.com/solo-io/solo-kit/pkg/api/external/envoy/api/v2"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/server"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/types"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// wildcardSubscription is the resource name a client subscribes to (or unsubscribes from) to
+// receive all the resources of a type.
+const wildcardSubscription = "*"
+
+type DeltaStreamEnvoyV3 interface {
+	Send(response *envoy_service_discovery_v3.DeltaDiscoveryResponse) error
+	Recv() (*envoy_service_discovery_v3.DeltaDiscoveryRequest, error)
+	grpc.ServerStream
+}
+
+type DeltaStreamSolo interface {
+	Send(response *sk_discovery.DeltaDiscoveryResponse) error
+	Recv() (*sk_discovery.DeltaDiscoveryRequest, error)
+	grpc.ServerStream
+}
+
+// DeltaServer serves incremental xDS streams from the snapshot cache. Instead of the whole state of
+// the world, each response only holds the resources that were added or changed since the last
+// response, and the names of the resources that were removed.
+type DeltaServer interface {
+	// DeltaEnvoyV3 is the incremental streaming method for Envoy V3 xDS
+	DeltaEnvoyV3(stream DeltaStreamEnvoyV3, defaultTypeURL string) error
+	// DeltaSolo is the incremental streaming method for Solo discovery
+	DeltaSolo(stream DeltaStreamSolo, defaultTypeURL string) error
+}
+
+// NewDeltaServer creates incremental xDS handlers on top of the snapshot cache, which is watched the
+// same way as for state of the world streams.
+// Callbacks are notified when streams are opened and closed, and of each request and response, as the
+// equivalent state of the world request and response.
+func NewDeltaServer(ctx context.Context, config cache.Cache, callbacks server.Callbacks) DeltaServer {
+	return &deltaServer{ctx: ctx, cache: config, callbacks: callbacks}
+}
+
+type deltaServer struct {
+	cache     cache.Cache
+	callbacks server.Callbacks
+	ctx       context.Context
+
+	// streamCount for counting bi-di streams
+	streamCount int64
+}
+
+// deltaResponse is the response of a cache watch, for a type URL.
+type deltaResponse struct {
+	typeURL  string
+	response *cache.Response
+}
+
+// versionedResource is a resource of the snapshot, with the version the client is sent for it.
+type versionedResource struct {
+	resource *anypb.Any
+	version  string
+}
+
+// sentResponse is a response sent on a stream, that the client hasn't acked or nacked yet.
+type sentResponse struct {
+	nonce string
+	// version is the version of the snapshot the response brings the client up to date with.
+	version string
+}
+
+// deltaSubscription is the state of the resources of a type URL on a stream.
+type deltaSubscription struct {
+	// wildcard is true when the client is subscribed to all the resources of the type.
+	wildcard bool
+	// names are the resources the client explicitly subscribed to.
+	names map[string]struct{}
+	// resolvedNames are the names of the virtual hosts that aliases resolved to. The client is
+	// subscribed to them in place of the aliases.
+	resolvedNames map[string]struct{}
+	// clientVersions are the versions of the resources the client has. Resources the client
+	// subscribed to, but has not been sent, have an empty version.
+	clientVersions map[string]string
+
+	// resources are the resources of the type in the last snapshot, by name.
+	resources map[string]versionedResource
+	// virtualHostDomains are the domains of the virtual hosts in the last snapshot, by name. They
+	// resolve the aliases VHDS clients subscribe to.
+	virtualHostDomains map[string][]string
+	// snapshotVersion is the version of the resources of the type in the last snapshot.
+	snapshotVersion string
+	// ackedVersion is the snapshot version of the last response the client accepted.
+	ackedVersion string
+	// sent are the responses the client hasn't acked or nacked yet, oldest first.
+	sent []sentResponse
+	// request is the state of the world equivalent of the last request of the type, for callbacks.
+	request *envoy_service_discovery_v3.DiscoveryRequest
+	// watching is true once a watch on the cache was created for the type.
+	watching bool
+	// responded is true once a response was sent for the type.
+	responded bool
+	cancel    func()
+}
+
+func newDeltaSubscription() *deltaSubscription {
+	return &deltaSubscription{
+		names:          map[string]struct{}{},
+		resolvedNames:  map[string]struct{}{},
+		clientVersions: map[string]string{},
+	}
+}
+
+func (sub *deltaSubscription) subscribed(name string) bool {
+	if sub.wildcard {
+		return true
+	}
+	_, ok := sub.names[name]
+	return ok
+}
+
+// update applies the subscriptions and unsubscriptions of a request.
+func (sub *deltaSubscription) update(req *envoy_service_discovery_v3.DeltaDiscoveryRequest, first bool) {
+	if first {
+		// like state of the world, a first request that doesn't name any resource is a wildcard request,
+		// except for VHDS, where the client only fetches the virtual hosts it needs
+		sub.wildcard = len(req.GetResourceNamesSubscribe()) == 0 && req.GetTypeUrl() != VirtualHostTypeV3
+		for name, version := range req.GetInitialResourceVersions() {
+			sub.clientVersions[name] = version
+		}
+	}
+	for _, name := range req.GetResourceNamesSubscribe() {
+		if name == wildcardSubscription {
+			sub.wildcard = true
+			continue
+		}
+		sub.names[name] = struct{}{}
+		// the client may have dropped the resource, so it must be sent again, unless it told us its version
+		if _, ok := req.GetInitialResourceVersions()[name]; !ok {
+			sub.clientVersions[name] = ""
+		}
+	}
+	for _, name := range req.GetResourceNamesUnsubscribe() {
+		if name == wildcardSubscription {
+			sub.wildcard = false
+			continue
+		}
+		delete(sub.names, name)
+		delete(sub.resolvedNames, name)
+		delete(sub.clientVersions, name)
+	}
+	if !sub.wildcard {
+		// resources the client had from a wildcard subscription are forgotten once it unsubscribes
+		for name := range sub.clientVersions {
+			if _, ok := sub.names[name]; !ok {
+				delete(sub.clientVersions, name)
+			}
+		}
+	}
+}
+
+// acknowledge records the ACK or NACK of a response by the client. The client handles responses in order,
+// so the responses sent before it are forgotten.
+func (sub *deltaSubscription) acknowledge(req *envoy_service_discovery_v3.DeltaDiscoveryRequest) {
+	for i, resp := range sub.sent {
+		if resp.nonce != req.GetResponseNonce() {
+			continue
+		}
+		if req.GetErrorDetail() == nil {
+			sub.ackedVersion = resp.version
+		}
+		sub.sent = sub.sent[i+1:]
+		return
+	}
+}
+
+// setResources stores the resources of a new snapshot, with a version for each resource derived from
+// its content, so that resources that didn't change in the snapshot are not sent again.
+func (sub *deltaSubscription) setResources(resp *cache.Response, typeURL string) error {
+	resources := make(map[string]versionedResource, len(resp.Resources))
+	virtualHostDomains := make(map[string][]string)
+	for _, resource := range resp.Resources {
+		if virtualHost, ok := resource.(*VirtualHostResource); ok {
+			virtualHostDomains[resource.Self().Name] = virtualHost.VirtualHost.GetDomains()
+		}
+		data, err := proto.MarshalOptions{Deterministic: true}.Marshal(golangproto.MessageV2(resource.ResourceProto()))
+		if err != nil {
+			return err
+		}
+		hasher := fnv.New64a()
+		hasher.Write(data)
+		resources[resource.Self().Name] = versionedResource{
+			resource: &anypb.Any{TypeUrl: typeURL, Value: data},
+			version:  strconv.FormatUint(hasher.Sum64(), 16),
+		}
+	}
+	sub.resources = resources
+	sub.virtualHostDomains = virtualHostDomains
+	sub.snapshotVersion = resp.Version
+	return nil
+}
+
+// diff returns the response that brings the client up to date with the last snapshot, and records
+// the versions it sends. It returns nil when the client is already up to date.
+func (sub *deltaSubscription) diff(typeURL string) *envoy_service_discovery_v3.DeltaDiscoveryResponse {
+	if sub.resources == nil {
+		// no snapshot yet
+		return nil
+	}
+	out := &envoy_service_discovery_v3.DeltaDiscoveryResponse{
+		SystemVersionInfo: sub.snapshotVersion,
+		TypeUrl:           typeURL,
+	}
+	var aliases map[string][]string
+	if typeURL == VirtualHostTypeV3 {
+		var unresolved []string
+		aliases, unresolved = sub.resolveAliases()
+		for _, alias := range unresolved {
+			// a resource without a body tells the client the alias doesn't resolve, so that it
+			// doesn't wait for the virtual host
+			out.Resources = append(out.Resources, &envoy_service_discovery_v3.Resource{
+				Name:    alias,
+				Aliases: []string{alias},
+			})
+		}
+	}
+	for name, resource := range sub.resources {
+		if !sub.subscribed(name) {
+			continue
+		}
+		resourceAliases, aliased := aliases[name]
+		if version, ok := sub.clientVersions[name]; ok && version == resource.version && !aliased {
+			continue
+		}
+		outResource := &envoy_service_discovery_v3.Resource{
+			Name:     name,
+			Version:  resource.version,
+			Resource: resource.resource,
+		}
+		if aliased {
+			outResource.Aliases = resourceAliases
+		}
+		out.Resources = append(out.Resources, outResource)
+		sub.clientVersions[name] = resource.version
+	}
+	for name := range sub.clientVersions {
+		if _, ok := sub.resources[name]; !ok {
+			// tell the client about resources that were removed, or that it subscribed to but don't exist
+			out.RemovedResources = append(out.RemovedResources, name)
+			delete(sub.clientVersions, name)
+		}
+	}
+	// the first response is sent even if it's empty, so that the client doesn't wait for the resources
+	if len(out.GetResources()) == 0 && len(out.GetRemovedResources()) == 0 && sub.responded {
+		return nil
+	}
+	sub.responded = true
+	return out
+}
+
+// resolveAliases resolves the aliases VHDS clients subscribe to on demand. An alias is the name of a
+// RouteConfiguration and the authority of a request, i.e. "<route configuration>/<authority>".
+// The alias is replaced in the subscription by the virtual host it resolves to, and the returned map
+// has the aliases the client is waiting for, by virtual host name.
+// Aliases that were just subscribed to and don't resolve are returned, and stay subscribed in case a
+// later snapshot has a matching virtual host.
+func (sub *deltaSubscription) resolveAliases() (map[string][]string, []string) {
+	resolved := make(map[string][]string)
+	var unresolved []string
+	for name := range sub.names {
+		if _, ok := sub.resources[name]; ok {
+			continue
+		}
+		if _, ok := sub.resolvedNames[name]; ok {
+			// a virtual host that was removed
+			continue
+		}
+		routeConfigName, authority, ok := SplitVirtualHostName(name)
+		if !ok {
+			// not an alias, e.g. the name of the RouteConfiguration the client subscribes to at first
+			continue
+		}
+		virtualHostName := matchVirtualHost(sub.virtualHostDomains, routeConfigName, authority)
+		if virtualHostName == "" {
+			if _, ok := sub.clientVersions[name]; ok {
+				unresolved = append(unresolved, name)
+				delete(sub.clientVersions, name)
+			}
+			continue
+		}
+		delete(sub.names, name)
+		delete(sub.clientVersions, name)
+		sub.names[virtualHostName] = struct{}{}
+		sub.resolvedNames[virtualHostName] = struct{}{}
+		resolved[virtualHostName] = append(resolved[virtualHostName], name)
+	}
+	for _, aliases := range resolved {
+		sort.Strings(aliases)
+	}
+	sort.Strings(unresolved)
+	return resolved, unresolved
+}
+
+type deltaSendFunc func(resp *envoy_service_discovery_v3.DeltaDiscoveryResponse) error
+
+func (s *deltaServer) DeltaEnvoyV3(stream DeltaStreamEnvoyV3, defaultTypeURL string) error {
+	reqCh := make(chan *envoy_service_discovery_v3.DeltaDiscoveryRequest)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		defer close(reqCh)
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			select {
+			case reqCh <- req:
+			case <-done:
+				return
+			}
+		}
+	}()
+	return s.process(stream.Context(), stream.Send, reqCh, defaultTypeURL)
+}
+
+func (s *deltaServer) DeltaSolo(stream DeltaStreamSolo, defaultTypeURL string) error {
+	reqCh := make(chan *envoy_service_discovery_v3.DeltaDiscoveryRequest)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		defer close(reqCh)
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			select {
+			case reqCh <- upgradeDeltaDiscoveryRequest(req):
+			case <-done:
+				return
+			}
+		}
+	}()
+	send := func(resp *envoy_service_discovery_v3.DeltaDiscoveryResponse) error {
+		return stream.Send(downgradeDeltaDiscoveryResponse(resp))
+	}
+	return s.process(stream.Context(), send, reqCh, defaultTypeURL)
+}
+
+// process handles a bi-di incremental stream
+func (s *deltaServer) process(
+	ctx context.Context,
+	send deltaSendFunc,
+	reqCh <-chan *envoy_service_discovery_v3.DeltaDiscoveryRequest,
+	defaultTypeURL string,
+) error {
+	logger := contextutils.LoggerFrom(ctx)
+	streamID := atomic.AddInt64(&s.streamCount, 1)
+
+	// unique nonce generator for req-resp pairs per xDS stream
+	var streamNonce int64
+
+	subscriptions := map[string]*deltaSubscription{}
+	// closed when the stream ends, so that pending watches stop forwarding responses
+	done := make(chan struct{})
+	defer func() {
+		close(done)
+		for _, sub := range subscriptions {
+			if sub.cancel != nil {
+				sub.cancel()
+			}
+		}
+		if s.callbacks != nil {
+			s.callbacks.OnStreamClosed(streamID)
+		}
+	}()
+
+	if s.callbacks != nil {
+		if err := s.callbacks.OnStreamOpen(ctx, streamID, defaultTypeURL); err != nil {
+			return err
+		}
+	}
+
+	responses := make(chan deltaResponse)
+	respond := func(sub *deltaSubscription, typeURL string) error {
+		out := sub.diff(typeURL)
+		if out == nil {
+			return nil
+		}
+		streamNonce++
+		out.Nonce = strconv.FormatInt(streamNonce, 10)
+		sub.sent = append(sub.sent, sentResponse{nonce: out.GetNonce(), version: out.GetSystemVersionInfo()})
+		if s.callbacks != nil {
+			s.callbacks.OnStreamResponse(streamID, sub.request, stateOfTheWorldResponse(out))
+		}
+		return send(out)
+	}
+
+	// node may only be set on the first discovery request
+	var node = &envoy_config_core_v3.Node{}
+	for {
+		select {
+		case <-s.ctx.Done():
+			return nil
+		case resp := <-responses:
+			if resp.response == nil {
+				return status.Errorf(codes.Unavailable, "watching failed for %s", resp.typeURL)
+			}
+			sub := subscriptions[resp.typeURL]
+			if err := sub.setResources(resp.response, resp.typeURL); err != nil {
+				return err
+			}
+			// cache watches are responded once; watch for the next version of the resources
+			sub.cancel = s.watch(responses, done, node, resp.typeURL, resp.response.Version)
+			if err := respond(sub, resp.typeURL); err != nil {
+				return err
+			}
+
+		case req, more := <-reqCh:
+			// input stream ended or errored out
+			if !more {
+				return nil
+			}
+			if req == nil {
+				return status.Errorf(codes.Unavailable, "empty request")
+			}
+
+			// node field in discovery request is delta-compressed
+			if req.GetNode() != nil {
+				node = req.GetNode()
+			} else {
+				req.Node = node
+			}
+
+			// type URL is required for ADS but is implicit for xDS
+			if defaultTypeURL == types.AnyType {
+				if req.GetTypeUrl() == "" {
+					return status.Errorf(codes.InvalidArgument, "type URL is required for ADS")
+				}
+			} else if req.GetTypeUrl() == "" {
+				req.TypeUrl = defaultTypeURL
+			}
+			typeURL := req.GetTypeUrl()
+
+			if req.GetErrorDetail() != nil {
+				// the client keeps its previous versions of the rejected resources; they are sent
+				// again when they change in a later snapshot
+				logger.Warnf("delta xDS %s response nonce %s was rejected by node %s: %s",
+					typeURL, req.GetResponseNonce(), node.GetId(), req.GetErrorDetail().GetMessage())
+			}
+
+			sub, ok := subscriptions[typeURL]
+			if !ok {
+				sub = newDeltaSubscription()
+				subscriptions[typeURL] = sub
+			}
+			sub.update(req, !ok)
+			sub.acknowledge(req)
+			sub.request = stateOfTheWorldRequest(req, sub)
+
+			if s.callbacks != nil {
+				if err := s.callbacks.OnStreamRequest(streamID, sub.request); err != nil {
+					return err
+				}
+			}
+
+			if !sub.watching {
+				sub.watching = true
+				sub.cancel = s.watch(responses, done, node, typeURL, "")
+				continue
+			}
+			// respond to new subscriptions with the resources of the last snapshot
+			if err := respond(sub, typeURL); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// watch creates a watch on the cache for all the resources of a type, that is responded when the
+// version of the resources in the node's snapshot differs from version.
+func (s *deltaServer) watch(
+	responses chan<- deltaResponse,
+	done <-chan struct{},
+	node *envoy_config_core_v3.Node,
+	typeURL, version string,
+) func() {
+	value, cancel := s.cache.CreateWatch(cache.Request{
+		Node:        node,
+		TypeUrl:     typeURL,
+		VersionInfo: version,
+	})
+	go func() {
+		var out deltaResponse
+		select {
+		case resp, ok := <-value:
+			out = deltaResponse{typeURL: typeURL}
+			if ok {
+				out.response = &resp
+			}
+		case <-done:
+			return
+		}
+		select {
+		case responses <- out:
+		case <-done:
+		}
+	}()
+	return cancel
+}
+
+// stateOfTheWorldRequest returns the state of the world equivalent of a delta request, for callbacks.
+// Like a state of the world client, it has the version of the last response the client accepted.
+func stateOfTheWorldRequest(req *envoy_service_discovery_v3.DeltaDiscoveryRequest, sub *deltaSubscription) *envoy_service_discovery_v3.DiscoveryRequest {
+	out := &envoy_service_discovery_v3.DiscoveryRequest{
+		Node:          req.GetNode(),
+		TypeUrl:       req.GetTypeUrl(),
+		ResponseNonce: req.GetResponseNonce(),
+		ErrorDetail:   req.GetErrorDetail(),
+		VersionInfo:   sub.ackedVersion,
+	}
+	if !sub.wildcard {
+		for name := range sub.names {
+			out.ResourceNames = append(out.ResourceNames, name)
+		}
+	}
+	return out
+}
+
+// stateOfTheWorldResponse returns the state of the world equivalent of a delta response, for callbacks.
+// It only has the resources that were added or changed.
+func stateOfTheWorldResponse(resp *envoy_service_discovery_v3.DeltaDiscoveryResponse) *envoy_service_discovery_v3.DiscoveryResponse {
+	out := &envoy_service_discovery_v3.DiscoveryResponse{
+		VersionInfo: resp.GetSystemVersionInfo(),
+		TypeUrl:     resp.GetTypeUrl(),
+		Nonce:       resp.GetNonce(),
+	}
+	for _, resource := range resp.GetResources() {
+		if resource.GetResource() != nil {
+			out.Resources = append(out.Resources, resource.GetResource())
+		}
+	}
+	return out
+}
+
+func upgradeDeltaDiscoveryRequest(req *sk_discovery.DeltaDiscoveryRequest) *envoy_service_discovery_v3.DeltaDiscoveryRequest {
+	if req == nil {
+		return nil
+	}
+	return &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+		Node:                     util.UpgradeNode(req.GetNode()),
+		TypeUrl:                  req.GetTypeUrl(),
+		ResourceNamesSubscribe:   req.GetResourceNamesSubscribe(),
+		ResourceNamesUnsubscribe: req.GetResourceNamesUnsubscribe(),
+		InitialResourceVersions:  req.GetInitialResourceVersions(),
+		ResponseNonce:            req.GetResponseNonce(),
+		ErrorDetail:              req.GetErrorDetail(),
+	}
+}
+
+func downgradeDeltaDiscoveryResponse(resp *envoy_service_discovery_v3.DeltaDiscoveryResponse) *sk_discovery.DeltaDiscoveryResponse {
+	if resp == nil {
+		return nil
+	}
+	out := &sk_discovery.DeltaDiscoveryResponse{
+		SystemVersionInfo: resp.GetSystemVersionInfo(),
+		TypeUrl:           resp.GetTypeUrl(),
+		RemovedResources:  resp.GetRemovedResources(),
+		Nonce:             resp.GetNonce(),
+	}
+	for _, resource := range resp.GetResources() {
+		out.Resources = append(out.Resources, &sk_discovery.Resource{
+			Name:     resource.GetName(),
+			Aliases:  resource.GetAliases(),
+			Version:  resource.GetVersion(),
+			Resource: resource.GetResource(),
+		})
+	}
+	return out
+}
@@ -0,0 +1,367 @@
+package xds_test
+
+import (
+	"context"
+	"io"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	sk_discovery "github.com/solo-io/solo-kit/pkg/api/external/envoy/api/v2"
+	sk_discovery_core "github.com/solo-io/solo-kit/pkg/api/external/envoy/api/v2/core"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/types"
+	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type fakeDeltaStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	requests  chan *envoy_service_discovery_v3.DeltaDiscoveryRequest
+	responses chan *envoy_service_discovery_v3.DeltaDiscoveryResponse
+}
+
+func newFakeDeltaStream(ctx context.Context) *fakeDeltaStream {
+	return &fakeDeltaStream{
+		ctx:       ctx,
+		requests:  make(chan *envoy_service_discovery_v3.DeltaDiscoveryRequest, 10),
+		responses: make(chan *envoy_service_discovery_v3.DeltaDiscoveryResponse, 10),
+	}
+}
+
+func (s *fakeDeltaStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeDeltaStream) Send(resp *envoy_service_discovery_v3.DeltaDiscoveryResponse) error {
+	s.responses <- resp
+	return nil
+}
+
+func (s *fakeDeltaStream) Recv() (*envoy_service_discovery_v3.DeltaDiscoveryRequest, error) {
+	select {
+	case req := <-s.requests:
+		return req, nil
+	case <-s.ctx.Done():
+		return nil, io.EOF
+	}
+}
+
+type fakeSoloDeltaStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	requests  chan *sk_discovery.DeltaDiscoveryRequest
+	responses chan *sk_discovery.DeltaDiscoveryResponse
+}
+
+func (s *fakeSoloDeltaStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeSoloDeltaStream) Send(resp *sk_discovery.DeltaDiscoveryResponse) error {
+	s.responses <- resp
+	return nil
+}
+
+func (s *fakeSoloDeltaStream) Recv() (*sk_discovery.DeltaDiscoveryRequest, error) {
+	select {
+	case req := <-s.requests:
+		return req, nil
+	case <-s.ctx.Done():
+		return nil, io.EOF
+	}
+}
+
+var _ = Describe("DeltaServer", func() {
+
+	const nodeKey = "gloo-system~gateway-proxy"
+
+	var (
+		ctx           context.Context
+		cancel        context.CancelFunc
+		snapshotCache cache.SnapshotCache
+		deltaServer   xds.DeltaServer
+		node          *envoy_config_core_v3.Node
+	)
+
+	cluster := func(name string, lbPolicy envoy_config_cluster_v3.Cluster_LbPolicy) cache.Resource {
+		return resource.NewEnvoyResource(&envoy_config_cluster_v3.Cluster{
+			Name:                 name,
+			ClusterDiscoveryType: &envoy_config_cluster_v3.Cluster_Type{Type: envoy_config_cluster_v3.Cluster_EDS},
+			LbPolicy:             lbPolicy,
+		})
+	}
+
+	endpoints := func(name string) cache.Resource {
+		return resource.NewEnvoyResource(&envoy_config_endpoint_v3.ClusterLoadAssignment{ClusterName: name})
+	}
+
+	resourceNames := func(resp *envoy_service_discovery_v3.DeltaDiscoveryResponse) []string {
+		var names []string
+		for _, r := range resp.GetResources() {
+			names = append(names, r.GetName())
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		snapshotCache = xds.NewAdsSnapshotCache(ctx)
+		deltaServer = xds.NewDeltaServer(ctx, snapshotCache, nil)
+		node = &envoy_config_core_v3.Node{
+			Id: "envoy",
+			Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{
+				xds.RoleKey: structpb.NewStringValue(nodeKey),
+			}},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("sends only the resources that changed to wildcard subscribers", func() {
+		snapshotCache.SetSnapshot(nodeKey, xds.NewSnapshot("1", nil, []cache.Resource{cluster("a", 0), cluster("b", 0)}, nil, nil))
+
+		stream := newFakeDeltaStream(ctx)
+		go deltaServer.DeltaEnvoyV3(stream, types.AnyType)
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{Node: node, TypeUrl: types.ClusterTypeV3}
+
+		var resp *envoy_service_discovery_v3.DeltaDiscoveryResponse
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(resp.GetSystemVersionInfo()).To(Equal("1"))
+		Expect(resp.GetTypeUrl()).To(Equal(types.ClusterTypeV3))
+		Expect(resourceNames(resp)).To(ConsistOf("a", "b"))
+		Expect(resp.GetRemovedResources()).To(BeEmpty())
+		Expect(resp.GetResources()[0].GetResource().GetTypeUrl()).To(Equal(types.ClusterTypeV3))
+
+		// ack
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{TypeUrl: types.ClusterTypeV3, ResponseNonce: resp.GetNonce()}
+
+		// a is removed, b changes and c is added
+		snapshotCache.SetSnapshot(nodeKey, xds.NewSnapshot("2", nil, []cache.Resource{cluster("b", envoy_config_cluster_v3.Cluster_RANDOM), cluster("c", 0)}, nil, nil))
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(resp.GetSystemVersionInfo()).To(Equal("2"))
+		Expect(resourceNames(resp)).To(ConsistOf("b", "c"))
+		Expect(resp.GetRemovedResources()).To(ConsistOf("a"))
+
+		// resources that didn't change are not sent again
+		snapshotCache.SetSnapshot(nodeKey, xds.NewSnapshot("3", nil, []cache.Resource{cluster("b", envoy_config_cluster_v3.Cluster_RANDOM), cluster("c", 0)}, nil, nil))
+		Consistently(stream.responses).ShouldNot(Receive())
+	})
+
+	It("only sends the resources the client subscribed to", func() {
+		snapshotCache.SetSnapshot(nodeKey, xds.NewSnapshot("1",
+			[]cache.Resource{endpoints("x"), endpoints("y"), endpoints("z")},
+			[]cache.Resource{cluster("x", 0), cluster("y", 0), cluster("z", 0)}, nil, nil))
+
+		stream := newFakeDeltaStream(ctx)
+		go deltaServer.DeltaEnvoyV3(stream, types.EndpointTypeV3)
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			Node:                   node,
+			ResourceNamesSubscribe: []string{"x", "missing"},
+		}
+
+		var resp *envoy_service_discovery_v3.DeltaDiscoveryResponse
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(resp.GetTypeUrl()).To(Equal(types.EndpointTypeV3))
+		Expect(resourceNames(resp)).To(ConsistOf("x"))
+		// the client is told resources it subscribed to don't exist
+		Expect(resp.GetRemovedResources()).To(ConsistOf("missing"))
+
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			ResourceNamesSubscribe:   []string{"y"},
+			ResourceNamesUnsubscribe: []string{"x"},
+			ResponseNonce:            resp.GetNonce(),
+		}
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(resourceNames(resp)).To(ConsistOf("y"))
+		Expect(resp.GetRemovedResources()).To(BeEmpty())
+	})
+
+	It("doesn't resend the resources the client already has", func() {
+		snapshotCache.SetSnapshot(nodeKey, xds.NewSnapshot("1", nil, []cache.Resource{cluster("a", 0)}, nil, nil))
+
+		// learn the version of a
+		stream := newFakeDeltaStream(ctx)
+		go deltaServer.DeltaEnvoyV3(stream, types.ClusterTypeV3)
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{Node: node}
+		var resp *envoy_service_discovery_v3.DeltaDiscoveryResponse
+		Eventually(stream.responses).Should(Receive(&resp))
+		version := resp.GetResources()[0].GetVersion()
+
+		snapshotCache.SetSnapshot(nodeKey, xds.NewSnapshot("2", nil, []cache.Resource{cluster("a", 0), cluster("b", 0)}, nil, nil))
+		Eventually(stream.responses).Should(Receive())
+
+		// a reconnecting client only gets the resources it doesn't have
+		reconnected := newFakeDeltaStream(ctx)
+		go deltaServer.DeltaEnvoyV3(reconnected, types.ClusterTypeV3)
+		reconnected.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			Node:                    node,
+			InitialResourceVersions: map[string]string{"a": version, "old": "1"},
+		}
+		Eventually(reconnected.responses).Should(Receive(&resp))
+		Expect(resourceNames(resp)).To(ConsistOf("b"))
+		Expect(resp.GetRemovedResources()).To(ConsistOf("old"))
+	})
+
+	It("serves solo delta streams", func() {
+		snapshotCache.SetSnapshot(nodeKey, xds.NewSnapshot("1", nil, []cache.Resource{cluster("a", 0)}, nil, nil))
+
+		stream := &fakeSoloDeltaStream{
+			ctx:       ctx,
+			requests:  make(chan *sk_discovery.DeltaDiscoveryRequest, 1),
+			responses: make(chan *sk_discovery.DeltaDiscoveryResponse, 1),
+		}
+		go deltaServer.DeltaSolo(stream, types.AnyType)
+		stream.requests <- &sk_discovery.DeltaDiscoveryRequest{
+			Node: &sk_discovery_core.Node{
+				Metadata: node.GetMetadata(),
+			},
+			TypeUrl: types.ClusterTypeV3,
+		}
+
+		var resp *sk_discovery.DeltaDiscoveryResponse
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(resp.GetResources()).To(HaveLen(1))
+		Expect(resp.GetResources()[0].GetName()).To(Equal("a"))
+		Expect(resp.GetNonce()).NotTo(BeEmpty())
+	})
+
+	It("resolves the virtual hosts VHDS clients subscribe to on demand", func() {
+		virtualHost := func(name string, domains ...string) cache.Resource {
+			return xds.NewVirtualHostResource(&envoy_config_route_v3.VirtualHost{Name: name, Domains: domains})
+		}
+		snapshotCache.SetSnapshot(nodeKey, xds.NewSnapshotFromResources(
+			cache.NewResources("", nil),
+			cache.NewResources("", nil),
+			cache.NewResources("", nil),
+			cache.NewResources("", nil),
+			cache.NewResources("", nil),
+			cache.NewResources("1", []cache.Resource{
+				virtualHost("routes/exact", "a.com", "a.com:8080"),
+				virtualHost("routes/wildcard", "*.a.com"),
+				virtualHost("other/any", "*"),
+			}),
+			cache.NewResources("", nil),
+			cache.NewResources("", nil),
+		))
+
+		stream := newFakeDeltaStream(ctx)
+		go deltaServer.DeltaEnvoyV3(stream, xds.VirtualHostTypeV3)
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{Node: node}
+
+		// the client isn't sent all the virtual hosts, but it isn't left waiting either
+		var resp *envoy_service_discovery_v3.DeltaDiscoveryResponse
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(resp.GetTypeUrl()).To(Equal(xds.VirtualHostTypeV3))
+		Expect(resp.GetResources()).To(BeEmpty())
+
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			ResourceNamesSubscribe: []string{"routes/a.com", "routes/A.com:8080", "routes/www.a.com", "routes/b.com"},
+			ResponseNonce:          resp.GetNonce(),
+		}
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(resp.GetResources()).To(HaveLen(3))
+		aliases := map[string][]string{}
+		for _, r := range resp.GetResources() {
+			aliases[r.GetName()] = r.GetAliases()
+		}
+		Expect(aliases).To(Equal(map[string][]string{
+			"routes/exact":    {"routes/A.com:8080", "routes/a.com"},
+			"routes/wildcard": {"routes/www.a.com"},
+			// the virtual host of the other route configuration doesn't match
+			"routes/b.com": {"routes/b.com"},
+		}))
+		for _, r := range resp.GetResources() {
+			if r.GetName() == "routes/b.com" {
+				// unresolved aliases have no resource
+				Expect(r.GetResource()).To(BeNil())
+			} else {
+				Expect(r.GetResource().GetTypeUrl()).To(Equal(xds.VirtualHostTypeV3))
+			}
+		}
+
+		// removed virtual hosts are removed by name
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{ResponseNonce: resp.GetNonce()}
+		snapshotCache.SetSnapshot(nodeKey, xds.NewSnapshotFromResources(
+			cache.NewResources("", nil),
+			cache.NewResources("", nil),
+			cache.NewResources("", nil),
+			cache.NewResources("", nil),
+			cache.NewResources("", nil),
+			cache.NewResources("2", []cache.Resource{
+				virtualHost("routes/exact", "a.com", "a.com:8080"),
+			}),
+			cache.NewResources("", nil),
+			cache.NewResources("", nil),
+		))
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(resp.GetResources()).To(BeEmpty())
+		Expect(resp.GetRemovedResources()).To(ConsistOf("routes/wildcard"))
+	})
+
+	It("notifies callbacks of the versions the client accepts and rejects", func() {
+		ackTracker := xds.NewAckTracker()
+		deltaServer = xds.NewDeltaServer(ctx, snapshotCache, ackTracker)
+		snapshotCache.SetSnapshot(nodeKey, xds.NewSnapshot("1", nil, []cache.Resource{cluster("a", 0)}, nil, nil))
+
+		stream := newFakeDeltaStream(ctx)
+		go deltaServer.DeltaEnvoyV3(stream, types.AnyType)
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{Node: node, TypeUrl: types.ClusterTypeV3}
+		var resp *envoy_service_discovery_v3.DeltaDiscoveryResponse
+		Eventually(stream.responses).Should(Receive(&resp))
+
+		clusterStatus := func() xds.ResourceTypeStatus {
+			statuses := ackTracker.Statuses()[nodeKey]
+			if len(statuses) == 0 {
+				return xds.ResourceTypeStatus{}
+			}
+			return statuses[0]
+		}
+
+		// ack
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{TypeUrl: types.ClusterTypeV3, ResponseNonce: resp.GetNonce()}
+		Eventually(clusterStatus).Should(And(
+			HaveField("AckedVersion", "1"),
+			HaveField("RejectedVersion", ""),
+		))
+
+		// nack
+		snapshotCache.SetSnapshot(nodeKey, xds.NewSnapshot("2", nil, []cache.Resource{cluster("a", envoy_config_cluster_v3.Cluster_RANDOM)}, nil, nil))
+		Eventually(stream.responses).Should(Receive(&resp))
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			TypeUrl:       types.ClusterTypeV3,
+			ResponseNonce: resp.GetNonce(),
+			ErrorDetail:   &rpcstatus.Status{Message: "cluster a: invalid"},
+		}
+		Eventually(clusterStatus).Should(And(
+			HaveField("AckedVersion", "1"),
+			HaveField("RejectedVersion", "2"),
+			HaveField("Error", "cluster a: invalid"),
+		))
+
+		// the client accepts a later version
+		snapshotCache.SetSnapshot(nodeKey, xds.NewSnapshot("3", nil, []cache.Resource{cluster("a", envoy_config_cluster_v3.Cluster_MAGLEV)}, nil, nil))
+		Eventually(stream.responses).Should(Receive(&resp))
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{TypeUrl: types.ClusterTypeV3, ResponseNonce: resp.GetNonce()}
+		Eventually(clusterStatus).Should(And(
+			HaveField("AckedVersion", "3"),
+			HaveField("Error", ""),
+		))
+	})
+
+	It("requires a type URL for ADS", func() {
+		stream := newFakeDeltaStream(ctx)
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{Node: node}
+		Expect(deltaServer.DeltaEnvoyV3(stream, types.AnyType)).To(MatchError(ContainSubstring("type URL is required for ADS")))
+	})
+})
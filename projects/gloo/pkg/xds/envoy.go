@@ -4,8 +4,10 @@ import (
 	envoy_service_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/service/cluster/v3"
 	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	envoy_service_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/service/endpoint/v3"
+	envoy_service_extension_v3 "github.com/envoyproxy/go-control-plane/envoy/service/extension/v3"
 	envoy_service_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/service/listener/v3"
 	envoy_service_route_v3 "github.com/envoyproxy/go-control-plane/envoy/service/route/v3"
+	envoy_service_secret_v3 "github.com/envoyproxy/go-control-plane/envoy/service/secret/v3"
 
 	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
 	envoyserver "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/server"
@@ -14,7 +16,7 @@ import (
 )
 
 // register xDS methods with GRPC server
-func SetupEnvoyXds(grpcServer *grpc.Server, xdsServer envoyserver.Server, envoyCache envoycache.SnapshotCache) {
+func SetupEnvoyXds(grpcServer *grpc.Server, xdsServer envoyserver.Server, deltaXdsServer DeltaServer, envoyCache envoycache.SnapshotCache) {
 
 	// check if we need to register
 	if _, ok := grpcServer.GetServiceInfo()["solo.io.xds.SoloDiscoveryService"]; ok {
@@ -24,14 +26,19 @@ func SetupEnvoyXds(grpcServer *grpc.Server, xdsServer envoyserver.Server, envoyC
 	// The Gloo Server is an xDS server that accepts v2 Envoy ADS requests. The Envoy v2 API has been
 	// deprecated but the ADS api has been preserved internally to support discovery of
 	// ext-auth and rate-limit configurations.
-	glooServer := NewGlooXdsServer(xdsServer)
+	// Both servers also serve incremental (delta) xDS streams, for clients that opt in with DELTA_GRPC.
+	glooServer := NewGlooXdsServer(xdsServer, deltaXdsServer)
 	solo_xds.RegisterSoloDiscoveryServiceServer(grpcServer, glooServer)
 
-	envoyServer := NewEnvoyServerV3(xdsServer)
+	envoyServer := NewEnvoyServerV3(xdsServer, deltaXdsServer)
 	envoy_service_endpoint_v3.RegisterEndpointDiscoveryServiceServer(grpcServer, envoyServer)
 	envoy_service_cluster_v3.RegisterClusterDiscoveryServiceServer(grpcServer, envoyServer)
 	envoy_service_route_v3.RegisterRouteDiscoveryServiceServer(grpcServer, envoyServer)
+	envoy_service_route_v3.RegisterScopedRoutesDiscoveryServiceServer(grpcServer, envoyServer)
+	envoy_service_route_v3.RegisterVirtualHostDiscoveryServiceServer(grpcServer, envoyServer)
 	envoy_service_listener_v3.RegisterListenerDiscoveryServiceServer(grpcServer, envoyServer)
+	envoy_service_extension_v3.RegisterExtensionConfigDiscoveryServiceServer(grpcServer, envoyServer)
+	envoy_service_secret_v3.RegisterSecretDiscoveryServiceServer(grpcServer, envoyServer)
 	envoy_service_discovery_v3.RegisterAggregatedDiscoveryServiceServer(grpcServer, envoyServer)
 
 	// Seed the cache with a fallback snapshot
@@ -17,13 +17,14 @@ package xds
 
 import (
 	"context"
-	"errors"
 
 	envoy_service_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/service/cluster/v3"
 	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	envoy_service_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/service/endpoint/v3"
+	envoy_service_extension_v3 "github.com/envoyproxy/go-control-plane/envoy/service/extension/v3"
 	envoy_service_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/service/listener/v3"
 	envoy_service_route_v3 "github.com/envoyproxy/go-control-plane/envoy/service/route/v3"
+	envoy_service_secret_v3 "github.com/envoyproxy/go-control-plane/envoy/service/secret/v3"
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -36,16 +37,21 @@ type EnvoyServerV3 interface {
 	envoy_service_endpoint_v3.EndpointDiscoveryServiceServer
 	envoy_service_cluster_v3.ClusterDiscoveryServiceServer
 	envoy_service_route_v3.RouteDiscoveryServiceServer
+	envoy_service_route_v3.ScopedRoutesDiscoveryServiceServer
+	envoy_service_route_v3.VirtualHostDiscoveryServiceServer
 	envoy_service_listener_v3.ListenerDiscoveryServiceServer
+	envoy_service_extension_v3.ExtensionConfigDiscoveryServiceServer
+	envoy_service_secret_v3.SecretDiscoveryServiceServer
 	envoy_service_discovery_v3.AggregatedDiscoveryServiceServer
 }
 
 type envoyServerV3 struct {
 	server.Server
+	deltaServer DeltaServer
 }
 
-func NewEnvoyServerV3(genericServer server.Server) EnvoyServerV3 {
-	return &envoyServerV3{Server: genericServer}
+func NewEnvoyServerV3(genericServer server.Server, deltaServer DeltaServer) EnvoyServerV3 {
+	return &envoyServerV3{Server: genericServer, deltaServer: deltaServer}
 }
 
 func (s *envoyServerV3) StreamAggregatedResources(
@@ -72,12 +78,30 @@ func (s *envoyServerV3) StreamRoutes(
 	return s.Server.StreamEnvoyV3(stream, types.RouteTypeV3)
 }
 
+func (s *envoyServerV3) StreamScopedRoutes(
+	stream envoy_service_route_v3.ScopedRoutesDiscoveryService_StreamScopedRoutesServer,
+) error {
+	return s.Server.StreamEnvoyV3(stream, ScopedRouteTypeV3)
+}
+
 func (s *envoyServerV3) StreamListeners(
 	stream envoy_service_listener_v3.ListenerDiscoveryService_StreamListenersServer,
 ) error {
 	return s.Server.StreamEnvoyV3(stream, types.ListenerTypeV3)
 }
 
+func (s *envoyServerV3) StreamExtensionConfigs(
+	stream envoy_service_extension_v3.ExtensionConfigDiscoveryService_StreamExtensionConfigsServer,
+) error {
+	return s.Server.StreamEnvoyV3(stream, ExtensionConfigTypeV3)
+}
+
+func (s *envoyServerV3) StreamSecrets(
+	stream envoy_service_secret_v3.SecretDiscoveryService_StreamSecretsServer,
+) error {
+	return s.Server.StreamEnvoyV3(stream, SecretTypeV3)
+}
+
 func (s *envoyServerV3) FetchEndpoints(
 	ctx context.Context,
 	req *envoy_service_discovery_v3.DiscoveryRequest,
@@ -111,6 +135,17 @@ func (s *envoyServerV3) FetchRoutes(
 	return s.Server.FetchEnvoyV3(ctx, req)
 }
 
+func (s *envoyServerV3) FetchScopedRoutes(
+	ctx context.Context,
+	req *envoy_service_discovery_v3.DiscoveryRequest,
+) (*envoy_service_discovery_v3.DiscoveryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.Unavailable, "empty request")
+	}
+	req.TypeUrl = ScopedRouteTypeV3
+	return s.Server.FetchEnvoyV3(ctx, req)
+}
+
 func (s *envoyServerV3) FetchListeners(
 	ctx context.Context,
 	req *envoy_service_discovery_v3.DiscoveryRequest,
@@ -122,24 +157,79 @@ func (s *envoyServerV3) FetchListeners(
 	return s.Server.FetchEnvoyV3(ctx, req)
 }
 
-func (s *envoyServerV3) DeltaEndpoints(envoy_service_endpoint_v3.EndpointDiscoveryService_DeltaEndpointsServer) error {
-	return errors.New("not implemented")
+func (s *envoyServerV3) FetchExtensionConfigs(
+	ctx context.Context,
+	req *envoy_service_discovery_v3.DiscoveryRequest,
+) (*envoy_service_discovery_v3.DiscoveryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.Unavailable, "empty request")
+	}
+	req.TypeUrl = ExtensionConfigTypeV3
+	return s.Server.FetchEnvoyV3(ctx, req)
+}
+
+func (s *envoyServerV3) FetchSecrets(
+	ctx context.Context,
+	req *envoy_service_discovery_v3.DiscoveryRequest,
+) (*envoy_service_discovery_v3.DiscoveryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.Unavailable, "empty request")
+	}
+	req.TypeUrl = SecretTypeV3
+	return s.Server.FetchEnvoyV3(ctx, req)
+}
+
+func (s *envoyServerV3) DeltaEndpoints(
+	stream envoy_service_endpoint_v3.EndpointDiscoveryService_DeltaEndpointsServer,
+) error {
+	return s.deltaServer.DeltaEnvoyV3(stream, types.EndpointTypeV3)
+}
+
+func (s *envoyServerV3) DeltaClusters(
+	stream envoy_service_cluster_v3.ClusterDiscoveryService_DeltaClustersServer,
+) error {
+	return s.deltaServer.DeltaEnvoyV3(stream, types.ClusterTypeV3)
 }
 
-func (s *envoyServerV3) DeltaClusters(envoy_service_cluster_v3.ClusterDiscoveryService_DeltaClustersServer) error {
-	return errors.New("not implemented")
+func (s *envoyServerV3) DeltaRoutes(
+	stream envoy_service_route_v3.RouteDiscoveryService_DeltaRoutesServer,
+) error {
+	return s.deltaServer.DeltaEnvoyV3(stream, types.RouteTypeV3)
 }
 
-func (s *envoyServerV3) DeltaRoutes(envoy_service_route_v3.RouteDiscoveryService_DeltaRoutesServer) error {
-	return errors.New("not implemented")
+func (s *envoyServerV3) DeltaScopedRoutes(
+	stream envoy_service_route_v3.ScopedRoutesDiscoveryService_DeltaScopedRoutesServer,
+) error {
+	return s.deltaServer.DeltaEnvoyV3(stream, ScopedRouteTypeV3)
 }
 
-func (s *envoyServerV3) DeltaListeners(envoy_service_listener_v3.ListenerDiscoveryService_DeltaListenersServer) error {
-	return errors.New("not implemented")
+// DeltaVirtualHosts serves VHDS, which is only defined for incremental xDS
+func (s *envoyServerV3) DeltaVirtualHosts(
+	stream envoy_service_route_v3.VirtualHostDiscoveryService_DeltaVirtualHostsServer,
+) error {
+	return s.deltaServer.DeltaEnvoyV3(stream, VirtualHostTypeV3)
+}
+
+func (s *envoyServerV3) DeltaListeners(
+	stream envoy_service_listener_v3.ListenerDiscoveryService_DeltaListenersServer,
+) error {
+	return s.deltaServer.DeltaEnvoyV3(stream, types.ListenerTypeV3)
+}
+
+func (s *envoyServerV3) DeltaExtensionConfigs(
+	stream envoy_service_extension_v3.ExtensionConfigDiscoveryService_DeltaExtensionConfigsServer,
+) error {
+	return s.deltaServer.DeltaEnvoyV3(stream, ExtensionConfigTypeV3)
+}
+
+func (s *envoyServerV3) DeltaSecrets(
+	stream envoy_service_secret_v3.SecretDiscoveryService_DeltaSecretsServer,
+) error {
+	return s.deltaServer.DeltaEnvoyV3(stream, SecretTypeV3)
 }
 
 func (s *envoyServerV3) DeltaAggregatedResources(
-	envoy_service_discovery_v3.AggregatedDiscoveryService_DeltaAggregatedResourcesServer,
+	stream envoy_service_discovery_v3.AggregatedDiscoveryService_DeltaAggregatedResourcesServer,
 ) error {
-	return errors.New("not implemented")
+	return s.deltaServer.DeltaEnvoyV3(stream, types.AnyType)
 }
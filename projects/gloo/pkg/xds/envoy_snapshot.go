@@ -22,6 +22,7 @@ import (
 
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
@@ -32,6 +33,18 @@ import (
 var (
 	// Compile-time assertion
 	_ cache.Snapshot = new(EnvoySnapshot)
+
+	// EnvoySnapshotTypeURLs are the types of the resources of an EnvoySnapshot
+	EnvoySnapshotTypeURLs = []string{
+		types.EndpointTypeV3,
+		types.ClusterTypeV3,
+		types.RouteTypeV3,
+		ScopedRouteTypeV3,
+		VirtualHostTypeV3,
+		ExtensionConfigTypeV3,
+		SecretTypeV3,
+		types.ListenerTypeV3,
+	}
 )
 
 // Snapshot is an internally consistent snapshot of xDS resources.
@@ -47,6 +60,18 @@ type EnvoySnapshot struct {
 	// Routes are items in the RDS response payload.
 	Routes cache.Resources
 
+	// ScopedRoutes are items in the SRDS response payload.
+	ScopedRoutes cache.Resources
+
+	// VirtualHosts are items in the VHDS response payload.
+	VirtualHosts cache.Resources
+
+	// ExtensionConfigs are items in the ECDS response payload.
+	ExtensionConfigs cache.Resources
+
+	// Secrets are items in the SDS response payload.
+	Secrets cache.Resources
+
 	// Listeners are items in the LDS response payload.
 	Listeners cache.Resources
 }
@@ -61,10 +86,14 @@ func NewSnapshot(
 ) *EnvoySnapshot {
 	// TODO: Copy resources
 	return &EnvoySnapshot{
-		Endpoints: cache.NewResources(version, endpoints),
-		Clusters:  cache.NewResources(version, clusters),
-		Routes:    cache.NewResources(version, routes),
-		Listeners: cache.NewResources(version, listeners),
+		Endpoints:        cache.NewResources(version, endpoints),
+		Clusters:         cache.NewResources(version, clusters),
+		Routes:           cache.NewResources(version, routes),
+		ScopedRoutes:     cache.NewResources(version, nil),
+		VirtualHosts:     cache.NewResources(version, nil),
+		ExtensionConfigs: cache.NewResources(version, nil),
+		Secrets:          cache.NewResources(version, nil),
+		Listeners:        cache.NewResources(version, listeners),
 	}
 }
 
@@ -73,13 +102,21 @@ func NewSnapshotFromResources(
 	clusters cache.Resources,
 	routes cache.Resources,
 	listeners cache.Resources,
+	scopedRoutes cache.Resources,
+	virtualHosts cache.Resources,
+	extensionConfigs cache.Resources,
+	secrets cache.Resources,
 ) cache.Snapshot {
 	// TODO: Copy resources and downgrade, maybe maintain hash to not do it too many times (https://github.com/solo-io/gloo/issues/4421)
 	return &EnvoySnapshot{
-		Endpoints: endpoints,
-		Clusters:  clusters,
-		Routes:    routes,
-		Listeners: listeners,
+		Endpoints:        endpoints,
+		Clusters:         clusters,
+		Routes:           routes,
+		ScopedRoutes:     scopedRoutes,
+		VirtualHosts:     virtualHosts,
+		ExtensionConfigs: extensionConfigs,
+		Secrets:          secrets,
+		Listeners:        listeners,
 	}
 }
 
@@ -96,7 +133,7 @@ func NewEndpointsSnapshotFromResources(
 // Consistent check verifies that the dependent resources are exactly listed in the
 // snapshot:
 // - all EDS resources are listed by name in CDS resources
-// - all RDS resources are listed by name in LDS resources
+// - all RDS resources are listed by name in LDS or SRDS resources
 //
 // Note that clusters and listeners are requested without name references, so
 // Envoy will accept the snapshot list of clusters as-is even if it does not match
@@ -113,7 +150,7 @@ func (s *EnvoySnapshot) Consistent() error {
 		return err
 	}
 
-	routes := resource.GetResourceReferences(s.Listeners.Items)
+	routes := s.getRouteReferences()
 	if len(routes) != len(s.Routes.Items) {
 		return fmt.Errorf("mismatched route reference and resource lengths: length of %v does not equal length of %v", routes, s.Routes.Items)
 	}
@@ -132,6 +169,22 @@ func (s *EnvoySnapshot) MakeConsistent() {
 			Version: "empty",
 			Items:   map[string]cache.Resource{},
 		}
+		s.ScopedRoutes = cache.Resources{
+			Version: "empty",
+			Items:   map[string]cache.Resource{},
+		}
+		s.VirtualHosts = cache.Resources{
+			Version: "empty",
+			Items:   map[string]cache.Resource{},
+		}
+		s.ExtensionConfigs = cache.Resources{
+			Version: "empty",
+			Items:   map[string]cache.Resource{},
+		}
+		s.Secrets = cache.Resources{
+			Version: "empty",
+			Items:   map[string]cache.Resource{},
+		}
 		s.Clusters = cache.Resources{
 			Version: "empty",
 			Items:   map[string]cache.Resource{},
@@ -169,8 +222,8 @@ func (s *EnvoySnapshot) MakeConsistent() {
 		}
 	}
 
-	// for each listener persisted, add placeholder route if referenced route does not exist
-	childRoutes := resource.GetResourceReferences(s.Listeners.Items)
+	// for each listener or scoped route persisted, add placeholder route if referenced route does not exist
+	childRoutes := s.getRouteReferences()
 	persistedRouteNameSet := map[string]struct{}{}
 	for _, route := range s.Routes.Items {
 		persistedRouteNameSet[route.Self().Name] = struct{}{}
@@ -212,13 +265,39 @@ func (s *EnvoySnapshot) MakeConsistent() {
 			})
 	}
 
-	// remove each route not referenced by a listener
+	// remove each route not referenced by a listener or scoped route
 	// it is safe to delete from a map you are iterating over, example in effective go https://go.dev/doc/effective_go#for
 	for name, _ := range s.Routes.Items {
 		if _, exists := childRoutes[name]; !exists {
 			delete(s.Routes.Items, name)
 		}
 	}
+
+	// remove each virtual host whose route does not exist
+	for name, virtualHost := range s.VirtualHosts.Items {
+		for _, ref := range virtualHost.References() {
+			if _, exists := s.Routes.Items[ref.Name]; !exists {
+				delete(s.VirtualHosts.Items, name)
+			}
+		}
+	}
+
+	// remove each extension config not fetched by the HttpFilters of a listener
+	childExtensionConfigs := getExtensionConfigReferences(s.Listeners.Items)
+	for name, _ := range s.ExtensionConfigs.Items {
+		if _, exists := childExtensionConfigs[name]; !exists {
+			delete(s.ExtensionConfigs.Items, name)
+		}
+	}
+}
+
+// getRouteReferences returns the RouteConfigurations referenced by the listeners and the scoped routes, keyed by name.
+func (s *EnvoySnapshot) getRouteReferences() map[string]cache.Resource {
+	routes := resource.GetResourceReferences(s.Listeners.Items)
+	for name, scopedRoute := range getScopedRouteReferences(s.ScopedRoutes.Items) {
+		routes[name] = scopedRoute
+	}
+	return routes
 }
 
 // GetResources selects snapshot resources by type.
@@ -233,6 +312,14 @@ func (s *EnvoySnapshot) GetResources(typ string) cache.Resources {
 		return s.Clusters
 	case types.RouteTypeV3:
 		return s.Routes
+	case ScopedRouteTypeV3:
+		return s.ScopedRoutes
+	case VirtualHostTypeV3:
+		return s.VirtualHosts
+	case ExtensionConfigTypeV3:
+		return s.ExtensionConfigs
+	case SecretTypeV3:
+		return s.Secrets
 	case types.ListenerTypeV3:
 		return s.Listeners
 	}
@@ -257,6 +344,26 @@ func (s *EnvoySnapshot) Clone() cache.Snapshot {
 		Items:   cloneItems(s.Routes.Items),
 	}
 
+	snapshotClone.ScopedRoutes = cache.Resources{
+		Version: s.ScopedRoutes.Version,
+		Items:   cloneItems(s.ScopedRoutes.Items),
+	}
+
+	snapshotClone.VirtualHosts = cache.Resources{
+		Version: s.VirtualHosts.Version,
+		Items:   cloneItems(s.VirtualHosts.Items),
+	}
+
+	snapshotClone.ExtensionConfigs = cache.Resources{
+		Version: s.ExtensionConfigs.Version,
+		Items:   cloneItems(s.ExtensionConfigs.Items),
+	}
+
+	snapshotClone.Secrets = cache.Resources{
+		Version: s.Secrets.Version,
+		Items:   cloneItems(s.Secrets.Items),
+	}
+
 	snapshotClone.Listeners = cache.Resources{
 		Version: s.Listeners.Version,
 		Items:   cloneItems(s.Listeners.Items),
@@ -270,6 +377,22 @@ func cloneItems(items map[string]cache.Resource) map[string]cache.Resource {
 	for k, v := range items {
 		resProto := v.ResourceProto()
 		resClone := proto.Clone(resProto)
+		if scopedRoute, ok := resClone.(*envoy_config_route_v3.ScopedRouteConfiguration); ok {
+			clonedItems[k] = NewScopedRouteResource(scopedRoute)
+			continue
+		}
+		if virtualHost, ok := resClone.(*envoy_config_route_v3.VirtualHost); ok {
+			clonedItems[k] = NewVirtualHostResource(virtualHost)
+			continue
+		}
+		if extensionConfig, ok := resClone.(*envoy_config_core_v3.TypedExtensionConfig); ok {
+			clonedItems[k] = NewExtensionConfigResource(extensionConfig)
+			continue
+		}
+		if secret, ok := resClone.(*envoy_extensions_transport_sockets_tls_v3.Secret); ok {
+			clonedItems[k] = NewSecretResource(secret)
+			continue
+		}
 		clonedItems[k] = resource.NewEnvoyResource(resClone)
 	}
 	return clonedItems
@@ -313,6 +436,54 @@ func (this *EnvoySnapshot) Equal(that *EnvoySnapshot) bool {
 			return false
 		}
 	}
+	if len(this.ScopedRoutes.Items) != len(that.ScopedRoutes.Items) || this.ScopedRoutes.Version != that.ScopedRoutes.Version {
+		return false
+	}
+	for key, thisVal := range this.ScopedRoutes.Items {
+		thatVal, ok := that.ScopedRoutes.Items[key]
+		if !ok {
+			return false
+		}
+		if !proto.Equal(thisVal.ResourceProto(), thatVal.ResourceProto()) {
+			return false
+		}
+	}
+	if len(this.VirtualHosts.Items) != len(that.VirtualHosts.Items) || this.VirtualHosts.Version != that.VirtualHosts.Version {
+		return false
+	}
+	for key, thisVal := range this.VirtualHosts.Items {
+		thatVal, ok := that.VirtualHosts.Items[key]
+		if !ok {
+			return false
+		}
+		if !proto.Equal(thisVal.ResourceProto(), thatVal.ResourceProto()) {
+			return false
+		}
+	}
+	if len(this.ExtensionConfigs.Items) != len(that.ExtensionConfigs.Items) || this.ExtensionConfigs.Version != that.ExtensionConfigs.Version {
+		return false
+	}
+	for key, thisVal := range this.ExtensionConfigs.Items {
+		thatVal, ok := that.ExtensionConfigs.Items[key]
+		if !ok {
+			return false
+		}
+		if !proto.Equal(thisVal.ResourceProto(), thatVal.ResourceProto()) {
+			return false
+		}
+	}
+	if len(this.Secrets.Items) != len(that.Secrets.Items) || this.Secrets.Version != that.Secrets.Version {
+		return false
+	}
+	for key, thisVal := range this.Secrets.Items {
+		thatVal, ok := that.Secrets.Items[key]
+		if !ok {
+			return false
+		}
+		if !proto.Equal(thisVal.ResourceProto(), thatVal.ResourceProto()) {
+			return false
+		}
+	}
 	if len(this.Endpoints.Items) != len(that.Endpoints.Items) || this.Endpoints.Version != that.Endpoints.Version {
 		return false
 	}
@@ -7,6 +7,7 @@ import (
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_extensions_filters_network_http_connection_manager_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -15,6 +16,7 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/types"
+	"google.golang.org/protobuf/types/known/anypb"
 )
 
 var _ = Describe("EnvoySnapshot", func() {
@@ -96,4 +98,259 @@ var _ = Describe("EnvoySnapshot", func() {
 		snapshot.MakeConsistent()
 		Expect(snapshot.Consistent()).NotTo(HaveOccurred())
 	})
+
+	Context("scoped routes", func() {
+
+		var snapshot *xds.EnvoySnapshot
+
+		BeforeEach(func() {
+			routes := func(names ...string) cache.Resources {
+				var items []cache.Resource
+				for _, name := range names {
+					items = append(items, resource.NewEnvoyResource(&envoy_config_route_v3.RouteConfiguration{Name: name}))
+				}
+				return cache.NewResources("routes", items)
+			}
+			scopedRoute := func(name, routeConfigName string) cache.Resource {
+				return xds.NewScopedRouteResource(&envoy_config_route_v3.ScopedRouteConfiguration{
+					Name:                   name,
+					RouteConfigurationName: routeConfigName,
+				})
+			}
+
+			snapshot = xds.NewSnapshotFromResources(
+				cache.NewResources("", nil),
+				cache.NewResources("", nil),
+				routes("scoped", "orphan"),
+				cache.NewResources("", nil),
+				cache.NewResources("scoped-routes", []cache.Resource{
+					scopedRoute("a.com", "scoped"),
+					scopedRoute("b.com", "missing"),
+				}),
+				cache.NewResources("", nil),
+				cache.NewResources("", nil),
+				cache.NewResources("", nil),
+			).(*xds.EnvoySnapshot)
+		})
+
+		It("serves scoped routes", func() {
+			scopedRoutes := snapshot.GetResources(xds.ScopedRouteTypeV3)
+			Expect(scopedRoutes.Version).To(Equal("scoped-routes"))
+			Expect(scopedRoutes.Items).To(HaveKey("a.com"))
+			Expect(scopedRoutes.Items).To(HaveKey("b.com"))
+		})
+
+		It("keeps the routes referenced by scoped routes when making the snapshot consistent", func() {
+			Expect(snapshot.Consistent()).To(HaveOccurred())
+			snapshot.MakeConsistent()
+			Expect(snapshot.Consistent()).NotTo(HaveOccurred())
+
+			routes := snapshot.GetResources(types.RouteTypeV3).Items
+			Expect(routes).To(HaveKey("scoped"))
+			Expect(routes).To(HaveKey("missing"))
+			Expect(routes).NotTo(HaveKey("orphan"))
+		})
+
+		It("clones scoped routes", func() {
+			clone := snapshot.Clone().(*xds.EnvoySnapshot)
+			Expect(snapshot.Equal(clone)).To(BeTrue())
+
+			cloned := clone.GetResources(xds.ScopedRouteTypeV3).Items["a.com"]
+			Expect(cloned).To(BeAssignableToTypeOf(&xds.ScopedRouteResource{}))
+			Expect(cloned.References()).To(ConsistOf(cache.XdsResourceReference{Name: "scoped", Type: types.RouteTypeV3}))
+
+			cloned.(*xds.ScopedRouteResource).ScopedRoute.RouteConfigurationName = "changed"
+			Expect(snapshot.Equal(clone)).To(BeFalse())
+		})
+	})
+
+	Context("virtual hosts", func() {
+
+		var snapshot *xds.EnvoySnapshot
+
+		BeforeEach(func() {
+			virtualHost := func(name string) cache.Resource {
+				return xds.NewVirtualHostResource(&envoy_config_route_v3.VirtualHost{Name: name, Domains: []string{"*"}})
+			}
+			hcmAny, err := utils.MessageToAny(&envoy_extensions_filters_network_http_connection_manager_v3.HttpConnectionManager{
+				RouteSpecifier: &envoy_extensions_filters_network_http_connection_manager_v3.HttpConnectionManager_Rds{
+					Rds: &envoy_extensions_filters_network_http_connection_manager_v3.Rds{
+						RouteConfigName: "routes",
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			snapshot = xds.NewSnapshotFromResources(
+				cache.NewResources("", nil),
+				cache.NewResources("", nil),
+				cache.NewResources("routes", []cache.Resource{
+					resource.NewEnvoyResource(&envoy_config_route_v3.RouteConfiguration{
+						Name: "routes",
+						Vhds: &envoy_config_route_v3.Vhds{},
+					}),
+				}),
+				cache.NewResources("listeners", []cache.Resource{
+					resource.NewEnvoyResource(&envoy_config_listener_v3.Listener{
+						FilterChains: []*envoy_config_listener_v3.FilterChain{{
+							Filters: []*envoy_config_listener_v3.Filter{{
+								Name:       wellknown.HTTPConnectionManager,
+								ConfigType: &envoy_config_listener_v3.Filter_TypedConfig{TypedConfig: hcmAny},
+							}},
+						}},
+					}),
+				}),
+				cache.NewResources("", nil),
+				cache.NewResources("virtual-hosts", []cache.Resource{
+					virtualHost("routes/a"),
+					virtualHost("missing/b"),
+				}),
+				cache.NewResources("", nil),
+				cache.NewResources("", nil),
+			).(*xds.EnvoySnapshot)
+		})
+
+		It("serves virtual hosts", func() {
+			virtualHosts := snapshot.GetResources(xds.VirtualHostTypeV3)
+			Expect(virtualHosts.Version).To(Equal("virtual-hosts"))
+			Expect(virtualHosts.Items).To(HaveKey("routes/a"))
+			Expect(virtualHosts.Items["routes/a"].References()).To(ConsistOf(cache.XdsResourceReference{Name: "routes", Type: types.RouteTypeV3}))
+		})
+
+		It("removes the virtual hosts of missing routes when making the snapshot consistent", func() {
+			snapshot.MakeConsistent()
+
+			virtualHosts := snapshot.GetResources(xds.VirtualHostTypeV3).Items
+			Expect(virtualHosts).To(HaveKey("routes/a"))
+			Expect(virtualHosts).NotTo(HaveKey("missing/b"))
+		})
+
+		It("clones virtual hosts", func() {
+			clone := snapshot.Clone().(*xds.EnvoySnapshot)
+			Expect(snapshot.Equal(clone)).To(BeTrue())
+
+			cloned := clone.GetResources(xds.VirtualHostTypeV3).Items["routes/a"]
+			Expect(cloned).To(BeAssignableToTypeOf(&xds.VirtualHostResource{}))
+
+			cloned.(*xds.VirtualHostResource).VirtualHost.Domains = []string{"changed"}
+			Expect(snapshot.Equal(clone)).To(BeFalse())
+		})
+	})
+
+	Context("extension configs", func() {
+
+		var snapshot *xds.EnvoySnapshot
+
+		BeforeEach(func() {
+			extensionConfig := func(name string) cache.Resource {
+				return xds.NewExtensionConfigResource(&envoy_config_core_v3.TypedExtensionConfig{
+					Name:        name,
+					TypedConfig: &anypb.Any{TypeUrl: "type.googleapis.com/testing.config.TestHTTPFilter"},
+				})
+			}
+			hcmAny, err := utils.MessageToAny(&envoy_extensions_filters_network_http_connection_manager_v3.HttpConnectionManager{
+				HttpFilters: []*envoy_extensions_filters_network_http_connection_manager_v3.HttpFilter{{
+					Name: "discovered",
+					ConfigType: &envoy_extensions_filters_network_http_connection_manager_v3.HttpFilter_ConfigDiscovery{
+						ConfigDiscovery: &envoy_config_core_v3.ExtensionConfigSource{
+							TypeUrls: []string{"type.googleapis.com/testing.config.TestHTTPFilter"},
+						},
+					},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			snapshot = xds.NewSnapshotFromResources(
+				cache.NewResources("", nil),
+				cache.NewResources("", nil),
+				cache.NewResources("", nil),
+				cache.NewResources("listeners", []cache.Resource{
+					resource.NewEnvoyResource(&envoy_config_listener_v3.Listener{
+						FilterChains: []*envoy_config_listener_v3.FilterChain{{
+							Filters: []*envoy_config_listener_v3.Filter{{
+								Name:       wellknown.HTTPConnectionManager,
+								ConfigType: &envoy_config_listener_v3.Filter_TypedConfig{TypedConfig: hcmAny},
+							}},
+						}},
+					}),
+				}),
+				cache.NewResources("", nil),
+				cache.NewResources("", nil),
+				cache.NewResources("extension-configs", []cache.Resource{
+					extensionConfig("discovered"),
+					extensionConfig("orphan"),
+				}),
+				cache.NewResources("", nil),
+			).(*xds.EnvoySnapshot)
+		})
+
+		It("serves extension configs", func() {
+			extensionConfigs := snapshot.GetResources(xds.ExtensionConfigTypeV3)
+			Expect(extensionConfigs.Version).To(Equal("extension-configs"))
+			Expect(extensionConfigs.Items).To(HaveKey("discovered"))
+		})
+
+		It("removes the extension configs no listener fetches when making the snapshot consistent", func() {
+			snapshot.MakeConsistent()
+
+			extensionConfigs := snapshot.GetResources(xds.ExtensionConfigTypeV3).Items
+			Expect(extensionConfigs).To(HaveKey("discovered"))
+			Expect(extensionConfigs).NotTo(HaveKey("orphan"))
+		})
+
+		It("clones extension configs", func() {
+			clone := snapshot.Clone().(*xds.EnvoySnapshot)
+			Expect(snapshot.Equal(clone)).To(BeTrue())
+
+			cloned := clone.GetResources(xds.ExtensionConfigTypeV3).Items["discovered"]
+			Expect(cloned).To(BeAssignableToTypeOf(&xds.ExtensionConfigResource{}))
+
+			cloned.(*xds.ExtensionConfigResource).ExtensionConfig.Name = "changed"
+			Expect(snapshot.Equal(clone)).To(BeFalse())
+		})
+	})
+
+	Context("secrets", func() {
+
+		var snapshot *xds.EnvoySnapshot
+
+		BeforeEach(func() {
+			snapshot = xds.NewSnapshotFromResources(
+				cache.NewResources("", nil),
+				cache.NewResources("", nil),
+				cache.NewResources("", nil),
+				cache.NewResources("", nil),
+				cache.NewResources("", nil),
+				cache.NewResources("", nil),
+				cache.NewResources("", nil),
+				cache.NewResources("secrets", []cache.Resource{
+					xds.NewSecretResource(&envoy_extensions_transport_sockets_tls_v3.Secret{
+						Name: "secret",
+						Type: &envoy_extensions_transport_sockets_tls_v3.Secret_GenericSecret{
+							GenericSecret: &envoy_extensions_transport_sockets_tls_v3.GenericSecret{},
+						},
+					}),
+				}),
+			).(*xds.EnvoySnapshot)
+		})
+
+		It("serves secrets, which MakeConsistent keeps", func() {
+			snapshot.MakeConsistent()
+
+			secrets := snapshot.GetResources(xds.SecretTypeV3)
+			Expect(secrets.Version).To(Equal("secrets"))
+			Expect(secrets.Items).To(HaveKey("secret"))
+		})
+
+		It("clones secrets", func() {
+			clone := snapshot.Clone().(*xds.EnvoySnapshot)
+			Expect(snapshot.Equal(clone)).To(BeTrue())
+
+			cloned := clone.GetResources(xds.SecretTypeV3).Items["secret"]
+			Expect(cloned).To(BeAssignableToTypeOf(&xds.SecretResource{}))
+
+			cloned.(*xds.SecretResource).Secret.Name = "changed"
+			Expect(snapshot.Equal(clone)).To(BeFalse())
+		})
+	})
 })
@@ -0,0 +1,73 @@
+package xds
+
+import (
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/types"
+)
+
+// ExtensionConfigTypeV3 is the type URL of the resources served by ECDS.
+// The solo-kit control-plane only knows the EDS, CDS, RDS and LDS types, so it is defined here.
+const ExtensionConfigTypeV3 = types.TypePrefix + "/envoy.config.core.v3.TypedExtensionConfig"
+
+var (
+	// Compile-time assertion
+	_ cache.Resource = new(ExtensionConfigResource)
+)
+
+// ExtensionConfigResource wraps the config of an HttpFilter served by ECDS so it can be served from an EnvoySnapshot.
+// The name of the TypedExtensionConfig is the name of the HttpFilter that references it with config_discovery.
+type ExtensionConfigResource struct {
+	ExtensionConfig *envoy_config_core_v3.TypedExtensionConfig
+}
+
+func NewExtensionConfigResource(extensionConfig *envoy_config_core_v3.TypedExtensionConfig) *ExtensionConfigResource {
+	return &ExtensionConfigResource{ExtensionConfig: extensionConfig}
+}
+
+func (e *ExtensionConfigResource) Self() cache.XdsResourceReference {
+	return cache.XdsResourceReference{
+		Name: e.ExtensionConfig.GetName(),
+		Type: ExtensionConfigTypeV3,
+	}
+}
+
+func (e *ExtensionConfigResource) ResourceProto() cache.ResourceProto {
+	return e.ExtensionConfig
+}
+
+// References returns nothing, extension configs are referenced by the listeners, not the other way around.
+func (e *ExtensionConfigResource) References() []cache.XdsResourceReference {
+	return nil
+}
+
+// getExtensionConfigReferences returns the names of the extension configs the HttpFilters of the listeners
+// fetch with config_discovery.
+func getExtensionConfigReferences(listeners map[string]cache.Resource) map[string]struct{} {
+	out := make(map[string]struct{})
+	for _, res := range listeners {
+		if res == nil {
+			continue
+		}
+		listener, ok := res.ResourceProto().(*envoy_config_listener_v3.Listener)
+		if !ok {
+			continue
+		}
+		for _, chain := range listener.GetFilterChains() {
+			for _, filter := range chain.GetFilters() {
+				var hcm envoyhttp.HttpConnectionManager
+				if !filter.GetTypedConfig().MessageIs(&hcm) || filter.GetTypedConfig().UnmarshalTo(&hcm) != nil {
+					continue
+				}
+				for _, httpFilter := range hcm.GetHttpFilters() {
+					if httpFilter.GetConfigDiscovery() != nil {
+						out[httpFilter.GetName()] = struct{}{}
+					}
+				}
+			}
+		}
+	}
+	return out
+}
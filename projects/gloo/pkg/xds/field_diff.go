@@ -0,0 +1,215 @@
+package xds
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/cncf/xds/go/udpa/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const redactedValue = "<redacted>"
+
+// sensitiveFieldSuffixes identify the fields that hold credentials, in the extensions whose protos don't mark them
+// as sensitive, such as the access keys of the AWS Lambda extension of Gloo
+var sensitiveFieldSuffixes = []string{"secret", "_key", "_token", "password"}
+
+// FieldDiff is a field of a resource that differs between two snapshots, identified by the path of its proto field.
+// From is omitted for an added field, and To for a removed one. The values of sensitive fields are redacted.
+type FieldDiff struct {
+	Path string      `json:"path"`
+	From interface{} `json:"from,omitempty"`
+	To   interface{} `json:"to,omitempty"`
+}
+
+// diffMessages recursively compares two messages of the same type, and returns the fields that differ.
+// The messages packed in an Any are compared field by field when their type is known.
+func diffMessages(path string, from, to protoreflect.Message) []FieldDiff {
+	if from.Descriptor().FullName() == anyFullName {
+		return diffAnys(path, from, to)
+	}
+
+	var diffs []FieldDiff
+	fields := from.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		hasFrom, hasTo := from.Has(field), to.Has(field)
+		if !hasFrom && !hasTo {
+			continue
+		}
+		fieldPath := string(field.Name())
+		if path != "" {
+			fieldPath = path + "." + fieldPath
+		}
+		fromValue, toValue := from.Get(field), to.Get(field)
+
+		if isSensitive(field) {
+			if !fromValue.Equal(toValue) {
+				diffs = append(diffs, FieldDiff{
+					Path: fieldPath,
+					From: presentValue(hasFrom, redactedValue),
+					To:   presentValue(hasTo, redactedValue),
+				})
+			}
+			continue
+		}
+
+		switch {
+		case field.IsList():
+			diffs = append(diffs, diffLists(fieldPath, field, fromValue.List(), toValue.List())...)
+		case field.IsMap():
+			diffs = append(diffs, diffMaps(fieldPath, field, fromValue.Map(), toValue.Map())...)
+		default:
+			diffs = append(diffs, diffValues(fieldPath, field, fromValue, hasFrom, toValue, hasTo)...)
+		}
+	}
+	return diffs
+}
+
+func diffLists(path string, field protoreflect.FieldDescriptor, from, to protoreflect.List) []FieldDiff {
+	var diffs []FieldDiff
+	for i := 0; i < max(from.Len(), to.Len()); i++ {
+		var fromItem, toItem protoreflect.Value
+		if i < from.Len() {
+			fromItem = from.Get(i)
+		}
+		if i < to.Len() {
+			toItem = to.Get(i)
+		}
+		diffs = append(diffs, diffValues(fmt.Sprintf("%s[%d]", path, i), field, fromItem, i < from.Len(), toItem, i < to.Len())...)
+	}
+	return diffs
+}
+
+func diffMaps(path string, field protoreflect.FieldDescriptor, from, to protoreflect.Map) []FieldDiff {
+	keys := map[string]protoreflect.MapKey{}
+	collectKeys := func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys[key.String()] = key
+		return true
+	}
+	from.Range(collectKeys)
+	to.Range(collectKeys)
+	sortedKeys := make([]string, 0, len(keys))
+	for key := range keys {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Strings(sortedKeys)
+
+	var diffs []FieldDiff
+	for _, key := range sortedKeys {
+		mapKey := keys[key]
+		diffs = append(diffs, diffValues(fmt.Sprintf("%s[%s]", path, key), field.MapValue(),
+			from.Get(mapKey), from.Has(mapKey), to.Get(mapKey), to.Has(mapKey))...)
+	}
+	return diffs
+}
+
+// diffValues compares a singular value, such as an item of a list or map, of the type of the field
+func diffValues(path string, field protoreflect.FieldDescriptor, from protoreflect.Value, hasFrom bool, to protoreflect.Value, hasTo bool) []FieldDiff {
+	if field.Message() == nil {
+		fromValue, toValue := scalarValue(field, from, hasFrom), scalarValue(field, to, hasTo)
+		if reflect.DeepEqual(fromValue, toValue) {
+			return nil
+		}
+		return []FieldDiff{{Path: path, From: fromValue, To: toValue}}
+	}
+
+	fromMessage, toMessage := messageOrZero(from, hasFrom, to), messageOrZero(to, hasTo, from)
+	diffs := diffMessages(path, fromMessage, toMessage)
+	if len(diffs) == 0 && hasFrom != hasTo {
+		// an empty message was added or removed
+		diffs = append(diffs, FieldDiff{
+			Path: path,
+			From: presentValue(hasFrom, map[string]interface{}{}),
+			To:   presentValue(hasTo, map[string]interface{}{}),
+		})
+	}
+	return diffs
+}
+
+// diffAnys compares the messages packed in two Anys. The fields of messages whose type isn't known can't be
+// compared, so they are only reported as changed, by their type URL.
+func diffAnys(path string, from, to protoreflect.Message) []FieldDiff {
+	fromAny, toAny := asAny(from), asAny(to)
+	if proto.Equal(fromAny, toAny) {
+		return nil
+	}
+	if fromAny.GetTypeUrl() == toAny.GetTypeUrl() {
+		fromMessage, fromErr := fromAny.UnmarshalNew()
+		toMessage, toErr := toAny.UnmarshalNew()
+		if fromErr == nil && toErr == nil {
+			return diffMessages(path, fromMessage.ProtoReflect(), toMessage.ProtoReflect())
+		}
+	}
+	return []FieldDiff{{
+		Path: path,
+		From: presentValue(fromAny.GetTypeUrl() != "", fromAny.GetTypeUrl()),
+		To:   presentValue(toAny.GetTypeUrl() != "", toAny.GetTypeUrl()),
+	}}
+}
+
+var anyFullName = (&anypb.Any{}).ProtoReflect().Descriptor().FullName()
+
+func asAny(message protoreflect.Message) *anypb.Any {
+	if !message.IsValid() {
+		return &anypb.Any{}
+	}
+	if packed, ok := message.Interface().(*anypb.Any); ok {
+		return packed
+	}
+	// the message is an Any of another registry, whose fields are read by reflection
+	fields := message.Descriptor().Fields()
+	return &anypb.Any{
+		TypeUrl: message.Get(fields.ByName("type_url")).String(),
+		Value:   message.Get(fields.ByName("value")).Bytes(),
+	}
+}
+
+// isSensitive returns whether the field holds credentials, whose values must not be exposed
+func isSensitive(field protoreflect.FieldDescriptor) bool {
+	if sensitive, ok := proto.GetExtension(field.Options(), annotations.E_Sensitive).(bool); ok && sensitive {
+		return true
+	}
+	name := strings.ToLower(string(field.Name()))
+	for _, suffix := range sensitiveFieldSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// messageOrZero returns the message of a value, or the empty message of the type of the other value
+// when it isn't present
+func messageOrZero(value protoreflect.Value, present bool, other protoreflect.Value) protoreflect.Message {
+	if present {
+		return value.Message()
+	}
+	return other.Message().Type().Zero()
+}
+
+// scalarValue returns the value of a field that isn't a message, as it is encoded to JSON.
+// It is nil for a field that isn't set, so that it is omitted from the diff.
+func scalarValue(field protoreflect.FieldDescriptor, value protoreflect.Value, present bool) interface{} {
+	if !present {
+		return nil
+	}
+	if field.Enum() != nil {
+		if enumValue := field.Enum().Values().ByNumber(value.Enum()); enumValue != nil {
+			return string(enumValue.Name())
+		}
+		return int32(value.Enum())
+	}
+	return value.Interface()
+}
+
+func presentValue(present bool, value interface{}) interface{} {
+	if !present {
+		return nil
+	}
+	return value
+}
@@ -16,8 +16,6 @@
 package xds
 
 import (
-	"errors"
-
 	discovery_service "github.com/solo-io/solo-kit/pkg/api/xds"
 
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/server"
@@ -31,10 +29,11 @@ type GlooXdsServer interface {
 
 type glooXdsServer struct {
 	server.Server
+	deltaServer DeltaServer
 }
 
-func NewGlooXdsServer(genericServer server.Server) GlooXdsServer {
-	return &glooXdsServer{Server: genericServer}
+func NewGlooXdsServer(genericServer server.Server, deltaServer DeltaServer) GlooXdsServer {
+	return &glooXdsServer{Server: genericServer, deltaServer: deltaServer}
 }
 
 func (s *glooXdsServer) StreamAggregatedResources(
@@ -44,7 +43,7 @@ func (s *glooXdsServer) StreamAggregatedResources(
 }
 
 func (s *glooXdsServer) DeltaAggregatedResources(
-	discovery_service.SoloDiscoveryService_DeltaAggregatedResourcesServer,
+	stream discovery_service.SoloDiscoveryService_DeltaAggregatedResourcesServer,
 ) error {
-	return errors.New("not implemented")
+	return s.deltaServer.DeltaSolo(stream, types.AnyType)
 }
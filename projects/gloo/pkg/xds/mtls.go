@@ -0,0 +1,209 @@
+package xds
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"path/filepath"
+	"sync"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"github.com/fsnotify/fsnotify"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// MTLSServerOptionsFromSettings returns the options of an xDS server that requires mTLS, as configured by the
+// settings, or nil if mTLS is not enabled.
+func MTLSServerOptionsFromSettings(ctx context.Context, xdsTls *v1.GlooOptions_XdsTlsOptions) ([]grpc.ServerOption, error) {
+	if xdsTls.GetCertFile() == "" {
+		return nil, nil
+	}
+	if xdsTls.GetKeyFile() == "" || xdsTls.GetCaFile() == "" {
+		return nil, eris.New("keyFile and caFile must be set when certFile is set")
+	}
+	return NewMTLSServerOptions(ctx, xdsTls.GetCertFile(), xdsTls.GetKeyFile(), xdsTls.GetCaFile(), xdsTls.GetTrustDomain())
+}
+
+// NewMTLSServerOptions returns the options of an xDS server that serves the certificate of certFile and keyFile,
+// requires the clients to present a certificate signed by a CA of caFile, and only serves a node the roles that its
+// certificate authorizes (see nodeRoleHasher.Authorize).
+// The files are reloaded when they change, until the context is cancelled, so that certificates can be rotated.
+func NewMTLSServerOptions(ctx context.Context, certFile, keyFile, caFile, trustDomain string) ([]grpc.ServerOption, error) {
+	certs := &certReloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		caFile:   caFile,
+	}
+	if err := certs.reload(); err != nil {
+		return nil, err
+	}
+	if err := certs.watch(ctx); err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, clientCAs := certs.get()
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				ClientCAs:    clientCAs,
+				ClientAuth:   tls.RequireAndVerifyClientCert,
+				// the config returned here replaces the one of the gRPC credentials, which negotiates HTTP/2
+				NextProtos: []string{"h2"},
+			}, nil
+		},
+	}
+	authorizer := &nodeRoleAuthorizer{
+		hasher:      NewNodeRoleHasher(),
+		trustDomain: trustDomain,
+	}
+	return []grpc.ServerOption{
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.ChainStreamInterceptor(authorizer.streamInterceptor),
+		grpc.ChainUnaryInterceptor(authorizer.unaryInterceptor),
+	}, nil
+}
+
+// certReloader holds the latest valid certificate and CAs read from the files
+type certReloader struct {
+	certFile, keyFile, caFile string
+
+	lock      sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+}
+
+func (c *certReloader) get() (*tls.Certificate, *x509.CertPool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.cert, c.clientCAs
+}
+
+func (c *certReloader) reload() error {
+	cert, err := tls.LoadX509KeyPair(c.certFile, c.keyFile)
+	if err != nil {
+		return eris.Wrapf(err, "loading the xDS server certificate")
+	}
+	caPEM, err := os.ReadFile(c.caFile)
+	if err != nil {
+		return eris.Wrapf(err, "reading the xDS client CAs")
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caPEM) {
+		return eris.Errorf("no valid CA certificate found in %s", c.caFile)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cert = &cert
+	c.clientCAs = clientCAs
+	return nil
+}
+
+// watch reloads the files when they change. The directories of the files are watched rather than the files,
+// since the files of a mounted Secret are updated by swapping a symlink.
+func (c *certReloader) watch(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	dirs := map[string]struct{}{}
+	for _, file := range []string{c.certFile, c.keyFile, c.caFile} {
+		dirs[filepath.Dir(file)] = struct{}{}
+	}
+	for dir := range dirs {
+		if err := watcher.Add(dir); err != nil {
+			watcher.Close()
+			return err
+		}
+	}
+
+	logger := contextutils.LoggerFrom(ctx)
+	go func() {
+		defer watcher.Close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-watcher.Events:
+				logger.Debugf("xDS TLS files changed: %v", event)
+				if err := c.reload(); err != nil {
+					// the previous certificate is served until the files are valid again
+					logger.Warnf("failed to reload the xDS TLS files: %v", err)
+				}
+			case err := <-watcher.Errors:
+				logger.Warnf("error watching the xDS TLS files: %v", err)
+			}
+		}
+	}()
+	return nil
+}
+
+// nodeRoleAuthorizer rejects the xDS requests of nodes whose client certificate doesn't authorize their role
+type nodeRoleAuthorizer struct {
+	hasher      *nodeRoleHasher
+	trustDomain string
+}
+
+// nodeRequest is implemented by all the xDS requests, both state of the world and delta
+type nodeRequest interface {
+	GetNode() *envoy_config_core_v3.Node
+}
+
+func (a *nodeRoleAuthorizer) authorize(ctx context.Context, msg interface{}) error {
+	request, ok := msg.(nodeRequest)
+	// envoy may only set the node on the first request of a stream, which the server remembers
+	if !ok || request.GetNode() == nil {
+		return nil
+	}
+	if err := a.hasher.Authorize(request.GetNode(), peerCertificate(ctx), a.trustDomain); err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return nil
+}
+
+func (a *nodeRoleAuthorizer) streamInterceptor(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &authorizedServerStream{ServerStream: ss, authorizer: a})
+}
+
+func (a *nodeRoleAuthorizer) unaryInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := a.authorize(ctx, req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+type authorizedServerStream struct {
+	grpc.ServerStream
+	authorizer *nodeRoleAuthorizer
+}
+
+func (s *authorizedServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.authorizer.authorize(s.Context(), m)
+}
+
+// peerCertificate returns the verified client certificate of a gRPC call, or nil if there is none
+func peerCertificate(ctx context.Context) *x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return tlsInfo.State.VerifiedChains[0][0]
+}
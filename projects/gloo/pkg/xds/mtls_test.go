@@ -0,0 +1,186 @@
+package xds_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/service/cluster/v3"
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var _ = Describe("mTLS", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+
+		ca       *x509.Certificate
+		caKey    *ecdsa.PrivateKey
+		caPool   *x509.CertPool
+		certsDir string
+		addr     string
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		var err error
+		caKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		ca = &x509.Certificate{
+			SerialNumber:          big.NewInt(1),
+			Subject:               pkix.Name{CommonName: "xds-ca"},
+			NotBefore:             time.Now().Add(-time.Hour),
+			NotAfter:              time.Now().Add(time.Hour),
+			IsCA:                  true,
+			KeyUsage:              x509.KeyUsageCertSign,
+			BasicConstraintsValid: true,
+		}
+		caDER, err := x509.CreateCertificate(rand.Reader, ca, ca, &caKey.PublicKey, caKey)
+		Expect(err).NotTo(HaveOccurred())
+		ca, err = x509.ParseCertificate(caDER)
+		Expect(err).NotTo(HaveOccurred())
+		caPool = x509.NewCertPool()
+		caPool.AddCert(ca)
+
+		certsDir = GinkgoT().TempDir()
+		serverCert := issueCertificate(ca, caKey, &x509.Certificate{
+			DNSNames:    []string{"localhost"},
+			ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		})
+		writePEM(filepath.Join(certsDir, "tls.crt"), "CERTIFICATE", serverCert.Certificate[0])
+		keyDER, err := x509.MarshalECPrivateKey(serverCert.PrivateKey.(*ecdsa.PrivateKey))
+		Expect(err).NotTo(HaveOccurred())
+		writePEM(filepath.Join(certsDir, "tls.key"), "EC PRIVATE KEY", keyDER)
+		writePEM(filepath.Join(certsDir, "ca.crt"), "CERTIFICATE", caDER)
+
+		serverOpts, err := xds.MTLSServerOptionsFromSettings(ctx, &v1.GlooOptions_XdsTlsOptions{
+			CertFile:    filepath.Join(certsDir, "tls.crt"),
+			KeyFile:     filepath.Join(certsDir, "tls.key"),
+			CaFile:      filepath.Join(certsDir, "ca.crt"),
+			TrustDomain: "cluster.local",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		grpcServer := grpc.NewServer(serverOpts...)
+		envoy_service_cluster_v3.RegisterClusterDiscoveryServiceServer(grpcServer, &fetchClustersServer{})
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		addr = lis.Addr().String()
+		go grpcServer.Serve(lis)
+		DeferCleanup(grpcServer.Stop)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	fetchClusters := func(clientCert *tls.Certificate, role string) error {
+		tlsConfig := &tls.Config{
+			RootCAs:    caPool,
+			ServerName: "localhost",
+		}
+		if clientCert != nil {
+			tlsConfig.Certificates = []tls.Certificate{*clientCert}
+		}
+		conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+		Expect(err).NotTo(HaveOccurred())
+		defer conn.Close()
+
+		_, err = envoy_service_cluster_v3.NewClusterDiscoveryServiceClient(conn).FetchClusters(ctx, &envoy_service_discovery_v3.DiscoveryRequest{
+			Node: &envoy_config_core_v3.Node{
+				Metadata: &structpb.Struct{
+					Fields: map[string]*structpb.Value{
+						xds.RoleKey: structpb.NewStringValue(role),
+					},
+				},
+			},
+		})
+		return err
+	}
+
+	spiffeCertificate := func(namespace string) *tls.Certificate {
+		return issueCertificate(ca, caKey, &x509.Certificate{
+			URIs:        []*url.URL{spiffeID("cluster.local", namespace, "gateway-proxy")},
+			ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		})
+	}
+
+	It("serves the roles authorized by the client certificate", func() {
+		Expect(fetchClusters(spiffeCertificate("gloo-system"), "gloo-system~gateway-proxy")).To(Succeed())
+	})
+
+	It("denies the roles not authorized by the client certificate", func() {
+		err := fetchClusters(spiffeCertificate("other-namespace"), "gloo-system~gateway-proxy")
+		Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+	})
+
+	It("rejects clients without a certificate", func() {
+		err := fetchClusters(nil, "gloo-system~gateway-proxy")
+		Expect(err).To(HaveOccurred())
+		Expect(status.Code(err)).NotTo(Equal(codes.OK))
+	})
+
+	It("doesn't require mTLS by default", func() {
+		serverOpts, err := xds.MTLSServerOptionsFromSettings(ctx, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(serverOpts).To(BeEmpty())
+	})
+
+	It("requires the key and the CAs of the certificate", func() {
+		_, err := xds.MTLSServerOptionsFromSettings(ctx, &v1.GlooOptions_XdsTlsOptions{
+			CertFile: filepath.Join(certsDir, "tls.crt"),
+		})
+		Expect(err).To(MatchError(ContainSubstring("keyFile and caFile must be set")))
+	})
+})
+
+type fetchClustersServer struct {
+	envoy_service_cluster_v3.UnimplementedClusterDiscoveryServiceServer
+}
+
+func (*fetchClustersServer) FetchClusters(context.Context, *envoy_service_discovery_v3.DiscoveryRequest) (*envoy_service_discovery_v3.DiscoveryResponse, error) {
+	return &envoy_service_discovery_v3.DiscoveryResponse{}, nil
+}
+
+// issueCertificate signs the template with the CA and returns it with a new private key
+func issueCertificate(ca *x509.Certificate, caKey *ecdsa.PrivateKey, template *x509.Certificate) *tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+	template.SerialNumber = big.NewInt(time.Now().UnixNano())
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+	template.KeyUsage = x509.KeyUsageDigitalSignature
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	Expect(err).NotTo(HaveOccurred())
+	return &tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+	}
+}
+
+func writePEM(path, blockType string, der []byte) {
+	Expect(os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600)).To(Succeed())
+}
@@ -1,7 +1,11 @@
 package xds
 
 import (
+	"crypto/x509"
+	"strings"
+
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"github.com/rotisserie/eris"
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
 )
 
@@ -37,3 +41,47 @@ func (h *nodeRoleHasher) ID(node *envoy_config_core_v3.Node) string {
 
 	return FallbackNodeCacheKey
 }
+
+// Authorize returns an error unless the client certificate of the node authorizes the role it requests:
+// - a URI or DNS SAN equal to the role authorizes it
+// - a SPIFFE ID of the form spiffe://<trust-domain>/ns/<namespace>/sa/<service-account> authorizes the roles of the
+// proxies in its namespace. If trustDomain is not empty, SPIFFE IDs of other trust domains are ignored.
+func (h *nodeRoleHasher) Authorize(node *envoy_config_core_v3.Node, cert *x509.Certificate, trustDomain string) error {
+	role := h.ID(node)
+	if cert == nil {
+		return eris.Errorf("no client certificate authorizes role %s", role)
+	}
+	for _, dnsName := range cert.DNSNames {
+		if dnsName == role {
+			return nil
+		}
+	}
+	roleNamespace := roleNamespace(role)
+	for _, uri := range cert.URIs {
+		if uri.String() == role {
+			return nil
+		}
+		if uri.Scheme != "spiffe" || (trustDomain != "" && uri.Host != trustDomain) {
+			continue
+		}
+		// the path of a kubernetes SPIFFE ID is /ns/<namespace>/sa/<service-account>
+		segments := strings.Split(strings.TrimPrefix(uri.Path, "/"), "/")
+		if len(segments) == 4 && segments[0] == "ns" && segments[2] == "sa" && roleNamespace != "" && segments[1] == roleNamespace {
+			return nil
+		}
+	}
+	return eris.Errorf("the client certificate does not authorize role %s", role)
+}
+
+// roleNamespace returns the namespace of the proxy of a role, formatted as <proxy_namespace>~<proxy_name> or
+// <owner>~<proxy_namespace>~<proxy_name>, or an empty string if the role is not formatted as either.
+func roleNamespace(role string) string {
+	parts := strings.Split(role, KeyDelimiter)
+	switch len(parts) {
+	case 2:
+		return parts[0]
+	case 3:
+		return parts[1]
+	}
+	return ""
+}
@@ -1,6 +1,9 @@
 package xds_test
 
 import (
+	"crypto/x509"
+	"net/url"
+
 	"github.com/onsi/gomega/types"
 
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
@@ -45,4 +48,43 @@ var _ = Describe("NodeHash", func() {
 		}, Equal("no-tilde-in-role")),
 	)
 
+	DescribeTable("NodeRoleHasher authorization",
+		func(role string, cert *x509.Certificate, trustDomain string, expectAuthorized bool) {
+			node := &envoy_config_core_v3.Node{
+				Metadata: &structpb.Struct{
+					Fields: map[string]*structpb.Value{
+						xds.RoleKey: structpb.NewStringValue(role),
+					},
+				},
+			}
+			err := xds.NewNodeRoleHasher().Authorize(node, cert, trustDomain)
+			if expectAuthorized {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("no certificate", "proxy-namespace~proxy-name", nil, "", false),
+		Entry("DNS SAN equal to the role", "proxy-namespace~proxy-name",
+			&x509.Certificate{DNSNames: []string{"proxy-namespace~proxy-name"}}, "", true),
+		Entry("DNS SAN of another role", "proxy-namespace~proxy-name",
+			&x509.Certificate{DNSNames: []string{"proxy-namespace~other-proxy"}}, "", false),
+		Entry("SPIFFE ID of the namespace of the proxy", "proxy-namespace~proxy-name",
+			&x509.Certificate{URIs: []*url.URL{spiffeID("cluster.local", "proxy-namespace", "proxy-sa")}}, "", true),
+		Entry("SPIFFE ID of the namespace of the proxy of an owner", "gloo-kube-gateway-api~proxy-namespace~proxy-name",
+			&x509.Certificate{URIs: []*url.URL{spiffeID("cluster.local", "proxy-namespace", "proxy-sa")}}, "", true),
+		Entry("SPIFFE ID of another namespace", "proxy-namespace~proxy-name",
+			&x509.Certificate{URIs: []*url.URL{spiffeID("cluster.local", "other-namespace", "proxy-sa")}}, "", false),
+		Entry("SPIFFE ID of the trust domain", "proxy-namespace~proxy-name",
+			&x509.Certificate{URIs: []*url.URL{spiffeID("cluster.local", "proxy-namespace", "proxy-sa")}}, "cluster.local", true),
+		Entry("SPIFFE ID of another trust domain", "proxy-namespace~proxy-name",
+			&x509.Certificate{URIs: []*url.URL{spiffeID("other.domain", "proxy-namespace", "proxy-sa")}}, "cluster.local", false),
+		Entry("SPIFFE ID for a role without namespace", "no-tilde-in-role",
+			&x509.Certificate{URIs: []*url.URL{spiffeID("cluster.local", "proxy-namespace", "proxy-sa")}}, "", false),
+	)
+
 })
+
+func spiffeID(trustDomain, namespace, serviceAccount string) *url.URL {
+	return &url.URL{Scheme: "spiffe", Host: trustDomain, Path: "/ns/" + namespace + "/sa/" + serviceAccount}
+}
@@ -0,0 +1,62 @@
+package xds
+
+import (
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/types"
+)
+
+// ScopedRouteTypeV3 is the type URL of the resources served by SRDS.
+// The solo-kit control-plane only knows the EDS, CDS, RDS and LDS types, so it is defined here.
+const ScopedRouteTypeV3 = types.TypePrefix + "/envoy.config.route.v3.ScopedRouteConfiguration"
+
+var (
+	// Compile-time assertion
+	_ cache.Resource = new(ScopedRouteResource)
+)
+
+// ScopedRouteResource wraps a ScopedRouteConfiguration so it can be served from an EnvoySnapshot.
+// resource.EnvoyResource can't be used, since it doesn't know the name, type and references of scoped routes.
+type ScopedRouteResource struct {
+	ScopedRoute *envoy_config_route_v3.ScopedRouteConfiguration
+}
+
+func NewScopedRouteResource(scopedRoute *envoy_config_route_v3.ScopedRouteConfiguration) *ScopedRouteResource {
+	return &ScopedRouteResource{ScopedRoute: scopedRoute}
+}
+
+func (s *ScopedRouteResource) Self() cache.XdsResourceReference {
+	return cache.XdsResourceReference{
+		Name: s.ScopedRoute.GetName(),
+		Type: ScopedRouteTypeV3,
+	}
+}
+
+func (s *ScopedRouteResource) ResourceProto() cache.ResourceProto {
+	return s.ScopedRoute
+}
+
+// References returns the RouteConfiguration that is used for the requests of the scope.
+func (s *ScopedRouteResource) References() []cache.XdsResourceReference {
+	if s.ScopedRoute.GetRouteConfigurationName() == "" {
+		return nil
+	}
+	return []cache.XdsResourceReference{{
+		Name: s.ScopedRoute.GetRouteConfigurationName(),
+		Type: types.RouteTypeV3,
+	}}
+}
+
+// getScopedRouteReferences returns the RouteConfigurations referenced by the scoped routes, keyed by name.
+func getScopedRouteReferences(scopedRoutes map[string]cache.Resource) map[string]cache.Resource {
+	out := make(map[string]cache.Resource)
+	for _, scopedRoute := range scopedRoutes {
+		if scopedRoute == nil {
+			continue
+		}
+		for _, ref := range scopedRoute.References() {
+			out[ref.Name] = scopedRoute
+		}
+	}
+	return out
+}
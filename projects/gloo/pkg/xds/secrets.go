@@ -0,0 +1,57 @@
+package xds
+
+import (
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/types"
+)
+
+// SecretTypeV3 is the type URL of the resources served by SDS.
+// The solo-kit control-plane only knows the EDS, CDS, RDS and LDS types, so it is defined here.
+const SecretTypeV3 = types.TypePrefix + "/envoy.extensions.transport_sockets.tls.v3.Secret"
+
+var (
+	// Compile-time assertion
+	_ cache.Resource = new(SecretResource)
+)
+
+// SecretResource wraps a Secret served by SDS so it can be served from an EnvoySnapshot.
+// The name of the Secret is the name of the SdsSecretConfig that references it.
+type SecretResource struct {
+	Secret *envoy_extensions_transport_sockets_tls_v3.Secret
+}
+
+func NewSecretResource(secret *envoy_extensions_transport_sockets_tls_v3.Secret) *SecretResource {
+	return &SecretResource{Secret: secret}
+}
+
+func (s *SecretResource) Self() cache.XdsResourceReference {
+	return cache.XdsResourceReference{
+		Name: s.Secret.GetName(),
+		Type: SecretTypeV3,
+	}
+}
+
+func (s *SecretResource) ResourceProto() cache.ResourceProto {
+	return s.Secret
+}
+
+// References returns nothing, secrets are referenced by the filters that use them, not the other way around.
+func (s *SecretResource) References() []cache.XdsResourceReference {
+	return nil
+}
+
+// NewAdsSdsSecretConfig returns the config of a filter that fetches the Secret of a name with SDS,
+// over the same ADS stream as the rest of the snapshot.
+func NewAdsSdsSecretConfig(name string) *envoy_extensions_transport_sockets_tls_v3.SdsSecretConfig {
+	return &envoy_extensions_transport_sockets_tls_v3.SdsSecretConfig{
+		Name: name,
+		SdsConfig: &envoy_config_core_v3.ConfigSource{
+			ConfigSourceSpecifier: &envoy_config_core_v3.ConfigSource_Ads{
+				Ads: &envoy_config_core_v3.AggregatedConfigSource{},
+			},
+			ResourceApiVersion: envoy_config_core_v3.ApiVersion_V3,
+		},
+	}
+}
@@ -0,0 +1,105 @@
+package xds
+
+import (
+	"sort"
+
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// ResourcesDiff lists the resources of a type that differ between two snapshots, by name
+type ResourcesDiff struct {
+	// Added are the resources that are only in the second snapshot
+	Added []string `json:"added,omitempty"`
+	// Removed are the resources that are only in the first snapshot
+	Removed []string `json:"removed,omitempty"`
+	// Changed are the fields that differ for each resource of both snapshots
+	Changed map[string][]FieldDiff `json:"changed,omitempty"`
+}
+
+// Len returns the number of resources that differ
+func (d ResourcesDiff) Len() int {
+	return len(d.Added) + len(d.Removed) + len(d.Changed)
+}
+
+// SnapshotDiff is the diff of the resources of two snapshots, keyed by type URL.
+// Types whose resources are the same in both snapshots are omitted.
+type SnapshotDiff map[string]ResourcesDiff
+
+// Len returns the number of resources that differ
+func (d SnapshotDiff) Len() int {
+	var n int
+	for _, resourcesDiff := range d {
+		n += resourcesDiff.Len()
+	}
+	return n
+}
+
+// DiffSnapshots compares the resources of two snapshots, of any of the types of an EnvoySnapshot.
+// Resources are compared by content, so that two snapshots with different versions but the same resources are equal,
+// and the fields that differ are listed for each changed resource, with the values of sensitive fields redacted.
+// A nil snapshot has no resources.
+func DiffSnapshots(from, to cache.Snapshot) SnapshotDiff {
+	diff := SnapshotDiff{}
+	for _, typeURL := range EnvoySnapshotTypeURLs {
+		resourcesDiff := diffResources(snapshotItems(from, typeURL), snapshotItems(to, typeURL))
+		if resourcesDiff.Len() > 0 {
+			diff[typeURL] = resourcesDiff
+		}
+	}
+	return diff
+}
+
+func snapshotItems(snapshot cache.Snapshot, typeURL string) map[string]cache.Resource {
+	if snapshot == nil {
+		return nil
+	}
+	return snapshot.GetResources(typeURL).Items
+}
+
+func diffResources(from, to map[string]cache.Resource) ResourcesDiff {
+	var diff ResourcesDiff
+	for name, fromResource := range from {
+		toResource, ok := to[name]
+		if !ok {
+			diff.Removed = append(diff.Removed, name)
+			continue
+		}
+		if fields := diffResourceFields(fromResource, toResource); len(fields) > 0 {
+			if diff.Changed == nil {
+				diff.Changed = map[string][]FieldDiff{}
+			}
+			diff.Changed[name] = fields
+		}
+	}
+	for name := range to {
+		if _, ok := from[name]; !ok {
+			diff.Added = append(diff.Added, name)
+		}
+	}
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	return diff
+}
+
+// diffResourceFields returns the fields that differ between two resources of the same type.
+// The whole resource, with an empty path, differs when its fields can't be compared.
+func diffResourceFields(from, to cache.Resource) []FieldDiff {
+	if from == nil || to == nil {
+		if from == to {
+			return nil
+		}
+		return []FieldDiff{{}}
+	}
+	fromMessage := protoadapt.MessageV2Of(from.ResourceProto())
+	toMessage := protoadapt.MessageV2Of(to.ResourceProto())
+	if proto.Equal(fromMessage, toMessage) {
+		return nil
+	}
+	if fields := diffMessages("", fromMessage.ProtoReflect(), toMessage.ProtoReflect()); len(fields) > 0 {
+		return fields
+	}
+	// the resources only differ by fields that are unknown to this version of the protos
+	return []FieldDiff{{}}
+}
@@ -0,0 +1,188 @@
+package xds_test
+
+import (
+	"encoding/json"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/aws"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/types"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var _ = Describe("DiffSnapshots", func() {
+
+	snapshotOf := func(version string, clusters []*envoy_config_cluster_v3.Cluster, listeners []*envoy_config_listener_v3.Listener) cache.Snapshot {
+		var clusterItems, listenerItems []cache.Resource
+		for _, cluster := range clusters {
+			clusterItems = append(clusterItems, resource.NewEnvoyResource(cluster))
+		}
+		for _, listener := range listeners {
+			listenerItems = append(listenerItems, resource.NewEnvoyResource(listener))
+		}
+		return xds.NewSnapshotFromResources(
+			cache.NewResources(version, nil),
+			cache.NewResources(version, clusterItems),
+			cache.NewResources(version, nil),
+			cache.NewResources(version, listenerItems),
+			cache.NewResources(version, nil),
+			cache.NewResources(version, nil),
+			cache.NewResources(version, nil),
+			cache.NewResources(version, nil),
+		)
+	}
+	cluster := func(name string, connectTimeoutSeconds int64) *envoy_config_cluster_v3.Cluster {
+		return &envoy_config_cluster_v3.Cluster{
+			Name:           name,
+			ConnectTimeout: &durationpb.Duration{Seconds: connectTimeoutSeconds},
+		}
+	}
+
+	It("is empty for snapshots with the same resources but different versions", func() {
+		from := snapshotOf("1", []*envoy_config_cluster_v3.Cluster{cluster("a", 1)}, nil)
+		to := snapshotOf("2", []*envoy_config_cluster_v3.Cluster{cluster("a", 1)}, nil)
+		diff := xds.DiffSnapshots(from, to)
+		Expect(diff).To(BeEmpty())
+		Expect(diff.Len()).To(BeZero())
+	})
+
+	It("lists the added, removed and changed resources of each type", func() {
+		from := snapshotOf("1",
+			[]*envoy_config_cluster_v3.Cluster{cluster("same", 1), cluster("changed", 1), cluster("removed", 1)},
+			[]*envoy_config_listener_v3.Listener{{Name: "listener"}})
+		to := snapshotOf("2",
+			[]*envoy_config_cluster_v3.Cluster{cluster("same", 1), cluster("changed", 2), cluster("added", 1)},
+			[]*envoy_config_listener_v3.Listener{{Name: "listener"}})
+		diff := xds.DiffSnapshots(from, to)
+		Expect(diff).To(Equal(xds.SnapshotDiff{
+			types.ClusterTypeV3: {
+				Added:   []string{"added"},
+				Removed: []string{"removed"},
+				Changed: map[string][]xds.FieldDiff{
+					"changed": {{Path: "connect_timeout.seconds", From: int64(1), To: int64(2)}},
+				},
+			},
+		}))
+		Expect(diff.Len()).To(Equal(3))
+	})
+
+	It("lists the fields of the messages packed in Anys, and of lists and maps", func() {
+		tlsCluster := func(certificateChain string) *envoy_config_cluster_v3.Cluster {
+			tlsContext, err := utils.MessageToAny(&envoy_extensions_transport_sockets_tls_v3.UpstreamTlsContext{
+				Sni: "example.com",
+				CommonTlsContext: &envoy_extensions_transport_sockets_tls_v3.CommonTlsContext{
+					TlsCertificates: []*envoy_extensions_transport_sockets_tls_v3.TlsCertificate{{
+						CertificateChain: &envoy_config_core_v3.DataSource{
+							Specifier: &envoy_config_core_v3.DataSource_InlineString{InlineString: certificateChain},
+						},
+					}},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			return &envoy_config_cluster_v3.Cluster{
+				Name:            "cluster",
+				TransportSocket: &envoy_config_core_v3.TransportSocket{Name: "tls", ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: tlsContext}},
+				Metadata: &envoy_config_core_v3.Metadata{FilterMetadata: map[string]*structpb.Struct{
+					"io.solo": {Fields: map[string]*structpb.Value{"chain": structpb.NewStringValue(certificateChain)}},
+				}},
+			}
+		}
+		from := snapshotOf("1", []*envoy_config_cluster_v3.Cluster{tlsCluster("first-chain")}, nil)
+		to := snapshotOf("2", []*envoy_config_cluster_v3.Cluster{tlsCluster("second-chain")}, nil)
+		Expect(xds.DiffSnapshots(from, to)).To(Equal(xds.SnapshotDiff{
+			types.ClusterTypeV3: {
+				Changed: map[string][]xds.FieldDiff{
+					"cluster": {
+						{
+							Path: "transport_socket.typed_config.common_tls_context.tls_certificates[0].certificate_chain.inline_string",
+							From: "first-chain",
+							To:   "second-chain",
+						},
+						{
+							Path: "metadata.filter_metadata[io.solo].fields[chain].string_value",
+							From: "first-chain",
+							To:   "second-chain",
+						},
+					},
+				},
+			},
+		}))
+	})
+
+	It("redacts the values of sensitive fields", func() {
+		const (
+			firstKey, secondKey             = "first-private-key", "second-private-key"
+			firstAwsSecret, secondAwsSecret = "first-aws-secret", "second-aws-secret"
+		)
+		credentialsCluster := func(privateKey, awsSecret string) *envoy_config_cluster_v3.Cluster {
+			tlsContext, err := utils.MessageToAny(&envoy_extensions_transport_sockets_tls_v3.UpstreamTlsContext{
+				CommonTlsContext: &envoy_extensions_transport_sockets_tls_v3.CommonTlsContext{
+					TlsCertificates: []*envoy_extensions_transport_sockets_tls_v3.TlsCertificate{{
+						PrivateKey: &envoy_config_core_v3.DataSource{
+							Specifier: &envoy_config_core_v3.DataSource_InlineString{InlineString: privateKey},
+						},
+					}},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			// the AWS Lambda extension doesn't mark its credentials as sensitive
+			lambdaOptions, err := utils.MessageToAny(&aws.AWSLambdaProtocolExtension{
+				Region:    "us-east-1",
+				SecretKey: awsSecret,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			return &envoy_config_cluster_v3.Cluster{
+				Name:                          "cluster",
+				TransportSocket:               &envoy_config_core_v3.TransportSocket{Name: "tls", ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: tlsContext}},
+				TypedExtensionProtocolOptions: map[string]*anypb.Any{"io.solo.aws_lambda": lambdaOptions},
+			}
+		}
+		from := snapshotOf("1", []*envoy_config_cluster_v3.Cluster{credentialsCluster(firstKey, firstAwsSecret)}, nil)
+		to := snapshotOf("2", []*envoy_config_cluster_v3.Cluster{credentialsCluster(secondKey, secondAwsSecret)}, nil)
+		diff := xds.DiffSnapshots(from, to)
+		Expect(diff).To(Equal(xds.SnapshotDiff{
+			types.ClusterTypeV3: {
+				Changed: map[string][]xds.FieldDiff{
+					"cluster": {
+						{
+							Path: "typed_extension_protocol_options[io.solo.aws_lambda].secret_key",
+							From: "<redacted>",
+							To:   "<redacted>",
+						},
+						{
+							Path: "transport_socket.typed_config.common_tls_context.tls_certificates[0].private_key",
+							From: "<redacted>",
+							To:   "<redacted>",
+						},
+					},
+				},
+			},
+		}))
+		diffJson, err := json.Marshal(diff)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(diffJson)).NotTo(Or(
+			ContainSubstring(firstKey), ContainSubstring(secondKey),
+			ContainSubstring(firstAwsSecret), ContainSubstring(secondAwsSecret),
+		))
+	})
+
+	It("treats a nil snapshot as empty", func() {
+		to := snapshotOf("1", []*envoy_config_cluster_v3.Cluster{cluster("a", 1)}, nil)
+		Expect(xds.DiffSnapshots(nil, to)).To(Equal(xds.SnapshotDiff{
+			types.ClusterTypeV3: {Added: []string{"a"}},
+		}))
+		Expect(xds.DiffSnapshots(to, nil)).To(Equal(xds.SnapshotDiff{
+			types.ClusterTypeV3: {Removed: []string{"a"}},
+		}))
+	})
+})
@@ -0,0 +1,339 @@
+package xds
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/kubeutils"
+	"github.com/solo-io/gloo/pkg/version"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/anypb"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DefaultSnapshotMaxAge is the maximum age of a persisted snapshot that is restored, unless configured otherwise
+const DefaultSnapshotMaxAge = time.Hour
+
+var (
+	// Compile-time assertion
+	_ cache.SnapshotCache = new(persistentSnapshotCache)
+)
+
+// SnapshotStore checkpoints the encoded snapshots of the nodes, so that they can be restored when the control plane restarts.
+type SnapshotStore interface {
+	// Save checkpoints the encoded snapshot of a node, replacing its previous checkpoint
+	Save(ctx context.Context, nodeKey string, data []byte) error
+	// Delete removes the checkpoint of a node
+	Delete(ctx context.Context, nodeKey string) error
+	// LoadAll returns the checkpoints of all the nodes
+	LoadAll(ctx context.Context) ([][]byte, error)
+}
+
+type persistedSnapshot struct {
+	NodeKey     string    `json:"nodeKey"`
+	GlooVersion string    `json:"glooVersion"`
+	SavedAt     time.Time `json:"savedAt"`
+	// Resources are keyed by type URL
+	Resources map[string]persistedResources `json:"resources"`
+}
+
+type persistedResources struct {
+	Version string `json:"version"`
+	// Items are binary-encoded google.protobuf.Any messages
+	Items [][]byte `json:"items"`
+}
+
+// encodeSnapshot encodes the resources of a snapshot, with the version of Gloo and the time it is saved at.
+func encodeSnapshot(nodeKey string, snapshot *EnvoySnapshot, savedAt time.Time) ([]byte, error) {
+	persisted := persistedSnapshot{
+		NodeKey:     nodeKey,
+		GlooVersion: version.Version,
+		SavedAt:     savedAt,
+		Resources:   make(map[string]persistedResources, len(EnvoySnapshotTypeURLs)),
+	}
+	for _, typeURL := range EnvoySnapshotTypeURLs {
+		if typeURL == SecretTypeV3 {
+			// secrets are not written to the store, which is not meant to hold them.
+			// The filters of a restored snapshot wait for them until the next translation.
+			continue
+		}
+		resources := snapshot.GetResources(typeURL)
+		out := persistedResources{Version: resources.Version}
+		for _, res := range resources.Items {
+			if res == nil {
+				continue
+			}
+			anyResource, err := anypb.New(protoadapt.MessageV2Of(res.ResourceProto()))
+			if err != nil {
+				return nil, err
+			}
+			item, err := proto.Marshal(anyResource)
+			if err != nil {
+				return nil, err
+			}
+			out.Items = append(out.Items, item)
+		}
+		persisted.Resources[typeURL] = out
+	}
+
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(writer).Encode(persisted); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeSnapshot decodes a snapshot encoded by encodeSnapshot.
+func decodeSnapshot(data []byte) (*persistedSnapshot, *EnvoySnapshot, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, nil, err
+	}
+	decoded, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	var persisted persistedSnapshot
+	if err := json.Unmarshal(decoded, &persisted); err != nil {
+		return nil, nil, err
+	}
+
+	resourcesByType := make(map[string]cache.Resources, len(EnvoySnapshotTypeURLs))
+	for _, typeURL := range EnvoySnapshotTypeURLs {
+		resources := persisted.Resources[typeURL]
+		items := make([]cache.Resource, 0, len(resources.Items))
+		for _, item := range resources.Items {
+			var anyResource anypb.Any
+			if err := proto.Unmarshal(item, &anyResource); err != nil {
+				return nil, nil, err
+			}
+			msg, err := anyResource.UnmarshalNew()
+			if err != nil {
+				return nil, nil, err
+			}
+			items = append(items, newPersistedResource(msg))
+		}
+		resourcesByType[typeURL] = cache.NewResources(resources.Version, items)
+	}
+	snapshot := NewSnapshotFromResources(
+		resourcesByType[types.EndpointTypeV3],
+		resourcesByType[types.ClusterTypeV3],
+		resourcesByType[types.RouteTypeV3],
+		resourcesByType[types.ListenerTypeV3],
+		resourcesByType[ScopedRouteTypeV3],
+		resourcesByType[VirtualHostTypeV3],
+		resourcesByType[ExtensionConfigTypeV3],
+		resourcesByType[SecretTypeV3],
+	).(*EnvoySnapshot)
+	return &persisted, snapshot, nil
+}
+
+func newPersistedResource(msg proto.Message) cache.Resource {
+	switch typed := msg.(type) {
+	case *envoy_config_route_v3.ScopedRouteConfiguration:
+		return NewScopedRouteResource(typed)
+	case *envoy_config_route_v3.VirtualHost:
+		return NewVirtualHostResource(typed)
+	case *envoy_config_core_v3.TypedExtensionConfig:
+		return NewExtensionConfigResource(typed)
+	case *envoy_extensions_transport_sockets_tls_v3.Secret:
+		return NewSecretResource(typed)
+	}
+	return resource.NewEnvoyResource(protoadapt.MessageV1Of(msg))
+}
+
+// RestoreSnapshots sets the snapshots checkpointed in the store on the cache.
+// Snapshots persisted by another version of Gloo, older than maxAge (if it is positive), or inconsistent are skipped,
+// as are the snapshots of the nodes that already have one, such as when the xDS server restarts.
+// It returns the number of snapshots restored.
+func RestoreSnapshots(ctx context.Context, snapshotCache cache.SnapshotCache, store SnapshotStore, maxAge time.Duration) (int, error) {
+	logger := contextutils.LoggerFrom(ctx)
+	checkpoints, err := store.LoadAll(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var restored int
+	for _, data := range checkpoints {
+		persisted, snapshot, err := decodeSnapshot(data)
+		if err != nil {
+			logger.Warnf("skipping persisted xDS snapshot that can't be decoded: %v", err)
+			continue
+		}
+		if persisted.GlooVersion != version.Version {
+			logger.Infof("skipping persisted xDS snapshot of node %s saved by gloo version %s", persisted.NodeKey, persisted.GlooVersion)
+			continue
+		}
+		if age := time.Since(persisted.SavedAt); maxAge > 0 && age > maxAge {
+			logger.Infof("skipping persisted xDS snapshot of node %s saved %s ago", persisted.NodeKey, age.Round(time.Second))
+			continue
+		}
+		if err := snapshot.Consistent(); err != nil {
+			logger.Warnf("skipping inconsistent persisted xDS snapshot of node %s: %v", persisted.NodeKey, err)
+			continue
+		}
+		if _, err := snapshotCache.GetSnapshot(persisted.NodeKey); err == nil {
+			logger.Debugf("skipping persisted xDS snapshot of node %s, which already has a snapshot", persisted.NodeKey)
+			continue
+		}
+		snapshotCache.SetSnapshot(persisted.NodeKey, snapshot)
+		restored++
+	}
+	return restored, nil
+}
+
+// NewPersistentSnapshotCache returns a SnapshotCache that checkpoints the consistent snapshots set on it to the store,
+// after it restored the snapshots of the store that are no older than maxAge.
+// Snapshots are saved in the background, so that a slow store doesn't delay the xDS responses; only the latest
+// snapshot of a node is saved if it is set again before its previous one was saved.
+func NewPersistentSnapshotCache(ctx context.Context, snapshotCache cache.SnapshotCache, store SnapshotStore, maxAge time.Duration) cache.SnapshotCache {
+	restored, err := RestoreSnapshots(ctx, snapshotCache, store, maxAge)
+	if err != nil {
+		contextutils.LoggerFrom(ctx).Warnf("failed to restore the persisted xDS snapshots: %v", err)
+	} else {
+		contextutils.LoggerFrom(ctx).Infof("restored %d persisted xDS snapshots", restored)
+	}
+
+	c := &persistentSnapshotCache{
+		SnapshotCache: snapshotCache,
+		store:         store,
+		pending:       make(map[string]*EnvoySnapshot),
+		notify:        make(chan struct{}, 1),
+	}
+	go c.checkpointLoop(ctx)
+	return c
+}
+
+type persistentSnapshotCache struct {
+	cache.SnapshotCache
+	store SnapshotStore
+
+	lock sync.Mutex
+	// the snapshots to save, keyed by node; a nil snapshot deletes the checkpoint of the node
+	pending map[string]*EnvoySnapshot
+	notify  chan struct{}
+}
+
+func (c *persistentSnapshotCache) SetSnapshot(node string, snapshot cache.Snapshot) {
+	c.SnapshotCache.SetSnapshot(node, snapshot)
+	// only EnvoySnapshots that envoy can apply are persisted; the snapshots of extensions are rebuilt by translation
+	envoySnapshot, ok := snapshot.(*EnvoySnapshot)
+	if !ok || envoySnapshot.Consistent() != nil {
+		return
+	}
+	c.enqueue(node, envoySnapshot)
+}
+
+func (c *persistentSnapshotCache) ClearSnapshot(node string) {
+	c.SnapshotCache.ClearSnapshot(node)
+	c.enqueue(node, nil)
+}
+
+func (c *persistentSnapshotCache) enqueue(node string, snapshot *EnvoySnapshot) {
+	c.lock.Lock()
+	c.pending[node] = snapshot
+	c.lock.Unlock()
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (c *persistentSnapshotCache) checkpointLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-c.notify:
+		}
+		c.lock.Lock()
+		pending := c.pending
+		c.pending = make(map[string]*EnvoySnapshot)
+		c.lock.Unlock()
+
+		for node, snapshot := range pending {
+			if err := c.checkpoint(ctx, node, snapshot); err != nil {
+				contextutils.LoggerFrom(ctx).Warnf("failed to persist the xDS snapshot of node %s: %v", node, err)
+			}
+		}
+	}
+}
+
+func (c *persistentSnapshotCache) checkpoint(ctx context.Context, node string, snapshot *EnvoySnapshot) error {
+	if snapshot == nil {
+		return c.store.Delete(ctx, node)
+	}
+	data, err := encodeSnapshot(node, snapshot, time.Now())
+	if err != nil {
+		return err
+	}
+	return c.store.Save(ctx, node, data)
+}
+
+// NewPersistentSnapshotCacheFromSettings returns a persistent SnapshotCache that wraps the snapshotCache, if the
+// settings configure snapshot persistence. Otherwise, the snapshotCache is returned as is.
+func NewPersistentSnapshotCacheFromSettings(
+	ctx context.Context,
+	snapshotCache cache.SnapshotCache,
+	persistence *v1.GlooOptions_XdsSnapshotPersistence,
+) cache.SnapshotCache {
+	store, err := snapshotStore(persistence)
+	if err != nil {
+		contextutils.LoggerFrom(ctx).Errorf("xDS snapshot persistence is disabled: %v", err)
+		return snapshotCache
+	}
+	if store == nil {
+		return snapshotCache
+	}
+	maxAge := DefaultSnapshotMaxAge
+	if persistence.GetMaxAge() != nil {
+		maxAge = persistence.GetMaxAge().AsDuration()
+	}
+	return NewPersistentSnapshotCache(ctx, snapshotCache, store, maxAge)
+}
+
+// snapshotStore returns the SnapshotStore configured by the settings, or nil if persistence is not enabled.
+func snapshotStore(persistence *v1.GlooOptions_XdsSnapshotPersistence) (SnapshotStore, error) {
+	switch store := persistence.GetStore().(type) {
+	case *v1.GlooOptions_XdsSnapshotPersistence_Directory:
+		return NewFileSnapshotStore(store.Directory)
+	case *v1.GlooOptions_XdsSnapshotPersistence_ConfigMap:
+		if store.ConfigMap.GetNamespace() == "" || store.ConfigMap.GetName() == "" {
+			return nil, eris.Errorf("the snapshot ConfigMap must have a namespace and a name, got %v", store.ConfigMap)
+		}
+		// the ConfigMaps of the snapshots are labeled with the name
+		if errs := validation.IsValidLabelValue(store.ConfigMap.GetName()); len(errs) > 0 {
+			return nil, eris.Errorf("invalid snapshot ConfigMap name %q: %s", store.ConfigMap.GetName(), strings.Join(errs, ", "))
+		}
+		restConfig, err := kubeutils.GetRestConfigWithKubeContext("")
+		if err != nil {
+			return nil, err
+		}
+		kubeClient, err := kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			return nil, err
+		}
+		return NewConfigMapSnapshotStore(kubeClient, store.ConfigMap.GetNamespace(), store.ConfigMap.GetName()), nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,309 @@
+package xds_test
+
+import (
+	"context"
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/pkg/version"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/types"
+	"github.com/solo-io/solo-kit/test/matchers"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+var _ = Describe("Snapshot persistence", func() {
+
+	const nodeKey = "gloo-system~gateway-proxy"
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+
+		cluster         *envoy_config_cluster_v3.Cluster
+		listener        *envoy_config_listener_v3.Listener
+		extensionConfig *envoy_config_core_v3.TypedExtensionConfig
+		snapshot        *xds.EnvoySnapshot
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		cluster = &envoy_config_cluster_v3.Cluster{
+			Name:                 "cluster",
+			ClusterDiscoveryType: &envoy_config_cluster_v3.Cluster_Type{Type: envoy_config_cluster_v3.Cluster_STATIC},
+		}
+		listener = &envoy_config_listener_v3.Listener{
+			Name: "listener",
+		}
+		config, err := anypb.New(wrapperspb.String("config"))
+		Expect(err).NotTo(HaveOccurred())
+		extensionConfig = &envoy_config_core_v3.TypedExtensionConfig{
+			Name:        "filter",
+			TypedConfig: config,
+		}
+		snapshot = xds.NewSnapshotFromResources(
+			cache.NewResources("endpoints", nil),
+			cache.NewResources("clusters", []cache.Resource{resource.NewEnvoyResource(cluster)}),
+			cache.NewResources("routes", nil),
+			cache.NewResources("listeners", []cache.Resource{resource.NewEnvoyResource(listener)}),
+			cache.NewResources("scoped-routes", nil),
+			cache.NewResources("virtual-hosts", nil),
+			cache.NewResources("extension-configs", []cache.Resource{xds.NewExtensionConfigResource(extensionConfig)}),
+			cache.NewResources("secrets", []cache.Resource{xds.NewSecretResource(&envoy_extensions_transport_sockets_tls_v3.Secret{
+				Name: "secret",
+				Type: &envoy_extensions_transport_sockets_tls_v3.Secret_GenericSecret{
+					GenericSecret: &envoy_extensions_transport_sockets_tls_v3.GenericSecret{
+						Secret: &envoy_config_core_v3.DataSource{
+							Specifier: &envoy_config_core_v3.DataSource_InlineString{InlineString: "sensitive"},
+						},
+					},
+				},
+			})}),
+		).(*xds.EnvoySnapshot)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	expectRestoredSnapshot := func(snapshotCache cache.SnapshotCache) {
+		restored, err := snapshotCache.GetSnapshot(nodeKey)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restored.GetResources(types.ClusterTypeV3).Version).To(Equal("clusters"))
+		Expect(restored.GetResources(types.ClusterTypeV3).Items["cluster"].ResourceProto()).To(matchers.MatchProto(cluster))
+		Expect(restored.GetResources(types.ListenerTypeV3).Version).To(Equal("listeners"))
+		Expect(restored.GetResources(types.ListenerTypeV3).Items["listener"].ResourceProto()).To(matchers.MatchProto(listener))
+		Expect(restored.GetResources(xds.ExtensionConfigTypeV3).Version).To(Equal("extension-configs"))
+		Expect(restored.GetResources(xds.ExtensionConfigTypeV3).Items["filter"].ResourceProto()).To(matchers.MatchProto(extensionConfig))
+		// secrets are never written to the store
+		Expect(restored.GetResources(xds.SecretTypeV3).Items).To(BeEmpty())
+	}
+
+	Context("file store", func() {
+
+		var store xds.SnapshotStore
+
+		BeforeEach(func() {
+			var err error
+			store, err = xds.NewFileSnapshotStore(GinkgoT().TempDir())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("restores the snapshots checkpointed by a previous cache", func() {
+			persistentCache := xds.NewPersistentSnapshotCache(ctx, xds.NewAdsSnapshotCache(ctx), store, time.Hour)
+			persistentCache.SetSnapshot(nodeKey, snapshot)
+			Eventually(func(g Gomega) {
+				checkpoints, err := store.LoadAll(ctx)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(checkpoints).To(HaveLen(1))
+			}, "2s", "0.1s").Should(Succeed())
+
+			restartedCache := xds.NewAdsSnapshotCache(ctx)
+			restored, err := xds.RestoreSnapshots(ctx, restartedCache, store, time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restored).To(Equal(1))
+			expectRestoredSnapshot(restartedCache)
+		})
+
+		It("removes the checkpoint of a cleared snapshot", func() {
+			persistentCache := xds.NewPersistentSnapshotCache(ctx, xds.NewAdsSnapshotCache(ctx), store, time.Hour)
+			persistentCache.SetSnapshot(nodeKey, snapshot)
+			Eventually(func(g Gomega) {
+				checkpoints, err := store.LoadAll(ctx)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(checkpoints).To(HaveLen(1))
+			}, "2s", "0.1s").Should(Succeed())
+
+			// the snapshot of a node is only cleared once it has connected
+			_, cancelWatch := persistentCache.CreateWatch(envoy_service_discovery_v3.DiscoveryRequest{
+				Node: &envoy_config_core_v3.Node{
+					Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{
+						xds.RoleKey: structpb.NewStringValue(nodeKey),
+					}},
+				},
+				TypeUrl:     types.ClusterTypeV3,
+				VersionInfo: "clusters",
+			})
+			defer cancelWatch()
+
+			persistentCache.ClearSnapshot(nodeKey)
+			Eventually(func(g Gomega) {
+				checkpoints, err := store.LoadAll(ctx)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(checkpoints).To(BeEmpty())
+			}, "2s", "0.1s").Should(Succeed())
+		})
+
+		It("does not checkpoint inconsistent snapshots", func() {
+			persistentCache := xds.NewPersistentSnapshotCache(ctx, xds.NewAdsSnapshotCache(ctx), store, time.Hour)
+			persistentCache.SetSnapshot(nodeKey, xds.NewSnapshotFromResources(
+				cache.NewResources("endpoints", nil),
+				cache.NewResources("clusters", []cache.Resource{resource.NewEnvoyResource(&envoy_config_cluster_v3.Cluster{
+					Name:                 "eds-cluster",
+					ClusterDiscoveryType: &envoy_config_cluster_v3.Cluster_Type{Type: envoy_config_cluster_v3.Cluster_EDS},
+				})}),
+				cache.NewResources("routes", nil),
+				cache.NewResources("listeners", nil),
+				cache.NewResources("scoped-routes", nil),
+				cache.NewResources("virtual-hosts", nil),
+				cache.NewResources("extension-configs", nil),
+				cache.NewResources("secrets", nil),
+			))
+			Consistently(func(g Gomega) {
+				checkpoints, err := store.LoadAll(ctx)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(checkpoints).To(BeEmpty())
+			}, "500ms", "0.1s").Should(Succeed())
+		})
+
+		It("does not restore snapshots older than the max age", func() {
+			persistentCache := xds.NewPersistentSnapshotCache(ctx, xds.NewAdsSnapshotCache(ctx), store, time.Hour)
+			persistentCache.SetSnapshot(nodeKey, snapshot)
+			Eventually(func(g Gomega) {
+				checkpoints, err := store.LoadAll(ctx)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(checkpoints).To(HaveLen(1))
+			}, "2s", "0.1s").Should(Succeed())
+
+			restored, err := xds.RestoreSnapshots(ctx, xds.NewAdsSnapshotCache(ctx), store, time.Nanosecond)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restored).To(BeZero())
+		})
+
+		It("does not restore snapshots persisted by another version of gloo", func() {
+			persistentCache := xds.NewPersistentSnapshotCache(ctx, xds.NewAdsSnapshotCache(ctx), store, time.Hour)
+			persistentCache.SetSnapshot(nodeKey, snapshot)
+			Eventually(func(g Gomega) {
+				checkpoints, err := store.LoadAll(ctx)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(checkpoints).To(HaveLen(1))
+			}, "2s", "0.1s").Should(Succeed())
+
+			originalVersion := version.Version
+			version.Version = "1.0.0-upgraded"
+			DeferCleanup(func() {
+				version.Version = originalVersion
+			})
+
+			restored, err := xds.RestoreSnapshots(ctx, xds.NewAdsSnapshotCache(ctx), store, time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restored).To(BeZero())
+		})
+
+		It("does not restore the snapshot of a node that already has one", func() {
+			persistentCache := xds.NewPersistentSnapshotCache(ctx, xds.NewAdsSnapshotCache(ctx), store, time.Hour)
+			persistentCache.SetSnapshot(nodeKey, snapshot)
+			Eventually(func(g Gomega) {
+				checkpoints, err := store.LoadAll(ctx)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(checkpoints).To(HaveLen(1))
+			}, "2s", "0.1s").Should(Succeed())
+
+			restored, err := xds.RestoreSnapshots(ctx, persistentCache, store, time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restored).To(BeZero())
+		})
+	})
+
+	Context("settings", func() {
+
+		It("restores the snapshots checkpointed to the directory of the settings", func() {
+			persistence := &v1.GlooOptions_XdsSnapshotPersistence{
+				Store: &v1.GlooOptions_XdsSnapshotPersistence_Directory{
+					Directory: GinkgoT().TempDir(),
+				},
+			}
+			persistentCache := xds.NewPersistentSnapshotCacheFromSettings(ctx, xds.NewAdsSnapshotCache(ctx), persistence)
+			persistentCache.SetSnapshot(nodeKey, snapshot)
+			store, err := xds.NewFileSnapshotStore(persistence.GetDirectory())
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(func(g Gomega) {
+				checkpoints, err := store.LoadAll(ctx)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(checkpoints).To(HaveLen(1))
+			}, "2s", "0.1s").Should(Succeed())
+
+			restartedCache := xds.NewPersistentSnapshotCacheFromSettings(ctx, xds.NewAdsSnapshotCache(ctx), persistence)
+			expectRestoredSnapshot(restartedCache)
+		})
+
+		It("does not persist snapshots by default", func() {
+			snapshotCache := xds.NewAdsSnapshotCache(ctx)
+			Expect(xds.NewPersistentSnapshotCacheFromSettings(ctx, snapshotCache, nil)).To(BeIdenticalTo(snapshotCache))
+		})
+	})
+
+	Context("ConfigMap store", func() {
+
+		It("restores the snapshots checkpointed by a previous cache", func() {
+			store := xds.NewConfigMapSnapshotStore(fake.NewSimpleClientset(), "gloo-system", "xds-snapshots")
+
+			checkpoints, err := store.LoadAll(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checkpoints).To(BeEmpty())
+
+			persistentCache := xds.NewPersistentSnapshotCache(ctx, xds.NewAdsSnapshotCache(ctx), store, time.Hour)
+			persistentCache.SetSnapshot(nodeKey, snapshot)
+			Eventually(func(g Gomega) {
+				checkpoints, err := store.LoadAll(ctx)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(checkpoints).To(HaveLen(1))
+			}, "2s", "0.1s").Should(Succeed())
+
+			restartedCache := xds.NewAdsSnapshotCache(ctx)
+			restored, err := xds.RestoreSnapshots(ctx, restartedCache, store, time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restored).To(Equal(1))
+			expectRestoredSnapshot(restartedCache)
+
+			Expect(store.Delete(ctx, nodeKey)).To(Succeed())
+			checkpoints, err = store.LoadAll(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checkpoints).To(BeEmpty())
+		})
+
+		It("saves the snapshot of each node to its own ConfigMap", func() {
+			kubeClient := fake.NewSimpleClientset()
+			store := xds.NewConfigMapSnapshotStore(kubeClient, "gloo-system", "xds-snapshots")
+			otherStore := xds.NewConfigMapSnapshotStore(kubeClient, "gloo-system", "other-xds-snapshots")
+
+			Expect(store.Save(ctx, "gloo-system~gateway-proxy", []byte("a"))).To(Succeed())
+			Expect(store.Save(ctx, "gloo-system~other-proxy", []byte("b"))).To(Succeed())
+			Expect(otherStore.Save(ctx, "gloo-system~gateway-proxy", []byte("c"))).To(Succeed())
+			Expect(store.Save(ctx, "gloo-system~gateway-proxy", []byte("d"))).To(Succeed())
+
+			configMaps, err := kubeClient.CoreV1().ConfigMaps("gloo-system").List(ctx, metav1.ListOptions{
+				LabelSelector: xds.ConfigMapSnapshotStoreLabel + "=xds-snapshots",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(configMaps.Items).To(HaveLen(2))
+			checkpoints, err := store.LoadAll(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checkpoints).To(ConsistOf([]byte("b"), []byte("d")))
+		})
+
+		It("doesn't save snapshots that a ConfigMap can't hold", func() {
+			store := xds.NewConfigMapSnapshotStore(fake.NewSimpleClientset(), "gloo-system", "xds-snapshots")
+
+			err := store.Save(ctx, nodeKey, make([]byte, xds.MaxConfigMapSnapshotSize+1))
+			Expect(err).To(MatchError(ContainSubstring("more than the %d bytes a ConfigMap can hold", xds.MaxConfigMapSnapshotSize)))
+			checkpoints, err := store.LoadAll(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checkpoints).To(BeEmpty())
+		})
+	})
+})
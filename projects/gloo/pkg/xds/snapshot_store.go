@@ -0,0 +1,185 @@
+package xds
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rotisserie/eris"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+const snapshotFileExtension = ".snapshot"
+
+var (
+	// Compile-time assertions
+	_ SnapshotStore = new(fileSnapshotStore)
+	_ SnapshotStore = new(configMapSnapshotStore)
+)
+
+// NewFileSnapshotStore returns a SnapshotStore that saves the snapshot of each node to a file of the directory,
+// which is created if it doesn't exist.
+func NewFileSnapshotStore(dir string) (SnapshotStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &fileSnapshotStore{dir: dir}, nil
+}
+
+type fileSnapshotStore struct {
+	dir string
+}
+
+func (f *fileSnapshotStore) path(nodeKey string) string {
+	return filepath.Join(f.dir, url.PathEscape(nodeKey)+snapshotFileExtension)
+}
+
+// Save writes the snapshot to a temporary file first, so that a crash never leaves a partially written snapshot.
+func (f *fileSnapshotStore) Save(_ context.Context, nodeKey string, data []byte) error {
+	tmp, err := os.CreateTemp(f.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), f.path(nodeKey))
+}
+
+func (f *fileSnapshotStore) Delete(_ context.Context, nodeKey string) error {
+	err := os.Remove(f.path(nodeKey))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (f *fileSnapshotStore) LoadAll(_ context.Context) ([][]byte, error) {
+	entries, err := os.ReadDir(f.dir)
+	if err != nil {
+		return nil, err
+	}
+	var out [][]byte
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), snapshotFileExtension) {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(f.dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, data)
+	}
+	return out, nil
+}
+
+const (
+	// ConfigMapSnapshotStoreLabel labels the ConfigMaps of a ConfigMap snapshot store with the name of the store
+	ConfigMapSnapshotStoreLabel = "gloo.solo.io/xds-snapshot-store"
+	// configMapNodeKeyAnnotation annotates the ConfigMap of the snapshot of a node with the key of the node
+	configMapNodeKeyAnnotation = "gloo.solo.io/xds-node-key"
+	// configMapSnapshotKey is the key of the snapshot in the binaryData of its ConfigMap
+	configMapSnapshotKey = "snapshot"
+
+	// MaxConfigMapSnapshotSize is the size of the largest encoded snapshot that a ConfigMap snapshot store saves.
+	// Kubernetes limits the size of a ConfigMap to 1MiB, which leaves some room for its metadata.
+	MaxConfigMapSnapshotSize = 1000 * 1024
+)
+
+// NewConfigMapSnapshotStore returns a SnapshotStore that saves the snapshot of each node to its own ConfigMap,
+// named after the store and the node, and labeled with the name of the store.
+// Snapshots larger than MaxConfigMapSnapshotSize can't be saved.
+func NewConfigMapSnapshotStore(kubeClient kubernetes.Interface, namespace, name string) SnapshotStore {
+	return &configMapSnapshotStore{
+		kubeClient: kubeClient,
+		namespace:  namespace,
+		name:       name,
+	}
+}
+
+type configMapSnapshotStore struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+	name       string
+}
+
+// configMapName returns the name of the ConfigMap of the snapshot of a node.
+// Node keys contain characters, such as '~', that are not valid in ConfigMap names, so they are hashed.
+func (c *configMapSnapshotStore) configMapName(nodeKey string) string {
+	hash := sha256.Sum256([]byte(nodeKey))
+	return c.name + "-" + hex.EncodeToString(hash[:8])
+}
+
+func (c *configMapSnapshotStore) Save(ctx context.Context, nodeKey string, data []byte) error {
+	if len(data) > MaxConfigMapSnapshotSize {
+		return eris.Errorf("the snapshot of node %s is %d bytes once compressed, more than the %d bytes a ConfigMap can hold; "+
+			"persist the snapshots to a directory instead", nodeKey, len(data), MaxConfigMapSnapshotSize)
+	}
+
+	configMaps := c.kubeClient.CoreV1().ConfigMaps(c.namespace)
+	name := c.configMapName(nodeKey)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		configMap, err := configMaps.Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			configMap = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   c.namespace,
+					Name:        name,
+					Labels:      map[string]string{ConfigMapSnapshotStoreLabel: c.name},
+					Annotations: map[string]string{configMapNodeKeyAnnotation: nodeKey},
+				},
+				BinaryData: map[string][]byte{configMapSnapshotKey: data},
+			}
+			_, err = configMaps.Create(ctx, configMap, metav1.CreateOptions{})
+			if apierrors.IsAlreadyExists(err) {
+				// retried as a conflict, so that the ConfigMap created concurrently is updated
+				return apierrors.NewConflict(corev1.Resource("configmaps"), name, err)
+			}
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		configMap.BinaryData = map[string][]byte{configMapSnapshotKey: data}
+		_, err = configMaps.Update(ctx, configMap, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func (c *configMapSnapshotStore) Delete(ctx context.Context, nodeKey string) error {
+	err := c.kubeClient.CoreV1().ConfigMaps(c.namespace).Delete(ctx, c.configMapName(nodeKey), metav1.DeleteOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (c *configMapSnapshotStore) LoadAll(ctx context.Context) ([][]byte, error) {
+	configMaps, err := c.kubeClient.CoreV1().ConfigMaps(c.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{ConfigMapSnapshotStoreLabel: c.name}).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var out [][]byte
+	for _, configMap := range configMaps.Items {
+		if data, ok := configMap.BinaryData[configMapSnapshotKey]; ok {
+			out = append(out, data)
+		}
+	}
+	return out, nil
+}
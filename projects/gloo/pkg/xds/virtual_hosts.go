@@ -0,0 +1,107 @@
+package xds
+
+import (
+	"strings"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/types"
+)
+
+// VirtualHostTypeV3 is the type URL of the resources served by VHDS.
+// The solo-kit control-plane only knows the EDS, CDS, RDS and LDS types, so it is defined here.
+const VirtualHostTypeV3 = types.TypePrefix + "/envoy.config.route.v3.VirtualHost"
+
+var (
+	// Compile-time assertion
+	_ cache.Resource = new(VirtualHostResource)
+)
+
+// VirtualHostResource wraps a VirtualHost served by VHDS so it can be served from an EnvoySnapshot.
+// Envoy expects the name of the VirtualHost to be prefixed with the name of its RouteConfiguration,
+// i.e. "<route configuration>/<virtual host>".
+type VirtualHostResource struct {
+	VirtualHost *envoy_config_route_v3.VirtualHost
+}
+
+func NewVirtualHostResource(virtualHost *envoy_config_route_v3.VirtualHost) *VirtualHostResource {
+	return &VirtualHostResource{VirtualHost: virtualHost}
+}
+
+func (v *VirtualHostResource) Self() cache.XdsResourceReference {
+	return cache.XdsResourceReference{
+		Name: v.VirtualHost.GetName(),
+		Type: VirtualHostTypeV3,
+	}
+}
+
+func (v *VirtualHostResource) ResourceProto() cache.ResourceProto {
+	return v.VirtualHost
+}
+
+// References returns the RouteConfiguration the VirtualHost belongs to.
+func (v *VirtualHostResource) References() []cache.XdsResourceReference {
+	routeConfigName, _, ok := SplitVirtualHostName(v.VirtualHost.GetName())
+	if !ok {
+		return nil
+	}
+	return []cache.XdsResourceReference{{
+		Name: routeConfigName,
+		Type: types.RouteTypeV3,
+	}}
+}
+
+// VirtualHostName returns the VHDS name of a VirtualHost of a RouteConfiguration.
+func VirtualHostName(routeConfigName, virtualHostName string) string {
+	return routeConfigName + "/" + virtualHostName
+}
+
+// SplitVirtualHostName splits a VHDS name into the name of the RouteConfiguration and the name of
+// the VirtualHost. Envoy uses the same format for the aliases it subscribes to on demand, where the
+// VirtualHost name is replaced by the authority of the request.
+func SplitVirtualHostName(name string) (string, string, bool) {
+	return strings.Cut(name, "/")
+}
+
+// matchVirtualHost returns the name of the VirtualHost of the RouteConfiguration that Envoy would select
+// for the authority, or the empty string if none matches.
+// domains are the domains of each VirtualHost, by VHDS name.
+// https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/route/v3/route_components.proto#envoy-v3-api-field-config-route-v3-virtualhost-domains
+func matchVirtualHost(domains map[string][]string, routeConfigName, authority string) string {
+	authority = strings.ToLower(authority)
+	var (
+		exact, suffix, prefix, catchAll string
+		// the longest wildcard match wins
+		suffixLen, prefixLen int
+	)
+	for name, virtualHostDomains := range domains {
+		rcName, _, ok := SplitVirtualHostName(name)
+		if !ok || rcName != routeConfigName {
+			continue
+		}
+		for _, domain := range virtualHostDomains {
+			domain = strings.ToLower(domain)
+			switch {
+			case domain == "*":
+				catchAll = name
+			case strings.HasPrefix(domain, "*"):
+				// the wildcard must match at least one character
+				if len(authority) > len(domain)-1 && strings.HasSuffix(authority, domain[1:]) && len(domain) > suffixLen {
+					suffix, suffixLen = name, len(domain)
+				}
+			case strings.HasSuffix(domain, "*"):
+				if len(authority) > len(domain)-1 && strings.HasPrefix(authority, domain[:len(domain)-1]) && len(domain) > prefixLen {
+					prefix, prefixLen = name, len(domain)
+				}
+			case domain == authority:
+				exact = name
+			}
+		}
+	}
+	for _, name := range []string{exact, suffix, prefix, catchAll} {
+		if name != "" {
+			return name
+		}
+	}
+	return ""
+}
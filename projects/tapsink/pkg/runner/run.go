@@ -0,0 +1,109 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+
+	envoytap "github.com/envoyproxy/go-control-plane/envoy/service/tap/v3"
+	"github.com/solo-io/gloo/projects/tapsink/pkg/tapservice"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/healthchecker"
+	"github.com/solo-io/go-utils/stats"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+func Run() {
+	clientSettings := NewSettings()
+	ctx := contextutils.WithLogger(context.Background(), "tap_sink")
+
+	if clientSettings.DebugPort != 0 {
+		stats.StartStatsServerWithPort(stats.StartupOptions{Port: clientSettings.DebugPort})
+	}
+
+	if err := os.MkdirAll(clientSettings.OutputDir, 0o755); err != nil {
+		panic(err)
+	}
+	service := tapservice.NewServer(tapservice.Options{
+		OutputDir: clientSettings.OutputDir,
+		Ctx:       ctx,
+	})
+
+	err := RunWithSettings(ctx, service, clientSettings)
+
+	if err != nil {
+		if ctx.Err() == nil {
+			// not a context error - panic
+			panic(err)
+		}
+	}
+}
+
+func RunWithSettings(ctx context.Context, service *tapservice.Server, clientSettings Settings) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		return StartGrpcTapSink(ctx, clientSettings, service)
+	})
+	eg.Go(func() error {
+		return StartHttpTapSink(ctx, clientSettings, service)
+	})
+	err := eg.Wait()
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+// StartGrpcTapSink serves the gRPC sink of the tap filter
+func StartGrpcTapSink(ctx context.Context, clientSettings Settings, service *tapservice.Server) error {
+	srv := grpc.NewServer()
+
+	envoytap.RegisterTapSinkServiceServer(srv, service)
+	hc := healthchecker.NewGrpc(clientSettings.ServiceName, health.NewServer(), false, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(srv, hc.GetServer())
+	reflection.Register(srv)
+
+	logger := contextutils.LoggerFrom(ctx)
+	addr := fmt.Sprintf(":%d", clientSettings.GrpcServerPort)
+	logger.Infof("tap sink running in [gRPC] mode, listening at [%s], writing to [%s]", addr, clientSettings.OutputDir)
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		logger.Errorw("Failed to announce on network", zap.Any("mode", "gRPC"), zap.Any("address", addr), zap.Any("error", err))
+		return err
+	}
+	go func() {
+		<-ctx.Done()
+		srv.Stop()
+		_ = lis.Close()
+	}()
+
+	return srv.Serve(lis)
+}
+
+// StartHttpTapSink serves the HTTP sink of the tap filter
+func StartHttpTapSink(ctx context.Context, clientSettings Settings, service *tapservice.Server) error {
+	logger := contextutils.LoggerFrom(ctx)
+	addr := fmt.Sprintf(":%d", clientSettings.HttpServerPort)
+	logger.Infof("tap sink running in [HTTP] mode, listening at [%s], writing to [%s]", addr, clientSettings.OutputDir)
+	srv := &http.Server{
+		Addr:    addr,
+		Handler: service,
+	}
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+
+	err := srv.ListenAndServe()
+	if err == http.ErrServerClosed {
+		return nil
+	}
+	return err
+}
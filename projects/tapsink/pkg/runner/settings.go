@@ -0,0 +1,24 @@
+package runner
+
+import (
+	"github.com/kelseyhightower/envconfig"
+)
+
+type Settings struct {
+	DebugPort      int    `envconfig:"DEBUG_PORT" default:"9091"`
+	GrpcServerPort int    `envconfig:"GRPC_SERVER_PORT" default:"8084"`
+	HttpServerPort int    `envconfig:"HTTP_SERVER_PORT" default:"8085"`
+	ServiceName    string `envconfig:"SERVICE_NAME" default:"TapSink"`
+	OutputDir      string `envconfig:"OUTPUT_DIR" default:"/tmp/taps"`
+}
+
+func NewSettings() Settings {
+	var s Settings
+
+	err := envconfig.Process("", &s)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
@@ -0,0 +1,267 @@
+package tapservice
+
+import (
+	"encoding/base64"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_data_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/data/tap/v3"
+)
+
+// The types of an HTTP Archive, as specified by http://www.softwareishard.com/blog/har-12-spec/
+
+type Har struct {
+	Log HarLog `json:"log"`
+}
+
+type HarLog struct {
+	Version string      `json:"version"`
+	Creator HarCreator  `json:"creator"`
+	Entries []*HarEntry `json:"entries"`
+}
+
+type HarCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type HarEntry struct {
+	StartedDateTime string       `json:"startedDateTime"`
+	Time            float64      `json:"time"`
+	Request         HarRequest   `json:"request"`
+	Response        HarResponse  `json:"response"`
+	Cache           struct{}     `json:"cache"`
+	Timings         HarTimings   `json:"timings"`
+	Downstream      *HarEndpoint `json:"_downstream,omitempty"`
+}
+
+type HarRequest struct {
+	Method      string         `json:"method"`
+	Url         string         `json:"url"`
+	HttpVersion string         `json:"httpVersion"`
+	Cookies     []HarNameValue `json:"cookies"`
+	Headers     []HarNameValue `json:"headers"`
+	QueryString []HarNameValue `json:"queryString"`
+	PostData    *HarPostData   `json:"postData,omitempty"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+	Comment     string         `json:"comment,omitempty"`
+}
+
+type HarResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HttpVersion string         `json:"httpVersion"`
+	Cookies     []HarNameValue `json:"cookies"`
+	Headers     []HarNameValue `json:"headers"`
+	Content     HarContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+	Comment     string         `json:"comment,omitempty"`
+}
+
+type HarNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type HarPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+	Encoding string `json:"encoding,omitempty"`
+}
+
+type HarContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+	Encoding string `json:"encoding,omitempty"`
+}
+
+type HarTimings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+// HarEndpoint is the downstream connection of an entry, which envoy records when record_downstream_connection is set
+type HarEndpoint struct {
+	LocalAddress  string `json:"localAddress,omitempty"`
+	RemoteAddress string `json:"remoteAddress,omitempty"`
+}
+
+const (
+	harVersion = "1.2"
+	// envoy doesn't record the HTTP version of the messages it taps
+	unknownHttpVersion = ""
+	truncatedComment   = "the body is truncated"
+)
+
+// NewHar returns the HTTP Archive of a trace, with a single entry
+func NewHar(trace *envoy_data_tap_v3.HttpBufferedTrace) *Har {
+	return &Har{
+		Log: HarLog{
+			Version: harVersion,
+			Creator: HarCreator{Name: "gloo-tap-sink", Version: harVersion},
+			Entries: []*HarEntry{newHarEntry(trace)},
+		},
+	}
+}
+
+func newHarEntry(trace *envoy_data_tap_v3.HttpBufferedTrace) *HarEntry {
+	request, response := trace.GetRequest(), trace.GetResponse()
+
+	startedAt := time.Now()
+	if request.GetHeadersReceivedTime() != nil {
+		startedAt = request.GetHeadersReceivedTime().AsTime()
+	}
+	var wait float64
+	if request.GetHeadersReceivedTime() != nil && response.GetHeadersReceivedTime() != nil {
+		wait = float64(response.GetHeadersReceivedTime().AsTime().Sub(startedAt)) / float64(time.Millisecond)
+	}
+
+	entry := &HarEntry{
+		StartedDateTime: startedAt.UTC().Format(time.RFC3339Nano),
+		Time:            wait,
+		Request:         newHarRequest(request),
+		Response:        newHarResponse(response),
+		Timings:         HarTimings{Wait: wait},
+	}
+	if connection := trace.GetDownstreamConnection(); connection != nil {
+		entry.Downstream = &HarEndpoint{
+			LocalAddress:  socketAddress(connection.GetLocalAddress()),
+			RemoteAddress: socketAddress(connection.GetRemoteAddress()),
+		}
+	}
+	return entry
+}
+
+func newHarRequest(message *envoy_data_tap_v3.HttpBufferedTrace_Message) HarRequest {
+	pseudoHeaders, headers := splitHeaders(message.GetHeaders())
+	path := pseudoHeaders[":path"]
+	scheme := pseudoHeaders[":scheme"]
+	if forwardedProto := headerValue(headers, "x-forwarded-proto"); forwardedProto != "" {
+		scheme = forwardedProto
+	}
+	if scheme == "" {
+		scheme = "http"
+	}
+
+	request := HarRequest{
+		Method:      pseudoHeaders[":method"],
+		Url:         scheme + "://" + pseudoHeaders[":authority"] + path,
+		HttpVersion: unknownHttpVersion,
+		Cookies:     []HarNameValue{},
+		Headers:     headers,
+		QueryString: queryString(path),
+		HeadersSize: -1,
+		BodySize:    -1,
+	}
+	if text, encoding, size := bodyText(message.GetBody()); size > 0 {
+		request.BodySize = size
+		request.PostData = &HarPostData{
+			MimeType: headerValue(headers, "content-type"),
+			Text:     text,
+			Encoding: encoding,
+		}
+	}
+	if message.GetBody().GetTruncated() {
+		request.Comment = truncatedComment
+	}
+	return request
+}
+
+func newHarResponse(message *envoy_data_tap_v3.HttpBufferedTrace_Message) HarResponse {
+	pseudoHeaders, headers := splitHeaders(message.GetHeaders())
+	status, _ := strconv.Atoi(pseudoHeaders[":status"])
+	text, encoding, size := bodyText(message.GetBody())
+
+	response := HarResponse{
+		Status:      status,
+		StatusText:  http.StatusText(status),
+		HttpVersion: unknownHttpVersion,
+		Cookies:     []HarNameValue{},
+		Headers:     headers,
+		Content: HarContent{
+			Size:     size,
+			MimeType: headerValue(headers, "content-type"),
+			Text:     text,
+			Encoding: encoding,
+		},
+		RedirectURL: headerValue(headers, "location"),
+		HeadersSize: -1,
+		BodySize:    size,
+	}
+	if message.GetBody().GetTruncated() {
+		response.Comment = truncatedComment
+	}
+	return response
+}
+
+// splitHeaders separates the pseudo-headers, such as ":path", from the other headers
+func splitHeaders(in []*envoy_config_core_v3.HeaderValue) (map[string]string, []HarNameValue) {
+	pseudoHeaders := map[string]string{}
+	headers := []HarNameValue{}
+	for _, header := range in {
+		if strings.HasPrefix(header.GetKey(), ":") {
+			pseudoHeaders[header.GetKey()] = header.GetValue()
+			continue
+		}
+		headers = append(headers, HarNameValue{Name: header.GetKey(), Value: header.GetValue()})
+	}
+	return pseudoHeaders, headers
+}
+
+func headerValue(headers []HarNameValue, name string) string {
+	for _, header := range headers {
+		if strings.EqualFold(header.Name, name) {
+			return header.Value
+		}
+	}
+	return ""
+}
+
+// queryString returns the parameters of the query of a path, in their order
+func queryString(path string) []HarNameValue {
+	params := []HarNameValue{}
+	_, rawQuery, _ := strings.Cut(path, "?")
+	for _, param := range strings.Split(rawQuery, "&") {
+		if param == "" {
+			continue
+		}
+		name, value, _ := strings.Cut(param, "=")
+		if unescaped, err := url.QueryUnescape(name); err == nil {
+			name = unescaped
+		}
+		if unescaped, err := url.QueryUnescape(value); err == nil {
+			value = unescaped
+		}
+		params = append(params, HarNameValue{Name: name, Value: value})
+	}
+	return params
+}
+
+// bodyText returns the text of a body, base64 encoded when envoy recorded it as bytes, and its size
+func bodyText(body *envoy_data_tap_v3.Body) (string, string, int) {
+	switch b := body.GetBodyType().(type) {
+	case *envoy_data_tap_v3.Body_AsString:
+		return b.AsString, "", len(b.AsString)
+	case *envoy_data_tap_v3.Body_AsBytes:
+		return base64.StdEncoding.EncodeToString(b.AsBytes), "base64", len(b.AsBytes)
+	}
+	return "", "", 0
+}
+
+func socketAddress(address *envoy_config_core_v3.Address) string {
+	socket := address.GetSocketAddress()
+	if socket == nil {
+		return ""
+	}
+	return net.JoinHostPort(socket.GetAddress(), strconv.Itoa(int(socket.GetPortValue())))
+}
@@ -0,0 +1,135 @@
+package tapservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	envoy_data_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/data/tap/v3"
+	envoytap "github.com/envoyproxy/go-control-plane/envoy/service/tap/v3"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Server receives the traces of the tap filter, from its gRPC sink or its HTTP sink, and writes the ones of HTTP
+// requests to the output directory as HAR files.
+type Server struct {
+	opts *Options
+	// distinguishes the files of traces that are written in the same nanosecond
+	sequence atomic.Uint64
+}
+
+var (
+	_ envoytap.TapSinkServiceServer = new(Server)
+	_ http.Handler                  = new(Server)
+)
+
+type Options struct {
+	OutputDir string
+	Ctx       context.Context
+}
+
+func NewServer(opts Options) *Server {
+	if opts.Ctx == nil {
+		opts.Ctx = context.Background()
+	}
+	return &Server{opts: &opts}
+}
+
+// StreamTaps receives the traces of the gRPC sink
+func (s *Server) StreamTaps(srv envoytap.TapSinkService_StreamTapsServer) error {
+	ctx := s.opts.Ctx
+	for {
+		msg, err := srv.Recv()
+		if err == io.EOF {
+			return srv.SendAndClose(&envoytap.StreamTapsResponse{})
+		}
+		if err != nil {
+			return err
+		}
+		// the identifier is only sent in the first message of the stream
+		if identifier := msg.GetIdentifier(); identifier != nil {
+			ctx = contextutils.WithLoggerValues(
+				s.opts.Ctx,
+				zap.String("tap_id", identifier.GetTapId()),
+				zap.String("node_id", identifier.GetNode().GetId()),
+				zap.String("node_cluster", identifier.GetNode().GetCluster()),
+			)
+		}
+		if err := s.WriteTrace(ctx, msg.GetTraceId(), msg.GetTrace()); err != nil {
+			return err
+		}
+	}
+}
+
+// ServeHTTP receives the traces of the HTTP sink, which posts them as JSON
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var trace envoy_data_tap_v3.TraceWrapper
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(body, &trace); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := s.WriteTrace(s.opts.Ctx, 0, &trace); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// WriteTrace writes the HAR file of a trace. Traces of other than buffered HTTP messages are skipped, since only
+// the tap filter of HTTP listeners is configured.
+func (s *Server) WriteTrace(ctx context.Context, traceId uint64, trace *envoy_data_tap_v3.TraceWrapper) error {
+	logger := contextutils.LoggerFrom(ctx)
+	httpTrace := trace.GetHttpBufferedTrace()
+	if httpTrace == nil {
+		logger.Debugw("skipping trace that is not a buffered HTTP trace", zap.Uint64("trace_id", traceId))
+		return nil
+	}
+
+	har, err := json.MarshalIndent(NewHar(httpTrace), "", "  ")
+	if err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%d-%d-%d.har", time.Now().UnixNano(), traceId, s.sequence.Add(1))
+	path, err := s.writeFile(name, har)
+	if err != nil {
+		logger.Errorw("failed to write HAR file", zap.String("file", name), zap.Error(err))
+		return err
+	}
+	logger.Infow("wrote trace", zap.Uint64("trace_id", traceId), zap.String("file", path))
+	return nil
+}
+
+// writeFile writes a file of the output directory atomically, so that readers never see a partial HAR file
+func (s *Server) writeFile(name string, content []byte) (string, error) {
+	tmp, err := os.CreateTemp(s.opts.OutputDir, name+".*.tmp")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		return "", err
+	}
+	path := filepath.Join(s.opts.OutputDir, name)
+	return path, os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,165 @@
+package tapservice_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_data_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/data/tap/v3"
+	envoytap "github.com/envoyproxy/go-control-plane/envoy/service/tap/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo/projects/tapsink/pkg/tapservice"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = Describe("Server", func() {
+
+	var (
+		outputDir string
+		server    *Server
+		received  time.Time
+		trace     *envoy_data_tap_v3.TraceWrapper
+	)
+
+	BeforeEach(func() {
+		outputDir = GinkgoT().TempDir()
+		server = NewServer(Options{OutputDir: outputDir, Ctx: context.Background()})
+		received = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		trace = &envoy_data_tap_v3.TraceWrapper{
+			Trace: &envoy_data_tap_v3.TraceWrapper_HttpBufferedTrace{
+				HttpBufferedTrace: &envoy_data_tap_v3.HttpBufferedTrace{
+					Request: &envoy_data_tap_v3.HttpBufferedTrace_Message{
+						Headers: []*envoy_config_core_v3.HeaderValue{
+							{Key: ":method", Value: "POST"},
+							{Key: ":path", Value: "/pets?species=cat&name=tom%20cat"},
+							{Key: ":authority", Value: "example.com"},
+							{Key: "x-forwarded-proto", Value: "https"},
+							{Key: "content-type", Value: "application/json"},
+						},
+						Body:                &envoy_data_tap_v3.Body{BodyType: &envoy_data_tap_v3.Body_AsString{AsString: `{"name":"tom"}`}},
+						HeadersReceivedTime: timestamppb.New(received),
+					},
+					Response: &envoy_data_tap_v3.HttpBufferedTrace_Message{
+						Headers: []*envoy_config_core_v3.HeaderValue{
+							{Key: ":status", Value: "201"},
+							{Key: "content-type", Value: "application/octet-stream"},
+						},
+						Body: &envoy_data_tap_v3.Body{
+							BodyType:  &envoy_data_tap_v3.Body_AsBytes{AsBytes: []byte("created")},
+							Truncated: true,
+						},
+						HeadersReceivedTime: timestamppb.New(received.Add(25 * time.Millisecond)),
+					},
+				},
+			},
+		}
+	})
+
+	readHars := func() []*Har {
+		files, err := filepath.Glob(filepath.Join(outputDir, "*.har"))
+		Expect(err).NotTo(HaveOccurred())
+		var hars []*Har
+		for _, file := range files {
+			content, err := os.ReadFile(file)
+			Expect(err).NotTo(HaveOccurred())
+			var har Har
+			Expect(json.Unmarshal(content, &har)).To(Succeed())
+			hars = append(hars, &har)
+		}
+		return hars
+	}
+
+	expectHar := func(har *Har) {
+		Expect(har.Log.Version).To(Equal("1.2"))
+		Expect(har.Log.Entries).To(HaveLen(1))
+		entry := har.Log.Entries[0]
+		Expect(entry.StartedDateTime).To(Equal("2024-05-01T12:00:00Z"))
+		Expect(entry.Time).To(BeNumerically("==", 25))
+
+		Expect(entry.Request.Method).To(Equal("POST"))
+		Expect(entry.Request.Url).To(Equal("https://example.com/pets?species=cat&name=tom%20cat"))
+		Expect(entry.Request.QueryString).To(Equal([]HarNameValue{{Name: "species", Value: "cat"}, {Name: "name", Value: "tom cat"}}))
+		Expect(entry.Request.Headers).To(ContainElement(HarNameValue{Name: "content-type", Value: "application/json"}))
+		Expect(entry.Request.Headers).NotTo(ContainElement(HaveField("Name", ":path")))
+		Expect(entry.Request.PostData).To(Equal(&HarPostData{MimeType: "application/json", Text: `{"name":"tom"}`}))
+
+		Expect(entry.Response.Status).To(Equal(201))
+		Expect(entry.Response.StatusText).To(Equal("Created"))
+		Expect(entry.Response.Content).To(Equal(HarContent{
+			Size:     len("created"),
+			MimeType: "application/octet-stream",
+			Text:     "Y3JlYXRlZA==",
+			Encoding: "base64",
+		}))
+		Expect(entry.Response.Comment).To(Equal("the body is truncated"))
+	}
+
+	It("writes the traces posted by the HTTP sink as HAR files", func() {
+		body, err := protojson.Marshal(trace)
+		Expect(err).NotTo(HaveOccurred())
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body)))
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		hars := readHars()
+		Expect(hars).To(HaveLen(1))
+		expectHar(hars[0])
+	})
+
+	It("rejects requests of the HTTP sink that are not traces", func() {
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte("not a trace"))))
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		Expect(readHars()).To(BeEmpty())
+	})
+
+	It("writes the traces streamed by the gRPC sink as HAR files", func() {
+		listener := bufconn.Listen(1024 * 1024)
+		grpcServer := grpc.NewServer()
+		envoytap.RegisterTapSinkServiceServer(grpcServer, server)
+		go grpcServer.Serve(listener)
+		defer grpcServer.Stop()
+
+		conn, err := grpc.NewClient("passthrough:///bufconn",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+			grpc.WithTransportCredentials(insecure.NewCredentials()))
+		Expect(err).NotTo(HaveOccurred())
+		defer conn.Close()
+
+		stream, err := envoytap.NewTapSinkServiceClient(conn).StreamTaps(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stream.Send(&envoytap.StreamTapsRequest{
+			Identifier: &envoytap.StreamTapsRequest_Identifier{TapId: "tap"},
+			TraceId:    1,
+			Trace:      trace,
+		})).To(Succeed())
+		Expect(stream.Send(&envoytap.StreamTapsRequest{TraceId: 2, Trace: trace})).To(Succeed())
+		// traces of sockets are skipped
+		Expect(stream.Send(&envoytap.StreamTapsRequest{
+			TraceId: 3,
+			Trace: &envoy_data_tap_v3.TraceWrapper{
+				Trace: &envoy_data_tap_v3.TraceWrapper_SocketBufferedTrace{SocketBufferedTrace: &envoy_data_tap_v3.SocketBufferedTrace{}},
+			},
+		})).To(Succeed())
+		_, err = stream.CloseAndRecv()
+		Expect(err).NotTo(HaveOccurred())
+
+		hars := readHars()
+		Expect(hars).To(HaveLen(2))
+		for _, har := range hars {
+			expectHar(har)
+		}
+	})
+})
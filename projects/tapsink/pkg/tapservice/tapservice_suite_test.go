@@ -0,0 +1,13 @@
+package tapservice_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestTapService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "TapService Suite")
+}